@@ -60,6 +60,9 @@ type queryOptions struct {
 	offset   int
 	limit    int
 	sort     string
+	cursor   string
+	// nextCursor receives the cursor for the next page of results
+	nextCursor *string
 }
 
 func makeQueryOptions(options []QueryOption) queryOptions {
@@ -112,6 +115,46 @@ func WithSort(field string) QueryOption {
 	}
 }
 
+// WithCursor requests the page of results following the cursor returned by a previous request with WithNextCursor. The
+// same sort must be used for each page.
+func WithCursor(cursor string) QueryOption {
+	return func(opts *queryOptions) {
+		opts.cursor = cursor
+	}
+}
+
+// WithNextCursor sets next to the cursor for the page of results following this request. It is set to "" when there
+// are no more results. Use with WithLimit to page through results and pass the cursor to WithCursor for the next page.
+func WithNextCursor(next *string) QueryOption {
+	return func(opts *queryOptions) {
+		opts.nextCursor = next
+	}
+}
+
+// listParams returns the query parameters used to request a page of a list
+func (opts queryOptions) listParams() map[string]string {
+	params := map[string]string{}
+	if opts.selector != "" {
+		params["selector"] = opts.selector
+	}
+	if opts.query != "" {
+		params["query"] = opts.query
+	}
+	if opts.offset != 0 {
+		params["offset"] = strconv.Itoa(opts.offset)
+	}
+	if opts.limit != 0 {
+		params["limit"] = strconv.Itoa(opts.limit)
+	}
+	if opts.sort != "" {
+		params["sort"] = opts.sort
+	}
+	if opts.cursor != "" {
+		params["cursor"] = opts.cursor
+	}
+	return params
+}
+
 // BindPlane TODO(doc)
 type BindPlane interface {
 	// Agents TODO(doc)
@@ -120,8 +163,17 @@ type BindPlane interface {
 	Agent(ctx context.Context, id string) (*model.Agent, error)
 	DeleteAgents(ctx context.Context, agentIDs []string) ([]*model.Agent, error)
 
+	// WatchAgents streams the agents matching the selector and query options followed by changes to those agents. The
+	// channels are closed when the context is done or the server closes the stream. If the server closes the stream,
+	// the reason is sent on the error channel.
+	WatchAgents(ctx context.Context, options ...QueryOption) (<-chan *model.AgentWatchResponse, <-chan error, error)
+
 	// Configurations TODO(doc)
-	Configurations(ctx context.Context) ([]*model.Configuration, error)
+	Configurations(ctx context.Context, options ...QueryOption) ([]*model.Configuration, error)
+	// WatchConfigurations streams the configurations matching the selector and query options followed by changes to
+	// those configurations. The channels are closed when the context is done or the server closes the stream. If the
+	// server closes the stream, the reason is sent on the error channel.
+	WatchConfigurations(ctx context.Context, options ...QueryOption) (<-chan *model.ConfigurationWatchResponse, <-chan error, error)
 	// Configuration TODO(doc)
 	Configuration(ctx context.Context, name string) (*model.Configuration, error)
 	// DeleteConfiguration TODO(doc)
@@ -129,30 +181,85 @@ type BindPlane interface {
 	// RawConfiguration TODO(doc)
 	RawConfiguration(ctx context.Context, name string) (string, error)
 
-	Sources(ctx context.Context) ([]*model.Source, error)
+	Sources(ctx context.Context, options ...QueryOption) ([]*model.Source, error)
 	Source(ctx context.Context, name string) (*model.Source, error)
 	DeleteSource(ctx context.Context, name string) error
 
-	SourceTypes(ctx context.Context) ([]*model.SourceType, error)
+	SourceTypes(ctx context.Context, options ...QueryOption) ([]*model.SourceType, error)
 	SourceType(ctx context.Context, name string) (*model.SourceType, error)
 	DeleteSourceType(ctx context.Context, name string) error
 
-	Processors(ctx context.Context) ([]*model.Processor, error)
+	Processors(ctx context.Context, options ...QueryOption) ([]*model.Processor, error)
 	Processor(ctx context.Context, name string) (*model.Processor, error)
 	DeleteProcessor(ctx context.Context, name string) error
 
-	ProcessorTypes(ctx context.Context) ([]*model.ProcessorType, error)
+	ProcessorTypes(ctx context.Context, options ...QueryOption) ([]*model.ProcessorType, error)
 	ProcessorType(ctx context.Context, name string) (*model.ProcessorType, error)
 	DeleteProcessorType(ctx context.Context, name string) error
 
-	Destinations(ctx context.Context) ([]*model.Destination, error)
+	Destinations(ctx context.Context, options ...QueryOption) ([]*model.Destination, error)
 	Destination(ctx context.Context, name string) (*model.Destination, error)
 	DeleteDestination(ctx context.Context, name string) error
 
-	DestinationTypes(ctx context.Context) ([]*model.DestinationType, error)
+	DestinationTypes(ctx context.Context, options ...QueryOption) ([]*model.DestinationType, error)
 	DestinationType(ctx context.Context, name string) (*model.DestinationType, error)
 	DeleteDestinationType(ctx context.Context, name string) error
 
+	Connectors(ctx context.Context, options ...QueryOption) ([]*model.Connector, error)
+	Connector(ctx context.Context, name string) (*model.Connector, error)
+	DeleteConnector(ctx context.Context, name string) error
+
+	ConnectorTypes(ctx context.Context, options ...QueryOption) ([]*model.ConnectorType, error)
+	ConnectorType(ctx context.Context, name string) (*model.ConnectorType, error)
+	DeleteConnectorType(ctx context.Context, name string) error
+
+	// ResourceTypeVersions returns the available versions of the SourceType, ProcessorType, DestinationType, or
+	// ConnectorType sorted from earliest to latest
+	ResourceTypeVersions(ctx context.Context, kind model.Kind, name string) ([]*model.ResourceType, error)
+	// OutdatedResources returns the resources that are pinned to a version of their resource type that is earlier than
+	// the current version
+	OutdatedResources(ctx context.Context) ([]*model.OutdatedResource, error)
+	// MigrateResources migrates outdated resources to the current version of their resource types. If kind is empty,
+	// all outdated resources are migrated. If name is empty, all outdated resources of the kind are migrated.
+	MigrateResources(ctx context.Context, kind model.Kind, name string) ([]*model.AnyResourceStatus, error)
+
+	// Dependents returns the resources affected by a change to the resource with the specified kind and name and the
+	// ids of the agents using the affected configurations
+	Dependents(ctx context.Context, kind model.Kind, name string) (*model.ResourceDependents, error)
+	// Dependencies returns the resources used by the resource with the specified kind and name
+	Dependencies(ctx context.Context, kind model.Kind, name string) ([]*model.ResourceReference, error)
+
+	// CatalogResourceTypes returns the resource types in the resource type catalog and their status compared to the
+	// installed resource types
+	CatalogResourceTypes(ctx context.Context) ([]*model.CatalogResourceType, error)
+	// CatalogDiff returns the resource types in the catalog that differ from the installed resource types, including
+	// a diff of the changes
+	CatalogDiff(ctx context.Context) ([]*model.CatalogResourceType, error)
+	// SyncCatalog installs the new and updated resource types from the resource type catalog
+	SyncCatalog(ctx context.Context) ([]*model.CatalogResourceType, error)
+
+	// Sync creates and updates the resources that differ from the store and labels them as managed by sync. With prune,
+	// resources managed by sync that are not included are deleted. With dryRun, the store is not changed.
+	Sync(ctx context.Context, resources []*model.AnyResource, prune bool, dryRun bool) ([]*model.SyncResource, error)
+
+	// Backups returns the backups of the store saved by the server, most recent first
+	Backups(ctx context.Context) ([]*model.Backup, error)
+	// CreateBackup saves a backup of the store while the server is running
+	CreateBackup(ctx context.Context) (*model.Backup, error)
+	// StartStoreMigration copies the store of the server to the target store. With follow, changes are copied until
+	// StopStoreMigration is called.
+	StartStoreMigration(ctx context.Context, request *model.StoreMigrationRequest) (*model.StoreMigration, error)
+	// StopStoreMigration stops following changes and copies and verifies the stores one last time
+	StopStoreMigration(ctx context.Context) (*model.StoreMigration, error)
+
+	AgentGroups(ctx context.Context, options ...QueryOption) ([]*model.AgentGroup, error)
+	AgentGroup(ctx context.Context, name string) (*model.AgentGroup, error)
+	DeleteAgentGroup(ctx context.Context, name string) error
+	// AgentGroupAgents returns the agents that are members of the agent group
+	AgentGroupAgents(ctx context.Context, name string) ([]*model.Agent, error)
+	// AgentGroupSummary returns the number of agents in the agent group by status and version
+	AgentGroupSummary(ctx context.Context, name string) (*model.AgentGroupSummary, error)
+
 	// Apply TODO(doc)
 	Apply(ctx context.Context, r []*model.AnyResource) ([]*model.AnyResourceStatus, error)
 	// Delete TODO(doc)
@@ -171,11 +278,45 @@ type BindPlane interface {
 	// ApplyAgentLabels applies the specified labels to an agent, merging the specified labels with the existing labels
 	// and returning the labels of the agent
 	ApplyAgentLabels(ctx context.Context, id string, labels *model.Labels, override bool) (*model.Labels, error)
+
+	// ExecuteAgentCommand sends a command to the agents with the specified ids and the agents matching the selector,
+	// returning the commands that were created. Agents that are not connected will receive the command when they
+	// connect.
+	ExecuteAgentCommand(ctx context.Context, commandType model.AgentCommandType, ids []string, selector string) ([]*model.AgentCommand, error)
+	// AgentCommands returns the commands sent to an agent
+	AgentCommands(ctx context.Context, id string) ([]*model.AgentCommand, error)
+
+	// DiagnoseAgent requests a diagnostics bundle from the agent and returns the collect-diagnostics command. The bundle
+	// will have the same ID as the command and will be available when the command succeeds.
+	DiagnoseAgent(ctx context.Context, id string) (*model.AgentCommand, error)
+	// AgentDiagnostics returns the diagnostics bundles collected from an agent
+	AgentDiagnostics(ctx context.Context, id string) ([]*model.DiagnosticsBundle, error)
+	// AgentDiagnosticsBundle returns the contents of a diagnostics bundle as a gzipped tar archive
+	AgentDiagnosticsBundle(ctx context.Context, id string, bundleID string) ([]byte, error)
+
+	// AgentsDrift returns the configuration drift of the agents matching the selector and query options. Agents that are
+	// in sync are only included if all is true.
+	AgentsDrift(ctx context.Context, all bool, options ...QueryOption) ([]*model.AgentDriftReport, error)
+
+	// AgentPendingChanges returns the configuration changes deferred by the schedule of the configuration for the agents
+	// matching the selector and query options
+	AgentPendingChanges(ctx context.Context, options ...QueryOption) ([]*model.AgentPendingChange, error)
+	// ApplyAgentPendingChange applies the pending change of an agent without waiting for the schedule of the configuration
+	ApplyAgentPendingChange(ctx context.Context, id string) (*model.AgentPendingChange, error)
+
+	// ExecuteAgentGroupCommand sends a command to the members of the agent group, returning the commands that were
+	// created
+	ExecuteAgentGroupCommand(ctx context.Context, commandType model.AgentCommandType, group string) ([]*model.AgentCommand, error)
+	// ApplyAgentGroupLabels applies the specified labels to the members of the agent group, merging the specified labels
+	// with the existing labels of each agent
+	ApplyAgentGroupLabels(ctx context.Context, group string, labels *model.Labels, overwrite bool) error
 }
 
 type bindplaneClient struct {
 	client *resty.Client
-	config *common.Client
+	// streamClient is used for requests that stream responses and has no timeout
+	streamClient *resty.Client
+	config       *common.Client
 	*zap.Logger
 }
 
@@ -183,21 +324,27 @@ var _ BindPlane = (*bindplaneClient)(nil)
 
 // NewBindPlane takes a client configuration, logger and returns a new BindPlane.
 func NewBindPlane(config *common.Client, logger *zap.Logger) (BindPlane, error) {
-	client := resty.New()
-	client.SetTimeout(time.Second * 20)
-	client.SetBasicAuth(config.Username, config.Password)
-	client.SetBaseURL(fmt.Sprintf("%s/v1", config.BindPlaneURL()))
-
 	tlsConfig, err := tlsClient(config.Certificate, config.PrivateKey, config.CertificateAuthority, config.InsecureSkipVerify)
 	if err != nil {
 		return nil, fmt.Errorf("failed to configure TLS client: %w", err)
 	}
-	client.SetTLSClientConfig(tlsConfig)
+
+	newClient := func() *resty.Client {
+		client := resty.New()
+		client.SetBasicAuth(config.Username, config.Password)
+		client.SetBaseURL(fmt.Sprintf("%s/v1", config.BindPlaneURL()))
+		client.SetTLSClientConfig(tlsConfig)
+		return client
+	}
+
+	client := newClient()
+	client.SetTimeout(time.Second * 20)
 
 	return &bindplaneClient{
-		client: client,
-		config: config,
-		Logger: logger.Named("bindplane-client"),
+		client:       client,
+		streamClient: newClient(),
+		config:       config,
+		Logger:       logger.Named("bindplane-client"),
 	}, nil
 }
 
@@ -209,18 +356,17 @@ func (c *bindplaneClient) Agents(ctx context.Context, options ...QueryOption) ([
 	ar := &model.AgentsResponse{}
 	resp, err := c.client.R().
 		SetResult(ar).
-		SetQueryParam("selector", opts.selector).
-		SetQueryParam("query", opts.query).
-		SetQueryParam("offset", fmt.Sprintf("%d", opts.offset)).
-		SetQueryParam("limit", fmt.Sprintf("%d", opts.limit)).
-		SetQueryParam("sort", opts.sort).
+		SetQueryParams(opts.listParams()).
 		Get("/agents")
 	if err != nil {
 		logRequestError(c.Logger, err, "/agents")
 		return nil, err
 	}
 
-	return ar.Agents, c.statusError(resp, err, "unable to get agents")
+	if err := c.statusError(resp, err, "unable to get agents"); err != nil {
+		return nil, err
+	}
+	return ar.Agents, setNextCursor(resp, opts)
 }
 
 // Agent TODO(doc)
@@ -249,13 +395,26 @@ func (c *bindplaneClient) DeleteAgents(ctx context.Context, ids []string) ([]*mo
 	return result.Agents, c.statusError(resp, err, "unable to delete agents")
 }
 
+// WatchAgents streams the agents matching the selector and query options followed by changes to those agents
+func (c *bindplaneClient) WatchAgents(ctx context.Context, options ...QueryOption) (<-chan *model.AgentWatchResponse, <-chan error, error) {
+	c.Debug("WatchAgents called")
+	return watch[model.AgentWatchResponse](ctx, c, "/agents", makeQueryOptions(options))
+}
+
 // Configurations TODO(doc)
-func (c *bindplaneClient) Configurations(ctx context.Context) ([]*model.Configuration, error) {
+func (c *bindplaneClient) Configurations(ctx context.Context, options ...QueryOption) ([]*model.Configuration, error) {
 	c.Debug("Configurations called")
 
 	pr := &model.ConfigurationsResponse{}
-	resp, err := c.client.R().SetResult(pr).Get("/configurations")
-	return pr.Configurations, c.statusError(resp, err, "unable to get configurations")
+	err := c.resources(ctx, "/configurations", pr, options)
+	return pr.Configurations, err
+}
+
+// WatchConfigurations streams the configurations matching the selector and query options followed by changes to those
+// configurations
+func (c *bindplaneClient) WatchConfigurations(ctx context.Context, options ...QueryOption) (<-chan *model.ConfigurationWatchResponse, <-chan error, error) {
+	c.Debug("WatchConfigurations called")
+	return watch[model.ConfigurationWatchResponse](ctx, c, "/configurations", makeQueryOptions(options))
 }
 
 // ----------------------------------------------------------------------
@@ -283,9 +442,9 @@ func (c *bindplaneClient) RawConfiguration(ctx context.Context, name string) (st
 
 // ----------------------------------------------------------------------
 
-func (c *bindplaneClient) Sources(ctx context.Context) ([]*model.Source, error) {
+func (c *bindplaneClient) Sources(ctx context.Context, options ...QueryOption) ([]*model.Source, error) {
 	result := model.SourcesResponse{}
-	err := c.resources(ctx, "/sources", &result)
+	err := c.resources(ctx, "/sources", &result, options)
 	return result.Sources, err
 }
 
@@ -301,9 +460,9 @@ func (c *bindplaneClient) DeleteSource(ctx context.Context, name string) error {
 
 // ----------------------------------------------------------------------
 
-func (c *bindplaneClient) SourceTypes(ctx context.Context) ([]*model.SourceType, error) {
+func (c *bindplaneClient) SourceTypes(ctx context.Context, options ...QueryOption) ([]*model.SourceType, error) {
 	result := model.SourceTypesResponse{}
-	err := c.resources(ctx, "/source-types", &result)
+	err := c.resources(ctx, "/source-types", &result, options)
 	return result.SourceTypes, err
 }
 
@@ -319,9 +478,9 @@ func (c *bindplaneClient) DeleteSourceType(ctx context.Context, name string) err
 
 // ----------------------------------------------------------------------
 
-func (c *bindplaneClient) Processors(ctx context.Context) ([]*model.Processor, error) {
+func (c *bindplaneClient) Processors(ctx context.Context, options ...QueryOption) ([]*model.Processor, error) {
 	result := model.ProcessorsResponse{}
-	err := c.resources(ctx, "/processors", &result)
+	err := c.resources(ctx, "/processors", &result, options)
 	return result.Processors, err
 }
 
@@ -337,9 +496,9 @@ func (c *bindplaneClient) DeleteProcessor(ctx context.Context, name string) erro
 
 // ----------------------------------------------------------------------
 
-func (c *bindplaneClient) ProcessorTypes(ctx context.Context) ([]*model.ProcessorType, error) {
+func (c *bindplaneClient) ProcessorTypes(ctx context.Context, options ...QueryOption) ([]*model.ProcessorType, error) {
 	result := model.ProcessorTypesResponse{}
-	err := c.resources(ctx, "/processor-types", &result)
+	err := c.resources(ctx, "/processor-types", &result, options)
 	return result.ProcessorTypes, err
 }
 
@@ -355,9 +514,9 @@ func (c *bindplaneClient) DeleteProcessorType(ctx context.Context, name string)
 
 // ----------------------------------------------------------------------
 
-func (c *bindplaneClient) Destinations(ctx context.Context) ([]*model.Destination, error) {
+func (c *bindplaneClient) Destinations(ctx context.Context, options ...QueryOption) ([]*model.Destination, error) {
 	result := model.DestinationsResponse{}
-	err := c.resources(ctx, "/destinations", &result)
+	err := c.resources(ctx, "/destinations", &result, options)
 	return result.Destinations, err
 }
 
@@ -373,9 +532,9 @@ func (c *bindplaneClient) DeleteDestination(ctx context.Context, name string) er
 
 // ----------------------------------------------------------------------
 
-func (c *bindplaneClient) DestinationTypes(ctx context.Context) ([]*model.DestinationType, error) {
+func (c *bindplaneClient) DestinationTypes(ctx context.Context, options ...QueryOption) ([]*model.DestinationType, error) {
 	result := model.DestinationTypesResponse{}
-	err := c.resources(ctx, "/destination-types", &result)
+	err := c.resources(ctx, "/destination-types", &result, options)
 	return result.DestinationTypes, err
 }
 
@@ -391,6 +550,280 @@ func (c *bindplaneClient) DeleteDestinationType(ctx context.Context, name string
 
 // ----------------------------------------------------------------------
 
+func (c *bindplaneClient) Connectors(ctx context.Context, options ...QueryOption) ([]*model.Connector, error) {
+	result := model.ConnectorsResponse{}
+	err := c.resources(ctx, "/connectors", &result, options)
+	return result.Connectors, err
+}
+
+func (c *bindplaneClient) Connector(ctx context.Context, name string) (*model.Connector, error) {
+	result := model.ConnectorResponse{}
+	err := c.resource(ctx, "/connectors", name, &result)
+	return result.Connector, err
+}
+
+func (c *bindplaneClient) DeleteConnector(ctx context.Context, name string) error {
+	return c.deleteResource(ctx, "/connectors", name)
+}
+
+// ----------------------------------------------------------------------
+
+func (c *bindplaneClient) ConnectorTypes(ctx context.Context, options ...QueryOption) ([]*model.ConnectorType, error) {
+	result := model.ConnectorTypesResponse{}
+	err := c.resources(ctx, "/connector-types", &result, options)
+	return result.ConnectorTypes, err
+}
+
+func (c *bindplaneClient) ConnectorType(ctx context.Context, name string) (*model.ConnectorType, error) {
+	result := model.ConnectorTypeResponse{}
+	err := c.resource(ctx, "/connector-types", name, &result)
+	return result.ConnectorType, err
+}
+
+func (c *bindplaneClient) DeleteConnectorType(ctx context.Context, name string) error {
+	return c.deleteResource(ctx, "/connector-types", name)
+}
+
+// ----------------------------------------------------------------------
+
+func (c *bindplaneClient) AgentGroups(ctx context.Context, options ...QueryOption) ([]*model.AgentGroup, error) {
+	result := model.AgentGroupsResponse{}
+	err := c.resources(ctx, "/agent-groups", &result, options)
+	return result.AgentGroups, err
+}
+
+func (c *bindplaneClient) AgentGroup(ctx context.Context, name string) (*model.AgentGroup, error) {
+	result := model.AgentGroupResponse{}
+	err := c.resource(ctx, "/agent-groups", name, &result)
+	return result.AgentGroup, err
+}
+
+func (c *bindplaneClient) DeleteAgentGroup(ctx context.Context, name string) error {
+	return c.deleteResource(ctx, "/agent-groups", name)
+}
+
+// AgentGroupAgents returns the agents that are members of the agent group
+func (c *bindplaneClient) AgentGroupAgents(ctx context.Context, name string) ([]*model.Agent, error) {
+	result := model.AgentsResponse{}
+	err := c.get(ctx, fmt.Sprintf("/agent-groups/%s/agents", name), &result)
+	return result.Agents, err
+}
+
+// AgentGroupSummary returns the number of agents in the agent group by status and version
+func (c *bindplaneClient) AgentGroupSummary(ctx context.Context, name string) (*model.AgentGroupSummary, error) {
+	result := model.AgentGroupSummaryResponse{}
+	err := c.get(ctx, fmt.Sprintf("/agent-groups/%s/summary", name), &result)
+	return result.Summary, err
+}
+
+// ----------------------------------------------------------------------
+
+// ResourceTypeVersions returns the available versions of the SourceType, ProcessorType, DestinationType, or
+// ConnectorType sorted from earliest to latest
+func (c *bindplaneClient) ResourceTypeVersions(ctx context.Context, kind model.Kind, name string) ([]*model.ResourceType, error) {
+	var resourcesURL string
+	switch kind {
+	case model.KindSourceType:
+		resourcesURL = "/source-types"
+	case model.KindProcessorType:
+		resourcesURL = "/processor-types"
+	case model.KindDestinationType:
+		resourcesURL = "/destination-types"
+	case model.KindConnectorType:
+		resourcesURL = "/connector-types"
+	default:
+		return nil, fmt.Errorf("%s is not a resource type", kind)
+	}
+	result := model.ResourceTypeVersionsResponse{}
+	err := c.get(ctx, fmt.Sprintf("%s/%s/versions", resourcesURL, name), &result)
+	return result.ResourceTypes, err
+}
+
+// Dependents returns the resources affected by a change to the resource with the specified kind and name and the ids of
+// the agents using the affected configurations
+func (c *bindplaneClient) Dependents(ctx context.Context, kind model.Kind, name string) (*model.ResourceDependents, error) {
+	resourcesURL, err := dependentsResourcesURL(kind)
+	if err != nil {
+		return nil, err
+	}
+	result := model.ResourceDependentsResponse{}
+	err = c.get(ctx, fmt.Sprintf("%s/%s/dependents", resourcesURL, name), &result)
+	if err != nil {
+		return nil, err
+	}
+	return &model.ResourceDependents{
+		Resources: result.Resources,
+		AgentIDs:  result.AgentIDs,
+	}, nil
+}
+
+// Dependencies returns the resources used by the resource with the specified kind and name
+func (c *bindplaneClient) Dependencies(ctx context.Context, kind model.Kind, name string) ([]*model.ResourceReference, error) {
+	resourcesURL, err := dependentsResourcesURL(kind)
+	if err != nil {
+		return nil, err
+	}
+	result := model.ResourceDependenciesResponse{}
+	err = c.get(ctx, fmt.Sprintf("%s/%s/dependencies", resourcesURL, name), &result)
+	return result.Resources, err
+}
+
+// dependentsResourcesURL returns the url of the resources of the kind that have dependents and dependencies
+func dependentsResourcesURL(kind model.Kind) (string, error) {
+	switch kind {
+	case model.KindConfiguration:
+		return "/configurations", nil
+	case model.KindSource:
+		return "/sources", nil
+	case model.KindSourceType:
+		return "/source-types", nil
+	case model.KindProcessor:
+		return "/processors", nil
+	case model.KindProcessorType:
+		return "/processor-types", nil
+	case model.KindDestination:
+		return "/destinations", nil
+	case model.KindDestinationType:
+		return "/destination-types", nil
+	case model.KindConnector:
+		return "/connectors", nil
+	case model.KindConnectorType:
+		return "/connector-types", nil
+	case model.KindAgentGroup:
+		return "/agent-groups", nil
+	}
+	return "", fmt.Errorf("%s does not have dependents or dependencies", kind)
+}
+
+// OutdatedResources returns the resources that are pinned to a version of their resource type that is earlier than the
+// current version
+func (c *bindplaneClient) OutdatedResources(ctx context.Context) ([]*model.OutdatedResource, error) {
+	result := model.OutdatedResourcesResponse{}
+	err := c.get(ctx, "/resource-types/outdated", &result)
+	return result.Resources, err
+}
+
+// MigrateResources migrates outdated resources to the current version of their resource types. If kind is empty, all
+// outdated resources are migrated. If name is empty, all outdated resources of the kind are migrated.
+func (c *bindplaneClient) MigrateResources(ctx context.Context, kind model.Kind, name string) ([]*model.AnyResourceStatus, error) {
+	c.Debug("MigrateResources called")
+
+	payload := model.MigrateResourcesPayload{
+		Kind: kind,
+		Name: name,
+	}
+	ar := &model.ApplyResponseClientSide{}
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(payload).
+		SetResult(ar).
+		Post("/resource-types/migrate")
+	return ar.Updates, c.statusError(resp, err, "unable to migrate resources")
+}
+
+// CatalogResourceTypes returns the resource types in the resource type catalog and their status compared to the
+// installed resource types
+func (c *bindplaneClient) CatalogResourceTypes(ctx context.Context) ([]*model.CatalogResourceType, error) {
+	result := model.CatalogResponse{}
+	err := c.get(ctx, "/catalog", &result)
+	return result.ResourceTypes, err
+}
+
+// CatalogDiff returns the resource types in the catalog that differ from the installed resource types, including a
+// diff of the changes
+func (c *bindplaneClient) CatalogDiff(ctx context.Context) ([]*model.CatalogResourceType, error) {
+	result := model.CatalogResponse{}
+	err := c.get(ctx, "/catalog/diff", &result)
+	return result.ResourceTypes, err
+}
+
+// SyncCatalog installs the new and updated resource types from the resource type catalog
+func (c *bindplaneClient) SyncCatalog(ctx context.Context) ([]*model.CatalogResourceType, error) {
+	c.Debug("SyncCatalog called")
+
+	result := model.CatalogResponse{}
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&result).
+		Post("/catalog/sync")
+	return result.ResourceTypes, c.statusError(resp, err, "unable to sync the resource type catalog")
+}
+
+// ----------------------------------------------------------------------
+
+// Sync creates and updates the resources that differ from the store and labels them as managed by sync. With prune,
+// resources managed by sync that are not included are deleted. With dryRun, the store is not changed.
+func (c *bindplaneClient) Sync(ctx context.Context, resources []*model.AnyResource, prune bool, dryRun bool) ([]*model.SyncResource, error) {
+	c.Debug("Sync called")
+
+	result := model.SyncResponse{}
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(model.SyncPayload{Resources: resources, Prune: prune, DryRun: dryRun}).
+		SetResult(&result).
+		Post("/sync")
+	return result.Resources, c.statusError(resp, err, "unable to sync resources")
+}
+
+// ----------------------------------------------------------------------
+
+// Backups returns the backups of the store saved by the server, most recent first
+func (c *bindplaneClient) Backups(ctx context.Context) ([]*model.Backup, error) {
+	c.Debug("Backups called")
+
+	result := model.BackupsResponse{}
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&result).
+		Get("/backups")
+	return result.Backups, c.statusError(resp, err, "unable to get backups")
+}
+
+// CreateBackup saves a backup of the store while the server is running
+func (c *bindplaneClient) CreateBackup(ctx context.Context) (*model.Backup, error) {
+	c.Debug("CreateBackup called")
+
+	result := model.BackupResponse{}
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&result).
+		Post("/backups")
+	return result.Backup, c.statusError(resp, err, "unable to create a backup")
+}
+
+// StartStoreMigration copies the store of the server to the target store. With follow, changes are copied until
+// StopStoreMigration is called. The stream client is used because copying a large store can take longer than the
+// timeout of other requests.
+func (c *bindplaneClient) StartStoreMigration(ctx context.Context, request *model.StoreMigrationRequest) (*model.StoreMigration, error) {
+	c.Debug("StartStoreMigration called")
+
+	result := model.StoreMigrationResponse{}
+	errorResponse := rest.ErrorResponse{}
+	resp, err := c.streamClient.R().
+		SetContext(ctx).
+		SetBody(request).
+		SetResult(&result).
+		SetError(&errorResponse).
+		Post("/store/migration")
+	return result.Migration, c.responseError(resp, err, &errorResponse, "unable to start the store migration")
+}
+
+// StopStoreMigration stops following changes and copies and verifies the stores one last time
+func (c *bindplaneClient) StopStoreMigration(ctx context.Context) (*model.StoreMigration, error) {
+	c.Debug("StopStoreMigration called")
+
+	result := model.StoreMigrationResponse{}
+	errorResponse := rest.ErrorResponse{}
+	resp, err := c.streamClient.R().
+		SetContext(ctx).
+		SetResult(&result).
+		SetError(&errorResponse).
+		Delete("/store/migration")
+	return result.Migration, c.responseError(resp, err, &errorResponse, "unable to stop the store migration")
+}
+
+// ----------------------------------------------------------------------
+
 // Apply TODO(doc)
 func (c *bindplaneClient) Apply(ctx context.Context, resources []*model.AnyResource) ([]*model.AnyResourceStatus, error) {
 	c.Debug("Apply called")
@@ -546,11 +979,237 @@ func (c *bindplaneClient) ApplyAgentLabels(ctx context.Context, id string, label
 	return response.Labels, err
 }
 
+// ExecuteAgentCommand sends a command to the agents with the specified ids and the agents matching the selector,
+// returning the commands that were created. Agents that are not connected will receive the command when they connect.
+func (c *bindplaneClient) ExecuteAgentCommand(ctx context.Context, commandType model.AgentCommandType, ids []string, selector string) ([]*model.AgentCommand, error) {
+	c.Debug("ExecuteAgentCommand called")
+
+	payload := model.AgentCommandPayload{
+		Type:     string(commandType),
+		IDs:      ids,
+		Selector: selector,
+	}
+	var response model.AgentCommandsResponse
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(payload).
+		SetResult(&response).
+		Post("/agents/commands")
+
+	err = c.statusError(resp, err, "unable to execute agent command")
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Errors != nil {
+		err = fmt.Errorf(strings.Join(response.Errors, "\n"))
+	}
+
+	return response.Commands, err
+}
+
+// AgentCommands returns the commands sent to an agent
+func (c *bindplaneClient) AgentCommands(ctx context.Context, id string) ([]*model.AgentCommand, error) {
+	c.Debug("AgentCommands called")
+
+	var response model.AgentCommandsResponse
+	err := c.get(ctx, fmt.Sprintf("/agents/%s/commands", id), &response)
+	return response.Commands, err
+}
+
+// DiagnoseAgent requests a diagnostics bundle from the agent and returns the collect-diagnostics command. The bundle
+// will have the same ID as the command and will be available when the command succeeds.
+func (c *bindplaneClient) DiagnoseAgent(ctx context.Context, id string) (*model.AgentCommand, error) {
+	c.Debug("DiagnoseAgent called")
+
+	var response model.AgentCommandResponse
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&response).
+		Post(fmt.Sprintf("/agents/%s/diagnostics", id))
+
+	err = c.statusError(resp, err, "unable to request diagnostics")
+	if err != nil {
+		return nil, err
+	}
+	return response.Command, nil
+}
+
+// AgentDiagnostics returns the diagnostics bundles collected from an agent
+func (c *bindplaneClient) AgentDiagnostics(ctx context.Context, id string) ([]*model.DiagnosticsBundle, error) {
+	c.Debug("AgentDiagnostics called")
+
+	var response model.DiagnosticsBundlesResponse
+	err := c.get(ctx, fmt.Sprintf("/agents/%s/diagnostics", id), &response)
+	return response.Bundles, err
+}
+
+// AgentDiagnosticsBundle returns the contents of a diagnostics bundle as a gzipped tar archive
+func (c *bindplaneClient) AgentDiagnosticsBundle(ctx context.Context, id string, bundleID string) ([]byte, error) {
+	c.Debug("AgentDiagnosticsBundle called")
+
+	url := fmt.Sprintf("/agents/%s/diagnostics/%s", id, bundleID)
+	resp, err := c.client.R().
+		SetContext(ctx).
+		Get(url)
+
+	err = c.statusError(resp, err, fmt.Sprintf("unable to get %s", url))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body(), nil
+}
+
+// AgentsDrift returns the configuration drift of the agents matching the selector and query options. Agents that are
+// in sync are only included if all is true.
+func (c *bindplaneClient) AgentsDrift(ctx context.Context, all bool, options ...QueryOption) ([]*model.AgentDriftReport, error) {
+	c.Debug("AgentsDrift called")
+
+	opts := makeQueryOptions(options)
+	var response model.AgentDriftResponse
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&response).
+		SetQueryParam("selector", opts.selector).
+		SetQueryParam("query", opts.query).
+		SetQueryParam("all", fmt.Sprintf("%t", all)).
+		Get("/agents/drift")
+
+	err = c.statusError(resp, err, "unable to get agent drift")
+	if err != nil {
+		return nil, err
+	}
+	return response.Agents, nil
+}
+
+// AgentPendingChanges returns the configuration changes deferred by the schedule of the configuration for the agents
+// matching the selector and query options
+func (c *bindplaneClient) AgentPendingChanges(ctx context.Context, options ...QueryOption) ([]*model.AgentPendingChange, error) {
+	c.Debug("AgentPendingChanges called")
+
+	opts := makeQueryOptions(options)
+	var response model.AgentPendingChangesResponse
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&response).
+		SetQueryParam("selector", opts.selector).
+		SetQueryParam("query", opts.query).
+		Get("/agents/pending")
+
+	err = c.statusError(resp, err, "unable to get pending changes")
+	if err != nil {
+		return nil, err
+	}
+	return response.Changes, nil
+}
+
+// ApplyAgentPendingChange applies the pending change of an agent without waiting for the schedule of the configuration
+func (c *bindplaneClient) ApplyAgentPendingChange(ctx context.Context, id string) (*model.AgentPendingChange, error) {
+	c.Debug("ApplyAgentPendingChange called")
+
+	var response model.AgentPendingChangeResponse
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(&response).
+		Post(fmt.Sprintf("/agents/%s/pending/apply", id))
+
+	err = c.statusError(resp, err, "unable to apply pending change")
+	if err != nil {
+		return nil, err
+	}
+	return response.Change, nil
+}
+
+// ExecuteAgentGroupCommand sends a command to the members of the agent group, returning the commands that were created
+func (c *bindplaneClient) ExecuteAgentGroupCommand(ctx context.Context, commandType model.AgentCommandType, group string) ([]*model.AgentCommand, error) {
+	c.Debug("ExecuteAgentGroupCommand called")
+
+	payload := model.AgentCommandPayload{
+		Type:  string(commandType),
+		Group: group,
+	}
+	var response model.AgentCommandsResponse
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(payload).
+		SetResult(&response).
+		Post("/agents/commands")
+
+	err = c.statusError(resp, err, "unable to execute agent command")
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Errors != nil {
+		err = fmt.Errorf(strings.Join(response.Errors, "\n"))
+	}
+
+	return response.Commands, err
+}
+
+// ApplyAgentGroupLabels applies the specified labels to the members of the agent group, merging the specified labels
+// with the existing labels of each agent
+func (c *bindplaneClient) ApplyAgentGroupLabels(ctx context.Context, group string, labels *model.Labels, overwrite bool) error {
+	c.Debug("ApplyAgentGroupLabels called")
+
+	payload := model.BulkAgentLabelsPayload{
+		Group:     group,
+		Labels:    labels.AsMap(),
+		Overwrite: overwrite,
+	}
+	var response model.BulkAgentLabelsResponse
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(payload).
+		SetResult(&response).
+		Patch("/agents/labels")
+
+	err = c.statusError(resp, err, "unable to apply labels")
+	if err != nil {
+		return err
+	}
+
+	if len(response.Errors) > 0 {
+		return fmt.Errorf(strings.Join(response.Errors, "\n"))
+	}
+	return nil
+}
+
 // ----------------------------------------------------------------------
 
 // resources gets the resources from the REST server and stores them in the provided result.
-func (c *bindplaneClient) resources(ctx context.Context, resourcesURL string, result any) error {
-	return c.get(ctx, resourcesURL, result)
+func (c *bindplaneClient) resources(ctx context.Context, resourcesURL string, result any, options []QueryOption) error {
+	opts := makeQueryOptions(options)
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetResult(result).
+		SetQueryParams(opts.listParams()).
+		Get(resourcesURL)
+
+	if err != nil {
+		logRequestError(c.Logger, err, resourcesURL)
+		return err
+	}
+
+	if err := c.statusError(resp, err, fmt.Sprintf("unable to get %s", resourcesURL)); err != nil {
+		return err
+	}
+	return setNextCursor(resp, opts)
+}
+
+// setNextCursor sets the cursor requested with WithNextCursor from the nextCursor of a list response
+func setNextCursor(resp *resty.Response, opts queryOptions) error {
+	if opts.nextCursor == nil {
+		return nil
+	}
+	var page struct {
+		NextCursor string `json:"nextCursor"`
+	}
+	if err := json.Unmarshal(resp.Body(), &page); err != nil {
+		return fmt.Errorf("unable to read the next cursor: %w", err)
+	}
+	*opts.nextCursor = page.NextCursor
+	return nil
 }
 
 // resource gets the resource with the specified name from the REST server and stores it in the provided result.
@@ -619,6 +1278,16 @@ func (c *bindplaneClient) deleteResource(ctx context.Context, resourcesURL strin
 
 }
 
+// responseError is like statusError but includes the first error returned by the server
+func (c *bindplaneClient) responseError(resp *resty.Response, err error, errorResponse *rest.ErrorResponse, message string) error {
+	if err == nil && resp.IsError() && len(errorResponse.Errors) > 0 {
+		err := fmt.Errorf("%s: %s", message, errorResponse.Errors[0])
+		logRequestError(c.Logger, err, resp.Request.URL)
+		return err
+	}
+	return c.statusError(resp, err, message)
+}
+
 func (c *bindplaneClient) unauthorizedError(resp *resty.Response) error {
 	if resp.StatusCode() == http.StatusUnauthorized {
 		err := fmt.Errorf(resp.Status())
@@ -15,11 +15,19 @@
 package client
 
 import (
+	"context"
 	"fmt"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
+	"github.com/go-resty/resty/v2"
 	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/rest"
+	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -115,6 +123,16 @@ func TestQueryOption(t *testing.T) {
 			},
 			expect: queryOptions{},
 		},
+		// WithCursor
+		{
+			name: "cursor",
+			optFunc: func() []QueryOption {
+				return []QueryOption{WithCursor("abc")}
+			},
+			expect: queryOptions{
+				cursor: "abc",
+			},
+		},
 		// Multiple Options
 		{
 			name: "multi",
@@ -158,6 +176,68 @@ func TestQueryOption(t *testing.T) {
 	}
 }
 
+func TestListParams(t *testing.T) {
+	require.Equal(t, map[string]string{}, makeQueryOptions(nil).listParams())
+	require.Equal(t, map[string]string{
+		"selector": "env=prod",
+		"limit":    "10",
+		"sort":     "-name",
+		"cursor":   "abc",
+	}, makeQueryOptions([]QueryOption{
+		WithSelector("env=prod"),
+		WithLimit(10),
+		WithSort("-name"),
+		WithCursor("abc"),
+	}).listParams())
+}
+
+func TestListPaging(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := store.NewMapStore(ctx, store.Options{
+		SessionsSecret:   "super-secret-key",
+		MaxEventsToMerge: 1,
+	}, zap.NewNop())
+	bindplane, err := server.NewBindPlane(&common.Server{}, zap.NewNop(), s, nil)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	rest.AddRestRoutes(ctx, router.Group("/v1"), bindplane)
+	svr := httptest.NewServer(router)
+	defer svr.Close()
+
+	for _, name := range []string{"a", "b", "c"} {
+		_, err := s.ApplyResources([]model.Resource{model.NewSourceType(name, nil)})
+		require.NoError(t, err)
+	}
+
+	c := &bindplaneClient{
+		client: resty.New().SetBaseURL(svr.URL + "/v1"),
+		Logger: zap.NewNop(),
+	}
+
+	names := []string{}
+	cursor := ""
+	for pages := 0; pages < 3; pages++ {
+		var next string
+		sourceTypes, err := c.SourceTypes(ctx, WithSort("-name"), WithLimit(2), WithCursor(cursor), WithNextCursor(&next))
+		require.NoError(t, err)
+		for _, sourceType := range sourceTypes {
+			names = append(names, sourceType.Name())
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	require.Equal(t, []string{"c", "b", "a"}, names)
+
+	_, err = c.SourceTypes(ctx, WithSort("spec"))
+	require.Error(t, err)
+}
+
 func TestNewBindPlane(t *testing.T) {
 	cases := []struct {
 		name      string
@@ -284,6 +364,8 @@ func TestNewBindPlane(t *testing.T) {
 			require.NotNil(t, out.(*bindplaneClient).Logger)
 			require.Equal(t, tc.expect.(*bindplaneClient).config, out.(*bindplaneClient).config)
 			require.Equal(t, time.Second*20, out.(*bindplaneClient).client.GetClient().Timeout)
+			require.NotNil(t, out.(*bindplaneClient).streamClient)
+			require.Equal(t, time.Duration(0), out.(*bindplaneClient).streamClient.GetClient().Timeout)
 
 			if tc.client.Username != "" {
 				require.Equal(t, tc.client.Username, out.(*bindplaneClient).client.UserInfo.Username)
@@ -294,6 +376,7 @@ func TestNewBindPlane(t *testing.T) {
 
 			base := fmt.Sprintf("%s/v1", tc.client.BindPlaneURL())
 			require.Equal(t, base, out.(*bindplaneClient).client.BaseURL)
+			require.Equal(t, base, out.(*bindplaneClient).streamClient.BaseURL)
 		})
 	}
 }
@@ -0,0 +1,139 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/internal/rest"
+)
+
+// watch requests a stream of server-sent events from the endpoint with watch=true and decodes the data of each event
+// into a new R. The channels are closed when the context is done or the server closes the stream. If the stream ends
+// before the context is done, the reason is sent on the error channel before it is closed.
+func watch[R any](ctx context.Context, c *bindplaneClient, endpoint string, opts queryOptions) (<-chan *R, <-chan error, error) {
+	resp, err := c.streamClient.R().
+		SetContext(ctx).
+		SetDoNotParseResponse(true).
+		SetHeader("Accept", "text/event-stream").
+		SetQueryParam("watch", "true").
+		SetQueryParam("selector", opts.selector).
+		SetQueryParam("query", opts.query).
+		Get(endpoint)
+	if err != nil {
+		logRequestError(c.Logger, err, endpoint)
+		return nil, nil, err
+	}
+
+	name := strings.TrimPrefix(endpoint, "/")
+	body := resp.RawBody()
+	if resp.StatusCode() != http.StatusOK {
+		defer body.Close()
+		return nil, nil, fmt.Errorf("unable to watch %s, got %s", name, resp.Status())
+	}
+
+	channel := make(chan *R)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(channel)
+		defer close(errs)
+		defer body.Close()
+
+		var closed error
+		err := readEvents(body, func(event string, data string) bool {
+			if event == "error" {
+				closed = streamError(data)
+				return false
+			}
+			result := new(R)
+			if err := json.Unmarshal([]byte(data), result); err != nil {
+				c.Error("unable to decode server-sent event", zap.String("endpoint", endpoint), zap.Error(err))
+				return true
+			}
+			select {
+			case channel <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		switch {
+		case closed != nil:
+			errs <- fmt.Errorf("watch of %s ended: %w", name, closed)
+		case err != nil:
+			c.Error("error reading server-sent events", zap.String("endpoint", endpoint), zap.Error(err))
+			errs <- fmt.Errorf("watch of %s ended: %w", name, err)
+		default:
+			errs <- fmt.Errorf("watch of %s ended: the server closed the stream", name)
+		}
+	}()
+
+	return channel, errs, nil
+}
+
+// streamError returns the error in the data of an error event
+func streamError(data string) error {
+	response := &rest.ErrorResponse{}
+	if err := json.Unmarshal([]byte(data), response); err != nil || len(response.Errors) == 0 {
+		return errors.New("the server closed the stream")
+	}
+	return errors.New(response.Errors[0])
+}
+
+// readEvents reads server-sent events from the reader and calls handle with the name and data of each event until
+// handle returns false or the reader is finished. Ids and comments are ignored.
+func readEvents(reader io.Reader, handle func(event string, data string) bool) error {
+	var event string
+	var data []string
+	buffered := bufio.NewReader(reader)
+	for {
+		line, err := buffered.ReadString('\n')
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		switch {
+		case line == "":
+			// a blank line dispatches the event
+			if len(data) > 0 && !handle(event, strings.Join(data, "\n")) {
+				return nil
+			}
+			event = ""
+			data = nil
+
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimPrefix(strings.TrimPrefix(line, "event:"), " ")
+
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/eventbus"
+	"github.com/observiq/bindplane-op/internal/rest"
+	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestReadEvents(t *testing.T) {
+	stream := ": comment\nevent:agents\ndata:{\"events\":[]}\n\nevent: agents\ndata: line1\ndata: line2\r\n\r\ndata:ignored without a blank line"
+
+	var names, events []string
+	err := readEvents(strings.NewReader(stream), func(name string, data string) bool {
+		names = append(names, name)
+		events = append(events, data)
+		return true
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"agents", "agents"}, names)
+	require.Equal(t, []string{`{"events":[]}`, "line1\nline2"}, events)
+
+	events = nil
+	err = readEvents(strings.NewReader(stream), func(name string, data string) bool {
+		events = append(events, data)
+		return false
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+}
+
+func TestWatchAgents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := store.NewMapStore(ctx, store.Options{
+		SessionsSecret:   "super-secret-key",
+		MaxEventsToMerge: 1,
+	}, zap.NewNop())
+	bindplane, err := server.NewBindPlane(&common.Server{}, zap.NewNop(), s, nil)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	rest.AddRestRoutes(ctx, router.Group("/v1"), bindplane)
+	svr := httptest.NewServer(router)
+	defer svr.Close()
+
+	upsertAgent := func(id string, labels string) {
+		_, err := s.UpsertAgent(ctx, id, func(agent *model.Agent) {
+			agent.ID = id
+			agent.Labels, _ = model.LabelsFromSelector(labels)
+		})
+		require.NoError(t, err)
+	}
+	// wait for the updates from the store before watching so that they are not included in the watch
+	updates, unsubscribe := eventbus.Subscribe(s.Updates())
+	upsertAgent("1", "env=prod")
+	upsertAgent("2", "env=dev")
+	for received := 0; received < 2; {
+		select {
+		case u := <-updates:
+			received += len(u.Agents)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timed out waiting for store updates")
+		}
+	}
+	unsubscribe()
+
+	c := &bindplaneClient{
+		client:       resty.New().SetBaseURL(svr.URL + "/v1"),
+		streamClient: resty.New().SetBaseURL(svr.URL + "/v1"),
+		config:       &common.Client{},
+		Logger:       zap.NewNop(),
+	}
+
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	defer stopWatch()
+	channel, errs, err := c.WatchAgents(watchCtx, WithSelector("env=prod"))
+	require.NoError(t, err)
+
+	next := func() *model.AgentWatchResponse {
+		select {
+		case response, ok := <-channel:
+			require.True(t, ok, "channel closed")
+			return response
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timed out waiting for agent changes")
+		}
+		return nil
+	}
+	requireEvent := func(response *model.AgentWatchResponse, eventType model.WatchEventType, id string) {
+		require.Len(t, response.Events, 1)
+		require.Equal(t, eventType, response.Events[0].Type)
+		require.Equal(t, id, response.Events[0].Agent.ID)
+	}
+
+	// the initial response contains the matching agents
+	requireEvent(next(), model.WatchEventInsert, "1")
+
+	// agent 2 now matches the selector
+	upsertAgent("2", "env=prod")
+	requireEvent(next(), model.WatchEventUpdate, "2")
+
+	// agent 1 no longer matches the selector
+	upsertAgent("1", "env=dev")
+	requireEvent(next(), model.WatchEventRemove, "1")
+
+	_, err = s.DeleteAgents(ctx, []string{"2"})
+	require.NoError(t, err)
+	requireEvent(next(), model.WatchEventRemove, "2")
+
+	// the channel is closed when the context is done
+	stopWatch()
+	require.Eventually(t, func() bool {
+		select {
+		case _, ok := <-channel:
+			return !ok
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, <-errs, "no error is reported when the context is done")
+}
+
+func TestWatchClosedByServer(t *testing.T) {
+	tests := []struct {
+		name      string
+		stream    string
+		expectErr string
+	}{
+		{
+			name:      "error event",
+			stream:    "event: agents\ndata: {\"events\":[]}\n\n: ping\n\nevent: error\ndata: {\"errors\":[\"client fell too far behind\"]}\n\n",
+			expectErr: "watch of agents ended: client fell too far behind",
+		},
+		{
+			name:      "closed",
+			stream:    "event: agents\ndata: {\"events\":[]}\n\n",
+			expectErr: "watch of agents ended: the server closed the stream",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.GET("/v1/agents", func(c *gin.Context) {
+				c.Header("Content-Type", "text/event-stream")
+				c.String(200, test.stream)
+			})
+			svr := httptest.NewServer(router)
+			defer svr.Close()
+
+			c := &bindplaneClient{
+				streamClient: resty.New().SetBaseURL(svr.URL + "/v1"),
+				Logger:       zap.NewNop(),
+			}
+			channel, errs, err := c.WatchAgents(context.Background())
+			require.NoError(t, err)
+
+			response, ok := <-channel
+			require.True(t, ok)
+			require.Empty(t, response.Events)
+			_, ok = <-channel
+			require.False(t, ok)
+			require.EqualError(t, <-errs, test.expectErr)
+		})
+	}
+}
+
+func TestWatchConfigurationsError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/v1/configurations", func(c *gin.Context) { c.Status(401) })
+	svr := httptest.NewServer(router)
+	defer svr.Close()
+
+	c := &bindplaneClient{
+		streamClient: resty.New().SetBaseURL(svr.URL + "/v1"),
+		Logger:       zap.NewNop(),
+	}
+	_, _, err := c.WatchConfigurations(context.Background())
+	require.EqualError(t, err, "unable to watch configurations, got 401 Unauthorized")
+}
@@ -21,14 +21,21 @@ import (
 	"github.com/observiq/bindplane-op/common"
 	"github.com/observiq/bindplane-op/internal/cli"
 	"github.com/observiq/bindplane-op/internal/cli/commands"
+	"github.com/observiq/bindplane-op/internal/cli/commands/agent"
 	"github.com/observiq/bindplane-op/internal/cli/commands/apply"
+	"github.com/observiq/bindplane-op/internal/cli/commands/backup"
+	"github.com/observiq/bindplane-op/internal/cli/commands/bundle"
+	"github.com/observiq/bindplane-op/internal/cli/commands/catalog"
 	"github.com/observiq/bindplane-op/internal/cli/commands/delete"
 	"github.com/observiq/bindplane-op/internal/cli/commands/get"
 	"github.com/observiq/bindplane-op/internal/cli/commands/initialize"
 	"github.com/observiq/bindplane-op/internal/cli/commands/install"
 	"github.com/observiq/bindplane-op/internal/cli/commands/label"
 	"github.com/observiq/bindplane-op/internal/cli/commands/profile"
+	"github.com/observiq/bindplane-op/internal/cli/commands/resourcetype"
 	"github.com/observiq/bindplane-op/internal/cli/commands/serve"
+	"github.com/observiq/bindplane-op/internal/cli/commands/store"
+	"github.com/observiq/bindplane-op/internal/cli/commands/sync"
 	"github.com/observiq/bindplane-op/internal/cli/commands/validate"
 	"github.com/observiq/bindplane-op/internal/cli/commands/version"
 	"github.com/spf13/cobra"
@@ -55,16 +62,24 @@ func main() {
 
 	// Server contains all commands
 	rootCmd.AddCommand(
+		agent.Command(bindplane),
 		apply.Command(bindplane),
 		get.Command(bindplane),
 		label.Command(bindplane),
 		delete.Command(bindplane),
 		serve.Command(bindplane, h),
+		store.Command(bindplane, h),
+		backup.Command(bindplane),
 		profile.Command(h),
 		version.Command(bindplane),
 		initialize.Command(bindplane, h, initialize.DualMode),
 		install.Command(bindplane),
 		validate.Command(bindplane),
+		resourcetype.Command(bindplane),
+		catalog.Command(bindplane),
+		bundle.ExportCommand(bindplane),
+		bundle.ImportCommand(bindplane),
+		sync.Command(bindplane),
 	)
 
 	cobra.CheckErr(rootCmd.Execute())
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"time"
 )
 
 const (
@@ -29,6 +30,10 @@ const (
 	BoldDatabaseName = "storage"
 	// DownloadsDirectoryName is the name of the directory where downloads are cached
 	DownloadsDirectoryName = "downloads"
+	// DiagnosticsDirectoryName is the name of the directory where agent diagnostics bundles are stored
+	DiagnosticsDirectoryName = "diagnostics"
+	// BackupsDirectoryName is the name of the directory where backups of the bbolt store are saved
+	BackupsDirectoryName = "backups"
 	// BindPlaneLogName returns the name of the BindPlane log file
 	BindPlaneLogName = "bindplane.log"
 	// DefaultProfileName is the name of the default profile
@@ -46,6 +51,34 @@ const (
 	LogOutputStdout LogOutput = "stdout"
 )
 
+// DriftRemediation is an enum of possible values for the DriftRemediation configuration setting
+type DriftRemediation string
+
+const (
+	// DriftRemediationNone will report configuration drift without changing the configuration of agents
+	DriftRemediationNone DriftRemediation = "none"
+
+	// DriftRemediationLocallyModified will resend the configuration to agents with configuration that was modified on
+	// the agent host
+	DriftRemediationLocallyModified DriftRemediation = "locallyModified"
+
+	// DriftRemediationAll will resend the configuration to all agents with drifted or locally modified configuration
+	DriftRemediationAll DriftRemediation = "all"
+)
+
+// CatalogConflictPolicy is an enum of possible values for the CatalogConflictPolicy configuration setting
+type CatalogConflictPolicy string
+
+const (
+	// CatalogConflictPolicyKeep will not sync resource types that were modified since they were synced or that were not
+	// synced from the catalog
+	CatalogConflictPolicyKeep CatalogConflictPolicy = "keep"
+
+	// CatalogConflictPolicyOverwrite will sync resource types from the catalog, replacing any changes to resource types in
+	// the store
+	CatalogConflictPolicyOverwrite CatalogConflictPolicy = "overwrite"
+)
+
 // DefaultBindPlaneHomePath returns the default value of the bindplane home path
 func DefaultBindPlaneHomePath() string {
 	return os.ExpandEnv(fmt.Sprintf("$HOME/%s", BindPlaneDirectoryName))
@@ -179,6 +212,49 @@ type Server struct {
 	// DisableDownloadsCache TODO(doc)
 	DisableDownloadsCache bool `mapstructure:"disableDownloadsCache,omitempty" yaml:"disableDownloadsCache,omitempty"`
 
+	// DiagnosticsFolderPath is the path to the folder where agent diagnostics bundles are stored
+	DiagnosticsFolderPath string `mapstructure:"diagnosticsFolderPath,omitempty" yaml:"diagnosticsFolderPath,omitempty"`
+	// MaxDiagnosticsBundles is the maximum number of diagnostics bundles stored for each agent
+	MaxDiagnosticsBundles int `mapstructure:"maxDiagnosticsBundles,omitempty" yaml:"maxDiagnosticsBundles,omitempty"`
+	// DiagnosticsRetention is the amount of time diagnostics bundles are stored before they are removed
+	DiagnosticsRetention time.Duration `mapstructure:"diagnosticsRetention,omitempty" yaml:"diagnosticsRetention,omitempty"`
+	// DiagnosticsIncludeConfig includes the effective configuration files of agents in diagnostics bundles. They are
+	// omitted by default because they may contain credentials.
+	DiagnosticsIncludeConfig bool `mapstructure:"diagnosticsIncludeConfig,omitempty" yaml:"diagnosticsIncludeConfig,omitempty"`
+
+	// BackupsFolderPath is the path to the folder where backups of the bbolt store are saved
+	BackupsFolderPath string `mapstructure:"backupsFolderPath,omitempty" yaml:"backupsFolderPath,omitempty"`
+	// BackupInterval is the amount of time between scheduled backups of the bbolt store. If zero, backups are only
+	// created on request.
+	BackupInterval time.Duration `mapstructure:"backupInterval,omitempty" yaml:"backupInterval,omitempty"`
+	// BackupRetention is the number of backups to keep. If zero, all backups are kept.
+	BackupRetention int `mapstructure:"backupRetention,omitempty" yaml:"backupRetention,omitempty"`
+
+	// DriftRemediation determines which agents with configuration drift will be sent their configuration again. The
+	// default is none.
+	DriftRemediation DriftRemediation `mapstructure:"driftRemediation,omitempty" yaml:"driftRemediation,omitempty"`
+
+	// ResourceTypeCatalog is the location of a catalog of resource types that the server syncs. It is either the path of
+	// a local directory containing index.yaml or the http(s) url of the index.
+	ResourceTypeCatalog string `mapstructure:"resourceTypeCatalog,omitempty" yaml:"resourceTypeCatalog,omitempty"`
+	// CatalogSyncInterval is the amount of time between syncs of the ResourceTypeCatalog. If zero, the catalog is only
+	// synced on request.
+	CatalogSyncInterval time.Duration `mapstructure:"catalogSyncInterval,omitempty" yaml:"catalogSyncInterval,omitempty"`
+	// CatalogPublicKeyFile is the path of an ed25519 public key used to verify the signature of the catalog index. If
+	// empty, the signature is not verified but the checksums of the resource types are always verified.
+	CatalogPublicKeyFile string `mapstructure:"catalogPublicKeyFile,omitempty" yaml:"catalogPublicKeyFile,omitempty"`
+	// CatalogConflictPolicy determines whether resource types modified in the store are replaced by resource types from
+	// the catalog. The default is keep.
+	CatalogConflictPolicy CatalogConflictPolicy `mapstructure:"catalogConflictPolicy,omitempty" yaml:"catalogConflictPolicy,omitempty"`
+
+	// SyncDirectory is the path of a local directory of resource yaml that the server syncs into the store. If empty,
+	// resources are only synced on request.
+	SyncDirectory string `mapstructure:"syncDirectory,omitempty" yaml:"syncDirectory,omitempty"`
+	// SyncInterval is the amount of time between syncs of the SyncDirectory
+	SyncInterval time.Duration `mapstructure:"syncInterval,omitempty" yaml:"syncInterval,omitempty"`
+	// SyncPrune deletes resources managed by sync that are removed from the SyncDirectory
+	SyncPrune bool `mapstructure:"syncPrune,omitempty" yaml:"syncPrune,omitempty"`
+
 	// SessionSecret is used to encode the user sessions cookies.  It should be a uuid.
 	SessionsSecret string `mapstructure:"sessionsSecret,omitempty" yaml:"sessionsSecret,omitempty"`
 
@@ -271,6 +347,26 @@ func (c *Server) BindPlaneDownloadsPath() string {
 	return path.Join(c.BindPlaneHomePath(), DownloadsDirectoryName)
 }
 
+// BindPlaneDiagnosticsPath returns the path to the directory where agent diagnostics bundles are stored. If neither
+// DiagnosticsFolderPath nor the BindPlane home path are set, it returns "" and bundles are stored in memory.
+func (c *Server) BindPlaneDiagnosticsPath() string {
+	if c.DiagnosticsFolderPath != "" {
+		return c.DiagnosticsFolderPath
+	}
+	if c.BindPlaneHomePath() == "" {
+		return ""
+	}
+	return path.Join(c.BindPlaneHomePath(), DiagnosticsDirectoryName)
+}
+
+// BindPlaneBackupsPath returns the path to the directory where backups of the bbolt store are saved
+func (c *Server) BindPlaneBackupsPath() string {
+	if c.BackupsFolderPath != "" {
+		return c.BackupsFolderPath
+	}
+	return path.Join(c.BindPlaneHomePath(), BackupsDirectoryName)
+}
+
 // ----------------------------------------------------------------------
 // Common
 
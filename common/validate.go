@@ -71,6 +71,32 @@ func (s *Server) validate() (errGroup error) {
 		}
 	}
 
+	switch s.DriftRemediation {
+	case "", DriftRemediationNone, DriftRemediationLocallyModified, DriftRemediationAll:
+	default:
+		err := fmt.Errorf("invalid drift remediation %s: valid values are %s, %s, and %s", s.DriftRemediation, DriftRemediationNone, DriftRemediationLocallyModified, DriftRemediationAll)
+		errGroup = multierror.Append(errGroup, err)
+	}
+
+	switch s.CatalogConflictPolicy {
+	case "", CatalogConflictPolicyKeep, CatalogConflictPolicyOverwrite:
+	default:
+		err := fmt.Errorf("invalid catalog conflict policy %s: valid values are %s and %s", s.CatalogConflictPolicy, CatalogConflictPolicyKeep, CatalogConflictPolicyOverwrite)
+		errGroup = multierror.Append(errGroup, err)
+	}
+
+	if s.CatalogPublicKeyFile != "" {
+		if _, err := os.Stat(s.CatalogPublicKeyFile); err != nil {
+			err = fmt.Errorf("failed to lookup catalog public key file %s: %w", s.CatalogPublicKeyFile, err)
+			errGroup = multierror.Append(errGroup, err)
+		}
+	}
+
+	if s.CatalogSyncInterval < 0 {
+		err := fmt.Errorf("invalid catalog sync interval %s: must not be negative", s.CatalogSyncInterval)
+		errGroup = multierror.Append(errGroup, err)
+	}
+
 	if err := s.Common.validate(); err != nil {
 		errGroup = multierror.Append(errGroup, err)
 	}
@@ -16,6 +16,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -497,6 +498,62 @@ func TestValidate(t *testing.T) {
 			},
 			"failed to validate agents service url ws://github.com:3000: scheme ws is invalid: valid schemes are [http https]",
 		},
+		{
+			"valid-drift-remediation",
+			Config{
+				Server: Server{
+					DriftRemediation: DriftRemediationLocallyModified,
+				},
+			},
+			"",
+		},
+		{
+			"invalid-drift-remediation",
+			Config{
+				Server: Server{
+					DriftRemediation: "sometimes",
+				},
+			},
+			"invalid drift remediation sometimes: valid values are none, locallyModified, and all",
+		},
+		{
+			"valid-catalog",
+			Config{
+				Server: Server{
+					ResourceTypeCatalog:   "https://example.com/catalog/index.yaml",
+					CatalogSyncInterval:   time.Hour,
+					CatalogConflictPolicy: CatalogConflictPolicyOverwrite,
+				},
+			},
+			"",
+		},
+		{
+			"invalid-catalog-conflict-policy",
+			Config{
+				Server: Server{
+					CatalogConflictPolicy: "merge",
+				},
+			},
+			"invalid catalog conflict policy merge: valid values are keep and overwrite",
+		},
+		{
+			"invalid-catalog-sync-interval",
+			Config{
+				Server: Server{
+					CatalogSyncInterval: -time.Minute,
+				},
+			},
+			"invalid catalog sync interval -1m0s: must not be negative",
+		},
+		{
+			"missing-catalog-public-key-file",
+			Config{
+				Server: Server{
+					CatalogPublicKeyFile: "/tmp/bindplane-missing-catalog.pub",
+				},
+			},
+			"failed to lookup catalog public key file /tmp/bindplane-missing-catalog.pub",
+		},
 	}
 
 	for _, tc := range cases {
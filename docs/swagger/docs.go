@@ -30,43 +30,47 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/agent-versions/{version}/install-command": {
+        "/agent-groups": {
             "get": {
-                "description": "Get the proper install command for the provided parameters.",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get Install Command",
+                "summary": "List agent groups",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "2.1.1",
-                        "name": "version",
-                        "in": "path",
-                        "required": true
+                        "description": "label selector to filter agent groups",
+                        "name": "selector",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "uuid",
-                        "name": "secret-key",
+                        "description": "field to sort by: name, id, or description",
+                        "name": "sort",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "http%3A%2F%2Flocalhost%3A3001",
-                        "name": "remote-url",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of agent groups to return",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "windows-amd64",
-                        "name": "platform",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "env=stage,app=bindplane",
-                        "name": "labels",
+                        "description": "comma separated fields to include for each agent group, e.g. metadata.name",
+                        "name": "fields",
                         "in": "query"
                     }
                 ],
@@ -74,23 +78,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.InstallCommandResponse"
+                            "$ref": "#/definitions/model.AgentGroupsResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/agents": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "summary": "List agents",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/model.AgentsResponse"
+                            "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
                     "500": {
@@ -100,35 +94,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/agent-groups/{name}": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "delete agents by ids",
+                "summary": "Get agent group by name",
                 "parameters": [
                     {
-                        "description": "list of agent ids to delete",
-                        "name": "id",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
+                        "type": "string",
+                        "description": "the name of the agent group",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.DeleteAgentsResponse"
+                            "$ref": "#/definitions/model.AgentGroupResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "the resourceVersion of the resource"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
@@ -140,69 +137,69 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/agents/labels": {
-            "patch": {
+            },
+            "delete": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Bulk apply labels to agents",
+                "summary": "Delete agent group by name",
                 "parameters": [
                     {
-                        "description": "agent IDs",
-                        "name": "ids",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "the name of the agent group to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the resource, which must match to delete it",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
-                    {
-                        "description": "labels to apply",
-                        "name": "labels",
-                        "in": "body",
-                        "required": true,
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
-                    {
-                        "description": "overwrite labels",
-                        "name": "labels",
-                        "in": "body",
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
-                            "type": "boolean"
+                            "$ref": "#/definitions/rest.ErrorResponse"
                         }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/model.BulkAgentLabelsResponse"
+                            "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/agents/{id}": {
+        "/agent-groups/{name}/agents": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get agent by id",
+                "summary": "List the agents that are members of an agent group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the id of the agent",
-                        "name": "id",
+                        "description": "the name of the agent group",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     }
@@ -211,7 +208,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AgentResponse"
+                            "$ref": "#/definitions/model.AgentsResponse"
                         }
                     },
                     "404": {
@@ -229,17 +226,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/agents/{id}/configuration": {
+        "/agent-groups/{name}/dependencies": {
             "get": {
+                "description": "Dependencies are the resources that the resource uses directly or indirectly, e.g. the sources of a\nconfiguration and the source types of those sources.",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get configuration for a given agent",
+                "summary": "List the resources used by a resource",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the id of the agent",
-                        "name": "id",
+                        "description": "the name of the resource",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     }
@@ -248,7 +246,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ConfigurationResponse"
+                            "$ref": "#/definitions/model.ResourceDependenciesResponse"
                         }
                     },
                     "404": {
@@ -266,17 +264,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/agents/{id}/labels": {
+        "/agent-groups/{name}/dependents": {
             "get": {
+                "description": "Dependents are the resources that use the resource directly or indirectly, e.g. the sources that use a\nsource type and the configurations that use those sources. Agent IDs are the agents using the resource\nif it is a configuration or any of the dependent configurations.",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get agent labels by agent id",
+                "summary": "List the resources and agents affected by a change to a resource",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the id of the agent",
-                        "name": "id",
+                        "description": "the name of the resource",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     }
@@ -285,7 +284,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AgentLabelsResponse"
+                            "$ref": "#/definitions/model.ResourceDependentsResponse"
                         }
                     },
                     "404": {
@@ -301,41 +300,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/agent-groups/{name}/summary": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Patch agent labels by agent id",
+                "summary": "Get the number of agents in an agent group by status and version",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the id of the agent",
-                        "name": "id",
+                        "description": "the name of the agent group",
+                        "name": "name",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "if true, overwrite any existing labels with the same names",
-                        "name": "overwrite",
-                        "in": "query"
-                    },
-                    {
-                        "description": "Labels to be merged with existing labels, empty values will delete existing labels",
-                        "name": "labels",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.AgentLabelsPayload"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AgentLabelsResponse"
+                            "$ref": "#/definitions/model.AgentGroupSummaryResponse"
                         }
                     },
                     "404": {
@@ -344,12 +330,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -359,96 +339,131 @@ const docTemplate = `{
                 }
             }
         },
-        "/agents/{id}/restart": {
-            "put": {
+        "/agent-versions/{version}/install-command": {
+            "get": {
+                "description": "Get the proper install command for the provided parameters.",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "TODO restart agent",
+                "summary": "Get Install Command",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the id of the agent",
-                        "name": "id",
+                        "description": "2.1.1",
+                        "name": "version",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {}
-            }
-        },
-        "/agents/{id}/version": {
-            "post": {
-                "produces": [
-                    "application/json"
-                ],
-                "summary": "TODO update agent",
-                "parameters": [
+                    },
                     {
                         "type": "string",
-                        "description": "the id of the agent",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {}
-            }
-        },
-        "/apply": {
-            "post": {
-                "description": "The /apply route will try to parse resources\nand upsert them into the store.  Additionally\nit will send reconfigure tasks to affected agents.",
-                "produces": [
-                    "application/json"
-                ],
-                "summary": "Create, edit, and configure multiple resources.",
-                "parameters": [
+                        "description": "uuid",
+                        "name": "secret-key",
+                        "in": "query"
+                    },
                     {
-                        "description": "Resources",
-                        "name": "resources",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.AnyResource"
-                            }
-                        }
+                        "type": "string",
+                        "description": "http%3A%2F%2Flocalhost%3A3001",
+                        "name": "remote-url",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "windows-amd64",
+                        "name": "platform",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "env=stage,app=bindplane",
+                        "name": "labels",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ApplyResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
+                            "$ref": "#/definitions/model.InstallCommandResponse"
                         }
                     }
                 }
             }
         },
-        "/configurations": {
+        "/agents": {
             "get": {
+                "description": "Use watch=true to stream the agents followed by changes to the agents as server-sent events.\nAgents that stop matching the selector or query are sent as removed.",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/event-stream"
+                ],
+                "summary": "List agents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "label selector to filter agents",
+                        "name": "selector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search query to filter agents",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "stream changes as server-sent events containing model.AgentWatchResponse",
+                        "name": "watch",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "field to sort by: name, id, or version",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of agents to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of agents to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to include for each agent, e.g. id,name,status",
+                        "name": "fields",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List Configurations",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ConfigurationsResponse"
+                            "$ref": "#/definitions/model.AgentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
                     "500": {
@@ -458,28 +473,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/configurations/{name}": {
-            "get": {
+            },
+            "delete": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get configuration by name",
+                "summary": "delete agents by ids",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "the name of the configuration",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "list of agent ids to delete",
+                        "name": "id",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ConfigurationResponse"
+                            "$ref": "#/definitions/model.DeleteAgentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
                     "500": {
@@ -489,27 +513,34 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/agents/commands": {
+            "post": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Delete configuration by name",
+                "summary": "Send a command to agents by id, selector, or agent group",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "the name of the configuration to delete",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "the command and the agents to receive it",
+                        "name": "command",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.AgentCommandPayload"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Successful Delete, no content"
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/model.AgentCommandsResponse"
+                        }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
@@ -523,42 +554,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/configurations/{name}/copy": {
-            "post": {
+        "/agents/drift": {
+            "get": {
+                "description": "Compares the effective configuration reported by each agent with the configuration it should be running.\nAgents that are in sync are only included when all=true.",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Duplicate an existing configuration",
+                "summary": "Get configuration drift of agents",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the configuration to duplicate",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "label selector used to filter agents",
+                        "name": "selector",
+                        "in": "query"
                     },
                     {
-                        "description": "the desired name of the duplicate configuration",
-                        "name": "name",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
+                        "type": "string",
+                        "description": "search query used to filter agents",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "include agents that are in sync",
+                        "name": "all",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Successful Copy, created"
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
+                            "$ref": "#/definitions/model.AgentDriftResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
@@ -572,95 +603,93 @@ const docTemplate = `{
                 }
             }
         },
-        "/delete": {
-            "post": {
-                "description": "/delete endpoint will try to parse resources\nand delete them from the store.  Additionally\nit will send reconfigure tasks to affected agents.",
+        "/agents/labels": {
+            "patch": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Delete multiple resources",
+                "summary": "Bulk apply labels to agents",
                 "parameters": [
                     {
-                        "description": "Resources",
-                        "name": "resources",
+                        "description": "agent IDs",
+                        "name": "ids",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.AnyResource"
+                                "type": "string"
                             }
                         }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    {
+                        "description": "name of an agent group whose members will also be labeled",
+                        "name": "group",
+                        "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/model.DeleteResponse"
+                            "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    {
+                        "description": "labels to apply",
+                        "name": "labels",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    {
+                        "description": "overwrite labels",
+                        "name": "labels",
+                        "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
+                            "type": "boolean"
                         }
                     }
-                }
-            }
-        },
-        "/destination-types": {
-            "get": {
-                "produces": [
-                    "application/json"
                 ],
-                "summary": "List destination types",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.DestinationTypesResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
+                            "$ref": "#/definitions/model.BulkAgentLabelsResponse"
                         }
                     }
                 }
             }
         },
-        "/destination-types/{name}": {
+        "/agents/pending": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get destination type by name",
+                "summary": "Get configuration changes deferred by the schedule of the configuration",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the destination type",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "label selector used to filter agents",
+                        "name": "selector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search query used to filter agents",
+                        "name": "query",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.DestinationTypeResponse"
+                            "$ref": "#/definitions/model.AgentPendingChangesResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
@@ -672,24 +701,29 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/agents/{id}": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Delete destination type by name",
+                "summary": "Get agent by id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the destination type to delete",
-                        "name": "name",
+                        "description": "the id of the agent",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Successful Delete, no content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AgentResponse"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -706,17 +740,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/destinations": {
+        "/agents/{id}/commands": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "List destinations",
+                "summary": "Get the commands sent to an agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the id of the agent",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.DestinationsResponse"
+                            "$ref": "#/definitions/model.AgentCommandsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
                     "500": {
@@ -728,17 +777,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/destinations/{name}": {
+        "/agents/{id}/configuration": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get destination by name",
+                "summary": "Get configuration for a given agent",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the destination",
-                        "name": "name",
+                        "description": "the id of the agent",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -747,11 +796,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.DestinationResponse"
+                            "$ref": "#/definitions/model.ConfigurationResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
@@ -763,29 +812,28 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/agents/{id}/diagnostics": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Delete destination by name",
+                "summary": "List the diagnostics bundles collected from an agent",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the destination to delete",
-                        "name": "name",
+                        "description": "the id of the agent",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Successful Delete, no content"
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
+                            "$ref": "#/definitions/model.DiagnosticsBundlesResponse"
                         }
                     },
                     "500": {
@@ -795,57 +843,27 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/downloads/{agent}/{version}/{platform}/{type}/{file}": {
-            "get": {
-                "description": "Get the agent download with the specified parameters",
+            },
+            "post": {
+                "description": "Sends a collect-diagnostics command to the agent. The ID of the command is the ID of the bundle that\nwill be available when the command succeeds.",
                 "produces": [
-                    "application/octet-stream"
+                    "application/json"
                 ],
-                "summary": "Get Agent Download",
+                "summary": "Request a diagnostics bundle from an agent",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "observiq-agent",
-                        "name": "agent",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "2.1.1",
-                        "name": "version",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "darwin-arm64",
-                        "name": "platform",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "installer",
-                        "name": "type",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "observiq-agent-installer.sh",
-                        "name": "file",
+                        "description": "the id of the agent",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "type": "file"
+                            "$ref": "#/definitions/model.AgentCommandResponse"
                         }
                     },
                     "404": {
@@ -863,17 +881,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/processor-types": {
+        "/agents/{id}/diagnostics/{bundleId}": {
             "get": {
                 "produces": [
-                    "application/json"
+                    "application/gzip"
+                ],
+                "summary": "Download a diagnostics bundle collected from an agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the id of the agent",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the id of the diagnostics bundle",
+                        "name": "bundleId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List processor types",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ProcessorTypesResponse"
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
                     "500": {
@@ -885,17 +925,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/processor-types/{name}": {
+        "/agents/{id}/labels": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get processor type by name",
+                "summary": "Get agent labels by agent id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the processor type",
-                        "name": "name",
+                        "description": "the id of the agent",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -904,11 +944,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ProcessorTypeResponse"
+                            "$ref": "#/definitions/model.AgentLabelsResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
@@ -921,23 +961,41 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "patch": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Delete processor type by name",
+                "summary": "Patch agent labels by agent id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the processor type to delete",
-                        "name": "name",
+                        "description": "the id of the agent",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "if true, overwrite any existing labels with the same names",
+                        "name": "overwrite",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Labels to be merged with existing labels, empty values will delete existing labels",
+                        "name": "labels",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.AgentLabelsPayload"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Successful Delete, no content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AgentLabelsResponse"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -945,27 +1003,11 @@ const docTemplate = `{
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/processors": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "summary": "List processors",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.ProcessorsResponse"
-                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -976,17 +1018,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/processors/{name}": {
-            "get": {
+        "/agents/{id}/pending/apply": {
+            "post": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get processor by name",
+                "summary": "Apply the pending change of an agent without waiting for the schedule of the configuration",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the processor",
-                        "name": "name",
+                        "description": "the id of the agent",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -995,11 +1037,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ProcessorResponse"
+                            "$ref": "#/definitions/model.AgentPendingChangeResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
@@ -1011,24 +1053,29 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/agents/{id}/restart": {
+            "put": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Delete processor by name",
+                "summary": "Restart agent",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the processor to delete",
-                        "name": "name",
+                        "description": "the id of the agent",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Successful Delete, no content"
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/model.AgentCommandResponse"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -1045,56 +1092,76 @@ const docTemplate = `{
                 }
             }
         },
-        "/source-types": {
-            "get": {
+        "/agents/{id}/version": {
+            "post": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "List source types",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.SourceTypesResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
-                        }
+                "summary": "TODO update agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the id of the agent",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     }
-                }
+                ],
+                "responses": {}
             }
         },
-        "/source-types/{name}": {
-            "get": {
+        "/apply": {
+            "post": {
+                "description": "The /apply route will try to parse resources\nand upsert them into the store.  Additionally\nit will send reconfigure tasks to affected agents.\nA resource with a resourceVersion is only applied if it matches the current\nresourceVersion, otherwise its status is conflict.",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get source type by name",
+                "summary": "Create, edit, and configure multiple resources.",
                 "parameters": [
+                    {
+                        "description": "Resources",
+                        "name": "resources",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.AnyResource"
+                            }
+                        }
+                    },
                     {
                         "type": "string",
-                        "description": "the name of the source type",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "ETag of the resource when applying a single resource",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.SourceTypeResponse"
+                            "$ref": "#/definitions/model.ApplyResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/model.ApplyResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1102,8 +1169,2268 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/backups": {
+            "get": {
+                "description": "Lists the backups saved by the server, most recent first.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List backups of the store",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.BackupsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Saves a consistent snapshot of the store while the server is running and removes the oldest backups\nbeyond the retention.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create a backup of the store",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.BackupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/catalog": {
+            "get": {
+                "description": "Compares each resource type in the catalog with the resource type in the store.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resource types in the resource type catalog",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.CatalogResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/catalog/diff": {
+            "get": {
+                "description": "Compares each resource type in the catalog with the resource type in the store, including a diff for\neach resource type that differs from the store.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Compare the resource types in the resource type catalog with the store",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.CatalogResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/catalog/sync": {
+            "post": {
+                "description": "Applies the new and updated resource types in the catalog to the store and returns the result for each\nresource type in the catalog.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Sync the resource types in the resource type catalog",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.CatalogResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/configurations": {
+            "get": {
+                "description": "Use watch=true to stream the configurations followed by changes to the configurations as server-sent events.\nConfigurations that stop matching the selector or query are sent as removed.",
+                "produces": [
+                    "application/json",
+                    "text/event-stream"
+                ],
+                "summary": "List Configurations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "label selector to filter configurations",
+                        "name": "selector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search query to filter configurations",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "stream changes as server-sent events containing model.ConfigurationWatchResponse",
+                        "name": "watch",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "field to sort by: name, id, or description",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of configurations to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to include for each configuration, e.g. metadata.name",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ConfigurationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/configurations/{name}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get configuration by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the configuration",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ConfigurationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "the resourceVersion of the resource"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete configuration by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the configuration to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the resource, which must match to delete it",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/configurations/{name}/copy": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Duplicate an existing configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the configuration to duplicate",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the desired name of the duplicate configuration",
+                        "name": "name",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successful Copy, created"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/configurations/{name}/dependencies": {
+            "get": {
+                "description": "Dependencies are the resources that the resource uses directly or indirectly, e.g. the sources of a\nconfiguration and the source types of those sources.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources used by a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependenciesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/configurations/{name}/dependents": {
+            "get": {
+                "description": "Dependents are the resources that use the resource directly or indirectly, e.g. the sources that use a\nsource type and the configurations that use those sources. Agent IDs are the agents using the resource\nif it is a configuration or any of the dependent configurations.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources and agents affected by a change to a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/connector-types": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List connector types",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "label selector to filter connector types",
+                        "name": "selector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "field to sort by: name, id, or description",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of connector types to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to include for each connector type, e.g. metadata.name",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ConnectorTypesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/connector-types/{name}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get connector type by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the connector type",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ConnectorTypeResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "the resourceVersion of the resource"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete connector type by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the connector type to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the resource, which must match to delete it",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/connector-types/{name}/dependencies": {
+            "get": {
+                "description": "Dependencies are the resources that the resource uses directly or indirectly, e.g. the sources of a\nconfiguration and the source types of those sources.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources used by a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependenciesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/connector-types/{name}/dependents": {
+            "get": {
+                "description": "Dependents are the resources that use the resource directly or indirectly, e.g. the sources that use a\nsource type and the configurations that use those sources. Agent IDs are the agents using the resource\nif it is a configuration or any of the dependent configurations.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources and agents affected by a change to a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/connector-types/{name}/versions": {
+            "get": {
+                "description": "Previous versions of a resource type are kept when it is replaced by a different version so that\nresources pinned to a previous version continue to use it. Versions are sorted from earliest to latest.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the versions of a resource type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource type",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceTypeVersionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/connectors": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List connectors",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "label selector to filter connectors",
+                        "name": "selector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "field to sort by: name, id, or description",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of connectors to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to include for each connector, e.g. metadata.name",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ConnectorsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/connectors/{name}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get connector by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the connector",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ConnectorResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "the resourceVersion of the resource"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete connector by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the connector to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the resource, which must match to delete it",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/connectors/{name}/dependencies": {
+            "get": {
+                "description": "Dependencies are the resources that the resource uses directly or indirectly, e.g. the sources of a\nconfiguration and the source types of those sources.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources used by a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependenciesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/connectors/{name}/dependents": {
+            "get": {
+                "description": "Dependents are the resources that use the resource directly or indirectly, e.g. the sources that use a\nsource type and the configurations that use those sources. Agent IDs are the agents using the resource\nif it is a configuration or any of the dependent configurations.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources and agents affected by a change to a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/delete": {
+            "post": {
+                "description": "/delete endpoint will try to parse resources\nand delete them from the store.  Additionally\nit will send reconfigure tasks to affected agents.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete multiple resources",
+                "parameters": [
+                    {
+                        "description": "Resources",
+                        "name": "resources",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.AnyResource"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.DeleteResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destination-types": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List destination types",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "label selector to filter destination types",
+                        "name": "selector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "field to sort by: name, id, or description",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of destination types to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to include for each destination type, e.g. metadata.name",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.DestinationTypesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destination-types/{name}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get destination type by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the destination type",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.DestinationTypeResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "the resourceVersion of the resource"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete destination type by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the destination type to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the resource, which must match to delete it",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destination-types/{name}/dependencies": {
+            "get": {
+                "description": "Dependencies are the resources that the resource uses directly or indirectly, e.g. the sources of a\nconfiguration and the source types of those sources.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources used by a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependenciesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destination-types/{name}/dependents": {
+            "get": {
+                "description": "Dependents are the resources that use the resource directly or indirectly, e.g. the sources that use a\nsource type and the configurations that use those sources. Agent IDs are the agents using the resource\nif it is a configuration or any of the dependent configurations.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources and agents affected by a change to a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destination-types/{name}/versions": {
+            "get": {
+                "description": "Previous versions of a resource type are kept when it is replaced by a different version so that\nresources pinned to a previous version continue to use it. Versions are sorted from earliest to latest.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the versions of a resource type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource type",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceTypeVersionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List destinations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "label selector to filter destinations",
+                        "name": "selector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "field to sort by: name, id, or description",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of destinations to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to include for each destination, e.g. metadata.name",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.DestinationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations/{name}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get destination by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the destination",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.DestinationResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "the resourceVersion of the resource"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete destination by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the destination to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the resource, which must match to delete it",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations/{name}/dependencies": {
+            "get": {
+                "description": "Dependencies are the resources that the resource uses directly or indirectly, e.g. the sources of a\nconfiguration and the source types of those sources.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources used by a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependenciesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations/{name}/dependents": {
+            "get": {
+                "description": "Dependents are the resources that use the resource directly or indirectly, e.g. the sources that use a\nsource type and the configurations that use those sources. Agent IDs are the agents using the resource\nif it is a configuration or any of the dependent configurations.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources and agents affected by a change to a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/downloads/{agent}/{version}/{platform}/{type}/{file}": {
+            "get": {
+                "description": "Get the agent download with the specified parameters",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "summary": "Get Agent Download",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "observiq-agent",
+                        "name": "agent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "2.1.1",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "darwin-arm64",
+                        "name": "platform",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "installer",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "observiq-agent-installer.sh",
+                        "name": "file",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events": {
+            "get": {
+                "description": "Streams events as newline-delimited JSON or as server-sent events if format=sse or the Accept header is\ntext/event-stream. To resume the stream after reconnecting, specify the ID of the last event received\nusing since or the Last-Event-ID header. 410 Gone is returned if those events are no longer available,\nincluding when the ID is from before the server restarted. An idle stream receives an empty line or a\ncomment as a heartbeat every 30 seconds. With a selector, an agent or resource that stops matching the\nselector is sent as a remove event.",
+                "produces": [
+                    "application/json",
+                    "text/event-stream"
+                ],
+                "summary": "Stream changes to agents and resources",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comma-separated kinds of events to stream, e.g. Agent,Configuration. All kinds are streamed by default.",
+                        "name": "kinds",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "label selector to filter events",
+                        "name": "selector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "resume after the event with this ID",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ndjson or sse",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "resume after the event with this ID",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Event"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/processor-types": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List processor types",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "label selector to filter processor types",
+                        "name": "selector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "field to sort by: name, id, or description",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of processor types to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to include for each processor type, e.g. metadata.name",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ProcessorTypesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/processor-types/{name}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get processor type by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the processor type",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ProcessorTypeResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "the resourceVersion of the resource"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete processor type by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the processor type to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the resource, which must match to delete it",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/processor-types/{name}/dependencies": {
+            "get": {
+                "description": "Dependencies are the resources that the resource uses directly or indirectly, e.g. the sources of a\nconfiguration and the source types of those sources.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources used by a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependenciesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/processor-types/{name}/dependents": {
+            "get": {
+                "description": "Dependents are the resources that use the resource directly or indirectly, e.g. the sources that use a\nsource type and the configurations that use those sources. Agent IDs are the agents using the resource\nif it is a configuration or any of the dependent configurations.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources and agents affected by a change to a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/processor-types/{name}/versions": {
+            "get": {
+                "description": "Previous versions of a resource type are kept when it is replaced by a different version so that\nresources pinned to a previous version continue to use it. Versions are sorted from earliest to latest.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the versions of a resource type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource type",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceTypeVersionsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/processors": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List processors",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "label selector to filter processors",
+                        "name": "selector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "field to sort by: name, id, or description",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of processors to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to include for each processor, e.g. metadata.name",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ProcessorsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/processors/{name}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get processor by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the processor",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ProcessorResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "the resourceVersion of the resource"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete processor by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the processor to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the resource, which must match to delete it",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/processors/{name}/dependencies": {
+            "get": {
+                "description": "Dependencies are the resources that the resource uses directly or indirectly, e.g. the sources of a\nconfiguration and the source types of those sources.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources used by a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependenciesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/processors/{name}/dependents": {
+            "get": {
+                "description": "Dependents are the resources that use the resource directly or indirectly, e.g. the sources that use a\nsource type and the configurations that use those sources. Agent IDs are the agents using the resource\nif it is a configuration or any of the dependent configurations.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources and agents affected by a change to a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource-types/migrate": {
+            "post": {
+                "description": "Applies the migrations of resource types to the parameters of outdated resources and pins them to the\ncurrent version. Resources that cannot be migrated are returned with the invalid status.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Migrate resources to the current resource type versions",
+                "parameters": [
+                    {
+                        "description": "the kind and name of the resources to migrate",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.MigrateResourcesPayload"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/model.ApplyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource-types/outdated": {
+            "get": {
+                "description": "Lists the sources, processors, destinations, connectors, and configurations that are pinned to a version\nof their resource type that is earlier than the current version.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List resources pinned to outdated resource type versions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OutdatedResourcesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/source-types": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List source types",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "label selector to filter source types",
+                        "name": "selector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "field to sort by: name, id, or description",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of source types to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to include for each source type, e.g. metadata.name",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SourceTypesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/source-types/{name}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get source type by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the source type",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SourceTypeResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "the resourceVersion of the resource"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
                 "produces": [
                     "application/json"
                 ],
@@ -1111,15 +3438,141 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the source type to delete",
+                        "description": "the name of the source type to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the resource, which must match to delete it",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/source-types/{name}/dependencies": {
+            "get": {
+                "description": "Dependencies are the resources that the resource uses directly or indirectly, e.g. the sources of a\nconfiguration and the source types of those sources.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources used by a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependenciesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/source-types/{name}/dependents": {
+            "get": {
+                "description": "Dependents are the resources that use the resource directly or indirectly, e.g. the sources that use a\nsource type and the configurations that use those sources. Agent IDs are the agents using the resource\nif it is a configuration or any of the dependent configurations.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources and agents affected by a change to a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
                         "name": "name",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Successful Delete, no content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/source-types/{name}/versions": {
+            "get": {
+                "description": "Previous versions of a resource type are kept when it is replaced by a different version so that\nresources pinned to a previous version continue to use it. Versions are sorted from earliest to latest.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the versions of a resource type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource type",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceTypeVersionsResponse"
+                        }
                     },
                     "404": {
                         "description": "Not Found",
@@ -1142,6 +3595,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "summary": "List sources",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "label selector to filter sources",
+                        "name": "selector",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "field to sort by: name, id, or description",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of sources to return",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to include for each source, e.g. metadata.name",
+                        "name": "fields",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1149,6 +3640,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.SourcesResponse"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1178,6 +3675,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/model.SourceResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "the resourceVersion of the resource"
+                            }
                         }
                     },
                     "401": {
@@ -1198,22 +3701,223 @@ const docTemplate = `{
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Delete source by name",
+                "summary": "Delete source by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the source to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the resource, which must match to delete it",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/{name}/dependencies": {
+            "get": {
+                "description": "Dependencies are the resources that the resource uses directly or indirectly, e.g. the sources of a\nconfiguration and the source types of those sources.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources used by a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependenciesResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/{name}/dependents": {
+            "get": {
+                "description": "Dependents are the resources that use the resource directly or indirectly, e.g. the sources that use a\nsource type and the configurations that use those sources. Agent IDs are the agents using the resource\nif it is a configuration or any of the dependent configurations.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List the resources and agents affected by a change to a resource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the resource",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ResourceDependentsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/store/migration": {
+            "post": {
+                "description": "Copies all resources and agents from the store of the server to the target store while the server is\nrunning. The target uses the sessions secret of the server so that user sessions remain valid. With\nfollow=true, changes are copied until the migration is stopped. Otherwise the stores are verified and\nthe checks are included in the response.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Migrate the store to another store",
+                "parameters": [
+                    {
+                        "description": "target store",
+                        "name": "migration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.StoreMigrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.StoreMigrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stops following changes, copies and verifies the stores one last time, and closes the target store so\nthat a server can be started with it.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Stop migrating the store",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.StoreMigrationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sync": {
+            "post": {
+                "description": "Compares the resources with the store, creates and updates resources that differ, and labels them as\nmanaged by sync. With prune, resources managed by sync that are not included are deleted in reverse\ndependency order. Resources in the store modified since they were synced are reported as drift.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Sync resources",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "the name of the source to delete",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "Resources to sync",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SyncPayload"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Successful Delete, no content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SyncResponse"
+                        }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
@@ -1246,12 +3950,54 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "common.GoogleCloudDatastore": {
+            "type": "object",
+            "properties": {
+                "credentialsFile": {
+                    "type": "string"
+                },
+                "endpoint": {
+                    "type": "string"
+                },
+                "projectID": {
+                    "type": "string"
+                }
+            }
+        },
+        "common.GoogleCloudPubSub": {
+            "type": "object",
+            "properties": {
+                "credentialsFile": {
+                    "type": "string"
+                },
+                "endpoint": {
+                    "type": "string"
+                },
+                "projectID": {
+                    "type": "string"
+                },
+                "subscription": {
+                    "description": "Subscription is the name of the subscription that this node should use. In production this will be generated but it\nis useful to specify in development.",
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
+                }
+            }
+        },
         "model.Agent": {
             "type": "object",
             "properties": {
                 "arch": {
                     "type": "string"
                 },
+                "commands": {
+                    "description": "Commands sent to the agent, most recent last",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AgentCommand"
+                    }
+                },
                 "configuration": {
                     "description": "tracked by BindPlane"
                 },
@@ -1261,6 +4007,10 @@ const docTemplate = `{
                 "disconnectedAt": {
                     "type": "string"
                 },
+                "drift": {
+                    "description": "Drift is updated periodically by comparing the effective configuration with the desired configuration",
+                    "$ref": "#/definitions/model.AgentDrift"
+                },
                 "errorMessage": {
                     "type": "string"
                 },
@@ -1285,6 +4035,10 @@ const docTemplate = `{
                 "operatingSystem": {
                     "type": "string"
                 },
+                "pendingChange": {
+                    "description": "PendingChange is a configuration change deferred by the schedule of the configuration",
+                    "$ref": "#/definitions/model.AgentPendingChange"
+                },
                 "platform": {
                     "type": "string"
                 },
@@ -1308,6 +4062,221 @@ const docTemplate = `{
                 }
             }
         },
+        "model.AgentCommand": {
+            "type": "object",
+            "properties": {
+                "agentId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "message": {
+                    "description": "Message contains details about the status, typically an error message if the command failed",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AgentCommandPayload": {
+            "type": "object",
+            "properties": {
+                "group": {
+                    "type": "string"
+                },
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "selector": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AgentCommandResponse": {
+            "type": "object",
+            "properties": {
+                "command": {
+                    "$ref": "#/definitions/model.AgentCommand"
+                }
+            }
+        },
+        "model.AgentCommandsResponse": {
+            "type": "object",
+            "properties": {
+                "commands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AgentCommand"
+                    }
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.AgentDrift": {
+            "type": "object",
+            "properties": {
+                "configuration": {
+                    "description": "Configuration is the name of the configuration the agent should be running",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "syncedHash": {
+                    "description": "SyncedHash is the hash of the desired collector configuration the last time the agent was in sync. It is used to\ndistinguish local modifications from changes on the server.",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "description": "UpdatedAt is the time the status last changed",
+                    "type": "string"
+                }
+            }
+        },
+        "model.AgentDriftReport": {
+            "type": "object",
+            "properties": {
+                "agentId": {
+                    "type": "string"
+                },
+                "agentName": {
+                    "type": "string"
+                },
+                "configuration": {
+                    "type": "string"
+                },
+                "diff": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AgentDriftResponse": {
+            "type": "object",
+            "properties": {
+                "agents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AgentDriftReport"
+                    }
+                }
+            }
+        },
+        "model.AgentGroup": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model.Metadata"
+                },
+                "spec": {
+                    "$ref": "#/definitions/model.AgentGroupSpec"
+                }
+            }
+        },
+        "model.AgentGroupCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AgentGroupResponse": {
+            "type": "object",
+            "properties": {
+                "agentGroup": {
+                    "$ref": "#/definitions/model.AgentGroup"
+                }
+            }
+        },
+        "model.AgentGroupSpec": {
+            "type": "object",
+            "properties": {
+                "query": {
+                    "type": "string"
+                },
+                "selector": {
+                    "$ref": "#/definitions/model.AgentSelector"
+                }
+            }
+        },
+        "model.AgentGroupSummary": {
+            "type": "object",
+            "properties": {
+                "agents": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "statuses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AgentGroupCount"
+                    }
+                },
+                "versions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AgentGroupCount"
+                    }
+                }
+            }
+        },
+        "model.AgentGroupSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "summary": {
+                    "$ref": "#/definitions/model.AgentGroupSummary"
+                }
+            }
+        },
+        "model.AgentGroupsResponse": {
+            "type": "object",
+            "properties": {
+                "agentGroups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AgentGroup"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
         "model.AgentLabelsPayload": {
             "type": "object",
             "properties": {
@@ -1319,17 +4288,59 @@ const docTemplate = `{
                 }
             }
         },
-        "model.AgentLabelsResponse": {
+        "model.AgentLabelsResponse": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "labels": {
+                    "$ref": "#/definitions/model.Labels"
+                }
+            }
+        },
+        "model.AgentPendingChange": {
+            "type": "object",
+            "properties": {
+                "agentId": {
+                    "type": "string"
+                },
+                "configuration": {
+                    "description": "Configuration is the name of the configuration with the pending change",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "forced": {
+                    "description": "Forced is true if the change was applied before the schedule allows it. The pending change remains until the agent\nreports the new configuration.",
+                    "type": "boolean"
+                },
+                "notBefore": {
+                    "description": "NotBefore is the next time the schedule of the configuration allows the change to be applied",
+                    "type": "string"
+                }
+            }
+        },
+        "model.AgentPendingChangeResponse": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "$ref": "#/definitions/model.AgentPendingChange"
+                }
+            }
+        },
+        "model.AgentPendingChangesResponse": {
             "type": "object",
             "properties": {
-                "errors": {
+                "changes": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/model.AgentPendingChange"
                     }
-                },
-                "labels": {
-                    "$ref": "#/definitions/model.Labels"
                 }
             }
         },
@@ -1352,6 +4363,10 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/model.Agent"
                     }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is the cursor for the next page of results when a limit is specified and there are more results",
+                    "type": "string"
                 }
             }
         },
@@ -1384,6 +4399,45 @@ const docTemplate = `{
                 }
             }
         },
+        "model.Backup": {
+            "type": "object",
+            "properties": {
+                "checksum": {
+                    "description": "Checksum is the hex encoded sha256 checksum of the snapshot, used to verify its integrity before it is restored",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name identifies the backup and is derived from the time it was created, e.g. bindplane-20221019T120000.000Z",
+                    "type": "string"
+                },
+                "size": {
+                    "description": "Size is the size of the snapshot in bytes",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.BackupResponse": {
+            "type": "object",
+            "properties": {
+                "backup": {
+                    "$ref": "#/definitions/model.Backup"
+                }
+            }
+        },
+        "model.BackupsResponse": {
+            "type": "object",
+            "properties": {
+                "backups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Backup"
+                    }
+                }
+            }
+        },
         "model.BulkAgentLabelsResponse": {
             "type": "object",
             "properties": {
@@ -1395,6 +4449,46 @@ const docTemplate = `{
                 }
             }
         },
+        "model.CatalogResourceType": {
+            "type": "object",
+            "properties": {
+                "diff": {
+                    "description": "Diff is a unified diff from the resource type in the store to the resource type in the catalog",
+                    "type": "string"
+                },
+                "installedVersion": {
+                    "description": "InstalledVersion is the version of the resource type in the store",
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Reason explains conflict and invalid statuses",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CatalogResponse": {
+            "type": "object",
+            "properties": {
+                "resourceTypes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.CatalogResourceType"
+                    }
+                }
+            }
+        },
         "model.Configuration": {
             "type": "object",
             "properties": {
@@ -1424,9 +4518,36 @@ const docTemplate = `{
                 }
             }
         },
+        "model.ConfigurationSchedule": {
+            "type": "object",
+            "properties": {
+                "activateAt": {
+                    "description": "ActivateAt is the earliest time changes will be applied",
+                    "type": "string"
+                },
+                "maintenanceWindows": {
+                    "description": "MaintenanceWindows are the recurring windows when changes may be applied. If no window matches the labels of an\nagent, changes are applied to the agent at any time after ActivateAt.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MaintenanceWindow"
+                    }
+                }
+            }
+        },
         "model.ConfigurationSpec": {
             "type": "object",
             "properties": {
+                "agentGroup": {
+                    "description": "AgentGroup limits the configuration to agents that are members of the AgentGroup with this name",
+                    "type": "string"
+                },
+                "connectors": {
+                    "description": "Connectors join the pipelines of the sources to the pipelines of the destinations, e.g. to generate metrics from\ntraces. The processors of a connector process the telemetry emitted by the connector.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ResourceConfiguration"
+                    }
+                },
                 "contentType": {
                     "type": "string"
                 },
@@ -1436,9 +4557,20 @@ const docTemplate = `{
                         "$ref": "#/definitions/model.ResourceConfiguration"
                     }
                 },
+                "processors": {
+                    "description": "Processors apply to all of the telemetry sent to the destinations, e.g. for batching or resource attribute\nenrichment. They are placed after the processors of each source and before the processors of each destination.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ResourceConfiguration"
+                    }
+                },
                 "raw": {
                     "type": "string"
                 },
+                "schedule": {
+                    "description": "Schedule restricts when changes to the configuration are applied to agents",
+                    "$ref": "#/definitions/model.ConfigurationSchedule"
+                },
                 "selector": {
                     "$ref": "#/definitions/model.AgentSelector"
                 },
@@ -1458,6 +4590,88 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/model.Configuration"
                     }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Connector": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model.Metadata"
+                },
+                "spec": {
+                    "description": "Spec TODO(doc)",
+                    "$ref": "#/definitions/model.ParameterizedSpec"
+                }
+            }
+        },
+        "model.ConnectorResponse": {
+            "type": "object",
+            "properties": {
+                "connector": {
+                    "$ref": "#/definitions/model.Connector"
+                }
+            }
+        },
+        "model.ConnectorType": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model.Metadata"
+                },
+                "spec": {
+                    "$ref": "#/definitions/model.ResourceTypeSpec"
+                }
+            }
+        },
+        "model.ConnectorTypeResponse": {
+            "type": "object",
+            "properties": {
+                "connectorType": {
+                    "$ref": "#/definitions/model.ConnectorType"
+                }
+            }
+        },
+        "model.ConnectorTypesResponse": {
+            "type": "object",
+            "properties": {
+                "connectorTypes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ConnectorType"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ConnectorsResponse": {
+            "type": "object",
+            "properties": {
+                "connectors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Connector"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
                 }
             }
         },
@@ -1469,6 +4683,10 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/model.Agent"
                     }
+                },
+                "nextCursor": {
+                    "description": "NextCursor is the cursor for the next page of results when a limit is specified and there are more results",
+                    "type": "string"
                 }
             }
         },
@@ -1548,6 +4766,9 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/model.DestinationType"
                     }
+                },
+                "nextCursor": {
+                    "type": "string"
                 }
             }
         },
@@ -1559,43 +4780,186 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/model.Destination"
                     }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DiagnosticsBundle": {
+            "type": "object",
+            "properties": {
+                "agentId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "description": "ID is the ID of the bundle which matches the ID of the collect-diagnostics command that requested it",
+                    "type": "string"
+                },
+                "size": {
+                    "description": "Size is the size of the archive in bytes",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.DiagnosticsBundlesResponse": {
+            "type": "object",
+            "properties": {
+                "bundles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.DiagnosticsBundle"
+                    }
+                }
+            }
+        },
+        "model.Event": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of the resource or the ID of the agent",
+                    "type": "string"
+                },
+                "resource": {},
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InstallCommandResponse": {
+            "type": "object",
+            "properties": {
+                "command": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Labels": {
+            "type": "object"
+        },
+        "model.MaintenanceWindow": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "description": "Days are the days of the week the window starts, e.g. [\"sat\", \"sun\"]. The window starts every day if empty.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "duration": {
+                    "description": "Duration is the length of the window, e.g. \"4h\"",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "selector": {
+                    "description": "Selector limits the window to agents with matching labels. The window applies to all agents if empty.",
+                    "$ref": "#/definitions/model.AgentSelector"
+                },
+                "start": {
+                    "description": "Start is the time of day the window starts in 24 hour format, e.g. \"22:00\"",
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "Timezone is the IANA name of the timezone of Start, e.g. \"America/New_York\". It defaults to UTC.",
+                    "type": "string"
+                }
+            }
+        },
+        "model.Metadata": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "displayName": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "labels": {
+                    "$ref": "#/definitions/model.Labels"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "ResourceVersion is incremented by the store each time the resource changes. When specified on apply or delete,\nit must match the current resourceVersion of the stored resource.",
+                    "type": "integer"
                 }
             }
         },
-        "model.InstallCommandResponse": {
+        "model.MigrateResourcesPayload": {
             "type": "object",
             "properties": {
-                "command": {
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "model.Labels": {
-            "type": "object"
-        },
-        "model.Metadata": {
+        "model.OutdatedResource": {
             "type": "object",
             "properties": {
-                "description": {
+                "currentVersion": {
                     "type": "string"
                 },
-                "displayName": {
+                "kind": {
                     "type": "string"
                 },
-                "icon": {
+                "migratable": {
+                    "description": "Migratable is true if the resource type has migrations from the pinned version to the current version",
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
                 },
-                "id": {
+                "path": {
+                    "description": "Path identifies the source, processor, or destination within the resource that is pinned, e.g.\nsources[0].processors[1]. It is empty if the resource itself is pinned.",
                     "type": "string"
                 },
-                "labels": {
-                    "$ref": "#/definitions/model.Labels"
+                "resourceType": {
+                    "type": "string"
                 },
-                "name": {
+                "typeVersion": {
                     "type": "string"
                 }
             }
         },
+        "model.OutdatedResourcesResponse": {
+            "type": "object",
+            "properties": {
+                "resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.OutdatedResource"
+                    }
+                }
+            }
+        },
         "model.Parameter": {
             "type": "object",
             "properties": {
@@ -1625,9 +4989,27 @@ const docTemplate = `{
                 "label": {
                     "type": "string"
                 },
+                "max": {
+                    "type": "number"
+                },
+                "min": {
+                    "description": "Min and Max constrain the value of \"int\" and \"float\" parameters, the number of bytes of \"byteSize\" parameters, the\nnumber of seconds of \"duration\" parameters, and the number of items of \"strings\", \"enums\", and \"objects\"\nparameters.",
+                    "type": "number"
+                },
                 "name": {
                     "type": "string"
                 },
+                "pattern": {
+                    "description": "Pattern is a regular expression that must match the value of \"string\", \"strings\", \"filePath\", and \"hostPort\"\nparameters",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties is the schema of each field of \"object\" parameters and of each item of \"objects\" parameters",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ParameterDefinition"
+                    }
+                },
                 "relevantIf": {
                     "type": "array",
                     "items": {
@@ -1638,11 +5020,11 @@ const docTemplate = `{
                     "type": "boolean"
                 },
                 "type": {
-                    "description": "\"string\", \"int\", \"bool\", \"strings\", or \"enum\"",
+                    "description": "\"string\", \"int\", \"bool\", \"strings\", \"enum\", \"enums\", \"yaml\", \"map\", \"float\", \"duration\", \"byteSize\", \"regex\",\n\"timezone\", \"filePath\", \"hostPort\", \"object\", or \"objects\"",
                     "type": "string"
                 },
                 "validValues": {
-                    "description": "only useable if Type == \"enum\"",
+                    "description": "only useable if Type == \"enum\" or Type == \"enums\"",
                     "type": "array",
                     "items": {
                         "type": "string"
@@ -1650,6 +5032,17 @@ const docTemplate = `{
                 }
             }
         },
+        "model.ParameterRename": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
         "model.ParameterizedSpec": {
             "type": "object",
             "properties": {
@@ -1667,6 +5060,10 @@ const docTemplate = `{
                 },
                 "type": {
                     "type": "string"
+                },
+                "typeVersion": {
+                    "description": "TypeVersion pins the resource to a version of the resource type. If empty, the current version is used.",
+                    "type": "string"
                 }
             }
         },
@@ -1724,6 +5121,9 @@ const docTemplate = `{
         "model.ProcessorTypesResponse": {
             "type": "object",
             "properties": {
+                "nextCursor": {
+                    "type": "string"
+                },
                 "processorTypes": {
                     "type": "array",
                     "items": {
@@ -1735,6 +5135,9 @@ const docTemplate = `{
         "model.ProcessorsResponse": {
             "type": "object",
             "properties": {
+                "nextCursor": {
+                    "type": "string"
+                },
                 "processors": {
                     "type": "array",
                     "items": {
@@ -1746,6 +5149,12 @@ const docTemplate = `{
         "model.RelevantIfCondition": {
             "type": "object",
             "properties": {
+                "conditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.RelevantIfCondition"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
@@ -1777,6 +5186,49 @@ const docTemplate = `{
                 },
                 "type": {
                     "type": "string"
+                },
+                "typeVersion": {
+                    "description": "TypeVersion pins the resource to a version of the resource type. For named resources, it overrides the version\npinned by the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ResourceDependenciesResponse": {
+            "type": "object",
+            "properties": {
+                "resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ResourceReference"
+                    }
+                }
+            }
+        },
+        "model.ResourceDependentsResponse": {
+            "type": "object",
+            "properties": {
+                "agentIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ResourceReference"
+                    }
+                }
+            }
+        },
+        "model.ResourceReference": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
@@ -1796,9 +5248,58 @@ const docTemplate = `{
                 }
             }
         },
+        "model.ResourceType": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model.Metadata"
+                },
+                "spec": {
+                    "$ref": "#/definitions/model.ResourceTypeSpec"
+                }
+            }
+        },
+        "model.ResourceTypeMigration": {
+            "type": "object",
+            "properties": {
+                "default": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Parameter"
+                    }
+                },
+                "drop": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "rename": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ParameterRename"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
         "model.ResourceTypeOutput": {
             "type": "object",
             "properties": {
+                "connectors": {
+                    "type": "string"
+                },
                 "exporters": {
                     "type": "string"
                 },
@@ -1816,6 +5317,13 @@ const docTemplate = `{
         "model.ResourceTypeSpec": {
             "type": "object",
             "properties": {
+                "connectorOutputs": {
+                    "description": "ConnectorOutputs are the telemetry types emitted by the connectors of a ConnectorType. The connectors rendered for\na telemetry type are exporters of the pipelines of that type and receivers of the pipelines of these types, e.g.\na connector rendered by traces with metrics outputs converts traces to metrics.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "logs": {
                     "description": "individual",
                     "$ref": "#/definitions/model.ResourceTypeOutput"
@@ -1837,6 +5345,20 @@ const docTemplate = `{
                 "metrics+traces": {
                     "$ref": "#/definitions/model.ResourceTypeOutput"
                 },
+                "migrations": {
+                    "description": "Migrations describe how to update the parameters of resources using previous versions of this resource type",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ResourceTypeMigration"
+                    }
+                },
+                "parameterGroups": {
+                    "description": "ParameterGroups are the names of groups of parameters shared by resource types, e.g. retry_on_failure and\nsending_queue. The parameters of the groups are added to Parameters when the resource type is parsed.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "parameters": {
                     "description": "Parameters currently uses the model from stanza. Eventually we will probably create a separate definition for\nBindPlane.",
                     "type": "array",
@@ -1858,6 +5380,17 @@ const docTemplate = `{
                 }
             }
         },
+        "model.ResourceTypeVersionsResponse": {
+            "type": "object",
+            "properties": {
+                "resourceTypes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ResourceType"
+                    }
+                }
+            }
+        },
         "model.Source": {
             "type": "object",
             "properties": {
@@ -1912,6 +5445,9 @@ const docTemplate = `{
         "model.SourceTypesResponse": {
             "type": "object",
             "properties": {
+                "nextCursor": {
+                    "type": "string"
+                },
                 "sourceTypes": {
                     "type": "array",
                     "items": {
@@ -1923,6 +5459,9 @@ const docTemplate = `{
         "model.SourcesResponse": {
             "type": "object",
             "properties": {
+                "nextCursor": {
+                    "type": "string"
+                },
                 "sources": {
                     "type": "array",
                     "items": {
@@ -1931,6 +5470,147 @@ const docTemplate = `{
                 }
             }
         },
+        "model.StoreMigration": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "description": "Checks compare the resources and agents of each kind in both stores once the migration is complete",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.StoreMigrationCheck"
+                    }
+                },
+                "counts": {
+                    "description": "Counts are the number of resources and agents of each kind copied by the most recent copy",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.StoreMigrationCounts"
+                    }
+                },
+                "following": {
+                    "description": "Following is true while changes are being copied to the target store",
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.StoreMigrationCheck": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string"
+                },
+                "matches": {
+                    "type": "boolean"
+                },
+                "sourceCount": {
+                    "type": "integer"
+                },
+                "targetCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.StoreMigrationCounts": {
+            "type": "object",
+            "properties": {
+                "copied": {
+                    "type": "integer"
+                },
+                "deleted": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "unchanged": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.StoreMigrationRequest": {
+            "type": "object",
+            "properties": {
+                "datastore": {
+                    "description": "GoogleCloudDatastore and GoogleCloudPubSub configure a googlecloud target store",
+                    "$ref": "#/definitions/common.GoogleCloudDatastore"
+                },
+                "follow": {
+                    "description": "Follow continues copying changes to the store of the server until the migration is stopped",
+                    "type": "boolean"
+                },
+                "pubsub": {
+                    "$ref": "#/definitions/common.GoogleCloudPubSub"
+                },
+                "storageFilePath": {
+                    "description": "StorageFilePath is the path of the bbolt file of the target store, which must not be in use by another server",
+                    "type": "string"
+                },
+                "storeType": {
+                    "description": "StoreType is the type of the target store, e.g. bbolt or googlecloud",
+                    "type": "string"
+                }
+            }
+        },
+        "model.StoreMigrationResponse": {
+            "type": "object",
+            "properties": {
+                "migration": {
+                    "$ref": "#/definitions/model.StoreMigration"
+                }
+            }
+        },
+        "model.SyncPayload": {
+            "type": "object",
+            "properties": {
+                "dryRun": {
+                    "description": "DryRun compares Resources with the store without changing the store",
+                    "type": "boolean"
+                },
+                "prune": {
+                    "description": "Prune deletes resources managed by sync that are not included in Resources",
+                    "type": "boolean"
+                },
+                "resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AnyResource"
+                    }
+                }
+            }
+        },
+        "model.SyncResource": {
+            "type": "object",
+            "properties": {
+                "drift": {
+                    "description": "Drift is true if the resource in the store was modified since it was synced",
+                    "type": "boolean"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Reason explains drift and in-use and invalid statuses",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.SyncResponse": {
+            "type": "object",
+            "properties": {
+                "resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SyncResource"
+                    }
+                }
+            }
+        },
         "rest.ErrorResponse": {
             "type": "object",
             "properties": {
@@ -0,0 +1,452 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backup takes snapshots of the bbolt store, keeps a limited number of them, and restores them after verifying
+// their integrity.
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+const (
+	// DefaultInterval is the default amount of time between scheduled backups
+	DefaultInterval = 24 * time.Hour
+
+	// DefaultRetention is the default number of backups to keep
+	DefaultRetention = 7
+
+	namePrefix        = "bindplane-"
+	nameTimeFormat    = "20060102T150405.000Z"
+	snapshotExtension = ".db"
+	metadataExtension = ".json"
+
+	// lockTimeout is the maximum time to wait for the lock on the storage file when restoring
+	lockTimeout = time.Second
+)
+
+// ErrNotSupported is returned when a backup is requested but the store does not support backups
+var ErrNotSupported = errors.New("backups are only supported by the bbolt store")
+
+// Source writes a consistent snapshot of the store
+type Source interface {
+	// Backup writes a snapshot of the store to the writer and returns the number of bytes written
+	Backup(writer io.Writer) (int64, error)
+}
+
+// Backups creates and lists backups of the store
+type Backups interface {
+	// Create saves a new backup of the store and removes the oldest backups beyond the retention
+	Create(ctx context.Context) (*model.Backup, error)
+
+	// List returns the backups, most recent first
+	List() ([]*model.Backup, error)
+
+	// Interval returns the amount of time between scheduled backups or zero if backups are only created on request
+	Interval() time.Duration
+}
+
+// Settings configures Backups
+type Settings struct {
+	// Directory is the folder where backups are saved
+	Directory string
+
+	// Interval is the amount of time between scheduled backups. If zero, backups are only created on request.
+	Interval time.Duration
+
+	// Retention is the number of backups to keep. If zero, all backups are kept.
+	Retention int
+
+	Source Source
+	Logger *zap.Logger
+}
+
+type backups struct {
+	directory string
+	interval  time.Duration
+	retention int
+	source    Source
+	logger    *zap.Logger
+
+	// mtx ensures that only one backup is created at a time
+	mtx sync.Mutex
+}
+
+var _ Backups = (*backups)(nil)
+
+// NewBackups returns new Backups using the specified settings
+func NewBackups(settings Settings) Backups {
+	return &backups{
+		directory: settings.Directory,
+		interval:  settings.Interval,
+		retention: settings.Retention,
+		source:    settings.Source,
+		logger:    settings.Logger,
+	}
+}
+
+func (b *backups) Create(_ context.Context) (*model.Backup, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	backup, err := Create(b.directory, b.source, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	b.logger.Info("created backup", zap.String("name", backup.Name), zap.Int64("size", backup.Size))
+
+	removed, err := Prune(b.directory, b.retention)
+	if err != nil {
+		b.logger.Error("unable to remove old backups", zap.Error(err))
+	}
+	for _, r := range removed {
+		b.logger.Info("removed backup", zap.String("name", r.Name))
+	}
+	return backup, nil
+}
+
+func (b *backups) List() ([]*model.Backup, error) {
+	return List(b.directory)
+}
+
+func (b *backups) Interval() time.Duration {
+	return b.interval
+}
+
+// ----------------------------------------------------------------------
+
+// Create writes a snapshot of the source to the directory. The snapshot is written to {directory}/{name}.db and its
+// size and checksum are written to {directory}/{name}.json.
+func Create(directory string, source Source, now time.Time) (*model.Backup, error) {
+	if err := os.MkdirAll(directory, 0750); err != nil {
+		return nil, fmt.Errorf("unable to create the backups directory: %w", err)
+	}
+
+	now = now.UTC()
+	backup := &model.Backup{
+		Name:      namePrefix + now.Format(nameTimeFormat),
+		CreatedAt: now,
+	}
+
+	// write to a temporary file first so that an incomplete snapshot is never listed
+	file, err := os.CreateTemp(directory, ".backup-*")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the backup: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(file.Name())
+	}()
+
+	hash := sha256.New()
+	backup.Size, err = source.Backup(io.MultiWriter(file, hash))
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to write the backup: %w", err)
+	}
+	backup.Checksum = hex.EncodeToString(hash.Sum(nil))
+
+	if err := os.Rename(file.Name(), snapshotPath(directory, backup.Name)); err != nil {
+		return nil, fmt.Errorf("unable to write the backup: %w", err)
+	}
+	metadata, err := json.Marshal(backup)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(metadataPath(directory, backup.Name), metadata, 0600); err != nil {
+		return nil, fmt.Errorf("unable to write the backup: %w", err)
+	}
+	return backup, nil
+}
+
+// List returns the backups in the directory, most recent first. There are no backups if the directory does not exist.
+func List(directory string) ([]*model.Backup, error) {
+	entries, err := os.ReadDir(directory)
+	if errors.Is(err, os.ErrNotExist) {
+		return []*model.Backup{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the backups directory: %w", err)
+	}
+
+	result := []*model.Backup{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, namePrefix) || !strings.HasSuffix(name, metadataExtension) {
+			continue
+		}
+		backup, err := readMetadata(directory, strings.TrimSuffix(name, metadataExtension))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, backup)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+// Prune removes the oldest backups in the directory so that only the specified number remain. If retention is zero,
+// all backups are kept.
+func Prune(directory string, retention int) ([]*model.Backup, error) {
+	if retention <= 0 {
+		return nil, nil
+	}
+	list, err := List(directory)
+	if err != nil || len(list) <= retention {
+		return nil, err
+	}
+	removed := list[retention:]
+	for _, backup := range removed {
+		// remove the metadata first so that a backup is never listed without its snapshot
+		if err := os.Remove(metadataPath(directory, backup.Name)); err != nil {
+			return nil, fmt.Errorf("unable to remove backup %s: %w", backup.Name, err)
+		}
+		if err := os.Remove(snapshotPath(directory, backup.Name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("unable to remove backup %s: %w", backup.Name, err)
+		}
+	}
+	return removed, nil
+}
+
+// Verify checks that the snapshot of the backup matches the size and checksum recorded when it was created and that
+// the snapshot is a consistent bbolt database
+func Verify(directory string, name string) (*model.Backup, error) {
+	backup, err := readMetadata(directory, name)
+	if err != nil {
+		return nil, err
+	}
+
+	path := snapshotPath(directory, name)
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read backup %s: %w", name, err)
+	}
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	_ = file.Close()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read backup %s: %w", name, err)
+	}
+	if size != backup.Size {
+		return nil, fmt.Errorf("backup %s is corrupt, expected %d bytes but found %d", name, backup.Size, size)
+	}
+	if checksum := hex.EncodeToString(hash.Sum(nil)); checksum != backup.Checksum {
+		return nil, fmt.Errorf("backup %s is corrupt, expected checksum %s but found %s", name, backup.Checksum, checksum)
+	}
+
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{ReadOnly: true, Timeout: lockTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("backup %s is not a valid database: %w", name, err)
+	}
+	defer db.Close()
+	err = db.View(func(tx *bbolt.Tx) error {
+		var errs []string
+		for err := range tx.Check() {
+			errs = append(errs, err.Error())
+		}
+		if len(errs) > 0 {
+			return errors.New(strings.Join(errs, ", "))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("backup %s is corrupt: %w", name, err)
+	}
+	return backup, nil
+}
+
+// Restore verifies the backup and replaces the storage file with its snapshot. The storage file must not be in use by
+// a running server. The existing storage file is kept with the suffix .pre-restore and its path is returned.
+//
+// The storage file stays locked until its contents are replaced in a single transaction, so a server cannot open it
+// during the restore and a failed restore leaves it unchanged. A storage file that cannot be opened is replaced instead
+// and moved back if the snapshot cannot be copied.
+func Restore(directory string, name string, storageFilePath string) (string, error) {
+	if _, err := Verify(directory, name); err != nil {
+		return "", err
+	}
+
+	if _, err := os.Stat(storageFilePath); err != nil {
+		if err := copyFile(snapshotPath(directory, name), storageFilePath); err != nil {
+			return "", fmt.Errorf("unable to restore backup %s: %w", name, err)
+		}
+		return "", nil
+	}
+	previous := storageFilePath + ".pre-restore"
+
+	db, err := bbolt.Open(storageFilePath, 0600, &bbolt.Options{Timeout: lockTimeout})
+	if errors.Is(err, bbolt.ErrTimeout) {
+		return "", fmt.Errorf("%s is in use, stop the server before restoring a backup", storageFilePath)
+	}
+	if err != nil {
+		if err := replaceStorageFile(snapshotPath(directory, name), storageFilePath, previous); err != nil {
+			return "", fmt.Errorf("unable to restore backup %s: %w", name, err)
+		}
+		return previous, nil
+	}
+	defer func() { _ = db.Close() }()
+
+	err = db.View(func(tx *bbolt.Tx) error {
+		return tx.CopyFile(previous, 0600)
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to keep the existing storage file: %w", err)
+	}
+	if err := restoreSnapshot(db, snapshotPath(directory, name)); err != nil {
+		return previous, fmt.Errorf("unable to restore backup %s: %w", name, err)
+	}
+	return previous, nil
+}
+
+// restoreSnapshot replaces all of the buckets in the database with the buckets in the snapshot in a single transaction
+func restoreSnapshot(db *bbolt.DB, path string) error {
+	snapshot, err := bbolt.Open(path, 0600, &bbolt.Options{ReadOnly: true, Timeout: lockTimeout})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = snapshot.Close() }()
+
+	return snapshot.View(func(source *bbolt.Tx) error {
+		return db.Update(func(tx *bbolt.Tx) error {
+			var names [][]byte
+			_ = tx.ForEach(func(name []byte, _ *bbolt.Bucket) error {
+				names = append(names, append([]byte{}, name...))
+				return nil
+			})
+			for _, name := range names {
+				if err := tx.DeleteBucket(name); err != nil {
+					return err
+				}
+			}
+			return source.ForEach(func(name []byte, from *bbolt.Bucket) error {
+				to, err := tx.CreateBucket(name)
+				if err != nil {
+					return err
+				}
+				return copyBucket(from, to)
+			})
+		})
+	})
+}
+
+// copyBucket copies the keys and nested buckets of a bucket
+func copyBucket(from, to *bbolt.Bucket) error {
+	if err := to.SetSequence(from.Sequence()); err != nil {
+		return err
+	}
+	return from.ForEach(func(key, value []byte) error {
+		if value != nil {
+			return to.Put(key, value)
+		}
+		child, err := to.CreateBucket(key)
+		if err != nil {
+			return err
+		}
+		return copyBucket(from.Bucket(key), child)
+	})
+}
+
+// replaceStorageFile moves a storage file that cannot be opened to previous and copies the snapshot in its place. The
+// storage file is moved back if the snapshot cannot be copied.
+func replaceStorageFile(snapshot, storageFilePath, previous string) error {
+	if err := os.Rename(storageFilePath, previous); err != nil {
+		return fmt.Errorf("unable to keep the existing storage file: %w", err)
+	}
+	if err := copyFile(snapshot, storageFilePath); err != nil {
+		if renameErr := os.Rename(previous, storageFilePath); renameErr != nil {
+			return fmt.Errorf("%w, and unable to move %s back: %s", err, previous, renameErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------
+
+func readMetadata(directory string, name string) (*model.Backup, error) {
+	if !strings.HasPrefix(name, namePrefix) || filepath.Base(name) != name {
+		return nil, fmt.Errorf("invalid backup name %s", name)
+	}
+	data, err := os.ReadFile(metadataPath(directory, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("backup %s not found", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read backup %s: %w", name, err)
+	}
+	var backup model.Backup
+	if err := json.Unmarshal(data, &backup); err != nil {
+		return nil, fmt.Errorf("unable to read backup %s: %w", name, err)
+	}
+	return &backup, nil
+}
+
+// copyFile copies the file to a temporary file next to the destination and renames it so that the destination is
+// never partially written
+func copyFile(from, to string) error {
+	source, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	temp := to + ".restore"
+	destination, err := os.OpenFile(temp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0640)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(destination, source)
+	if err == nil {
+		err = destination.Sync()
+	}
+	if closeErr := destination.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(temp)
+		return err
+	}
+	return os.Rename(temp, to)
+}
+
+func snapshotPath(directory, name string) string {
+	return filepath.Join(directory, name+snapshotExtension)
+}
+
+func metadataPath(directory, name string) string {
+	return filepath.Join(directory, name+metadataExtension)
+}
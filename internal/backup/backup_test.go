@@ -0,0 +1,267 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/internal/store"
+)
+
+func testDB(t *testing.T, path string, value string) *bbolt.DB {
+	db, err := store.InitDB(path)
+	require.NoError(t, err)
+	err = db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte("Resources"))
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte("key"), []byte(value))
+	})
+	require.NoError(t, err)
+	return db
+}
+
+// testSource returns a bolt store for the database, which is the Source used by the server
+func testSource(t *testing.T, db *bbolt.DB) Source {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	source, ok := store.NewBoltStore(ctx, db, store.Options{MaxEventsToMerge: 1}, zap.NewNop()).(Source)
+	require.True(t, ok, "bolt store should implement backup.Source")
+	return source
+}
+
+func readValue(t *testing.T, path string) string {
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	defer db.Close()
+	var value string
+	err = db.View(func(tx *bbolt.Tx) error {
+		value = string(tx.Bucket([]byte("Resources")).Get([]byte("key")))
+		return nil
+	})
+	require.NoError(t, err)
+	return value
+}
+
+func TestCreateListPrune(t *testing.T) {
+	dir := t.TempDir()
+	db := testDB(t, filepath.Join(dir, "storage"), "value")
+	defer db.Close()
+
+	backupsDir := filepath.Join(dir, "backups")
+	list, err := List(backupsDir)
+	require.NoError(t, err)
+	require.Empty(t, list)
+
+	start := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		backup, err := Create(backupsDir, testSource(t, db), start.Add(time.Duration(i)*time.Hour))
+		require.NoError(t, err)
+		require.NotZero(t, backup.Size)
+		require.Len(t, backup.Checksum, 64)
+	}
+
+	list, err = List(backupsDir)
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	require.Equal(t, "bindplane-20220601T140000.000Z", list[0].Name)
+	require.Equal(t, "bindplane-20220601T120000.000Z", list[2].Name)
+
+	removed, err := Prune(backupsDir, 2)
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	require.Equal(t, "bindplane-20220601T120000.000Z", removed[0].Name)
+	require.NoFileExists(t, filepath.Join(backupsDir, "bindplane-20220601T120000.000Z.db"))
+
+	list, err = List(backupsDir)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	// zero retention keeps all backups
+	removed, err = Prune(backupsDir, 0)
+	require.NoError(t, err)
+	require.Empty(t, removed)
+}
+
+func TestBackupsCreate(t *testing.T) {
+	dir := t.TempDir()
+	db := testDB(t, filepath.Join(dir, "storage"), "value")
+	defer db.Close()
+
+	backups := NewBackups(Settings{
+		Directory: filepath.Join(dir, "backups"),
+		Interval:  time.Hour,
+		Retention: 1,
+		Source:    testSource(t, db),
+		Logger:    zap.NewNop(),
+	})
+	require.Equal(t, time.Hour, backups.Interval())
+
+	_, err := backups.Create(context.Background())
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	latest, err := backups.Create(context.Background())
+	require.NoError(t, err)
+
+	list, err := backups.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, latest.Name, list[0].Name)
+}
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name      string
+		corrupt   func(path string)
+		expectErr string
+	}{
+		{
+			name:    "valid",
+			corrupt: func(path string) {},
+		},
+		{
+			name: "truncated",
+			corrupt: func(path string) {
+				require.NoError(t, os.Truncate(path, 100))
+			},
+			expectErr: "is corrupt, expected",
+		},
+		{
+			name: "modified",
+			corrupt: func(path string) {
+				data, err := os.ReadFile(path)
+				require.NoError(t, err)
+				data[len(data)-1] ^= 0xff
+				require.NoError(t, os.WriteFile(path, data, 0600))
+			},
+			expectErr: "is corrupt, expected checksum",
+		},
+		{
+			name: "missing",
+			corrupt: func(path string) {
+				require.NoError(t, os.Remove(path))
+			},
+			expectErr: "unable to read backup",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			db := testDB(t, filepath.Join(dir, "storage"), "value")
+			backup, err := Create(dir, testSource(t, db), time.Now())
+			require.NoError(t, err)
+			require.NoError(t, db.Close())
+
+			test.corrupt(snapshotPath(dir, backup.Name))
+			_, err = Verify(dir, backup.Name)
+			if test.expectErr != "" {
+				require.ErrorContains(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, err := Verify(t.TempDir(), "../storage")
+		require.EqualError(t, err, "invalid backup name ../storage")
+	})
+}
+
+func TestRestore(t *testing.T) {
+	dir := t.TempDir()
+	storage := filepath.Join(dir, "storage")
+	db := testDB(t, storage, "before")
+	backup, err := Create(dir, testSource(t, db), time.Now())
+	require.NoError(t, err)
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte("Resources")).Put([]byte("key"), []byte("after"))
+	})
+	require.NoError(t, err)
+
+	// the storage file is locked while the database is open
+	_, err = Restore(dir, backup.Name, storage)
+	require.EqualError(t, err, storage+" is in use, stop the server before restoring a backup")
+	require.NoError(t, db.Close())
+
+	previous, err := Restore(dir, backup.Name, storage)
+	require.NoError(t, err)
+	require.Equal(t, storage+".pre-restore", previous)
+	require.Equal(t, "before", readValue(t, storage))
+	require.Equal(t, "after", readValue(t, previous))
+
+	// restoring without an existing storage file
+	require.NoError(t, os.Remove(storage))
+	previous, err = Restore(dir, backup.Name, storage)
+	require.NoError(t, err)
+	require.Empty(t, previous)
+	require.Equal(t, "before", readValue(t, storage))
+
+	// a storage file that cannot be opened is replaced
+	require.NoError(t, os.WriteFile(storage, []byte("corrupt"), 0600))
+	previous, err = Restore(dir, backup.Name, storage)
+	require.NoError(t, err)
+	require.Equal(t, storage+".pre-restore", previous)
+	require.Equal(t, "before", readValue(t, storage))
+	contents, err := os.ReadFile(previous)
+	require.NoError(t, err)
+	require.Equal(t, "corrupt", string(contents))
+
+	// the storage file is moved back if the snapshot cannot be copied
+	require.NoError(t, os.WriteFile(storage, []byte("corrupt"), 0600))
+	require.NoError(t, os.Mkdir(storage+".restore", 0700))
+	_, err = Restore(dir, backup.Name, storage)
+	require.Error(t, err)
+	contents, err = os.ReadFile(storage)
+	require.NoError(t, err)
+	require.Equal(t, "corrupt", string(contents))
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	db := testDB(t, filepath.Join(dir, "storage"), "before")
+	defer db.Close()
+	backup, err := Create(dir, testSource(t, db), time.Now())
+	require.NoError(t, err)
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		if err := tx.Bucket([]byte("Resources")).Put([]byte("key"), []byte("after")); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucket([]byte("Other"))
+		return err
+	})
+	require.NoError(t, err)
+
+	// the contents are replaced while the database stays open
+	require.NoError(t, restoreSnapshot(db, snapshotPath(dir, backup.Name)))
+	err = db.View(func(tx *bbolt.Tx) error {
+		require.Nil(t, tx.Bucket([]byte("Other")))
+		require.Equal(t, "before", string(tx.Bucket([]byte("Resources")).Get([]byte("key"))))
+		return nil
+	})
+	require.NoError(t, err)
+}
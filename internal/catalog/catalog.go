@@ -0,0 +1,449 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package catalog syncs resource types from a catalog in a local directory or at an http(s) url into the store. The
+// catalog consists of an index listing each resource type with the sha256 checksum of its file and an optional ed25519
+// signature of the index.
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// DefaultSyncInterval is the default amount of time between syncs of the catalog
+const DefaultSyncInterval = time.Hour
+
+// ErrNotConfigured is returned when a catalog operation is requested but no catalog is configured
+var ErrNotConfigured = errors.New("no resource type catalog is configured")
+
+// Catalog syncs resource types from a catalog into the store
+type Catalog interface {
+	// List returns the resource types in the catalog compared with the resource types in the store
+	List(ctx context.Context) ([]*model.CatalogResourceType, error)
+
+	// Diff returns the resource types in the catalog compared with the resource types in the store, including a diff for
+	// each resource type that differs from the store
+	Diff(ctx context.Context) ([]*model.CatalogResourceType, error)
+
+	// Sync applies the new and updated resource types in the catalog to the store and returns the result for each
+	// resource type in the catalog
+	Sync(ctx context.Context) ([]*model.CatalogResourceType, error)
+
+	// SyncInterval returns the amount of time between periodic syncs or zero if the catalog is only synced on request
+	SyncInterval() time.Duration
+}
+
+// Settings configures the Catalog
+type Settings struct {
+	// URL is the path of a local directory containing index.yaml or the http(s) url of the index
+	URL string
+
+	// PublicKeyFile is the path of an ed25519 public key used to verify the signature of the index. If empty, the
+	// signature is not verified.
+	PublicKeyFile string
+
+	// ConflictPolicy determines whether resource types modified in the store are replaced by resource types from the
+	// catalog, defaulting to common.CatalogConflictPolicyKeep
+	ConflictPolicy common.CatalogConflictPolicy
+
+	// SyncInterval is the amount of time between periodic syncs. If zero, the catalog is only synced on request.
+	SyncInterval time.Duration
+
+	// Client is the http client used for catalogs at http(s) urls, defaulting to http.DefaultClient
+	Client *http.Client
+
+	Store  store.Store
+	Logger *zap.Logger
+}
+
+type catalog struct {
+	source       source
+	publicKey    ed25519.PublicKey
+	policy       common.CatalogConflictPolicy
+	syncInterval time.Duration
+	store        store.Store
+	logger       *zap.Logger
+
+	// mtx ensures that only one sync occurs at a time
+	mtx sync.Mutex
+}
+
+var _ Catalog = (*catalog)(nil)
+
+// NewCatalog returns a new Catalog using the specified settings
+func NewCatalog(settings Settings) (Catalog, error) {
+	client := settings.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	src, err := newSource(settings.URL, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var publicKey ed25519.PublicKey
+	if settings.PublicKeyFile != "" {
+		publicKey, err = readPublicKey(settings.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	policy := settings.ConflictPolicy
+	if policy == "" {
+		policy = common.CatalogConflictPolicyKeep
+	}
+
+	return &catalog{
+		source:       src,
+		publicKey:    publicKey,
+		policy:       policy,
+		syncInterval: settings.SyncInterval,
+		store:        settings.Store,
+		logger:       settings.Logger,
+	}, nil
+}
+
+// List returns the resource types in the catalog compared with the resource types in the store
+func (c *catalog) List(ctx context.Context) ([]*model.CatalogResourceType, error) {
+	planned, err := c.plan(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	return statuses(planned), nil
+}
+
+// Diff returns the resource types in the catalog compared with the resource types in the store, including a diff for
+// each resource type that differs from the store
+func (c *catalog) Diff(ctx context.Context) ([]*model.CatalogResourceType, error) {
+	planned, err := c.plan(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return statuses(planned), nil
+}
+
+// Sync applies the new and updated resource types in the catalog to the store and returns the result for each
+// resource type in the catalog
+func (c *catalog) Sync(ctx context.Context) ([]*model.CatalogResourceType, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	planned, err := c.plan(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []model.Resource{}
+	byKey := map[string]*plannedResourceType{}
+	for _, p := range planned {
+		if p.resource != nil {
+			resources = append(resources, p.resource)
+			byKey[resourceKey(p.resource.GetKind(), p.resource.Name())] = p
+		}
+	}
+	if len(resources) == 0 {
+		return statuses(planned), nil
+	}
+
+	updates, err := c.store.ApplyResources(resources)
+	if err != nil {
+		return nil, err
+	}
+	for _, update := range updates {
+		p, ok := byKey[resourceKey(update.Resource.GetKind(), update.Resource.Name())]
+		if !ok {
+			continue
+		}
+		p.applied(update)
+	}
+
+	result := statuses(planned)
+	c.logger.Info("Synced resource type catalog", zap.Any("resourceTypes", summary(result)))
+	return result, nil
+}
+
+// SyncInterval returns the amount of time between periodic syncs or zero if the catalog is only synced on request
+func (c *catalog) SyncInterval() time.Duration {
+	return c.syncInterval
+}
+
+// ----------------------------------------------------------------------
+
+// plannedResourceType is a resource type in the catalog and the resource to apply to the store when syncing, if any
+type plannedResourceType struct {
+	status   *model.CatalogResourceType
+	resource model.Resource
+}
+
+// applied updates the status of the resource type with the result of applying it to the store
+func (p *plannedResourceType) applied(update model.ResourceStatus) {
+	switch update.Status {
+	case model.StatusCreated:
+		p.status.Status = model.CatalogStatusCreated
+	case model.StatusConfigured:
+		if p.status.Status != model.CatalogStatusUnchanged {
+			// unchanged resource types are only configured to add the digest label
+			p.status.Status = model.CatalogStatusUpdated
+		}
+	case model.StatusInvalid, model.StatusError:
+		p.status.Status = model.CatalogStatusInvalid
+		p.status.Reason = update.Reason
+	}
+}
+
+func statuses(planned []*plannedResourceType) []*model.CatalogResourceType {
+	result := make([]*model.CatalogResourceType, len(planned))
+	for i, p := range planned {
+		result[i] = p.status
+	}
+	return result
+}
+
+func summary(resourceTypes []*model.CatalogResourceType) map[model.CatalogStatus]int {
+	result := map[model.CatalogStatus]int{}
+	for _, rt := range resourceTypes {
+		result[rt.Status]++
+	}
+	return result
+}
+
+func resourceKey(kind model.Kind, name string) string {
+	return fmt.Sprintf("%s|%s", kind, name)
+}
+
+// plan reads the index and each resource type in the catalog and compares them with the resource types in the store
+func (c *catalog) plan(ctx context.Context, diff bool) ([]*plannedResourceType, error) {
+	index, err := c.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	planned := make([]*plannedResourceType, 0, len(index.ResourceTypes))
+	for _, entry := range index.ResourceTypes {
+		p, err := c.planEntry(ctx, entry, diff)
+		if err != nil {
+			return nil, err
+		}
+		planned = append(planned, p)
+	}
+	return planned, nil
+}
+
+// index reads the index and verifies its signature if a public key is configured
+func (c *catalog) index(ctx context.Context) (*model.CatalogIndex, error) {
+	data, err := c.source.index(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read catalog index: %w", err)
+	}
+
+	if c.publicKey != nil {
+		signature, err := c.source.signature(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read catalog index signature: %w", err)
+		}
+		if err := verifySignature(c.publicKey, data, signature); err != nil {
+			return nil, err
+		}
+	}
+
+	index := &model.CatalogIndex{}
+	if err := yaml.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("unable to parse catalog index: %w", err)
+	}
+	return index, nil
+}
+
+// planEntry compares a single resource type in the catalog with the resource type in the store. Errors reading or
+// validating the resource type are reported in the status and only errors from the store are returned.
+func (c *catalog) planEntry(ctx context.Context, entry *model.CatalogEntry, diff bool) (*plannedResourceType, error) {
+	status := &model.CatalogResourceType{
+		Kind:    entry.Kind,
+		Name:    entry.Name,
+		Version: entry.Version,
+	}
+	p := &plannedResourceType{status: status}
+
+	resource, err := c.read(ctx, entry)
+	if err != nil {
+		status.Status = model.CatalogStatusInvalid
+		status.Reason = err.Error()
+		return p, nil
+	}
+	rt := model.ResourceTypeOf(resource)
+	status.Version = rt.Spec.Version
+
+	digest, err := model.CatalogDigest(rt)
+	if err != nil {
+		return nil, err
+	}
+	if rt.Metadata.Labels.Set == nil {
+		rt.Metadata.Labels = model.MakeLabels()
+	}
+	rt.Metadata.Labels.Set[model.LabelBindPlaneCatalogDigest] = digest
+
+	existing, err := store.CurrentResourceType(c.store, entry.Kind, entry.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		status.Status = model.CatalogStatusNew
+		p.resource = resource
+		return p, nil
+	}
+	status.InstalledVersion = existing.Spec.Version
+
+	existingDigest, err := model.CatalogDigest(existing)
+	if err != nil {
+		return nil, err
+	}
+	syncedDigest := existing.Metadata.Labels.Get(model.LabelBindPlaneCatalogDigest)
+
+	switch {
+	case existingDigest == digest:
+		status.Status = model.CatalogStatusUnchanged
+		if syncedDigest != digest {
+			// identical resource types that were not synced from the catalog are labeled so that future changes to the
+			// catalog are synced
+			p.resource = resource
+		}
+		return p, nil
+
+	case existing.Spec.Version != "" && rt.Spec.Version != "" && model.CompareVersions(rt.Spec.Version, existing.Spec.Version) < 0:
+		status.Status = model.CatalogStatusOutdated
+
+	case syncedDigest == "":
+		status.Status = model.CatalogStatusConflict
+		status.Reason = "not synced from the catalog"
+
+	case syncedDigest != existingDigest:
+		status.Status = model.CatalogStatusConflict
+		status.Reason = "modified since it was synced"
+
+	default:
+		status.Status = model.CatalogStatusUpdate
+		p.resource = resource
+	}
+
+	if status.Status == model.CatalogStatusConflict && c.policy == common.CatalogConflictPolicyOverwrite {
+		status.Reason = fmt.Sprintf("%s, overwritten by the catalog", status.Reason)
+		p.resource = resource
+	}
+
+	if diff {
+		status.Diff = resourceTypeDiff(existing, rt)
+	}
+	return p, nil
+}
+
+// read reads the resource type in the catalog, verifying the checksum and ensuring that it is a valid resource type
+// matching the entry in the index
+func (c *catalog) read(ctx context.Context, entry *model.CatalogEntry) (model.Resource, error) {
+	if entry.SHA256 == "" {
+		return nil, fmt.Errorf("missing checksum for %s", entry.Path)
+	}
+
+	data, err := c.source.file(ctx, entry.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+	if checksum := hex.EncodeToString(sum[:]); checksum != entry.SHA256 {
+		return nil, fmt.Errorf("checksum mismatch for %s: expected %s, got %s", entry.Path, entry.SHA256, checksum)
+	}
+
+	resources, err := model.ResourcesFromReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", entry.Path, err)
+	}
+	if len(resources) != 1 {
+		return nil, fmt.Errorf("expected 1 resource type in %s, found %d resources", entry.Path, len(resources))
+	}
+	resource, err := model.ParseResource(resources[0])
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", entry.Path, err)
+	}
+
+	rt := model.ResourceTypeOf(resource)
+	switch {
+	case rt == nil:
+		return nil, fmt.Errorf("%s in %s is not a resource type", resource.GetKind(), entry.Path)
+	case resource.GetKind() != entry.Kind || resource.Name() != entry.Name:
+		return nil, fmt.Errorf("%s contains %s %s, expected %s %s", entry.Path, resource.GetKind(), resource.Name(), entry.Kind, entry.Name)
+	case entry.Version != "" && rt.Spec.Version != entry.Version:
+		return nil, fmt.Errorf("%s contains version %s, expected %s", entry.Path, rt.Spec.Version, entry.Version)
+	}
+
+	if err := resource.ValidateWithStore(c.store); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+// resourceTypeDiff returns a unified diff from the installed resource type to the resource type in the catalog
+func resourceTypeDiff(installed, updated *model.ResourceType) string {
+	from, err := diffYaml(installed)
+	if err != nil {
+		return fmt.Sprintf("unable to compute diff: %v", err)
+	}
+	to, err := diffYaml(updated)
+	if err != nil {
+		return fmt.Sprintf("unable to compute diff: %v", err)
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: "installed",
+		ToFile:   "catalog",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Sprintf("unable to compute diff: %v", err)
+	}
+	return diff
+}
+
+// diffYaml returns the yaml of the resource type without the ID, resourceVersion, and digest label which always differ
+func diffYaml(rt *model.ResourceType) (string, error) {
+	copied := *rt
+	copied.Metadata.ID = ""
+	copied.Metadata.ResourceVersion = 0
+	copied.Metadata.Labels = model.MakeLabels()
+	for name, value := range rt.Metadata.Labels.Set {
+		if name != model.LabelBindPlaneCatalogDigest {
+			copied.Metadata.Labels.Set[name] = value
+		}
+	}
+	data, err := yaml.Marshal(&copied)
+	return string(data), err
+}
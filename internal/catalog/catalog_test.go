@@ -0,0 +1,369 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// testCatalog is a catalog in a temporary directory
+type testCatalog struct {
+	t     *testing.T
+	dir   string
+	index model.CatalogIndex
+}
+
+func newTestCatalog(t *testing.T) *testCatalog {
+	return &testCatalog{t: t, dir: t.TempDir()}
+}
+
+// add writes the source type to the catalog and adds it to the index
+func (c *testCatalog) add(name, version, interval string) {
+	contents := fmt.Sprintf(`apiVersion: bindplane.observiq.com/v1
+kind: SourceType
+metadata:
+  name: %s
+spec:
+  version: %s
+  parameters:
+    - name: interval
+      type: int
+      default: %s
+  metrics:
+    receivers: |
+      - %s:
+          collection_interval: {{ .interval }}s
+`, name, version, interval, name)
+	c.addFile(model.KindSourceType, name, version, fmt.Sprintf("source-types/%s.yaml", name), []byte(contents))
+}
+
+func (c *testCatalog) addFile(kind model.Kind, name, version, path string, contents []byte) {
+	full := filepath.Join(c.dir, filepath.FromSlash(path))
+	require.NoError(c.t, os.MkdirAll(filepath.Dir(full), 0750))
+	require.NoError(c.t, os.WriteFile(full, contents, 0600))
+
+	sum := sha256.Sum256(contents)
+	entry := &model.CatalogEntry{Kind: kind, Name: name, Version: version, Path: path, SHA256: hex.EncodeToString(sum[:])}
+	for i, existing := range c.index.ResourceTypes {
+		if existing.Kind == kind && existing.Name == name {
+			c.index.ResourceTypes[i] = entry
+			c.writeIndex()
+			return
+		}
+	}
+	c.index.ResourceTypes = append(c.index.ResourceTypes, entry)
+	c.writeIndex()
+}
+
+func (c *testCatalog) writeIndex() {
+	data, err := yaml.Marshal(c.index)
+	require.NoError(c.t, err)
+	require.NoError(c.t, os.WriteFile(filepath.Join(c.dir, IndexFileName), data, 0600))
+}
+
+// sign writes the signature of the index
+func (c *testCatalog) sign(privateKey ed25519.PrivateKey) {
+	data, err := os.ReadFile(filepath.Join(c.dir, IndexFileName))
+	require.NoError(c.t, err)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, data))
+	require.NoError(c.t, os.WriteFile(filepath.Join(c.dir, IndexFileName+SignatureSuffix), []byte(signature), 0600))
+}
+
+func newTestStore(t *testing.T) store.Store {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key", MaxEventsToMerge: 1}, zap.NewNop())
+}
+
+func newTestSyncCatalog(t *testing.T, s store.Store, settings Settings) Catalog {
+	settings.Store = s
+	settings.Logger = zap.NewNop()
+	c, err := NewCatalog(settings)
+	require.NoError(t, err)
+	return c
+}
+
+func findStatus(t *testing.T, statuses []*model.CatalogResourceType, name string) *model.CatalogResourceType {
+	for _, status := range statuses {
+		if status.Name == name {
+			return status
+		}
+	}
+	require.Failf(t, "missing status", "no status for %s", name)
+	return nil
+}
+
+func TestCatalogSync(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t)
+	tc := newTestCatalog(t)
+	tc.add("alpha", "1.0.0", "60")
+	tc.add("beta", "1.0.0", "60")
+	c := newTestSyncCatalog(t, s, Settings{URL: tc.dir})
+
+	// new resource types
+	statuses, err := c.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	require.Equal(t, model.CatalogStatusNew, findStatus(t, statuses, "alpha").Status)
+
+	statuses, err = c.Sync(ctx)
+	require.NoError(t, err)
+	require.Equal(t, model.CatalogStatusCreated, findStatus(t, statuses, "alpha").Status)
+	require.Equal(t, model.CatalogStatusCreated, findStatus(t, statuses, "beta").Status)
+
+	alpha, err := s.SourceType("alpha")
+	require.NoError(t, err)
+	require.NotEmpty(t, alpha.GetLabels().Get(model.LabelBindPlaneCatalogDigest))
+
+	statuses, err = c.Sync(ctx)
+	require.NoError(t, err)
+	require.Equal(t, model.CatalogStatusUnchanged, findStatus(t, statuses, "alpha").Status)
+
+	// updated resource types
+	tc.add("alpha", "1.1.0", "30")
+	statuses, err = c.Diff(ctx)
+	require.NoError(t, err)
+	status := findStatus(t, statuses, "alpha")
+	require.Equal(t, model.CatalogStatusUpdate, status.Status)
+	require.Equal(t, "1.0.0", status.InstalledVersion)
+	require.Contains(t, status.Diff, "-          default: 60\n+          default: 30\n")
+
+	statuses, err = c.Sync(ctx)
+	require.NoError(t, err)
+	require.Equal(t, model.CatalogStatusUpdated, findStatus(t, statuses, "alpha").Status)
+	alpha, err = s.SourceType("alpha")
+	require.NoError(t, err)
+	require.Equal(t, "1.1.0", alpha.Spec.Version)
+
+	// outdated resource types are not synced
+	tc.add("alpha", "0.9.0", "90")
+	statuses, err = c.Sync(ctx)
+	require.NoError(t, err)
+	require.Equal(t, model.CatalogStatusOutdated, findStatus(t, statuses, "alpha").Status)
+	alpha, err = s.SourceType("alpha")
+	require.NoError(t, err)
+	require.Equal(t, "1.1.0", alpha.Spec.Version)
+}
+
+func TestCatalogConflicts(t *testing.T) {
+	ctx := context.Background()
+
+	modify := func(t *testing.T, s store.Store, name string) {
+		rt, err := s.SourceType(name)
+		require.NoError(t, err)
+		rt.Spec.Parameters[0].Default = 120
+		_, err = s.ApplyResources([]model.Resource{rt})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name         string
+		policy       common.CatalogConflictPolicy
+		expectStatus model.CatalogStatus
+		expectReason string
+		expectSynced bool
+	}{
+		{
+			name:         "keep",
+			expectStatus: model.CatalogStatusConflict,
+			expectReason: "modified since it was synced",
+		},
+		{
+			name:         "overwrite",
+			policy:       common.CatalogConflictPolicyOverwrite,
+			expectStatus: model.CatalogStatusUpdated,
+			expectReason: "modified since it was synced, overwritten by the catalog",
+			expectSynced: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := newTestStore(t)
+			tc := newTestCatalog(t)
+			tc.add("alpha", "1.0.0", "60")
+			c := newTestSyncCatalog(t, s, Settings{URL: tc.dir, ConflictPolicy: test.policy})
+
+			_, err := c.Sync(ctx)
+			require.NoError(t, err)
+			modify(t, s, "alpha")
+
+			tc.add("alpha", "1.1.0", "30")
+			statuses, err := c.Sync(ctx)
+			require.NoError(t, err)
+			status := findStatus(t, statuses, "alpha")
+			require.Equal(t, test.expectStatus, status.Status)
+			require.Equal(t, test.expectReason, status.Reason)
+
+			alpha, err := s.SourceType("alpha")
+			require.NoError(t, err)
+			require.Equal(t, test.expectSynced, alpha.Spec.Version == "1.1.0")
+		})
+	}
+
+	t.Run("not synced from the catalog", func(t *testing.T) {
+		s := newTestStore(t)
+		tc := newTestCatalog(t)
+		tc.add("alpha", "1.0.0", "60")
+		tc.add("beta", "1.0.0", "60")
+		c := newTestSyncCatalog(t, s, Settings{URL: tc.dir})
+
+		// apply the resource types without the catalog and modify beta
+		for _, name := range []string{"alpha", "beta"} {
+			resources, err := model.ResourcesFromFile(filepath.Join(tc.dir, "source-types", name+".yaml"))
+			require.NoError(t, err)
+			parsed, err := model.ParseResources(resources)
+			require.NoError(t, err)
+			_, err = s.ApplyResources(parsed)
+			require.NoError(t, err)
+		}
+		modify(t, s, "beta")
+
+		statuses, err := c.Sync(ctx)
+		require.NoError(t, err)
+		require.Equal(t, model.CatalogStatusUnchanged, findStatus(t, statuses, "alpha").Status)
+		beta := findStatus(t, statuses, "beta")
+		require.Equal(t, model.CatalogStatusConflict, beta.Status)
+		require.Equal(t, "not synced from the catalog", beta.Reason)
+
+		// identical resource types are labeled so that they are synced in the future
+		alpha, err := s.SourceType("alpha")
+		require.NoError(t, err)
+		require.NotEmpty(t, alpha.GetLabels().Get(model.LabelBindPlaneCatalogDigest))
+
+		tc.add("alpha", "1.1.0", "30")
+		statuses, err = c.Sync(ctx)
+		require.NoError(t, err)
+		require.Equal(t, model.CatalogStatusUpdated, findStatus(t, statuses, "alpha").Status)
+	})
+}
+
+func TestCatalogInvalid(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStore(t)
+	tc := newTestCatalog(t)
+	tc.add("alpha", "1.0.0", "60")
+	tc.addFile(model.KindSourceType, "escape", "", "../escape.yaml", []byte("apiVersion: v1\n"))
+	tc.addFile(model.KindDestinationType, "mismatch", "", "source-types/mismatch.yaml", []byte("apiVersion: bindplane.observiq.com/v1\nkind: SourceType\nmetadata:\n  name: mismatch\n"))
+	tc.index.ResourceTypes = append(tc.index.ResourceTypes, &model.CatalogEntry{Kind: model.KindSourceType, Name: "unchecked", Path: "source-types/alpha.yaml"})
+	tc.writeIndex()
+
+	// tamper with alpha after the checksum is computed
+	require.NoError(t, os.WriteFile(filepath.Join(tc.dir, "source-types", "alpha.yaml"), []byte("tampered"), 0600))
+
+	c := newTestSyncCatalog(t, s, Settings{URL: tc.dir})
+	statuses, err := c.Sync(ctx)
+	require.NoError(t, err)
+
+	expect := map[string]string{
+		"alpha":     "checksum mismatch for source-types/alpha.yaml",
+		"escape":    "path ../escape.yaml is outside of the catalog",
+		"mismatch":  "source-types/mismatch.yaml contains SourceType mismatch, expected DestinationType mismatch",
+		"unchecked": "missing checksum for source-types/alpha.yaml",
+	}
+	for name, reason := range expect {
+		status := findStatus(t, statuses, name)
+		require.Equal(t, model.CatalogStatusInvalid, status.Status, name)
+		require.Contains(t, status.Reason, reason)
+	}
+
+	sourceTypes, err := s.SourceTypes()
+	require.NoError(t, err)
+	require.Len(t, sourceTypes, 0)
+}
+
+func TestCatalogSignature(t *testing.T) {
+	ctx := context.Background()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "catalog.pub")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+
+	tc := newTestCatalog(t)
+	tc.add("alpha", "1.0.0", "60")
+	c := newTestSyncCatalog(t, newTestStore(t), Settings{URL: tc.dir, PublicKeyFile: keyFile})
+
+	_, err = c.Sync(ctx)
+	require.ErrorContains(t, err, "unable to read catalog index signature")
+
+	tc.sign(otherKey)
+	_, err = c.Sync(ctx)
+	require.EqualError(t, err, "catalog index signature is not valid")
+
+	tc.sign(privateKey)
+	statuses, err := c.Sync(ctx)
+	require.NoError(t, err)
+	require.Equal(t, model.CatalogStatusCreated, findStatus(t, statuses, "alpha").Status)
+
+	// raw base64 keys are also supported
+	rawKeyFile := filepath.Join(t.TempDir(), "catalog.key")
+	require.NoError(t, os.WriteFile(rawKeyFile, []byte(base64.StdEncoding.EncodeToString(publicKey)), 0600))
+	c = newTestSyncCatalog(t, newTestStore(t), Settings{URL: tc.dir, PublicKeyFile: rawKeyFile})
+	_, err = c.List(ctx)
+	require.NoError(t, err)
+}
+
+func TestCatalogHTTP(t *testing.T) {
+	ctx := context.Background()
+	tc := newTestCatalog(t)
+	tc.add("alpha", "1.0.0", "60")
+
+	svr := httptest.NewServer(http.StripPrefix("/catalog", http.FileServer(http.Dir(tc.dir))))
+	defer svr.Close()
+
+	c := newTestSyncCatalog(t, newTestStore(t), Settings{URL: svr.URL + "/catalog/" + IndexFileName})
+	statuses, err := c.Sync(ctx)
+	require.NoError(t, err)
+	require.Equal(t, model.CatalogStatusCreated, findStatus(t, statuses, "alpha").Status)
+
+	c = newTestSyncCatalog(t, newTestStore(t), Settings{URL: svr.URL + "/missing/" + IndexFileName})
+	_, err = c.List(ctx)
+	require.ErrorContains(t, err, "404 Not Found")
+}
+
+func TestNewCatalog(t *testing.T) {
+	_, err := NewCatalog(Settings{})
+	require.ErrorIs(t, err, ErrNotConfigured)
+
+	_, err = NewCatalog(Settings{URL: t.TempDir(), PublicKeyFile: filepath.Join(t.TempDir(), "missing.pub")})
+	require.ErrorContains(t, err, "unable to read catalog public key")
+}
@@ -0,0 +1,189 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// IndexFileName is the name of the index in a catalog directory
+	IndexFileName = "index.yaml"
+
+	// SignatureSuffix is appended to the path or url of the index to locate the signature of the index. The signature is
+	// the base64 encoded ed25519 signature of the contents of the index.
+	SignatureSuffix = ".sig"
+
+	// maxFileSize limits the size of files read from a catalog
+	maxFileSize = 10 << 20
+)
+
+// source reads the index, signature, and resource type files of a catalog
+type source interface {
+	index(ctx context.Context) ([]byte, error)
+	signature(ctx context.Context) ([]byte, error)
+	file(ctx context.Context, path string) ([]byte, error)
+}
+
+// newSource returns a source for the catalog at the specified location, either a local directory or an http(s) url of
+// the index
+func newSource(location string, client *http.Client) (source, error) {
+	if location == "" {
+		return nil, ErrNotConfigured
+	}
+	u, err := url.Parse(location)
+	if err == nil {
+		switch u.Scheme {
+		case "http", "https":
+			return &httpSource{indexURL: u, client: client}, nil
+		case "file":
+			return &dirSource{dir: u.Path}, nil
+		}
+	}
+	return &dirSource{dir: location}, nil
+}
+
+// ----------------------------------------------------------------------
+
+// dirSource reads a catalog from a local directory
+type dirSource struct {
+	dir string
+}
+
+var _ source = (*dirSource)(nil)
+
+func (s *dirSource) index(_ context.Context) ([]byte, error) {
+	return os.ReadFile(filepath.Join(s.dir, IndexFileName))
+}
+
+func (s *dirSource) signature(_ context.Context) ([]byte, error) {
+	return os.ReadFile(filepath.Join(s.dir, IndexFileName+SignatureSuffix))
+}
+
+func (s *dirSource) file(_ context.Context, path string) ([]byte, error) {
+	cleaned := filepath.Clean(filepath.FromSlash(path))
+	if filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf("path %s is outside of the catalog", path)
+	}
+	return os.ReadFile(filepath.Join(s.dir, cleaned))
+}
+
+// ----------------------------------------------------------------------
+
+// httpSource reads a catalog from an http(s) server. The paths of resource types are relative to the url of the index.
+type httpSource struct {
+	indexURL *url.URL
+	client   *http.Client
+}
+
+var _ source = (*httpSource)(nil)
+
+func (s *httpSource) index(ctx context.Context) ([]byte, error) {
+	return s.get(ctx, s.indexURL)
+}
+
+func (s *httpSource) signature(ctx context.Context) ([]byte, error) {
+	u := *s.indexURL
+	u.Path += SignatureSuffix
+	return s.get(ctx, &u)
+}
+
+func (s *httpSource) file(ctx context.Context, path string) ([]byte, error) {
+	ref, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	if ref.IsAbs() || ref.Host != "" {
+		return nil, fmt.Errorf("path %s is outside of the catalog", path)
+	}
+	return s.get(ctx, s.indexURL.ResolveReference(ref))
+}
+
+func (s *httpSource) get(ctx context.Context, u *url.URL) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to get %s: %s", u, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxFileSize))
+}
+
+// ----------------------------------------------------------------------
+// signatures
+
+// readPublicKey reads an ed25519 public key from a PEM encoded PKIX public key or a base64 encoded raw public key
+func readPublicKey(path string) (ed25519.PublicKey, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read catalog public key: %w", err)
+	}
+	return parsePublicKey(data)
+}
+
+func parsePublicKey(data []byte) (ed25519.PublicKey, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse catalog public key: %w", err)
+		}
+		publicKey, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return nil, errors.New("catalog public key must be an ed25519 key")
+		}
+		return publicKey, nil
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse catalog public key: %w", err)
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, errors.New("catalog public key must be an ed25519 key")
+	}
+	return ed25519.PublicKey(raw), nil
+}
+
+// verifySignature verifies the base64 encoded signature of the index
+func verifySignature(publicKey ed25519.PublicKey, index []byte, signature []byte) error {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil {
+		return fmt.Errorf("unable to decode catalog index signature: %w", err)
+	}
+	if !ed25519.Verify(publicKey, index, decoded) {
+		return errors.New("catalog index signature is not valid")
+	}
+	return nil
+}
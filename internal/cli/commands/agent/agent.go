@@ -0,0 +1,41 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+)
+
+// Command returns the BindPlane agent cobra command.
+func Command(bindplane *cli.BindPlane) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "agent",
+		Aliases: []string{"agents"},
+		Short:   "Perform actions on agents",
+	}
+
+	cmd.AddCommand(
+		ExecCommand(bindplane),
+		CommandsCommand(bindplane),
+		DiagnoseCommand(bindplane),
+		DiagnosticsCommand(bindplane),
+		DriftCommand(bindplane),
+		PendingCommand(bindplane),
+	)
+
+	return cmd
+}
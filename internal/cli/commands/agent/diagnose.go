@@ -0,0 +1,162 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// pollInterval is the interval used to check the status of the collect-diagnostics command
+var pollInterval = 2 * time.Second
+
+// DiagnoseCommand returns the BindPlane agent diagnose cobra command
+func DiagnoseCommand(bindplane *cli.BindPlane) *cobra.Command {
+	var (
+		wait       time.Duration
+		outputFile string
+		bundleID   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "diagnose id",
+		Short: "Collect a diagnostics bundle from an agent",
+		Long: `Collect a diagnostics bundle from an agent and save it to a file.
+
+The bundle is a gzipped tar archive containing the agent details, host information, remote configuration status and
+effective configuration reported by the agent. Secrets in manager.yaml are redacted. OpAMP does not provide the agent
+logs, so the bundle does not include them and logs.yaml records where the agent writes them. Bundles are stored on the server
+and can be downloaded again with --bundle until they are removed by the retention limits.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("missing agent id")
+			}
+			id := args[0]
+
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			if bundleID == "" {
+				command, err := c.DiagnoseAgent(cmd.Context(), id)
+				if err != nil {
+					return err
+				}
+				if wait <= 0 {
+					printer.PrintResource(bindplane.Printer(), command)
+					return nil
+				}
+
+				command, err = waitForCommand(cmd.Context(), c, command, wait)
+				if err != nil {
+					return err
+				}
+				if command.Status != model.AgentCommandSucceeded {
+					return fmt.Errorf("unable to collect diagnostics from agent %s, command %s: %s", id, command.Status, command.Message)
+				}
+				bundleID = command.ID
+			}
+
+			contents, err := c.AgentDiagnosticsBundle(cmd.Context(), id, bundleID)
+			if err != nil {
+				return err
+			}
+
+			if outputFile == "" {
+				outputFile = (&model.DiagnosticsBundle{ID: bundleID, AgentID: id}).FileName()
+			}
+			if err := os.WriteFile(outputFile, contents, 0600); err != nil {
+				return fmt.Errorf("unable to write the diagnostics bundle: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Saved diagnostics bundle %s to %s\n", bundleID, outputFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&wait, "wait", 2*time.Minute, "maximum amount of time to wait for the agent to provide diagnostics, 0 returns immediately without downloading the bundle")
+	cmd.Flags().StringVarP(&outputFile, "output-file", "f", "", "file where the bundle will be saved, defaults to {agent}-diagnostics-{bundle}.tar.gz")
+	cmd.Flags().StringVar(&bundleID, "bundle", "", "download an existing bundle instead of collecting a new one")
+
+	return cmd
+}
+
+// DiagnosticsCommand returns the BindPlane agent diagnostics cobra command
+func DiagnosticsCommand(bindplane *cli.BindPlane) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diagnostics id",
+		Short: "Displays the diagnostics bundles collected from an agent",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("missing agent id")
+			}
+
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			bundles, err := c.AgentDiagnostics(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			printer.PrintResources(bindplane.Printer(), bundles)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// waitForCommand polls the commands of the agent until the specified command is complete or the timeout is reached
+func waitForCommand(ctx context.Context, c client.BindPlane, command *model.AgentCommand, timeout time.Duration) (*model.AgentCommand, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for agent %s to complete command %s, the command is %s", command.AgentID, command.ID, command.Status)
+		case <-ticker.C:
+			commands, err := c.AgentCommands(ctx, command.AgentID)
+			if err != nil {
+				return nil, err
+			}
+			for _, current := range commands {
+				if current.ID != command.ID {
+					continue
+				}
+				command = current
+				if command.Complete() {
+					return command, nil
+				}
+			}
+		}
+	}
+}
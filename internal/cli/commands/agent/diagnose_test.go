@@ -0,0 +1,93 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestDiagnoseCommand(t *testing.T) {
+	pollInterval = time.Millisecond
+
+	queued := &model.AgentCommand{ID: "b1", AgentID: "1", Type: model.AgentCommandCollectDiagnostics, Status: model.AgentCommandQueued}
+	succeeded := &model.AgentCommand{ID: "b1", AgentID: "1", Type: model.AgentCommandCollectDiagnostics, Status: model.AgentCommandSucceeded}
+	failed := &model.AgentCommand{ID: "b1", AgentID: "1", Type: model.AgentCommandCollectDiagnostics, Status: model.AgentCommandFailed, Message: "not supported"}
+
+	t.Run("missing agent id", func(t *testing.T) {
+		cmd := DiagnoseCommand(setupBindPlane(bytes.NewBufferString(""), &mockClient{}))
+		cmd.SetArgs([]string{})
+		require.ErrorContains(t, cmd.Execute(), "missing agent id")
+	})
+
+	t.Run("waits for the bundle and saves it", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "bundle.tar.gz")
+		buffer := bytes.NewBufferString("")
+		c := &mockClient{}
+		c.On("DiagnoseAgent", "1").Return(queued, nil)
+		c.On("AgentCommands", "1").Return([]*model.AgentCommand{queued}, nil).Once()
+		c.On("AgentCommands", "1").Return([]*model.AgentCommand{succeeded}, nil)
+		c.On("AgentDiagnosticsBundle", "1", "b1").Return([]byte("bundle"), nil)
+
+		cmd := DiagnoseCommand(setupBindPlane(buffer, c))
+		cmd.SetOut(buffer)
+		cmd.SetArgs([]string{"1", "-f", output})
+		require.NoError(t, cmd.Execute())
+		require.Equal(t, "Saved diagnostics bundle b1 to "+output+"\n", buffer.String())
+
+		contents, err := os.ReadFile(output)
+		require.NoError(t, err)
+		require.Equal(t, "bundle", string(contents))
+	})
+
+	t.Run("reports failed commands", func(t *testing.T) {
+		c := &mockClient{}
+		c.On("DiagnoseAgent", "1").Return(queued, nil)
+		c.On("AgentCommands", "1").Return([]*model.AgentCommand{failed}, nil)
+
+		cmd := DiagnoseCommand(setupBindPlane(bytes.NewBufferString(""), c))
+		cmd.SetArgs([]string{"1"})
+		require.ErrorContains(t, cmd.Execute(), "failed: not supported")
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		c := &mockClient{}
+		c.On("DiagnoseAgent", "1").Return(queued, nil)
+		c.On("AgentCommands", "1").Return([]*model.AgentCommand{queued}, nil)
+
+		cmd := DiagnoseCommand(setupBindPlane(bytes.NewBufferString(""), c))
+		cmd.SetArgs([]string{"1", "--wait", "20ms"})
+		require.Error(t, cmd.Execute())
+	})
+
+	t.Run("downloads an existing bundle", func(t *testing.T) {
+		output := filepath.Join(t.TempDir(), "bundle.tar.gz")
+		c := &mockClient{}
+		c.On("AgentDiagnosticsBundle", "1", "b0").Return([]byte("old"), nil)
+
+		cmd := DiagnoseCommand(setupBindPlane(bytes.NewBufferString(""), c))
+		cmd.SetOut(bytes.NewBufferString(""))
+		cmd.SetArgs([]string{"1", "--bundle", "b0", "-f", output})
+		require.NoError(t, cmd.Execute())
+		c.AssertNotCalled(t, "DiagnoseAgent", "1")
+	})
+}
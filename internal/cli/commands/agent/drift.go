@@ -0,0 +1,76 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+)
+
+// DriftCommand returns the BindPlane agent drift cobra command
+func DriftCommand(bindplane *cli.BindPlane) *cobra.Command {
+	var (
+		selector string
+		query    string
+		all      bool
+		diff     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Displays agents with configuration drift",
+		Long: `Displays agents that are not running the configuration they should be running.
+
+An agent is pending if it has not yet applied a change, drifted if it is running a different configuration, and
+locally-modified if its configuration was changed on the agent host after it was in sync. Agents that do not match a
+configuration are unmanaged. Agents that are in sync are only displayed with --all.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			reports, err := c.AgentsDrift(cmd.Context(), all,
+				client.WithSelector(selector),
+				client.WithQuery(query),
+			)
+			if err != nil {
+				return err
+			}
+
+			if !diff {
+				printer.PrintResources(bindplane.Printer(), reports)
+				return nil
+			}
+			for _, report := range reports {
+				fmt.Fprintf(cmd.OutOrStdout(), "# %s (%s): %s\n", report.AgentName, report.AgentID, report.Status)
+				fmt.Fprintln(cmd.OutOrStdout(), report.Diff)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "label selector to filter agents by label, e.g. name=value")
+	cmd.Flags().StringVarP(&query, "query", "q", "", "search query to filter agents, e.g. drift:true")
+	cmd.Flags().BoolVar(&all, "all", false, "include agents that are in sync")
+	cmd.Flags().BoolVar(&diff, "diff", false, "display the difference between the desired and effective configuration of each agent")
+
+	return cmd
+}
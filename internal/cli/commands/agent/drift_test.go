@@ -0,0 +1,64 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestDriftCommand(t *testing.T) {
+	reports := []*model.AgentDriftReport{
+		{AgentID: "1", AgentName: "one", Status: model.AgentDriftLocallyModified, Configuration: "test", Diff: "--- desired\n+++ effective\n"},
+	}
+
+	tests := []struct {
+		name      string
+		args      []string
+		all       bool
+		expectOut string
+	}{
+		{
+			name:      "table",
+			args:      []string{},
+			expectOut: "ID\tNAME\tSTATUS          \tCONFIGURATION \n1 \tone \tlocally-modified\ttest         \t\n",
+		},
+		{
+			name:      "all with diff",
+			args:      []string{"--all", "--diff"},
+			all:       true,
+			expectOut: "# one (1): locally-modified\n--- desired\n+++ effective\n\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buffer := bytes.NewBufferString("")
+			c := &mockClient{}
+			c.On("AgentsDrift", test.all).Return(reports, nil)
+
+			cmd := DriftCommand(setupBindPlane(buffer, c))
+			cmd.SetOut(buffer)
+			cmd.SetArgs(test.args)
+			require.NoError(t, cmd.Execute())
+			require.Equal(t, test.expectOut, buffer.String())
+			c.AssertExpectations(t)
+		})
+	}
+}
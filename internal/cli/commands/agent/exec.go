@@ -0,0 +1,110 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// ExecCommand returns the BindPlane agent exec cobra command
+func ExecCommand(bindplane *cli.BindPlane) *cobra.Command {
+	var selector string
+	var group string
+
+	commandTypes := make([]string, len(model.AgentCommandTypes))
+	for i, commandType := range model.AgentCommandTypes {
+		commandTypes[i] = string(commandType)
+	}
+
+	cmd := &cobra.Command{
+		Use:       "exec command [id ...]",
+		Short:     "Send a command to one or more agents",
+		Long:      fmt.Sprintf("Send a command to the agents with the specified ids, matching the selector, or in the group. Agents that are not connected will receive the command when they connect.\n\nCommands: %s", strings.Join(commandTypes, ", ")),
+		ValidArgs: commandTypes,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("missing command, must be one of: %s", strings.Join(commandTypes, ", "))
+			}
+			commandType, err := model.ParseAgentCommandType(args[0])
+			if err != nil {
+				return err
+			}
+
+			ids := args[1:]
+			if group != "" && (len(ids) > 0 || selector != "") {
+				return fmt.Errorf("--group cannot be combined with agent ids or --selector")
+			}
+			if len(ids) == 0 && selector == "" && group == "" {
+				return fmt.Errorf("missing agent ids, --selector, or --group")
+			}
+
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			var commands []*model.AgentCommand
+			if group != "" {
+				commands, err = c.ExecuteAgentGroupCommand(cmd.Context(), commandType, group)
+			} else {
+				commands, err = c.ExecuteAgentCommand(cmd.Context(), commandType, ids, selector)
+			}
+			if len(commands) > 0 {
+				printer.PrintResources(bindplane.Printer(), commands)
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "label selector of agents to receive the command, e.g. name=value")
+	cmd.Flags().StringVarP(&group, "group", "g", "", "name of the agent group to receive the command")
+
+	return cmd
+}
+
+// CommandsCommand returns the BindPlane agent commands cobra command
+func CommandsCommand(bindplane *cli.BindPlane) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commands id",
+		Short: "Displays the commands sent to an agent and their status",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("missing agent id")
+			}
+
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			commands, err := c.AgentCommands(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			printer.PrintResources(bindplane.Printer(), commands)
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,147 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+type mockClient struct {
+	client.BindPlane
+	mock.Mock
+}
+
+func (c *mockClient) ExecuteAgentCommand(ctx context.Context, commandType model.AgentCommandType, ids []string, selector string) ([]*model.AgentCommand, error) {
+	args := c.Called(commandType, ids, selector)
+	return args.Get(0).([]*model.AgentCommand), args.Error(1)
+}
+
+func (c *mockClient) ExecuteAgentGroupCommand(ctx context.Context, commandType model.AgentCommandType, group string) ([]*model.AgentCommand, error) {
+	args := c.Called(commandType, group)
+	return args.Get(0).([]*model.AgentCommand), args.Error(1)
+}
+
+func (c *mockClient) AgentCommands(ctx context.Context, id string) ([]*model.AgentCommand, error) {
+	args := c.Called(id)
+	return args.Get(0).([]*model.AgentCommand), args.Error(1)
+}
+
+func (c *mockClient) DiagnoseAgent(ctx context.Context, id string) (*model.AgentCommand, error) {
+	args := c.Called(id)
+	return args.Get(0).(*model.AgentCommand), args.Error(1)
+}
+
+func (c *mockClient) AgentDiagnosticsBundle(ctx context.Context, id string, bundleID string) ([]byte, error) {
+	args := c.Called(id, bundleID)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (c *mockClient) AgentsDrift(ctx context.Context, all bool, options ...client.QueryOption) ([]*model.AgentDriftReport, error) {
+	args := c.Called(all)
+	return args.Get(0).([]*model.AgentDriftReport), args.Error(1)
+}
+
+func (c *mockClient) AgentPendingChanges(ctx context.Context, options ...client.QueryOption) ([]*model.AgentPendingChange, error) {
+	args := c.Called()
+	return args.Get(0).([]*model.AgentPendingChange), args.Error(1)
+}
+
+func (c *mockClient) ApplyAgentPendingChange(ctx context.Context, id string) (*model.AgentPendingChange, error) {
+	args := c.Called(id)
+	return args.Get(0).(*model.AgentPendingChange), args.Error(1)
+}
+
+func setupBindPlane(buffer *bytes.Buffer, c *mockClient) *cli.BindPlane {
+	bindplane := cli.NewBindPlane(common.InitConfig(""), buffer)
+	bindplane.Config.Output = "table"
+	bindplane.SetClient(c)
+	return bindplane
+}
+
+func TestExecCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr string
+		expectOut string
+	}{
+		{
+			name:      "missing command",
+			args:      []string{},
+			expectErr: "missing command",
+		},
+		{
+			name:      "unknown command",
+			args:      []string{"explode", "1"},
+			expectErr: "unknown agent command: explode",
+		},
+		{
+			name:      "missing agents",
+			args:      []string{"restart"},
+			expectErr: "missing agent ids, --selector, or --group",
+		},
+		{
+			name:      "group with ids",
+			args:      []string{"restart", "1", "--group", "g1"},
+			expectErr: "--group cannot be combined",
+		},
+		{
+			name:      "restart by id",
+			args:      []string{"restart", "1"},
+			expectOut: "ID\tAGENT\tTYPE   \tSTATUS\tAGE\tMESSAGE \nc1\t1    \trestart\tqueued\t-  \t       \t\n",
+		},
+		{
+			name:      "restart by group",
+			args:      []string{"restart", "--group", "g1"},
+			expectOut: "ID\tAGENT\tTYPE   \tSTATUS\tAGE\tMESSAGE \nc2\t2    \trestart\tqueued\t-  \t       \t\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buffer := bytes.NewBufferString("")
+			c := &mockClient{}
+			c.On("ExecuteAgentCommand", model.AgentCommandRestart, []string{"1"}, "").Return([]*model.AgentCommand{
+				{ID: "c1", AgentID: "1", Type: model.AgentCommandRestart, Status: model.AgentCommandQueued},
+			}, nil)
+			c.On("ExecuteAgentGroupCommand", model.AgentCommandRestart, "g1").Return([]*model.AgentCommand{
+				{ID: "c2", AgentID: "2", Type: model.AgentCommandRestart, Status: model.AgentCommandQueued},
+			}, nil)
+
+			cmd := ExecCommand(setupBindPlane(buffer, c))
+			cmd.SetOut(buffer)
+			cmd.SetErr(buffer)
+			cmd.SetArgs(test.args)
+
+			err := cmd.Execute()
+			if test.expectErr != "" {
+				require.ErrorContains(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectOut, buffer.String())
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// PendingCommand returns the BindPlane agent pending cobra command
+func PendingCommand(bindplane *cli.BindPlane) *cobra.Command {
+	var (
+		selector string
+		query    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "pending",
+		Short: "Displays configuration changes waiting for a maintenance window",
+		Long: `Displays configuration changes that have not been applied to agents because the schedule of the configuration
+does not allow it. Changes are applied automatically when the schedule allows or can be applied immediately with
+"agent pending apply".`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			changes, err := c.AgentPendingChanges(cmd.Context(),
+				client.WithSelector(selector),
+				client.WithQuery(query),
+			)
+			if err != nil {
+				return err
+			}
+
+			printer.PrintResources(bindplane.Printer(), changes)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "label selector to filter agents by label, e.g. name=value")
+	cmd.Flags().StringVarP(&query, "query", "q", "", "search query to filter agents")
+
+	cmd.AddCommand(PendingApplyCommand(bindplane))
+
+	return cmd
+}
+
+// PendingApplyCommand returns the BindPlane agent pending apply cobra command
+func PendingApplyCommand(bindplane *cli.BindPlane) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply id [id...]",
+		Short: "Applies pending configuration changes without waiting for a maintenance window",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("missing agent ids")
+			}
+
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			changes := []*model.AgentPendingChange{}
+			for _, id := range args {
+				change, err := c.ApplyAgentPendingChange(cmd.Context(), id)
+				if err != nil {
+					return err
+				}
+				changes = append(changes, change)
+			}
+
+			printer.PrintResources(bindplane.Printer(), changes)
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestPendingCommand(t *testing.T) {
+	notBefore := time.Date(2022, 8, 1, 22, 0, 0, 0, time.UTC)
+	change := &model.AgentPendingChange{AgentID: "1", Configuration: "nightly", NotBefore: notBefore}
+	forced := &model.AgentPendingChange{AgentID: "1", Configuration: "nightly", NotBefore: notBefore, Forced: true}
+
+	t.Run("list", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		c := &mockClient{}
+		c.On("AgentPendingChanges").Return([]*model.AgentPendingChange{change}, nil)
+
+		cmd := PendingCommand(setupBindPlane(buffer, c))
+		cmd.SetOut(buffer)
+		cmd.SetArgs([]string{})
+		require.NoError(t, cmd.Execute())
+		require.Equal(t, "AGENT\tCONFIGURATION\tNOT BEFORE          \tFORCED\tAGE \n1    \tnightly      \t2022-08-01T22:00:00Z\tfalse \t-  \t\n", buffer.String())
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		c := &mockClient{}
+		c.On("ApplyAgentPendingChange", "1").Return(forced, nil)
+
+		cmd := PendingCommand(setupBindPlane(buffer, c))
+		cmd.SetOut(buffer)
+		cmd.SetArgs([]string{"apply", "1"})
+		require.NoError(t, cmd.Execute())
+		require.Contains(t, buffer.String(), "true")
+		c.AssertExpectations(t)
+	})
+
+	t.Run("apply without ids", func(t *testing.T) {
+		cmd := PendingCommand(setupBindPlane(bytes.NewBufferString(""), &mockClient{}))
+		cmd.SetArgs([]string{"apply"})
+		require.ErrorContains(t, cmd.Execute(), "missing agent ids")
+	})
+}
@@ -27,6 +27,7 @@ import (
 // Command returns the bindplane apply cobra command.
 func Command(bindplane *cli.BindPlane) *cobra.Command {
 	var fileFlag []string
+	var forceFlag bool
 
 	cmd := &cobra.Command{
 		Use:   "apply [file]",
@@ -67,6 +68,13 @@ func Command(bindplane *cli.BindPlane) *cobra.Command {
 				return errs
 			}
 
+			// without a resourceVersion, resources are applied even if they were modified by someone else
+			if forceFlag {
+				for _, resource := range resources {
+					resource.SetResourceVersion(0)
+				}
+			}
+
 			// apply them all together
 			resourceStatuses, err := c.Apply(cmd.Context(), resources)
 			if err != nil {
@@ -74,11 +82,12 @@ func Command(bindplane *cli.BindPlane) *cobra.Command {
 			}
 
 			model.PrintResourceUpdates(cmd.OutOrStdout(), resourceStatuses)
-			return nil
+			return conflictsError(resourceStatuses)
 		},
 	}
 
 	cmd.Flags().StringSliceVarP(&fileFlag, "file", "f", []string{}, "path to a yaml file that specifies bindplane resources")
+	cmd.Flags().BoolVar(&forceFlag, "force", false, "apply resources even if they were modified since their resourceVersion")
 
 	return cmd
 }
@@ -89,3 +98,17 @@ func readResources(cmd *cobra.Command, fileArg string) ([]*model.AnyResource, er
 	}
 	return model.ResourcesFromFile(fileArg)
 }
+
+// conflictsError returns an error if any resources were not applied because their resourceVersion did not match
+func conflictsError(resourceStatuses []*model.AnyResourceStatus) error {
+	conflicts := 0
+	for _, status := range resourceStatuses {
+		if status.Status == model.StatusConflict {
+			conflicts++
+		}
+	}
+	if conflicts == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d resource(s) not applied because they were modified since their resourceVersion, get the latest version and apply again or use --force to overwrite", conflicts)
+}
@@ -159,6 +159,14 @@ spec:
       value: true
     - name: enable_install_log
       value: true
+`
+	var macosVersionedYaml = `apiVersion: bindplane.observiq.com/v1beta
+kind: Source
+metadata:
+    name: macOS
+    resourceVersion: 1
+spec:
+  type: macos
 `
 	var malformedYaml = `apiVersion: bindplane.observiq.com/v1beta
 kind Destination
@@ -206,6 +214,51 @@ spec:
 		require.Error(t, err)
 	})
 
+	t.Run("conflicts are reported with an error", func(t *testing.T) {
+		conflictClient := &mockClient{}
+		conflictClient.On("Apply", mock.Anything, mock.Anything).Return([]*model.AnyResourceStatus{
+			sourceStatus,
+			{
+				Resource: model.AnyResource{ResourceMeta: model.ResourceMeta{Metadata: model.Metadata{Name: "resource-4", ResourceVersion: 1}, Kind: model.KindConfiguration}},
+				Status:   model.StatusConflict,
+				Reason:   "resourceVersion 1 does not match the current resourceVersion 2",
+			},
+		}, nil)
+		stub := &cli.BindPlane{}
+		stub.SetClient(conflictClient)
+
+		apply := Command(stub)
+		apply.SetArgs([]string{"-"})
+		apply.SetIn(bytes.NewBufferString(macosVersionedYaml))
+		apply.SilenceUsage = true
+		out := bytes.NewBufferString("")
+		apply.SetOut(out)
+
+		err := apply.Execute()
+		require.EqualError(t, err, "1 resource(s) not applied because they were modified since their resourceVersion, get the latest version and apply again or use --force to overwrite")
+		require.Equal(t, `Source resource-2 created
+Configuration resource-4 conflict
+	resourceVersion 1 does not match the current resourceVersion 2
+`, out.String())
+	})
+
+	t.Run("force clears the resourceVersion", func(t *testing.T) {
+		forceClient := &mockClient{}
+		forceClient.On("Apply", mock.Anything, mock.MatchedBy(func(resources []*model.AnyResource) bool {
+			return len(resources) == 1 && resources[0].ResourceVersion() == 0
+		})).Return([]*model.AnyResourceStatus{sourceStatus}, nil)
+		stub := &cli.BindPlane{}
+		stub.SetClient(forceClient)
+
+		apply := Command(stub)
+		apply.SetArgs([]string{"--force", "-"})
+		apply.SetIn(bytes.NewBufferString(macosVersionedYaml))
+		apply.SetOut(bytes.NewBufferString(""))
+
+		require.NoError(t, apply.Execute())
+		forceClient.AssertExpectations(t)
+	})
+
 	// TODO(jsirianni) decided if this is needed? https://github.com/observiq/bindplane/issues/246
 	// t.Run("applies malformed spec yaml", func(t *testing.T) {
 	// 	apply := Command(stub)
@@ -0,0 +1,117 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backup provides the backup command to create, list, and restore backups of the bbolt store
+package backup
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/backup"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+)
+
+// Command returns the BindPlane backup cobra command.
+func Command(bindplane *cli.BindPlane) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Create, list, and restore backups of the store",
+		Long: `Backups are consistent snapshots of the bbolt store saved to the backups folder. The server creates backups on the
+backup interval and keeps the most recent backups up to the backup retention.`,
+	}
+
+	cmd.AddCommand(
+		CreateCommand(bindplane),
+		ListCommand(bindplane),
+		RestoreCommand(bindplane),
+	)
+
+	return cmd
+}
+
+// CreateCommand returns the BindPlane backup create cobra command
+func CreateCommand(bindplane *cli.BindPlane) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create",
+		Short: "Create a backup of the store",
+		Long:  `Requests a backup from the running server, which takes a consistent snapshot of the store without stopping.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			created, err := c.CreateBackup(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			printer.PrintResource(bindplane.Printer(), created)
+			return nil
+		},
+	}
+}
+
+// ListCommand returns the BindPlane backup list cobra command
+func ListCommand(bindplane *cli.BindPlane) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Displays the backups in the backups folder",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			backups, err := backup.List(bindplane.Config.Server.BindPlaneBackupsPath())
+			if err != nil {
+				return err
+			}
+
+			printer.PrintResources(bindplane.Printer(), backups)
+			return nil
+		},
+	}
+}
+
+// RestoreCommand returns the BindPlane backup restore cobra command
+func RestoreCommand(bindplane *cli.BindPlane) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore [name]",
+		Short: "Restore a backup of the store",
+		Long: `Verifies the checksum and consistency of the backup and replaces the storage file with it. The server must be
+stopped before restoring a backup. The existing storage file is kept with the suffix .pre-restore.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := &bindplane.Config.Server
+			if config.StoreType != "" && config.StoreType != common.StoreTypeBbolt {
+				return backup.ErrNotSupported
+			}
+
+			name := args[0]
+			storageFilePath := config.BoltDatabasePath()
+			previous, err := backup.Restore(config.BindPlaneBackupsPath(), name, storageFilePath)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "restored backup %s to %s\n", name, storageFilePath)
+			if previous != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "the previous storage file was saved to %s\n", previous)
+			}
+			return nil
+		},
+	}
+}
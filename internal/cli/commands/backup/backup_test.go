@@ -0,0 +1,119 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/backup"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+type mockClient struct {
+	client.BindPlane
+	mock.Mock
+}
+
+func (c *mockClient) CreateBackup(ctx context.Context) (*model.Backup, error) {
+	args := c.Called()
+	return args.Get(0).(*model.Backup), args.Error(1)
+}
+
+func setupBindPlane(buffer *bytes.Buffer, c *mockClient) *cli.BindPlane {
+	bindplane := cli.NewBindPlane(common.InitConfig(""), buffer)
+	bindplane.Config.Output = "table"
+	bindplane.SetClient(c)
+	return bindplane
+}
+
+func TestCreateCommand(t *testing.T) {
+	buffer := bytes.NewBufferString("")
+	c := &mockClient{}
+	c.On("CreateBackup").Return(&model.Backup{
+		Name:      "bindplane-20220601T120000.000Z",
+		CreatedAt: time.Now(),
+		Size:      32768,
+		Checksum:  "0123456789abcdef",
+	}, nil)
+
+	cmd := CreateCommand(setupBindPlane(buffer, c))
+	cmd.SetOut(buffer)
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, buffer.String(), "bindplane-20220601T120000.000Z")
+	require.Contains(t, buffer.String(), "0123456789ab")
+	c.AssertExpectations(t)
+}
+
+func TestListAndRestoreCommands(t *testing.T) {
+	dir := t.TempDir()
+	buffer := bytes.NewBufferString("")
+	bindplane := setupBindPlane(buffer, &mockClient{})
+	bindplane.Config.Server.StoreType = common.StoreTypeBbolt
+	bindplane.Config.Server.StorageFilePath = filepath.Join(dir, "storage")
+	bindplane.Config.Server.BackupsFolderPath = filepath.Join(dir, "backups")
+
+	db, err := store.InitDB(bindplane.Config.Server.StorageFilePath)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source := store.NewBoltStore(ctx, db, store.Options{MaxEventsToMerge: 1}, zap.NewNop()).(backup.Source)
+	created, err := backup.Create(bindplane.Config.Server.BackupsFolderPath, source, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	cmd := ListCommand(bindplane)
+	cmd.SetOut(buffer)
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, buffer.String(), created.Name)
+
+	buffer.Reset()
+	cmd = RestoreCommand(bindplane)
+	cmd.SetOut(buffer)
+	cmd.SetArgs([]string{created.Name})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, buffer.String(), "restored backup "+created.Name)
+	require.FileExists(t, bindplane.Config.Server.StorageFilePath+".pre-restore")
+
+	buffer.Reset()
+	cmd = RestoreCommand(bindplane)
+	cmd.SetOut(buffer)
+	cmd.SilenceUsage = true
+	cmd.SetArgs([]string{"bindplane-missing"})
+	require.EqualError(t, cmd.Execute(), "backup bindplane-missing not found")
+}
+
+func TestRestoreCommandNotSupported(t *testing.T) {
+	buffer := bytes.NewBufferString("")
+	bindplane := setupBindPlane(buffer, &mockClient{})
+	bindplane.Config.Server.StoreType = common.StoreTypeGoogleCloud
+
+	cmd := RestoreCommand(bindplane)
+	cmd.SetOut(buffer)
+	cmd.SilenceUsage = true
+	cmd.SetArgs([]string{"bindplane-20220601T120000.000Z"})
+	require.ErrorIs(t, cmd.Execute(), backup.ErrNotSupported)
+}
@@ -0,0 +1,75 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle provides the export and import commands which write all of the resources on the server to a bundle and
+// apply them from a bundle.
+package bundle
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+// newFilter returns a filter for the specified kinds and label selector. All kinds are included if no kinds are
+// specified.
+func newFilter(kinds []string, selector string) (*model.BundleFilter, error) {
+	filter := &model.BundleFilter{}
+	for _, name := range kinds {
+		kind := model.ParseKind(name)
+		if !slices.Contains(model.BundleKinds, kind) {
+			return nil, fmt.Errorf("invalid kind %s, expected one of %s", name, kindNames())
+		}
+		filter.Kinds = append(filter.Kinds, kind)
+	}
+
+	var err error
+	filter.Selector, err = model.SelectorFromString(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector: %w", err)
+	}
+	return filter, nil
+}
+
+// parseRenames parses renames of the form kind/old=new
+func parseRenames(renames []string) (model.Renames, error) {
+	result := model.Renames{}
+	for _, rename := range renames {
+		kindName, to, ok := strings.Cut(rename, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rename %s, expected kind/old=new", rename)
+		}
+		kindArg, from, ok := strings.Cut(kindName, "/")
+		if !ok || from == "" || to == "" {
+			return nil, fmt.Errorf("invalid rename %s, expected kind/old=new", rename)
+		}
+		kind := model.ParseKind(kindArg)
+		if kind == model.KindAgent || !slices.Contains(model.BundleKinds, kind) {
+			return nil, fmt.Errorf("invalid rename %s, unable to rename resources of kind %s", rename, kindArg)
+		}
+		result.Add(kind, from, to)
+	}
+	return result, nil
+}
+
+func kindNames() string {
+	names := make([]string, 0, len(model.BundleKinds))
+	for _, kind := range model.BundleKinds {
+		names = append(names, strings.ToLower(string(kind)))
+	}
+	return strings.Join(names, ", ")
+}
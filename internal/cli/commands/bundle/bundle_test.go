@@ -0,0 +1,278 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+type mockClient struct {
+	client.BindPlane
+	mock.Mock
+}
+
+func (s *mockClient) SourceTypes(ctx context.Context, options ...client.QueryOption) ([]*model.SourceType, error) {
+	return []*model.SourceType{model.NewSourceType("file", nil)}, nil
+}
+func (s *mockClient) ProcessorTypes(ctx context.Context, options ...client.QueryOption) ([]*model.ProcessorType, error) {
+	return nil, nil
+}
+func (s *mockClient) DestinationTypes(ctx context.Context, options ...client.QueryOption) ([]*model.DestinationType, error) {
+	return []*model.DestinationType{model.NewDestinationType("otlp", nil)}, nil
+}
+func (s *mockClient) ConnectorTypes(ctx context.Context, options ...client.QueryOption) ([]*model.ConnectorType, error) {
+	return nil, nil
+}
+func (s *mockClient) Sources(ctx context.Context, options ...client.QueryOption) ([]*model.Source, error) {
+	return []*model.Source{model.NewSource("logs", "file", nil)}, nil
+}
+func (s *mockClient) Processors(ctx context.Context, options ...client.QueryOption) ([]*model.Processor, error) {
+	return nil, nil
+}
+func (s *mockClient) Destinations(ctx context.Context, options ...client.QueryOption) ([]*model.Destination, error) {
+	return []*model.Destination{model.NewDestination("gateway", "otlp", nil)}, nil
+}
+func (s *mockClient) Connectors(ctx context.Context, options ...client.QueryOption) ([]*model.Connector, error) {
+	return nil, nil
+}
+func (s *mockClient) AgentGroups(ctx context.Context, options ...client.QueryOption) ([]*model.AgentGroup, error) {
+	return nil, nil
+}
+func (s *mockClient) Configurations(ctx context.Context, options ...client.QueryOption) ([]*model.Configuration, error) {
+	configuration := model.NewConfigurationWithSpec("cabin", model.ConfigurationSpec{
+		Sources:      []model.ResourceConfiguration{{Name: "logs"}},
+		Destinations: []model.ResourceConfiguration{{Name: "gateway"}},
+	})
+	configuration.Metadata.ResourceVersion = 2
+	return []*model.Configuration{configuration}, nil
+}
+func (s *mockClient) Agents(ctx context.Context, options ...client.QueryOption) ([]*model.Agent, error) {
+	return []*model.Agent{
+		{ID: "1", Name: "cabin-agent", Labels: model.LabelsFromValidatedMap(map[string]string{"configuration": "cabin"})},
+	}, nil
+}
+
+func (s *mockClient) Apply(ctx context.Context, r []*model.AnyResource) ([]*model.AnyResourceStatus, error) {
+	args := s.Called(ctx, r)
+	statuses := []*model.AnyResourceStatus{}
+	for _, resource := range r {
+		statuses = append(statuses, &model.AnyResourceStatus{Resource: *resource, Status: model.StatusCreated})
+	}
+	return statuses, args.Error(1)
+}
+
+func (s *mockClient) ApplyAgentLabels(ctx context.Context, id string, labels *model.Labels, overwrite bool) (*model.Labels, error) {
+	args := s.Called(ctx, id, labels, overwrite)
+	return labels, args.Error(1)
+}
+
+// storeClient applies resources to a store like the server does
+type storeClient struct {
+	client.BindPlane
+	store store.Store
+}
+
+func (s *storeClient) Apply(ctx context.Context, r []*model.AnyResource) ([]*model.AnyResourceStatus, error) {
+	resources, err := model.ParseResources(r)
+	if err != nil {
+		return nil, err
+	}
+	updates, err := s.store.ApplyResources(resources)
+	if err != nil {
+		return nil, err
+	}
+	statuses := []*model.AnyResourceStatus{}
+	for i, update := range updates {
+		statuses = append(statuses, &model.AnyResourceStatus{Resource: *r[i], Status: update.Status, Reason: update.Reason})
+	}
+	return statuses, nil
+}
+
+func newStub(c client.BindPlane) *cli.BindPlane {
+	stub := &cli.BindPlane{}
+	stub.SetClient(c)
+	return stub
+}
+
+func export(t *testing.T, c client.BindPlane, args ...string) string {
+	out := bytes.NewBufferString("")
+	cmd := ExportCommand(newStub(c))
+	cmd.SetOut(out)
+	cmd.SetArgs(args)
+	require.NoError(t, cmd.Execute())
+	return out.String()
+}
+
+func TestExport(t *testing.T) {
+	c := &mockClient{}
+
+	t.Run("all resources in dependency order", func(t *testing.T) {
+		resources, err := model.ReadBundle(bytes.NewBufferString(export(t, c)))
+		require.NoError(t, err)
+
+		var names []string
+		for _, resource := range resources {
+			names = append(names, resource.Name())
+			require.Zero(t, resource.ResourceVersion())
+		}
+		require.Equal(t, []string{"file", "otlp", "logs", "gateway", "cabin"}, names)
+	})
+
+	t.Run("agent labels", func(t *testing.T) {
+		resources, err := model.ReadBundle(bytes.NewBufferString(export(t, c, "--agent-labels")))
+		require.NoError(t, err)
+		require.Len(t, resources, 6)
+		require.Equal(t, model.KindAgent, resources[5].GetKind())
+		require.Equal(t, "1", resources[5].ID())
+	})
+
+	t.Run("kinds", func(t *testing.T) {
+		resources, err := model.ReadBundle(bytes.NewBufferString(export(t, c, "--kind", "sources,destinations")))
+		require.NoError(t, err)
+		require.Len(t, resources, 2)
+		require.Equal(t, model.KindSource, resources[0].GetKind())
+		require.Equal(t, model.KindDestination, resources[1].GetKind())
+	})
+
+	t.Run("invalid kind", func(t *testing.T) {
+		cmd := ExportCommand(newStub(c))
+		cmd.SetArgs([]string{"--kind", "profile"})
+		cmd.SilenceUsage = true
+		cmd.SetOut(bytes.NewBufferString(""))
+		require.ErrorContains(t, cmd.Execute(), "invalid kind profile")
+	})
+
+	t.Run("tar file", func(t *testing.T) {
+		output := t.TempDir() + "/export.tar.gz"
+		require.Equal(t, "exported 5 resources to "+output+"\n", export(t, c, "-o", output))
+
+		out := bytes.NewBufferString("")
+		imp := &mockClient{}
+		imp.On("Apply", mock.Anything, mock.Anything).Return(nil, nil)
+		cmd := ImportCommand(newStub(imp))
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{output})
+		require.NoError(t, cmd.Execute())
+		require.Contains(t, out.String(), "Configuration cabin created")
+	})
+}
+
+func TestImport(t *testing.T) {
+	bundle := export(t, &mockClient{}, "--agent-labels")
+
+	t.Run("renames", func(t *testing.T) {
+		c := &mockClient{}
+		c.On("Apply", mock.Anything, mock.Anything).Return(nil, nil)
+		c.On("ApplyAgentLabels", mock.Anything, "1", mock.Anything, true).Return(nil, nil)
+
+		out := bytes.NewBufferString("")
+		cmd := ImportCommand(newStub(c))
+		cmd.SetOut(out)
+		cmd.SetIn(bytes.NewBufferString(bundle))
+		cmd.SetArgs([]string{"-", "--rename", "configuration/cabin=lodge", "--rename", "source/logs=app-logs"})
+		require.NoError(t, cmd.Execute())
+
+		applied := c.Calls[0].Arguments.Get(1).([]*model.AnyResource)
+		require.Len(t, applied, 5)
+		parsed, err := model.ParseResources(applied)
+		require.NoError(t, err)
+		require.Equal(t, "app-logs", parsed[2].Name())
+		configuration := parsed[4].(*model.Configuration)
+		require.Equal(t, "lodge", configuration.Name())
+		require.Equal(t, "app-logs", configuration.Spec.Sources[0].Name)
+
+		labels := c.Calls[1].Arguments.Get(2).(*model.Labels)
+		require.Equal(t, "lodge", labels.Get("configuration"))
+		require.Contains(t, out.String(), "Agent 1 labels applied")
+	})
+
+	t.Run("kinds", func(t *testing.T) {
+		c := &mockClient{}
+		c.On("Apply", mock.Anything, mock.Anything).Return(nil, nil)
+
+		cmd := ImportCommand(newStub(c))
+		cmd.SetOut(bytes.NewBufferString(""))
+		cmd.SetIn(bytes.NewBufferString(bundle))
+		cmd.SetArgs([]string{"-", "--kind", "sourcetype,source"})
+		require.NoError(t, cmd.Execute())
+
+		applied := c.Calls[0].Arguments.Get(1).([]*model.AnyResource)
+		require.Len(t, applied, 2)
+		c.AssertNotCalled(t, "ApplyAgentLabels", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid rename", func(t *testing.T) {
+		cmd := ImportCommand(newStub(&mockClient{}))
+		cmd.SilenceUsage = true
+		cmd.SetOut(bytes.NewBufferString(""))
+		cmd.SetArgs([]string{"-", "--rename", "source/logs"})
+		require.ErrorContains(t, cmd.Execute(), "expected kind/old=new")
+	})
+}
+
+func TestImportIntoStore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// processors referenced by name must be applied before the sources and destinations that use them
+	var bundle bytes.Buffer
+	require.NoError(t, model.WriteBundle(&bundle, model.BundleFormatYAML, []model.Resource{
+		model.NewConfigurationWithSpec("cabin", model.ConfigurationSpec{
+			Sources:      []model.ResourceConfiguration{{Name: "logs"}},
+			Destinations: []model.ResourceConfiguration{{Name: "gateway"}},
+		}),
+		model.NewDestinationWithSpec("gateway", model.ParameterizedSpec{
+			Type:       "otlp",
+			Processors: []model.ResourceConfiguration{{Name: "batch"}},
+		}),
+		model.NewSourceWithSpec("logs", model.ParameterizedSpec{
+			Type:       "file",
+			Processors: []model.ResourceConfiguration{{Name: "batch"}},
+		}),
+		model.NewProcessor("batch", "batch", nil),
+		model.NewDestinationType("otlp", nil),
+		model.NewSourceType("file", nil),
+		model.NewProcessorType("batch", nil),
+	}))
+
+	s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key", MaxEventsToMerge: 1}, zap.NewNop())
+
+	out := bytes.NewBufferString("")
+	cmd := ImportCommand(newStub(&storeClient{store: s}))
+	cmd.SetOut(out)
+	cmd.SetIn(&bundle)
+	cmd.SetArgs([]string{"-"})
+	require.NoError(t, cmd.Execute(), out.String())
+
+	require.Contains(t, out.String(), "Processor batch created")
+	require.Contains(t, out.String(), "Source logs created")
+	require.Contains(t, out.String(), "Destination gateway created")
+	require.Contains(t, out.String(), "Configuration cabin created")
+
+	source, err := s.Source("logs")
+	require.NoError(t, err)
+	require.Equal(t, "batch", source.Spec.Processors[0].Name)
+}
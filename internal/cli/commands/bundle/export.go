@@ -0,0 +1,160 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// ExportCommand returns the bindplane export cobra command.
+func ExportCommand(bindplane *cli.BindPlane) *cobra.Command {
+	var outputFlag string
+	var formatFlag string
+	var kindFlag []string
+	var selectorFlag string
+	var agentLabelsFlag bool
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export resources to a bundle",
+		Long: `Export resource types, sources, processors, destinations, connectors, agent groups, and configurations to a multi-document yaml file or tar archive in dependency order. Use --agent-labels to also export the labels of agents.
+
+The bundle can be applied to this or another server with 'bindplane import'.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filter, err := newFilter(kindFlag, selectorFlag)
+			if err != nil {
+				return err
+			}
+
+			format := model.BundleFormatOfFile(outputFlag)
+			if formatFlag != "" {
+				if format, err = model.ParseBundleFormat(formatFlag); err != nil {
+					return err
+				}
+			}
+
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			resources, err := exportResources(cmd.Context(), c, filter, selectorFlag, agentLabelsFlag)
+			if err != nil {
+				return err
+			}
+
+			if outputFlag == "" || outputFlag == "-" {
+				return model.WriteBundle(cmd.OutOrStdout(), format, resources)
+			}
+
+			file, err := os.Create(outputFlag)
+			if err != nil {
+				return fmt.Errorf("failed to create %s: %w", outputFlag, err)
+			}
+			defer file.Close()
+
+			if err := model.WriteBundle(file, format, resources); err != nil {
+				return fmt.Errorf("failed to write %s: %w", outputFlag, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "exported %d resources to %s\n", len(resources), outputFlag)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputFlag, "output", "o", "", "path of the bundle to write, defaults to stdout")
+	cmd.Flags().StringVar(&formatFlag, "format", "", "format of the bundle, one of yaml, tar, or tar.gz. defaults to the extension of --output or yaml")
+	cmd.Flags().StringSliceVar(&kindFlag, "kind", []string{}, "kinds of resources to export, defaults to all kinds")
+	cmd.Flags().StringVar(&selectorFlag, "selector", "", "label selector of the resources to export, e.g. env=prod")
+	cmd.Flags().BoolVar(&agentLabelsFlag, "agent-labels", false, "export the labels of agents")
+
+	return cmd
+}
+
+// exportResources returns the resources matching the filter in dependency order. Agents are only included if
+// agentLabels is true or agents are explicitly included by kind.
+func exportResources(ctx context.Context, c client.BindPlane, filter *model.BundleFilter, selector string, agentLabels bool) ([]model.Resource, error) {
+	var resources []model.Resource
+	for _, kind := range model.BundleKinds {
+		if len(filter.Kinds) == 0 && kind == model.KindAgent && !agentLabels {
+			continue
+		}
+		kindResources, err := listResources(ctx, c, kind, client.WithSelector(selector))
+		if err != nil {
+			return nil, fmt.Errorf("failed to export %s resources: %w", kind, err)
+		}
+		for _, resource := range kindResources {
+			if filter.Matches(resource) {
+				resources = append(resources, resource)
+			}
+		}
+	}
+	return resources, nil
+}
+
+func listResources(ctx context.Context, c client.BindPlane, kind model.Kind, options ...client.QueryOption) ([]model.Resource, error) {
+	switch kind {
+	case model.KindSourceType:
+		return asResources(c.SourceTypes(ctx, options...))
+	case model.KindProcessorType:
+		return asResources(c.ProcessorTypes(ctx, options...))
+	case model.KindDestinationType:
+		return asResources(c.DestinationTypes(ctx, options...))
+	case model.KindConnectorType:
+		return asResources(c.ConnectorTypes(ctx, options...))
+	case model.KindSource:
+		return asResources(c.Sources(ctx, options...))
+	case model.KindProcessor:
+		return asResources(c.Processors(ctx, options...))
+	case model.KindDestination:
+		return asResources(c.Destinations(ctx, options...))
+	case model.KindConnector:
+		return asResources(c.Connectors(ctx, options...))
+	case model.KindAgentGroup:
+		return asResources(c.AgentGroups(ctx, options...))
+	case model.KindConfiguration:
+		return asResources(c.Configurations(ctx, options...))
+	case model.KindAgent:
+		agents, err := c.Agents(ctx, options...)
+		if err != nil {
+			return nil, err
+		}
+		resources := make([]model.Resource, 0, len(agents))
+		for _, agent := range agents {
+			resources = append(resources, model.NewAgentBundleResource(agent))
+		}
+		return resources, nil
+	}
+	return nil, nil
+}
+
+func asResources[R model.Resource](list []R, err error) ([]model.Resource, error) {
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]model.Resource, 0, len(list))
+	for _, resource := range list {
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
@@ -0,0 +1,129 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// ImportCommand returns the bindplane import cobra command.
+func ImportCommand(bindplane *cli.BindPlane) *cobra.Command {
+	var kindFlag []string
+	var selectorFlag string
+	var renameFlag []string
+
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Import resources from a bundle",
+		Long: `Import resources from a bundle written by 'bindplane export' or use 'bindplane import -' to import a bundle from stdin. The format of the bundle is detected automatically.
+
+Resources are applied in dependency order. Use --rename kind/old=new to import a resource with a different name. References to renamed resources, e.g. the sources of a configuration, are also renamed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filter, err := newFilter(kindFlag, selectorFlag)
+			if err != nil {
+				return err
+			}
+			renames, err := parseRenames(renameFlag)
+			if err != nil {
+				return err
+			}
+
+			resources, err := readBundle(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			// filter before renaming so that kinds and labels match the bundle
+			var agents, others []*model.AnyResource
+			for _, resource := range resources {
+				if !filter.Matches(resource) {
+					continue
+				}
+				if resource.Kind == model.KindAgent {
+					agents = append(agents, resource)
+				} else {
+					others = append(others, resource)
+				}
+			}
+			if others, err = renames.Apply(others); err != nil {
+				return err
+			}
+			if agents, err = renames.Apply(agents); err != nil {
+				return err
+			}
+
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			failed := 0
+			if len(others) > 0 {
+				resourceStatuses, err := c.Apply(cmd.Context(), others)
+				if err != nil {
+					return err
+				}
+				model.PrintResourceUpdates(cmd.OutOrStdout(), resourceStatuses)
+				for _, status := range resourceStatuses {
+					if status.Status == model.StatusInvalid || status.Status == model.StatusError || status.Status == model.StatusConflict {
+						failed++
+					}
+				}
+			}
+
+			for _, agent := range agents {
+				labels := agent.GetLabels()
+				if _, err := c.ApplyAgentLabels(cmd.Context(), agent.ID(), &labels, true); err != nil {
+					fmt.Fprintf(cmd.OutOrStdout(), "Agent %s labels not applied: %s\n", agent.ID(), err.Error())
+					failed++
+					continue
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "Agent %s labels applied\n", agent.ID())
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d resource(s) not imported", failed)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&kindFlag, "kind", []string{}, "kinds of resources to import, defaults to all kinds")
+	cmd.Flags().StringVar(&selectorFlag, "selector", "", "label selector of the resources to import, e.g. env=prod")
+	cmd.Flags().StringArrayVar(&renameFlag, "rename", []string{}, "rename a resource when it is imported, e.g. --rename source/logs=app-logs. may be repeated")
+
+	return cmd
+}
+
+func readBundle(cmd *cobra.Command, fileArg string) ([]*model.AnyResource, error) {
+	var reader io.Reader = cmd.InOrStdin()
+	if fileArg != "-" {
+		file, err := os.Open(fileArg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", fileArg, err)
+		}
+		defer file.Close()
+		reader = file
+	}
+	return model.ReadBundle(reader)
+}
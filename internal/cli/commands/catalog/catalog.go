@@ -0,0 +1,116 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package catalog provides the catalog command to list, diff, and sync resource types from the resource type catalog
+package catalog
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+)
+
+// Command returns the BindPlane catalog cobra command.
+func Command(bindplane *cli.BindPlane) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "catalog",
+		Short: "Perform actions on the resource type catalog",
+		Long: `The resource type catalog is a directory or URL containing an index of source types, processor types, and
+destination types. Resource types are verified against the checksums in the index and, if a public key is configured,
+the signature of the index before they are installed.`,
+	}
+
+	cmd.AddCommand(
+		ListCommand(bindplane),
+		DiffCommand(bindplane),
+		SyncCommand(bindplane),
+	)
+
+	return cmd
+}
+
+// ListCommand returns the BindPlane catalog list cobra command
+func ListCommand(bindplane *cli.BindPlane) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Displays the resource types in the catalog",
+		Long: `Displays the resource types in the catalog and their status compared to the installed resource types. New and
+updated resource types are installed by 'catalog sync'. Conflicts are resource types that were modified on the server
+and are only overwritten when the catalog conflict policy is overwrite.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			resourceTypes, err := c.CatalogResourceTypes(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			printer.PrintResources(bindplane.Printer(), resourceTypes)
+			return nil
+		},
+	}
+}
+
+// DiffCommand returns the BindPlane catalog diff cobra command
+func DiffCommand(bindplane *cli.BindPlane) *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff",
+		Short: "Displays the difference between the catalog and the installed resource types",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			resourceTypes, err := c.CatalogDiff(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			for _, resourceType := range resourceTypes {
+				fmt.Fprintf(cmd.OutOrStdout(), "# %s %s (%s): %s\n", resourceType.Kind, resourceType.Name, resourceType.Version, resourceType.Status)
+				fmt.Fprintln(cmd.OutOrStdout(), resourceType.Diff)
+			}
+			return nil
+		},
+	}
+}
+
+// SyncCommand returns the BindPlane catalog sync cobra command
+func SyncCommand(bindplane *cli.BindPlane) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sync",
+		Short: "Installs new and updated resource types from the catalog",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			resourceTypes, err := c.SyncCatalog(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			printer.PrintResources(bindplane.Printer(), resourceTypes)
+			return nil
+		},
+	}
+}
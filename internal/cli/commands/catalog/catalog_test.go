@@ -0,0 +1,127 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+type mockClient struct {
+	client.BindPlane
+	mock.Mock
+}
+
+func (c *mockClient) CatalogResourceTypes(ctx context.Context) ([]*model.CatalogResourceType, error) {
+	args := c.Called()
+	return args.Get(0).([]*model.CatalogResourceType), args.Error(1)
+}
+
+func (c *mockClient) CatalogDiff(ctx context.Context) ([]*model.CatalogResourceType, error) {
+	args := c.Called()
+	return args.Get(0).([]*model.CatalogResourceType), args.Error(1)
+}
+
+func (c *mockClient) SyncCatalog(ctx context.Context) ([]*model.CatalogResourceType, error) {
+	args := c.Called()
+	return args.Get(0).([]*model.CatalogResourceType), args.Error(1)
+}
+
+func setupBindPlane(buffer *bytes.Buffer, c *mockClient) *cli.BindPlane {
+	bindplane := cli.NewBindPlane(common.InitConfig(""), buffer)
+	bindplane.Config.Output = "table"
+	bindplane.SetClient(c)
+	return bindplane
+}
+
+func TestListCommand(t *testing.T) {
+	buffer := bytes.NewBufferString("")
+	c := &mockClient{}
+	c.On("CatalogResourceTypes").Return([]*model.CatalogResourceType{
+		{Kind: model.KindSourceType, Name: "host", Version: "1.1.0", InstalledVersion: "1.0.0", Status: model.CatalogStatusUpdate},
+		{Kind: model.KindDestinationType, Name: "otlp", Version: "1.0.0", InstalledVersion: "1.0.0", Status: model.CatalogStatusConflict, Reason: "modified since it was synced"},
+	}, nil)
+
+	cmd := ListCommand(setupBindPlane(buffer, c))
+	cmd.SetOut(buffer)
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "KIND           \tNAME\tVERSION\tINSTALLED\tSTATUS  \tREASON                       \nSourceType     \thost\t1.1.0  \t1.0.0    \tupdate  \t                            \t\nDestinationType\totlp\t1.0.0  \t1.0.0    \tconflict\tmodified since it was synced\t\n", buffer.String())
+	c.AssertExpectations(t)
+}
+
+func TestDiffCommand(t *testing.T) {
+	buffer := bytes.NewBufferString("")
+	c := &mockClient{}
+	c.On("CatalogDiff").Return([]*model.CatalogResourceType{
+		{Kind: model.KindSourceType, Name: "host", Version: "1.1.0", Status: model.CatalogStatusUpdate, Diff: "--- installed\n+++ catalog\n"},
+	}, nil)
+
+	cmd := DiffCommand(setupBindPlane(buffer, c))
+	cmd.SetOut(buffer)
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "# SourceType host (1.1.0): update\n--- installed\n+++ catalog\n\n", buffer.String())
+	c.AssertExpectations(t)
+}
+
+func TestSyncCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		expectErr string
+		expectOut string
+	}{
+		{
+			name:      "sync",
+			expectOut: "KIND      \tNAME\tVERSION\tINSTALLED\tSTATUS \tREASON \nSourceType\thost\t1.1.0  \t1.1.0    \tupdated\t      \t\n",
+		},
+		{
+			name:      "error",
+			err:       errors.New("unable to sync the resource type catalog"),
+			expectErr: "unable to sync the resource type catalog",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buffer := bytes.NewBufferString("")
+			c := &mockClient{}
+			c.On("SyncCatalog").Return([]*model.CatalogResourceType{
+				{Kind: model.KindSourceType, Name: "host", Version: "1.1.0", InstalledVersion: "1.1.0", Status: model.CatalogStatusUpdated},
+			}, test.err)
+
+			cmd := SyncCommand(setupBindPlane(buffer, c))
+			cmd.SetOut(buffer)
+			cmd.SilenceUsage = true
+			err := cmd.Execute()
+			if test.expectErr != "" {
+				require.EqualError(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectOut, buffer.String())
+			c.AssertExpectations(t)
+		})
+	}
+}
@@ -66,6 +66,9 @@ func Command(bindplane *cli.BindPlane) *cobra.Command {
 		deleteResourceCommand(bindplane, "source-type", []string{"source-types", "sourceType", "sourceTypes"}),
 		deleteResourceCommand(bindplane, "destination", []string{"destinations"}),
 		deleteResourceCommand(bindplane, "destination-type", []string{"destination-types", "destinationType", "destinationTypes"}),
+		deleteResourceCommand(bindplane, "connector", []string{"connectors"}),
+		deleteResourceCommand(bindplane, "connector-type", []string{"connector-types", "connectorType", "connectorTypes"}),
+		deleteResourceCommand(bindplane, "group", []string{"groups", "agent-group", "agent-groups", "agentGroup", "agentGroups"}),
 	)
 
 	return cmd
@@ -103,6 +106,12 @@ func deleteResourceCommand(bindplane *cli.BindPlane, resourceType string, aliase
 				err = c.DeleteDestination(ctx, name)
 			case "destination-type":
 				err = c.DeleteDestinationType(ctx, name)
+			case "connector":
+				err = c.DeleteConnector(ctx, name)
+			case "connector-type":
+				err = c.DeleteConnectorType(ctx, name)
+			case "group":
+				err = c.DeleteAgentGroup(ctx, name)
 			default:
 				return fmt.Errorf("unknown type, unable to delete %s '%s'", resourceType, name)
 			}
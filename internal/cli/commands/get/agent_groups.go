@@ -0,0 +1,86 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// AgentGroupsCommand returns the BindPlane get groups cobra command
+func AgentGroupsCommand(bindplane *cli.BindPlane) *cobra.Command {
+	var summary bool
+	cmd := &cobra.Command{
+		Use:     "groups [name]",
+		Aliases: []string{"group", "agent-groups", "agent-group", "agentGroups", "agentGroup"},
+		Short:   "Displays the agent groups",
+		Long:    `An agent group is a set of agents matching a label selector and search query.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			var groups []*model.AgentGroup
+			if len(args) > 0 {
+				name := args[0]
+				group, err := c.AgentGroup(cmd.Context(), name)
+				if err != nil {
+					return err
+				}
+
+				if group == nil {
+					return fmt.Errorf("no agent group found with name %s", name)
+				}
+
+				if !summary {
+					printer.PrintResource(bindplane.Printer(), group)
+					return nil
+				}
+				groups = []*model.AgentGroup{group}
+			} else {
+				groups, err = c.AgentGroups(cmd.Context())
+				if err != nil {
+					return err
+				}
+
+				if !summary {
+					printer.PrintResources(bindplane.Printer(), groups)
+					return nil
+				}
+			}
+
+			summaries := make([]*model.AgentGroupSummary, 0, len(groups))
+			for _, group := range groups {
+				groupSummary, err := c.AgentGroupSummary(cmd.Context(), group.Name())
+				if err != nil {
+					return err
+				}
+				summaries = append(summaries, groupSummary)
+			}
+			printer.PrintResources(bindplane.Printer(), summaries)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&summary, "summary", false, "display the number of agents in each group by status and version")
+
+	return cmd
+}
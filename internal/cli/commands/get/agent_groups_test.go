@@ -0,0 +1,55 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package get
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAgentGroupsCommand(t *testing.T) {
+	t.Run("can print multiple groups as a table", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+		bindplane.Config.Output = tableOutput
+
+		cmd := AgentGroupsCommand(bindplane)
+		cmd.SetOut(buffer)
+		executeAndAssertOutput(t, cmd, buffer, "NAME   \tMATCH   \tQUERY         \ngroup-1\tenv=test\tversion:1.0.0\t\n")
+	})
+
+	t.Run("can print group summaries as a table", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+		bindplane.Config.Output = tableOutput
+
+		cmd := AgentGroupsCommand(bindplane)
+		cmd.SetOut(buffer)
+		cmd.SetArgs([]string{"group-1", "--summary"})
+		executeAndAssertOutput(t, cmd, buffer, "NAME   \tAGENTS\tCONNECTED\tDISCONNECTED\tERROR\tVERSIONS \ngroup-1\t2     \t1        \t1           \t0    \t1.0.0=2 \t\n")
+	})
+
+	t.Run("returns an error for a missing group", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+
+		cmd := AgentGroupsCommand(bindplane)
+		cmd.SetOut(buffer)
+		cmd.SetArgs([]string{"missing"})
+		require.EqualError(t, cmd.Execute(), "no agent group found with name missing")
+	})
+}
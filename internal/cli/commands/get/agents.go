@@ -15,6 +15,7 @@
 package get
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -22,6 +23,7 @@ import (
 	"github.com/observiq/bindplane-op/client"
 	"github.com/observiq/bindplane-op/internal/cli"
 	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/observiq/bindplane-op/model"
 )
 
 // AgentsCommand returns the BindPlane get agents cobra command
@@ -31,6 +33,7 @@ func AgentsCommand(bindplane *cli.BindPlane) *cobra.Command {
 		query    string
 		limit    int
 		offset   int
+		watch    bool
 	)
 	cmd := &cobra.Command{
 		Use:     "agents [id]",
@@ -43,6 +46,30 @@ func AgentsCommand(bindplane *cli.BindPlane) *cobra.Command {
 				return fmt.Errorf("error creating client: %w", err)
 			}
 
+			if watch {
+				if len(args) > 0 {
+					return errors.New("--watch cannot be used with an agent ID")
+				}
+				channel, errs, err := c.WatchAgents(cmd.Context(),
+					client.WithSelector(selector),
+					client.WithQuery(query),
+				)
+				if err != nil {
+					return err
+				}
+				return watchResources(cmd.Context(), bindplane.Printer(), channel, errs, func(response *model.AgentWatchResponse) []watchEvent[*model.Agent] {
+					events := make([]watchEvent[*model.Agent], 0, len(response.Events))
+					for _, event := range response.Events {
+						events = append(events, watchEvent[*model.Agent]{
+							key:    event.Agent.ID,
+							item:   event.Agent,
+							remove: event.Type == model.WatchEventRemove,
+						})
+					}
+					return events
+				})
+			}
+
 			if len(args) > 0 {
 				id := args[0]
 				agent, err := c.Agent(cmd.Context(), id)
@@ -77,6 +104,7 @@ func AgentsCommand(bindplane *cli.BindPlane) *cobra.Command {
 	cmd.Flags().StringVarP(&query, "query", "q", "", "search query to filter agents")
 	cmd.Flags().IntVar(&offset, "offset", 0, "number of agents to skip for paging")
 	cmd.Flags().IntVar(&limit, "limit", 100, "maximum number of agents to return")
+	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "after listing the agents, watch for changes and print the agents again")
 
 	return cmd
 }
@@ -16,6 +16,7 @@ package get
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -113,6 +114,46 @@ status: 0
 		executeAndAssertOutput(t, cmd, buffer, expected)
 	})
 
+	t.Run("can watch agents as a table", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+		bindplane.Config.Output = tableOutput
+
+		cmd := AgentsCommand(bindplane)
+		cmd.SetArgs([]string{"--watch"})
+		cmd.SetOut(buffer)
+		// the agents are printed after each change
+		expected := "ID\tNAME   \tVERSION\tSTATUS      \tCONNECTED\tDISCONNECTED\tLABELS \n1 \tAgent 1\t1.0.0  \tConnected   \t-        \t-           \t      \t\n2 \tAgent 2\t1.0.0  \tDisconnected\t-        \t-           \t      \t\n" +
+			"ID\tNAME   \tVERSION\tSTATUS      \tCONNECTED\tDISCONNECTED\tLABELS \n1 \tAgent 1\t1.0.0  \tConnected   \t-        \t-           \t      \t\n2 \tAgent 2\t1.0.0  \tConnected   \t-        \t-           \t      \t\n" +
+			"ID\tNAME   \tVERSION\tSTATUS      \tCONNECTED\tDISCONNECTED\tLABELS \n2 \tAgent 2\t1.0.0  \tConnected   \t-        \t-           \t      \t\n"
+
+		executeAndAssertOutput(t, cmd, buffer, expected)
+	})
+
+	t.Run("watch returns an error if the stream ends", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+		bindplane.Config.Output = tableOutput
+		bindplane.SetClient(&mockClient{watchErr: errors.New("watch of agents ended: the server closed the stream")})
+
+		cmd := AgentsCommand(bindplane)
+		cmd.SetArgs([]string{"--watch"})
+		cmd.SetOut(buffer)
+		cmd.SilenceUsage = true
+		require.EqualError(t, cmd.Execute(), "watch of agents ended: the server closed the stream")
+	})
+
+	t.Run("cannot watch a single agent", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+
+		cmd := AgentsCommand(bindplane)
+		cmd.SetArgs([]string{"1", "--watch"})
+		cmd.SetOut(buffer)
+
+		require.EqualError(t, cmd.Execute(), "--watch cannot be used with an agent ID")
+	})
+
 	t.Run("can print a single agent in a table", func(t *testing.T) {
 		buffer := bytes.NewBufferString("")
 		bindplane := setupBindPlane(buffer)
@@ -15,16 +15,24 @@
 package get
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/spf13/cobra"
 
+	"github.com/observiq/bindplane-op/client"
 	"github.com/observiq/bindplane-op/internal/cli"
 	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/observiq/bindplane-op/model"
 )
 
 // ConfigurationsCommand returns the BindPlane get configurations cobra command
 func ConfigurationsCommand(bindplane *cli.BindPlane) *cobra.Command {
+	var (
+		selector string
+		query    string
+		watch    bool
+	)
 	cmd := &cobra.Command{
 		Use:     "configurations",
 		Aliases: []string{"configuration", "configs", "config"},
@@ -36,6 +44,30 @@ func ConfigurationsCommand(bindplane *cli.BindPlane) *cobra.Command {
 				return fmt.Errorf("error creating client: %w", err)
 			}
 
+			if watch {
+				if len(args) > 0 {
+					return errors.New("--watch cannot be used with a configuration name")
+				}
+				channel, errs, err := c.WatchConfigurations(cmd.Context(),
+					client.WithSelector(selector),
+					client.WithQuery(query),
+				)
+				if err != nil {
+					return err
+				}
+				return watchResources(cmd.Context(), bindplane.Printer(), channel, errs, func(response *model.ConfigurationWatchResponse) []watchEvent[*model.Configuration] {
+					events := make([]watchEvent[*model.Configuration], 0, len(response.Events))
+					for _, event := range response.Events {
+						events = append(events, watchEvent[*model.Configuration]{
+							key:    event.Configuration.Name(),
+							item:   event.Configuration,
+							remove: event.Type == model.WatchEventRemove,
+						})
+					}
+					return events
+				})
+			}
+
 			if len(args) > 0 {
 				name := args[0]
 				configuration, err := c.Configuration(cmd.Context(), name)
@@ -71,5 +103,9 @@ func ConfigurationsCommand(bindplane *cli.BindPlane) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "label selector to filter configurations when watching, e.g. name=value")
+	cmd.Flags().StringVarP(&query, "query", "q", "", "search query to filter configurations when watching")
+	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "after listing the configurations, watch for changes and print the configurations again")
+
 	return cmd
 }
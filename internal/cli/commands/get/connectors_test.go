@@ -0,0 +1,44 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package get
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectorsCommand(t *testing.T) {
+	t.Run("can print connectors as a table", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+		bindplane.Config.Output = tableOutput
+
+		cmd := ConnectorsCommand(bindplane)
+		cmd.SetOut(buffer)
+		executeAndAssertOutput(t, cmd, buffer, "NAME       \tTYPE       \tDESCRIPTION \nspanmetrics\tspanmetrics\t           \t\n")
+	})
+
+	t.Run("returns an error for a missing connector", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+
+		cmd := ConnectorsCommand(bindplane)
+		cmd.SetOut(buffer)
+		cmd.SetArgs([]string{"missing"})
+		require.EqualError(t, cmd.Execute(), "no connector found with name missing")
+	})
+}
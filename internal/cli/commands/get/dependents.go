@@ -0,0 +1,108 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package get
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/observiq/bindplane-op/model"
+)
+
+const dependentKinds = "configuration, source, source-type, processor, processor-type, destination, destination-type, connector, connector-type, or agent-group"
+
+// DependentsCommand returns the BindPlane get dependents cobra command
+func DependentsCommand(bindplane *cli.BindPlane) *cobra.Command {
+	return &cobra.Command{
+		Use:     "dependents <kind> <name>",
+		Aliases: []string{"dependent"},
+		Short:   "Displays the resources and agents affected by a change to a resource",
+		Long: `Displays the resources that use a resource directly or indirectly and the agents using any of the affected
+configurations. The kind is one of ` + dependentKinds + `.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, err := parseDependentKind(args[0])
+			if err != nil {
+				return err
+			}
+
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			dependents, err := c.Dependents(cmd.Context(), kind, args[1])
+			if err != nil {
+				return err
+			}
+
+			references := dependents.Resources
+			for _, id := range dependents.AgentIDs {
+				references = append(references, &model.ResourceReference{Kind: model.KindAgent, Name: id})
+			}
+			printer.PrintResources(bindplane.Printer(), references)
+			return nil
+		},
+	}
+}
+
+// DependenciesCommand returns the BindPlane get dependencies cobra command
+func DependenciesCommand(bindplane *cli.BindPlane) *cobra.Command {
+	return &cobra.Command{
+		Use:     "dependencies <kind> <name>",
+		Aliases: []string{"dependency"},
+		Short:   "Displays the resources used by a resource",
+		Long: `Displays the resources that a resource uses directly or indirectly, e.g. the sources of a configuration and the
+source types of those sources. The kind is one of ` + dependentKinds + `.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, err := parseDependentKind(args[0])
+			if err != nil {
+				return err
+			}
+
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			dependencies, err := c.Dependencies(cmd.Context(), kind, args[1])
+			if err != nil {
+				return err
+			}
+			printer.PrintResources(bindplane.Printer(), dependencies)
+			return nil
+		},
+	}
+}
+
+// parseDependentKind parses a kind argument, ignoring case and dashes so that source-type and SourceType are equivalent
+func parseDependentKind(arg string) (model.Kind, error) {
+	kind := model.ParseKind(strings.ReplaceAll(arg, "-", ""))
+	switch kind {
+	case model.KindConfiguration,
+		model.KindSource, model.KindSourceType,
+		model.KindProcessor, model.KindProcessorType,
+		model.KindDestination, model.KindDestinationType,
+		model.KindConnector, model.KindConnectorType,
+		model.KindAgentGroup:
+		return kind, nil
+	}
+	return kind, fmt.Errorf("%s resources do not have dependents, expected %s", arg, dependentKinds)
+}
@@ -0,0 +1,59 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package get
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependentsCommand(t *testing.T) {
+	t.Run("can print dependents and agents as a table", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+		bindplane.Config.Output = tableOutput
+
+		cmd := DependentsCommand(bindplane)
+		cmd.SetOut(buffer)
+		cmd.SetArgs([]string{"source-type", "macos"})
+		executeAndAssertOutput(t, cmd, buffer, "KIND         \tNAME            \nSource       \tmacos-1        \t\nConfiguration\tconfiguration-1\t\nAgent        \t1              \t\n")
+	})
+
+	t.Run("returns an error for an unsupported kind", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+
+		cmd := DependentsCommand(bindplane)
+		cmd.SetOut(buffer)
+		cmd.SilenceUsage = true
+		cmd.SetArgs([]string{"agent", "1"})
+		require.EqualError(t, cmd.Execute(), "agent resources do not have dependents, expected "+dependentKinds)
+	})
+}
+
+func TestDependenciesCommand(t *testing.T) {
+	t.Run("can print dependencies as a table", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+		bindplane.Config.Output = tableOutput
+
+		cmd := DependenciesCommand(bindplane)
+		cmd.SetOut(buffer)
+		cmd.SetArgs([]string{"Configuration", "configuration-1"})
+		executeAndAssertOutput(t, cmd, buffer, "KIND      \tNAME    \nSourceType\tmacos  \t\nSource    \tmacos-1\t\n")
+	})
+}
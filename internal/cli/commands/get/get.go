@@ -29,7 +29,12 @@ func Command(bindplane *cli.BindPlane) *cobra.Command {
 
 	cmd.AddCommand(
 		AgentsCommand(bindplane),
+		AgentGroupsCommand(bindplane),
 		ConfigurationsCommand(bindplane),
+		ConnectorsCommand(bindplane),
+		ConnectorTypesCommand(bindplane),
+		DependenciesCommand(bindplane),
+		DependentsCommand(bindplane),
 		DestinationsCommand(bindplane),
 		DestinationTypesCommand(bindplane),
 		ProcessorsCommand(bindplane),
@@ -17,6 +17,7 @@ package get
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
 	"testing"
 
@@ -43,6 +44,9 @@ func setupBindPlane(buffer *bytes.Buffer) *cli.BindPlane {
 type mockClient struct {
 	client.BindPlane
 	mock.Mock
+
+	// watchErr is sent on the error channel of WatchAgents after the changes
+	watchErr error
 }
 
 // Agents TODO(doc)
@@ -81,6 +85,98 @@ func (c *mockClient) Agent(ctx context.Context, id string) (*model.Agent, error)
 	return nil, nil
 }
 
+// WatchAgents sends the mock agents, an update to the second agent, and a removal of the first agent and then closes
+// the channels, sending watchErr first if it is set
+func (c *mockClient) WatchAgents(ctx context.Context, options ...client.QueryOption) (<-chan *model.AgentWatchResponse, <-chan error, error) {
+	agents, _ := c.Agents(ctx)
+	updated := *agents[1]
+	updated.Status = model.Connected
+
+	channel := make(chan *model.AgentWatchResponse, 3)
+	channel <- &model.AgentWatchResponse{Events: []*model.AgentWatchEvent{
+		{Type: model.WatchEventInsert, Agent: agents[0]},
+		{Type: model.WatchEventInsert, Agent: agents[1]},
+	}}
+	channel <- &model.AgentWatchResponse{Events: []*model.AgentWatchEvent{
+		{Type: model.WatchEventUpdate, Agent: &updated},
+	}}
+	channel <- &model.AgentWatchResponse{Events: []*model.AgentWatchEvent{
+		{Type: model.WatchEventRemove, Agent: agents[0]},
+	}}
+	close(channel)
+
+	errs := make(chan error, 1)
+	if c.watchErr != nil {
+		errs <- c.watchErr
+	}
+	close(errs)
+	return channel, errs, nil
+}
+
+// AgentGroups returns a single group containing the mock agents
+func (c *mockClient) AgentGroups(ctx context.Context, options ...client.QueryOption) ([]*model.AgentGroup, error) {
+	return []*model.AgentGroup{
+		model.NewAgentGroup("group-1", map[string]string{"env": "test"}, "version:1.0.0"),
+	}, nil
+}
+
+// AgentGroup returns the group with the specified name or nil if it does not exist
+func (c *mockClient) AgentGroup(ctx context.Context, name string) (*model.AgentGroup, error) {
+	groups, _ := c.AgentGroups(ctx)
+	if name == groups[0].Name() {
+		return groups[0], nil
+	}
+	return nil, nil
+}
+
+// AgentGroupSummary returns a summary of the mock agents
+func (c *mockClient) AgentGroupSummary(ctx context.Context, name string) (*model.AgentGroupSummary, error) {
+	group, _ := c.AgentGroup(ctx, name)
+	agents, _ := c.Agents(ctx)
+	return model.NewAgentGroupSummary(group, agents), nil
+}
+
+// Connectors returns a single spanmetrics connector
+func (c *mockClient) Connectors(ctx context.Context, options ...client.QueryOption) ([]*model.Connector, error) {
+	return []*model.Connector{
+		model.NewConnector("spanmetrics", "spanmetrics", nil),
+	}, nil
+}
+
+// Connector returns the connector with the specified name or nil if it does not exist
+func (c *mockClient) Connector(ctx context.Context, name string) (*model.Connector, error) {
+	connectors, _ := c.Connectors(ctx)
+	if name == connectors[0].Name() {
+		return connectors[0], nil
+	}
+	return nil, nil
+}
+
+// Dependents returns the resources and agents affected by a change to the macos source type
+func (c *mockClient) Dependents(ctx context.Context, kind model.Kind, name string) (*model.ResourceDependents, error) {
+	if kind != model.KindSourceType || name != "macos" {
+		return nil, fmt.Errorf("%s %s not found", kind, name)
+	}
+	return &model.ResourceDependents{
+		Resources: []*model.ResourceReference{
+			{Kind: model.KindSource, Name: "macos-1"},
+			{Kind: model.KindConfiguration, Name: "configuration-1"},
+		},
+		AgentIDs: []string{"1"},
+	}, nil
+}
+
+// Dependencies returns the resources used by configuration-1
+func (c *mockClient) Dependencies(ctx context.Context, kind model.Kind, name string) ([]*model.ResourceReference, error) {
+	if kind != model.KindConfiguration || name != "configuration-1" {
+		return nil, fmt.Errorf("%s %s not found", kind, name)
+	}
+	return []*model.ResourceReference{
+		{Kind: model.KindSourceType, Name: "macos"},
+		{Kind: model.KindSource, Name: "macos-1"},
+	}, nil
+}
+
 func executeAndAssertOutput(t *testing.T, cmd *cobra.Command, buffer *bytes.Buffer, expected string) {
 	executeErr := cmd.Execute()
 	require.NoError(t, executeErr, "error while executing command")
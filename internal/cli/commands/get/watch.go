@@ -0,0 +1,67 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package get
+
+import (
+	"context"
+	"sort"
+
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// watchEvent is a change to a watched resource identified by key
+type watchEvent[T model.Printable] struct {
+	key    string
+	item   T
+	remove bool
+}
+
+// watchResources applies the events of each response received on the channel to the watched resources and prints the
+// resources sorted by key. It returns when the channel is closed or the context is done. If the channel is closed
+// because the stream ended unexpectedly, the error received on errs is returned.
+func watchResources[R any, T model.Printable](ctx context.Context, p printer.Printer, channel <-chan R, errs <-chan error, events func(response R) []watchEvent[T]) error {
+	resources := map[string]T{}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case response, ok := <-channel:
+			if !ok {
+				return <-errs
+			}
+			for _, event := range events(response) {
+				if event.remove {
+					delete(resources, event.key)
+				} else {
+					resources[event.key] = event.item
+				}
+			}
+
+			keys := make([]string, 0, len(resources))
+			for key := range resources {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			list := make([]T, 0, len(keys))
+			for _, key := range keys {
+				list = append(list, resources[key])
+			}
+			printer.PrintResources(p, list)
+		}
+	}
+}
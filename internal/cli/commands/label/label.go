@@ -29,6 +29,7 @@ import (
 var (
 	overwriteFlag bool
 	listFlag      bool
+	groupFlag     string
 )
 
 // Command returns the BindPlane label resource cobra command.
@@ -45,6 +46,7 @@ func Command(bindplane *cli.BindPlane) *cobra.Command {
 
 	cmd.Flags().BoolVar(&overwriteFlag, "overwrite", false, "If true, then existing labels will be overwritten. Defaults to false which will produce an error if a label with the same name and a different value already exists.")
 	cmd.Flags().BoolVar(&listFlag, "list", false, "If true, list the labels for the resource.")
+	cmd.Flags().StringVar(&groupFlag, "group", "", "Name of an agent group. If specified, the labels of all agents in the group will be modified.")
 
 	return cmd
 }
@@ -58,6 +60,9 @@ func label(ctx context.Context, stdout io.Writer, stderr io.Writer, args []strin
 		// TODO(andy): Support other resource types
 		return fmt.Errorf("only agent labels are currently supported, other resources coming soon")
 	}
+	if groupFlag != "" {
+		return labelGroup(ctx, stdout, args[1:], bindplane)
+	}
 	if len(args) == 1 {
 		if args[0] == "agent" {
 			return fmt.Errorf("missing agent id")
@@ -126,6 +131,37 @@ func label(ctx context.Context, stdout io.Writer, stderr io.Writer, args []strin
 	return nil
 }
 
+// labelGroup applies the label changes to all of the agents in the group specified by --group
+func labelGroup(ctx context.Context, stdout io.Writer, args []string, bindplane *cli.BindPlane) error {
+	resources, changes, err := splitArgs(args)
+	if err != nil {
+		return err
+	}
+	if len(resources) > 0 {
+		return fmt.Errorf("--group cannot be combined with agent ids")
+	}
+	if len(changes) == 0 {
+		return fmt.Errorf("no label updates specified")
+	}
+
+	labels, err := newLabels(changes)
+	if err != nil {
+		return err
+	}
+
+	client, err := bindplane.Client()
+	if err != nil {
+		return err
+	}
+
+	if err := client.ApplyAgentGroupLabels(ctx, groupFlag, &labels, overwriteFlag); err != nil {
+		// adjust &overwrite=true feedback that comes from REST API call
+		return fmt.Errorf("%s", strings.Replace(err.Error(), "?overwrite=true", "--overwrite", 1))
+	}
+	fmt.Fprintf(stdout, "agents in group %s labeled\n", groupFlag)
+	return nil
+}
+
 func printLabels(stdout io.Writer, resourceType model.Kind, resource string, printHeading bool, labels *model.Labels) {
 	if labels == nil {
 		return
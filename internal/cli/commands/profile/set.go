@@ -17,12 +17,15 @@ package profile
 import (
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"github.com/observiq/bindplane-op/common"
 	"github.com/observiq/bindplane-op/internal/cli/flags"
 	"github.com/observiq/bindplane-op/model"
 )
@@ -104,6 +107,66 @@ func SetCommand(h Helper) *cobra.Command {
 						profile.Spec.Server.DownloadsFolderPath = f.Value.String()
 					case "disable-downloads-cache":
 						profile.Spec.Server.DisableDownloadsCache = f.Value.String() == "true"
+					case "diagnostics-folder-path":
+						profile.Spec.Server.DiagnosticsFolderPath = f.Value.String()
+					case "max-diagnostics-bundles":
+						value, err := strconv.Atoi(f.Value.String())
+						if err != nil {
+							fmt.Println("failed to set max-diagnostics-bundles, must be a number")
+							return
+						}
+						profile.Spec.Server.MaxDiagnosticsBundles = value
+					case "diagnostics-retention":
+						value, err := time.ParseDuration(f.Value.String())
+						if err != nil {
+							fmt.Println("failed to set diagnostics-retention, must be a duration")
+							return
+						}
+						profile.Spec.Server.DiagnosticsRetention = value
+					case "diagnostics-include-config":
+						profile.Spec.Server.DiagnosticsIncludeConfig = f.Value.String() == "true"
+					case "backups-folder-path":
+						profile.Spec.Server.BackupsFolderPath = f.Value.String()
+					case "backup-interval":
+						value, err := time.ParseDuration(f.Value.String())
+						if err != nil {
+							fmt.Println("failed to set backup-interval, must be a duration")
+							return
+						}
+						profile.Spec.Server.BackupInterval = value
+					case "backup-retention":
+						value, err := strconv.Atoi(f.Value.String())
+						if err != nil {
+							fmt.Println("failed to set backup-retention, must be a number")
+							return
+						}
+						profile.Spec.Server.BackupRetention = value
+					case "drift-remediation":
+						profile.Spec.Server.DriftRemediation = common.DriftRemediation(f.Value.String())
+					case "resource-type-catalog":
+						profile.Spec.Server.ResourceTypeCatalog = f.Value.String()
+					case "catalog-sync-interval":
+						value, err := time.ParseDuration(f.Value.String())
+						if err != nil {
+							fmt.Println("failed to set catalog-sync-interval, must be a duration")
+							return
+						}
+						profile.Spec.Server.CatalogSyncInterval = value
+					case "catalog-public-key-file":
+						profile.Spec.Server.CatalogPublicKeyFile = f.Value.String()
+					case "catalog-conflict-policy":
+						profile.Spec.Server.CatalogConflictPolicy = common.CatalogConflictPolicy(f.Value.String())
+					case "sync-directory":
+						profile.Spec.Server.SyncDirectory = f.Value.String()
+					case "sync-interval":
+						value, err := time.ParseDuration(f.Value.String())
+						if err != nil {
+							fmt.Println("failed to set sync-interval, must be a duration")
+							return
+						}
+						profile.Spec.Server.SyncInterval = value
+					case "sync-prune":
+						profile.Spec.Server.SyncPrune = f.Value.String() == "true"
 					case "output":
 						profile.Spec.Command.Output = f.Value.String()
 					case "offline":
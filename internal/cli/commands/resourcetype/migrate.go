@@ -0,0 +1,83 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcetype
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// MigrateCommand returns the BindPlane resource-type migrate cobra command
+func MigrateCommand(bindplane *cli.BindPlane) *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "migrate [kind] [name]",
+		Short: "Migrates resources to the current resource type versions",
+		Long: `Migrates outdated sources, processors, destinations, connectors, and configurations to the current version of
+their resource types. The migrations of the resource type rename, drop, and add default parameters and the resource is
+pinned to the current version. Specify a kind and optional name to migrate specific resources or --all to migrate all outdated
+resources.`,
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if all && len(args) > 0 {
+				return errors.New("--all cannot be combined with a kind or name")
+			}
+			if !all && len(args) == 0 {
+				return errors.New("missing kind or --all")
+			}
+
+			var kind model.Kind
+			var name string
+			if len(args) > 0 {
+				kind = parseKind(args[0])
+				switch kind {
+				case model.KindSource, model.KindProcessor, model.KindDestination, model.KindConnector, model.KindConfiguration:
+				default:
+					return fmt.Errorf("%s resources cannot be migrated, expected source, processor, destination, connector, or configuration", args[0])
+				}
+			}
+			if len(args) > 1 {
+				name = args[1]
+			}
+
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			statuses, err := c.MigrateResources(cmd.Context(), kind, name)
+			if err != nil {
+				return err
+			}
+
+			if len(statuses) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No outdated resources found.")
+				return nil
+			}
+			model.PrintResourceUpdates(cmd.OutOrStdout(), statuses)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&all, "all", false, "migrate all outdated resources")
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcetype
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+)
+
+// OutdatedCommand returns the BindPlane resource-type outdated cobra command
+func OutdatedCommand(bindplane *cli.BindPlane) *cobra.Command {
+	return &cobra.Command{
+		Use:   "outdated",
+		Short: "Displays resources pinned to outdated resource type versions",
+		Long: `Displays the sources, processors, destinations, connectors, and configurations that are pinned to a version of
+their resource type that is earlier than the current version. The path identifies the source, processor, destination,
+or connector within a configuration. Resources that are migratable can be updated with 'resource-type migrate'.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			outdated, err := c.OutdatedResources(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			printer.PrintResources(bindplane.Printer(), outdated)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package resourcetype provides the resource-type command to manage versions of resource types and the resources that
+// use them
+package resourcetype
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// Command returns the BindPlane resource-type cobra command.
+func Command(bindplane *cli.BindPlane) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resource-type",
+		Aliases: []string{"resource-types", "resourcetype", "resourcetypes"},
+		Short:   "Perform actions on resource types",
+		Long: `Resource types are source types, processor types, destination types, and connector types.
+
+Sources, processors, destinations, connectors, and configurations can pin a version of a resource type with typeVersion. When a
+resource type is replaced by a new version, the previous version is kept so that pinned resources continue to use it
+until they are migrated.`,
+	}
+
+	cmd.AddCommand(
+		VersionsCommand(bindplane),
+		OutdatedCommand(bindplane),
+		MigrateCommand(bindplane),
+		TestCommand(bindplane),
+	)
+
+	return cmd
+}
+
+// parseKind parses a kind argument, ignoring case and dashes so that source-type and SourceType are equivalent
+func parseKind(arg string) model.Kind {
+	return model.ParseKind(strings.ReplaceAll(arg, "-", ""))
+}
@@ -0,0 +1,206 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcetype
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+type mockClient struct {
+	client.BindPlane
+	mock.Mock
+}
+
+func (c *mockClient) ResourceTypeVersions(ctx context.Context, kind model.Kind, name string) ([]*model.ResourceType, error) {
+	args := c.Called(kind, name)
+	return args.Get(0).([]*model.ResourceType), args.Error(1)
+}
+
+func (c *mockClient) OutdatedResources(ctx context.Context) ([]*model.OutdatedResource, error) {
+	args := c.Called()
+	return args.Get(0).([]*model.OutdatedResource), args.Error(1)
+}
+
+func (c *mockClient) MigrateResources(ctx context.Context, kind model.Kind, name string) ([]*model.AnyResourceStatus, error) {
+	args := c.Called(kind, name)
+	return args.Get(0).([]*model.AnyResourceStatus), args.Error(1)
+}
+
+func setupBindPlane(buffer *bytes.Buffer, c *mockClient) *cli.BindPlane {
+	bindplane := cli.NewBindPlane(common.InitConfig(""), buffer)
+	bindplane.Config.Output = "table"
+	bindplane.SetClient(c)
+	return bindplane
+}
+
+func testResourceType(version string, migrations int) *model.ResourceType {
+	resourceType := &model.ResourceType{
+		ResourceMeta: model.ResourceMeta{
+			APIVersion: model.V1Alpha,
+			Kind:       model.KindSourceType,
+			Metadata:   model.Metadata{Name: "versioned"},
+		},
+	}
+	resourceType.Spec.Version = version
+	for i := 0; i < migrations; i++ {
+		resourceType.Spec.Migrations = append(resourceType.Spec.Migrations, model.ResourceTypeMigration{})
+	}
+	return resourceType
+}
+
+func TestVersionsCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		expectErr string
+		expectOut string
+	}{
+		{
+			name:      "versions",
+			args:      []string{"source-type", "versioned"},
+			expectOut: "NAME     \tVERSION\tCURRENT\tMIGRATIONS \nversioned\t1.0.0  \tfalse  \t0         \t\nversioned\t2.0.0  \ttrue   \t2         \t\n",
+		},
+		{
+			name:      "not a resource type",
+			args:      []string{"source", "versioned"},
+			expectErr: "source is not a resource type, expected source-type, processor-type, destination-type, or connector-type",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buffer := bytes.NewBufferString("")
+			c := &mockClient{}
+			c.On("ResourceTypeVersions", model.KindSourceType, "versioned").Return([]*model.ResourceType{
+				testResourceType("1.0.0", 0),
+				testResourceType("2.0.0", 2),
+			}, nil)
+
+			cmd := VersionsCommand(setupBindPlane(buffer, c))
+			cmd.SetOut(buffer)
+			cmd.SetArgs(test.args)
+			err := cmd.Execute()
+			if test.expectErr != "" {
+				require.EqualError(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectOut, buffer.String())
+			c.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOutdatedCommand(t *testing.T) {
+	buffer := bytes.NewBufferString("")
+	c := &mockClient{}
+	c.On("OutdatedResources").Return([]*model.OutdatedResource{
+		{
+			Kind:           model.KindConfiguration,
+			Name:           "test",
+			Path:           "sources[0]",
+			ResourceType:   "versioned",
+			TypeVersion:    "1.0.0",
+			CurrentVersion: "2.0.0",
+			Migratable:     true,
+		},
+	}, nil)
+
+	cmd := OutdatedCommand(setupBindPlane(buffer, c))
+	cmd.SetOut(buffer)
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, buffer.String(), "sources[0]")
+	require.Contains(t, buffer.String(), "versioned")
+	c.AssertExpectations(t)
+}
+
+func TestMigrateCommand(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		expectKind model.Kind
+		expectName string
+		statuses   []*model.AnyResourceStatus
+		expectErr  string
+		expectOut  string
+	}{
+		{
+			name:      "missing kind",
+			args:      []string{},
+			expectErr: "missing kind or --all",
+		},
+		{
+			name:      "all with kind",
+			args:      []string{"--all", "source"},
+			expectErr: "--all cannot be combined with a kind or name",
+		},
+		{
+			name:      "invalid kind",
+			args:      []string{"agent"},
+			expectErr: "agent resources cannot be migrated, expected source, processor, destination, connector, or configuration",
+		},
+		{
+			name:      "nothing to migrate",
+			args:      []string{"--all"},
+			statuses:  []*model.AnyResourceStatus{},
+			expectOut: "No outdated resources found.\n",
+		},
+		{
+			name:       "migrate source",
+			args:       []string{"source", "test"},
+			expectKind: model.KindSource,
+			expectName: "test",
+			statuses: []*model.AnyResourceStatus{
+				{
+					Resource: model.AnyResource{ResourceMeta: model.ResourceMeta{Kind: model.KindSource, Metadata: model.Metadata{Name: "test"}}},
+					Status:   model.StatusConfigured,
+				},
+			},
+			expectOut: "Source test configured\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buffer := bytes.NewBufferString("")
+			c := &mockClient{}
+			if test.statuses != nil {
+				c.On("MigrateResources", test.expectKind, test.expectName).Return(test.statuses, nil)
+			}
+
+			cmd := MigrateCommand(setupBindPlane(buffer, c))
+			cmd.SetOut(buffer)
+			cmd.SetArgs(test.args)
+			err := cmd.Execute()
+			if test.expectErr != "" {
+				require.EqualError(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectOut, buffer.String())
+			c.AssertExpectations(t)
+		})
+	}
+}
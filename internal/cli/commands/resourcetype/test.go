@@ -0,0 +1,114 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcetype
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// TestCommand returns the BindPlane resource-type test cobra command
+func TestCommand(bindplane *cli.BindPlane) *cobra.Command {
+	var update bool
+
+	cmd := &cobra.Command{
+		Use:   "test <resource-type-file> <fixtures-file>",
+		Short: "Tests the templates of a resource type using fixtures and golden files",
+		Long: `Renders a source-type, processor-type, destination-type, or connector-type with each of the test cases in a
+fixtures file and compares the configuration with the expected configuration in a golden file. This does not require a
+connection to the server.
+
+A fixtures file contains a list of tests, each with a name and parameters. By default, the golden file of a test is
+<fixtures>/<name>.golden.yaml relative to the fixtures file, where <fixtures> is the name of the fixtures file without
+the extension. A test can specify a different golden file with golden or expect an error with expectError.
+
+  tests:
+    - name: default
+      parameters:
+        - name: collection_interval
+          value: 30
+    - name: invalid
+      parameters:
+        - name: collection_interval
+          value: fast
+      expectError: collection_interval
+
+Use --update to write the golden files with the rendered configuration.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rt, err := model.ResourceTypeFromFile(args[0])
+			if err != nil {
+				return fmt.Errorf("unable to read resource type: %w", err)
+			}
+			if err := rt.Validate(); err != nil {
+				return fmt.Errorf("invalid %s %s: %w", rt.Kind, rt.Name(), model.TemplateErrorDetails(err))
+			}
+
+			fixtures, err := model.ResourceTypeFixturesFromFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			results := fixtures.Run(rt, update)
+			failed := printTestResults(cmd.OutOrStdout(), results)
+			if failed > 0 {
+				return fmt.Errorf("%d of %d tests failed", failed, len(results))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&update, "update", false, "update the golden files with the rendered configuration")
+
+	return cmd
+}
+
+// printTestResults prints the result of each test and returns the number of tests that failed
+func printTestResults(out io.Writer, results []*model.ResourceTypeFixtureResult) int {
+	failed := 0
+	for _, result := range results {
+		switch {
+		case result.Updated:
+			fmt.Fprintf(out, "UPDATED %s (%s)\n", result.Name, result.Golden)
+		case result.Passed:
+			fmt.Fprintf(out, "PASS    %s\n", result.Name)
+		default:
+			failed++
+			fmt.Fprintf(out, "FAIL    %s\n", result.Name)
+			if result.Err != nil {
+				fmt.Fprintln(out, indent(result.Err.Error()))
+			}
+			if result.Diff != "" {
+				fmt.Fprintln(out, indent(result.Diff))
+			}
+		}
+	}
+	return failed
+}
+
+// indent indents each line of the text so that it is nested under the test result
+func indent(text string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = "    " + line
+	}
+	return strings.Join(lines, "\n")
+}
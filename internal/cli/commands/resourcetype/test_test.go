@@ -0,0 +1,114 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcetype
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSourceType = `apiVersion: bindplane.observiq.com/v1beta
+kind: SourceType
+metadata:
+  name: example
+spec:
+  parameters:
+    - name: endpoint
+      type: string
+      required: true
+  metrics:
+    receivers: |
+      - example:
+          endpoint: {{ .endpoint }}
+`
+
+const testFixtures = `tests:
+  - name: default
+    parameters:
+      - name: endpoint
+        value: localhost:1234
+  - name: missing-endpoint
+    expectError: missing required parameter endpoint
+`
+
+func TestTestCommand(t *testing.T) {
+	dir := t.TempDir()
+	typePath := filepath.Join(dir, "example.yaml")
+	fixturesPath := filepath.Join(dir, "fixtures", "example.yaml")
+	goldenPath := filepath.Join(dir, "fixtures", "example", "default.golden.yaml")
+	require.NoError(t, os.WriteFile(typePath, []byte(testSourceType), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Dir(fixturesPath), 0750))
+	require.NoError(t, os.WriteFile(fixturesPath, []byte(testFixtures), 0600))
+
+	run := func(args ...string) (string, error) {
+		buffer := bytes.NewBufferString("")
+		cmd := TestCommand(setupBindPlane(buffer, &mockClient{}))
+		cmd.SetOut(buffer)
+		cmd.SetErr(bytes.NewBufferString(""))
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return buffer.String(), err
+	}
+
+	t.Run("missing golden", func(t *testing.T) {
+		out, err := run(typePath, fixturesPath)
+		require.EqualError(t, err, "1 of 2 tests failed")
+		require.Contains(t, out, "FAIL    default\n    missing golden file "+goldenPath)
+		require.Contains(t, out, "PASS    missing-endpoint\n")
+	})
+
+	t.Run("update", func(t *testing.T) {
+		out, err := run(typePath, fixturesPath, "--update")
+		require.NoError(t, err)
+		require.Equal(t, "UPDATED default ("+goldenPath+")\nPASS    missing-endpoint\n", out)
+		require.FileExists(t, goldenPath)
+	})
+
+	t.Run("pass", func(t *testing.T) {
+		out, err := run(typePath, fixturesPath)
+		require.NoError(t, err)
+		require.Equal(t, "PASS    default\nPASS    missing-endpoint\n", out)
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		golden, err := os.ReadFile(goldenPath)
+		require.NoError(t, err)
+		changed := bytes.Replace(golden, []byte("localhost:1234"), []byte("localhost:5678"), 1)
+		require.NoError(t, os.WriteFile(goldenPath, changed, 0600))
+
+		out, err := run(typePath, fixturesPath)
+		require.EqualError(t, err, "1 of 2 tests failed")
+		require.Contains(t, out, "    -        endpoint: localhost:5678\n    +        endpoint: localhost:1234\n")
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		invalidPath := filepath.Join(dir, "invalid.yaml")
+		invalid := bytes.Replace([]byte(testSourceType), []byte("{{ .endpoint }}"), []byte("{{ .endpoint }"), 1)
+		require.NoError(t, os.WriteFile(invalidPath, invalid, 0600))
+
+		_, err := run(invalidPath, fixturesPath)
+		require.ErrorContains(t, err, "invalid SourceType example")
+		require.ErrorContains(t, err, ">    2 |     endpoint: {{ .endpoint }")
+	})
+
+	t.Run("not a resource type", func(t *testing.T) {
+		_, err := run(fixturesPath, fixturesPath)
+		require.ErrorContains(t, err, "unable to read resource type")
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcetype
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// VersionsCommand returns the BindPlane resource-type versions cobra command
+func VersionsCommand(bindplane *cli.BindPlane) *cobra.Command {
+	return &cobra.Command{
+		Use:   "versions <kind> <name>",
+		Short: "Displays the available versions of a resource type",
+		Long:  `Displays the available versions of a source-type, processor-type, destination-type, or connector-type.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind := parseKind(args[0])
+			switch kind {
+			case model.KindSourceType, model.KindProcessorType, model.KindDestinationType, model.KindConnectorType:
+			default:
+				return fmt.Errorf("%s is not a resource type, expected source-type, processor-type, destination-type, or connector-type", args[0])
+			}
+
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			resourceTypes, err := c.ResourceTypeVersions(cmd.Context(), kind, args[1])
+			if err != nil {
+				return err
+			}
+
+			versions := make([]*resourceTypeVersion, len(resourceTypes))
+			for i, resourceType := range resourceTypes {
+				versions[i] = &resourceTypeVersion{
+					Name:       resourceType.Name(),
+					Version:    resourceType.Spec.Version,
+					Current:    i == len(resourceTypes)-1,
+					Migrations: len(resourceType.Spec.Migrations),
+				}
+			}
+			printer.PrintResources(bindplane.Printer(), versions)
+			return nil
+		},
+	}
+}
+
+// resourceTypeVersion summarizes a version of a resource type for printing
+type resourceTypeVersion struct {
+	Name       string `json:"name" yaml:"name"`
+	Version    string `json:"version" yaml:"version"`
+	Current    bool   `json:"current" yaml:"current"`
+	Migrations int    `json:"migrations" yaml:"migrations"`
+}
+
+var _ model.Printable = (*resourceTypeVersion)(nil)
+
+// PrintableKindSingular returns the singular form of the Kind
+func (v *resourceTypeVersion) PrintableKindSingular() string {
+	return "Version"
+}
+
+// PrintableKindPlural returns the plural form of the Kind
+func (v *resourceTypeVersion) PrintableKindPlural() string {
+	return "Versions"
+}
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (v *resourceTypeVersion) PrintableFieldTitles() []string {
+	return []string{"Name", "Version", "Current", "Migrations"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (v *resourceTypeVersion) PrintableFieldValue(title string) string {
+	switch title {
+	case "Name":
+		return v.Name
+	case "Version":
+		return v.Version
+	case "Current":
+		return strconv.FormatBool(v.Current)
+	case "Migrations":
+		return strconv.Itoa(v.Migrations)
+	default:
+		return "-"
+	}
+}
@@ -51,6 +51,9 @@ import (
 type Server struct {
 	logger *zap.Logger
 	http   *http.Server
+
+	// cancel stops the background work started for the routes
+	cancel context.CancelFunc
 }
 
 // Start starts the BindPlane using the specified Config.
@@ -115,8 +118,11 @@ func (s *Server) Start(bindplane *cli.BindPlane, h profile.Helper, forceConsoleC
 	v1 := router.Group("/v1")
 	v1.Use(otelgin.Middleware("bindplane"))
 
+	routesCtx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
 	authv1 := v1.Group("/", auth.Chain(server)...)
-	rest.AddRestRoutes(authv1, server)
+	rest.AddRestRoutes(routesCtx, authv1, server)
 
 	// download routes do not require authorization
 	rest.AddDownloadRoutes(router, server)
@@ -203,41 +209,15 @@ func (s *Server) stop() error {
 	timeout := 20 * time.Second
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
-	return s.http.Shutdown(ctx)
+	err := s.http.Shutdown(ctx)
+	if s.cancel != nil {
+		s.cancel()
+	}
+	return err
 }
 
 func (s *Server) createStore(config *common.Server) (store.Store, error) {
-	if config.SessionsSecret == "" {
-		return nil, errors.New("cannot create store with unset value for sessions-secret, run bindplane init server to set value")
-	}
-
-	switch config.StoreType {
-	case common.StoreTypeMap:
-		return store.NewMapStore(context.Background(), store.Options{
-			SessionsSecret:   config.SessionsSecret,
-			MaxEventsToMerge: 100,
-		}, s.logger), nil
-
-	case common.StoreTypeGoogleCloud:
-		s.logger.Info("Using Google Cloud Datastore and Pub/Sub")
-		return store.NewGoogleCloudStore(context.Background(), config, s.logger)
-
-	default:
-		// case common.StoreTypeBbolt:
-		storageFilePath := config.BoltDatabasePath()
-
-		db, err := store.InitDB(storageFilePath)
-		// Exit if DB creation is unsuccessful.
-		if err != nil {
-			return nil, fmt.Errorf("BBolt storage file failed to open: %w", err)
-		}
-
-		s.logger.Info("Using BBolt Storage", zap.String("storageFilePath", storageFilePath))
-		return store.NewBoltStore(context.Background(), db, store.Options{
-			SessionsSecret:   config.SessionsSecret,
-			MaxEventsToMerge: 100,
-		}, s.logger), nil
-	}
+	return store.NewStore(context.Background(), config, s.logger)
 }
 
 func (s *Server) createVersions(config *common.Server) agent.Versions {
@@ -0,0 +1,157 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/commands/profile"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// MigrateCommand returns the bindplane store migrate cobra command
+func MigrateCommand(bindplane *cli.BindPlane, h profile.Helper) *cobra.Command {
+	var fromFlag string
+	var toFlag string
+	var followFlag bool
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Copy resources and agents from one store to another",
+		Long: `Copy all resources and agents from the store of the running server of the --from profile to the store configured by the --to profile and verify the number and checksum of each kind.
+
+The server copies its own store while it is running, so changes made by the server and its agents during the migration are included. Resources and agents that are unchanged in the target are skipped and those that no longer exist in the source are deleted from the target, so migrate can be run again to catch up. With --follow, changes are copied until interrupted, after which the stores are copied and verified one last time before cutting over to the new store.
+
+User sessions are stored in cookies encoded with the sessionsSecret, so both profiles must have the same sessionsSecret for users to remain logged in after the cutover. A bbolt target must not be in use by another server.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if fromFlag == "" || toFlag == "" {
+				return errors.New("both --from and --to profiles must be specified")
+			}
+			if fromFlag == toFlag {
+				return errors.New("--from and --to must be different profiles")
+			}
+
+			from, err := h.Folder().ReadProfile(fromFlag)
+			if err != nil {
+				return fmt.Errorf("failed to read profile %s: %w", fromFlag, err)
+			}
+			toConfig, err := serverConfig(h, toFlag)
+			if err != nil {
+				return err
+			}
+			if from.Spec.Server.SessionsSecret != toConfig.SessionsSecret {
+				return fmt.Errorf("the sessionsSecret of profile %s must match %s so that user sessions remain valid after the migration", toFlag, fromFlag)
+			}
+
+			c, err := client.NewBindPlane(&common.Client{Common: from.Spec.Common}, bindplane.Logger())
+			if err != nil {
+				return fmt.Errorf("failed to create a client for profile %s: %w", fromFlag, err)
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			out := cmd.OutOrStdout()
+
+			migration, err := c.StartStoreMigration(ctx, &model.StoreMigrationRequest{
+				StoreType:            toConfig.StoreType,
+				StorageFilePath:      toConfig.StorageFilePath,
+				GoogleCloudDatastore: toConfig.GoogleCloudDatastore,
+				GoogleCloudPubSub:    toConfig.GoogleCloudPubSub,
+				Follow:               followFlag,
+			})
+			if err != nil {
+				return err
+			}
+			printCounts(out, migration.Counts)
+
+			if migration.Following {
+				fmt.Fprintf(out, "the server of %s is following changes, press Ctrl+C to stop and verify\n", fromFlag)
+				<-ctx.Done()
+
+				migration, err = c.StopStoreMigration(context.Background())
+				if err != nil {
+					return err
+				}
+				printCounts(out, migration.Counts)
+			}
+
+			return printChecks(out, migration.Checks)
+		},
+	}
+
+	cmd.Flags().StringVar(&fromFlag, "from", "", "name of the profile of the running server with the store to copy from")
+	cmd.Flags().StringVar(&toFlag, "to", "", "name of the profile with the store to copy to")
+	cmd.Flags().BoolVar(&followFlag, "follow", false, "continue copying changes to the source store until interrupted")
+
+	return cmd
+}
+
+// serverConfig returns the server configuration of the profile. The default bbolt storage file is in the BindPlane
+// home directory.
+func serverConfig(h profile.Helper, name string) (*common.Server, error) {
+	p, err := h.Folder().ReadProfile(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profile %s: %w", name, err)
+	}
+	config := p.Spec.Server
+	if config.StorageFilePath == "" {
+		config.StorageFilePath = path.Join(h.Directory(), common.BoldDatabaseName)
+	}
+	return &config, nil
+}
+
+func printCounts(out io.Writer, counts []*model.StoreMigrationCounts) {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tCOPIED\tUNCHANGED\tDELETED")
+	for _, c := range counts {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", c.Kind, c.Copied, c.Unchanged, c.Deleted)
+	}
+	w.Flush()
+}
+
+func printChecks(out io.Writer, checks []*model.StoreMigrationCheck) error {
+	mismatched := 0
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tSOURCE\tTARGET\tCHECKSUM")
+	for _, check := range checks {
+		result := "ok"
+		if !check.Matches {
+			result = "mismatch"
+			mismatched++
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", check.Kind, check.SourceCount, check.TargetCount, result)
+	}
+	w.Flush()
+
+	if mismatched > 0 {
+		return fmt.Errorf("verification failed, %d kind(s) do not match", mismatched)
+	}
+	return nil
+}
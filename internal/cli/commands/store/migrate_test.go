@@ -0,0 +1,115 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/commands/profile"
+	"github.com/observiq/bindplane-op/internal/rest"
+	"github.com/observiq/bindplane-op/internal/server"
+	bpstore "github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func writeProfile(t *testing.T, h profile.Helper, name string, serverURL string, storageFilePath string, sessionsSecret string) {
+	err := h.Folder().WriteProfile(model.NewProfile(name, model.ProfileSpec{
+		Common: common.Common{
+			ServerURL: serverURL,
+		},
+		Server: common.Server{
+			StoreType:       common.StoreTypeBbolt,
+			StorageFilePath: storageFilePath,
+			SessionsSecret:  sessionsSecret,
+		},
+	}))
+	require.NoError(t, err)
+}
+
+func TestMigrateCommand(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the source is the store of a running server
+	dir := t.TempDir()
+	sourceConfig := &common.Server{StorageFilePath: filepath.Join(dir, "source"), SessionsSecret: "secret"}
+	source := bpstore.NewMapStore(ctx, bpstore.Options{SessionsSecret: "secret"}, zap.NewNop())
+	_, err := source.ApplyResources([]model.Resource{
+		model.NewSourceType("file", nil),
+		model.NewSource("logs", "file", nil),
+	})
+	require.NoError(t, err)
+	bindplane, err := server.NewBindPlane(sourceConfig, zap.NewNop(), source, nil)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	rest.AddRestRoutes(ctx, router.Group("/v1"), bindplane)
+	svr := httptest.NewServer(router)
+	defer svr.Close()
+
+	h := profile.NewHelper(dir)
+	writeProfile(t, h, "source", svr.URL, sourceConfig.StorageFilePath, "secret")
+	writeProfile(t, h, "target", "", filepath.Join(dir, "target"), "secret")
+	writeProfile(t, h, "other", "", filepath.Join(dir, "other"), "other-secret")
+
+	t.Run("requires profiles", func(t *testing.T) {
+		cmd := MigrateCommand(cli.NewBindPlaneForTesting(), h)
+		cmd.SilenceUsage = true
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetArgs([]string{"--from", "source"})
+		require.ErrorContains(t, cmd.Execute(), "both --from and --to profiles must be specified")
+	})
+
+	t.Run("requires the same sessions secret", func(t *testing.T) {
+		cmd := MigrateCommand(cli.NewBindPlaneForTesting(), h)
+		cmd.SilenceUsage = true
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetArgs([]string{"--from", "source", "--to", "other"})
+		require.ErrorContains(t, cmd.Execute(), "the sessionsSecret of profile other must match source")
+	})
+
+	t.Run("copies and verifies", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		cmd := MigrateCommand(cli.NewBindPlaneForTesting(), h)
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{"--from", "source", "--to", "target"})
+		require.NoError(t, cmd.Execute())
+
+		output := out.String()
+		require.Regexp(t, `SourceType\s+1\s+0\s+0`, output)
+		require.Regexp(t, `Source\s+1\s+1\s+ok`, output)
+		require.NotContains(t, output, "mismatch")
+	})
+
+	t.Run("rejects the store of the server as the target", func(t *testing.T) {
+		writeProfile(t, h, "same", "", sourceConfig.StorageFilePath, "secret")
+		cmd := MigrateCommand(cli.NewBindPlaneForTesting(), h)
+		cmd.SilenceUsage = true
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetArgs([]string{"--from", "source", "--to", "same"})
+		require.ErrorContains(t, cmd.Execute(), bpstore.ErrInvalidMigrationTarget.Error())
+	})
+}
@@ -0,0 +1,38 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package store provides commands for managing the store used by the server
+package store
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/commands/profile"
+)
+
+// Command returns the bindplane store cobra command
+func Command(bindplane *cli.BindPlane, h profile.Helper) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store",
+		Short: "Manage the store",
+		Long:  `Manage the store used by the server to persist resources and agents.`,
+	}
+
+	cmd.AddCommand(
+		MigrateCommand(bindplane, h),
+	)
+
+	return cmd
+}
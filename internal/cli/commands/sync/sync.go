@@ -0,0 +1,88 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sync provides the sync command to sync a directory of resource yaml with the server
+package sync
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// Command returns the BindPlane sync cobra command
+func Command(bindplane *cli.BindPlane) *cobra.Command {
+	var directoryFlag string
+	var pruneFlag bool
+	var dryRunFlag bool
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Sync resources from a directory",
+		Long: `Sync the resources in the yaml files in a directory and its subdirectories with the server. Resources that differ
+from the server are created or updated in dependency order and labeled as managed by sync. With --prune, resources
+managed by sync that were removed from the directory are deleted. Resources modified on the server since they were
+synced are reported as drift and replaced by the resources in the directory.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if directoryFlag == "" {
+				return errors.New("a directory is required, use -d or --directory")
+			}
+
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			resources, err := model.ResourcesFromDirectory(directoryFlag)
+			if err != nil {
+				return err
+			}
+
+			result, err := c.Sync(cmd.Context(), resources, pruneFlag, dryRunFlag)
+			if err != nil {
+				return err
+			}
+
+			printer.PrintResources(bindplane.Printer(), result)
+			return failuresError(result)
+		},
+	}
+
+	cmd.Flags().StringVarP(&directoryFlag, "directory", "d", "", "directory containing yaml files of bindplane resources")
+	cmd.Flags().BoolVar(&pruneFlag, "prune", false, "delete resources managed by sync that are not in the directory")
+	cmd.Flags().BoolVar(&dryRunFlag, "dry-run", false, "report the changes without applying them")
+
+	return cmd
+}
+
+// failuresError returns an error if any resources could not be synced
+func failuresError(result []*model.SyncResource) error {
+	failures := 0
+	for _, r := range result {
+		switch r.Status {
+		case model.SyncStatusInvalid, model.SyncStatusInUse:
+			failures++
+		}
+	}
+	if failures == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d resource(s) could not be synced", failures)
+}
@@ -0,0 +1,125 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+type mockClient struct {
+	client.BindPlane
+	mock.Mock
+}
+
+func (c *mockClient) Sync(ctx context.Context, resources []*model.AnyResource, prune bool, dryRun bool) ([]*model.SyncResource, error) {
+	args := c.Called(resources, prune, dryRun)
+	return args.Get(0).([]*model.SyncResource), args.Error(1)
+}
+
+func setupBindPlane(buffer *bytes.Buffer, c *mockClient) *cli.BindPlane {
+	bindplane := cli.NewBindPlane(common.InitConfig(""), buffer)
+	bindplane.Config.Output = "table"
+	bindplane.SetClient(c)
+	return bindplane
+}
+
+func TestSyncCommand(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "source.yaml"), []byte("apiVersion: bindplane.observiq.com/v1\nkind: Source\nmetadata:\n  name: cpu\nspec:\n  type: hostmetrics\n"), 0600))
+
+	tests := []struct {
+		name      string
+		args      []string
+		prune     bool
+		dryRun    bool
+		result    []*model.SyncResource
+		err       error
+		expectErr string
+		expectOut string
+	}{
+		{
+			name:   "sync with prune",
+			args:   []string{"-d", dir, "--prune"},
+			prune:  true,
+			result: []*model.SyncResource{{Kind: model.KindSource, Name: "cpu", Status: model.SyncStatusUpdated, Drift: true, Reason: "modified since it was synced"}, {Kind: model.KindSource, Name: "memory", Status: model.SyncStatusDeleted}},
+			expectOut: "KIND  \tNAME  \tSTATUS \tDRIFT\tREASON                       \n" +
+				"Source\tcpu   \tupdated\tdrift\tmodified since it was synced\t\n" +
+				"Source\tmemory\tdeleted\t     \t                            \t\n",
+		},
+		{
+			name:      "dry run",
+			args:      []string{"--directory", dir, "--dry-run"},
+			dryRun:    true,
+			result:    []*model.SyncResource{{Kind: model.KindSource, Name: "cpu", Status: model.SyncStatusCreate}},
+			expectOut: "KIND  \tNAME\tSTATUS\tDRIFT\tREASON \nSource\tcpu \tcreate\t     \t      \t\n",
+		},
+		{
+			name:      "failures",
+			args:      []string{"-d", dir},
+			result:    []*model.SyncResource{{Kind: model.KindSource, Name: "cpu", Status: model.SyncStatusInvalid, Reason: "unknown type"}},
+			expectErr: "1 resource(s) could not be synced",
+		},
+		{
+			name:      "error",
+			args:      []string{"-d", dir},
+			result:    []*model.SyncResource{},
+			err:       errors.New("unable to sync resources"),
+			expectErr: "unable to sync resources",
+		},
+		{
+			name:      "missing directory",
+			args:      []string{},
+			expectErr: "a directory is required, use -d or --directory",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buffer := bytes.NewBufferString("")
+			c := &mockClient{}
+			if test.result != nil {
+				c.On("Sync", mock.MatchedBy(func(resources []*model.AnyResource) bool {
+					return len(resources) == 1 && resources[0].Name() == "cpu"
+				}), test.prune, test.dryRun).Return(test.result, test.err)
+			}
+
+			cmd := Command(setupBindPlane(buffer, c))
+			cmd.SetOut(buffer)
+			cmd.SetArgs(test.args)
+			cmd.SilenceUsage = true
+			err := cmd.Execute()
+			c.AssertExpectations(t)
+			if test.expectErr != "" {
+				require.EqualError(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectOut, buffer.String())
+		})
+	}
+}
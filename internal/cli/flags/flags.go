@@ -15,7 +15,12 @@
 package flags
 
 import (
+	"github.com/observiq/bindplane-op/common"
 	"github.com/observiq/bindplane-op/internal/agent"
+	"github.com/observiq/bindplane-op/internal/backup"
+	"github.com/observiq/bindplane-op/internal/catalog"
+	"github.com/observiq/bindplane-op/internal/diagnostics"
+	"github.com/observiq/bindplane-op/internal/resourcesync"
 	"github.com/spf13/cobra"
 )
 
@@ -49,4 +54,19 @@ func Serve(cmd *cobra.Command) {
 	f.String("downloads-folder-path", "", "full path to the downloads folder where agents are cached, defaults to $HOME/.bindplane/downloads")
 	f.String("agents-service-url", agent.DefaultAgentVersionsURL, "url of the service that provides agent release information")
 	f.Bool("disable-downloads-cache", false, "true if agent distributions should be cached")
+	f.String("diagnostics-folder-path", "", "full path to the folder where agent diagnostics bundles are stored, defaults to $HOME/.bindplane/diagnostics")
+	f.Int("max-diagnostics-bundles", diagnostics.DefaultMaxBundles, "maximum number of diagnostics bundles stored for each agent")
+	f.Duration("diagnostics-retention", diagnostics.DefaultMaxAge, "amount of time diagnostics bundles are stored before they are removed")
+	f.Bool("diagnostics-include-config", false, "include the effective configuration files of agents, which may contain credentials, in diagnostics bundles")
+	f.String("backups-folder-path", "", "full path to the folder where backups of the bbolt store are saved, defaults to $HOME/.bindplane/backups")
+	f.Duration("backup-interval", backup.DefaultInterval, "interval at which backups of the bbolt store are created, 0 to disable scheduled backups")
+	f.Int("backup-retention", backup.DefaultRetention, "number of backups of the bbolt store to keep, 0 to keep all backups")
+	f.String("drift-remediation", string(common.DriftRemediationNone), "agents with configuration drift that will be sent their configuration again, one of none, locallyModified, or all")
+	f.String("resource-type-catalog", "", "directory or url of the resource type catalog index used to install source, processor, and destination types")
+	f.Duration("catalog-sync-interval", catalog.DefaultSyncInterval, "interval at which resource types are synced from the resource type catalog, 0 to disable automatic sync")
+	f.String("catalog-public-key-file", "", "ed25519 public key file used to verify the signature of the resource type catalog index")
+	f.String("catalog-conflict-policy", string(common.CatalogConflictPolicyKeep), "resolution of resource types modified since they were synced from the catalog, one of keep or overwrite")
+	f.String("sync-directory", "", "directory of resource yaml that is synced into the store")
+	f.Duration("sync-interval", resourcesync.DefaultInterval, "interval at which resources are synced from the sync directory")
+	f.Bool("sync-prune", false, "delete resources managed by sync that are removed from the sync directory")
 }
@@ -17,6 +17,7 @@ package flags
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -46,6 +47,14 @@ func (s *flags) Bool(name string, value bool, usage string, opts ...flagOption)
 	newflag(name, opts, withUsage(usage)).Bool(s.set, value)
 }
 
+func (s *flags) Int(name string, value int, usage string, opts ...flagOption) {
+	newflag(name, opts, withUsage(usage)).Int(s.set, value)
+}
+
+func (s *flags) Duration(name string, value time.Duration, usage string, opts ...flagOption) {
+	newflag(name, opts, withUsage(usage)).Duration(s.set, value)
+}
+
 // ----------------------------------------------------------------------
 type flag struct {
 	name           string
@@ -85,6 +94,16 @@ func (f *flag) Bool(set *pflag.FlagSet, defaultValue bool) {
 	f.BindViper(set)
 }
 
+func (f *flag) Int(set *pflag.FlagSet, defaultValue int) {
+	set.IntP(f.name, f.shorthand, defaultValue, f.usage)
+	f.BindViper(set)
+}
+
+func (f *flag) Duration(set *pflag.FlagSet, defaultValue time.Duration) {
+	set.DurationP(f.name, f.shorthand, defaultValue, f.usage)
+	f.BindViper(set)
+}
+
 func (f *flag) BindViper(set *pflag.FlagSet) {
 	// Bind flags to viper keys, ignoring errors because they will only be produced if the flags are nil,
 	// which they wont be because we just set them above.
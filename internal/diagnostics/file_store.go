@@ -0,0 +1,203 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+const (
+	metadataExtension = ".json"
+	archiveExtension  = ".tar.gz"
+)
+
+// fileStore stores each bundle as a pair of files, {directory}/{agentID}/{bundleID}.json containing the
+// model.DiagnosticsBundle and {directory}/{agentID}/{bundleID}.tar.gz containing the archive
+type fileStore struct {
+	retention
+	directory string
+	logger    *zap.Logger
+	mtx       sync.Mutex
+}
+
+var _ Store = (*fileStore)(nil)
+
+func newFileStore(directory string, r retention, logger *zap.Logger) *fileStore {
+	return &fileStore{
+		retention: r,
+		directory: directory,
+		logger:    logger,
+	}
+}
+
+func (s *fileStore) Save(bundle *model.DiagnosticsBundle, contents []byte) error {
+	if !validID(bundle.AgentID) || !validID(bundle.ID) {
+		return fmt.Errorf("invalid diagnostics bundle id %s for agent %s", bundle.ID, bundle.AgentID)
+	}
+	metadata, err := json.Marshal(bundle)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if err := os.MkdirAll(s.agentDirectory(bundle.AgentID), 0750); err != nil {
+		return fmt.Errorf("unable to create the diagnostics directory: %w", err)
+	}
+	// write the archive first so that a bundle is never listed without its contents
+	if err := os.WriteFile(s.path(bundle.AgentID, bundle.ID, archiveExtension), contents, 0600); err != nil {
+		return fmt.Errorf("unable to write the diagnostics bundle: %w", err)
+	}
+	if err := os.WriteFile(s.path(bundle.AgentID, bundle.ID, metadataExtension), metadata, 0600); err != nil {
+		return fmt.Errorf("unable to write the diagnostics bundle: %w", err)
+	}
+
+	_, err = s.prune(bundle.AgentID, time.Now())
+	return err
+}
+
+func (s *fileStore) Bundles(agentID string) ([]*model.DiagnosticsBundle, error) {
+	if !validID(agentID) {
+		return []*model.DiagnosticsBundle{}, nil
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.prune(agentID, time.Now())
+}
+
+func (s *fileStore) Bundle(agentID, bundleID string) (*model.DiagnosticsBundle, []byte, error) {
+	if !validID(agentID) || !validID(bundleID) {
+		return nil, nil, ErrBundleNotFound
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	bundles, err := s.prune(agentID, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, bundle := range bundles {
+		if bundle.ID != bundleID {
+			continue
+		}
+		contents, err := os.ReadFile(s.path(agentID, bundleID, archiveExtension))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil, nil, ErrBundleNotFound
+			}
+			return nil, nil, err
+		}
+		return bundle, contents, nil
+	}
+	return nil, nil, ErrBundleNotFound
+}
+
+func (s *fileStore) Prune() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	entries, err := os.ReadDir(s.directory)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	now := time.Now()
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := s.prune(entry.Name(), now); err != nil {
+			s.logger.Error("unable to prune diagnostics bundles", zap.String("agentID", entry.Name()), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// prune removes the bundles for the agent that exceed the retention limits and returns the remaining bundles. It must
+// be called with the lock held.
+func (s *fileStore) prune(agentID string, now time.Time) ([]*model.DiagnosticsBundle, error) {
+	bundles, err := s.readBundles(agentID)
+	if err != nil {
+		return nil, err
+	}
+	keep, remove := s.split(bundles, now)
+	for _, bundle := range remove {
+		for _, ext := range []string{metadataExtension, archiveExtension} {
+			if err := os.Remove(s.path(agentID, bundle.ID, ext)); err != nil && !errors.Is(err, os.ErrNotExist) {
+				s.logger.Error("unable to remove diagnostics bundle", zap.String("agentID", agentID), zap.String("bundleID", bundle.ID), zap.Error(err))
+			}
+		}
+	}
+	if len(keep) == 0 {
+		// the directory will only be removed if it is empty
+		_ = os.Remove(s.agentDirectory(agentID))
+		return []*model.DiagnosticsBundle{}, nil
+	}
+	return keep, nil
+}
+
+// readBundles reads the metadata of all bundles for the agent, oldest first
+func (s *fileStore) readBundles(agentID string) ([]*model.DiagnosticsBundle, error) {
+	entries, err := os.ReadDir(s.agentDirectory(agentID))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var bundles []*model.DiagnosticsBundle
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), metadataExtension) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.agentDirectory(agentID), entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var bundle model.DiagnosticsBundle
+		if err := json.Unmarshal(data, &bundle); err != nil {
+			s.logger.Error("unable to read diagnostics bundle", zap.String("agentID", agentID), zap.String("file", entry.Name()), zap.Error(err))
+			continue
+		}
+		bundles = append(bundles, &bundle)
+	}
+	sortBundles(bundles)
+	return bundles, nil
+}
+
+func (s *fileStore) agentDirectory(agentID string) string {
+	return filepath.Join(s.directory, agentID)
+}
+
+func (s *fileStore) path(agentID, bundleID, ext string) string {
+	return filepath.Join(s.directory, agentID, bundleID+ext)
+}
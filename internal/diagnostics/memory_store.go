@@ -0,0 +1,113 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+type memoryBundle struct {
+	bundle   *model.DiagnosticsBundle
+	contents []byte
+}
+
+type memoryStore struct {
+	retention
+	bundles map[string][]memoryBundle
+	mtx     sync.Mutex
+}
+
+var _ Store = (*memoryStore)(nil)
+
+func newMemoryStore(r retention) *memoryStore {
+	return &memoryStore{
+		retention: r,
+		bundles:   map[string][]memoryBundle{},
+	}
+}
+
+func (s *memoryStore) Save(bundle *model.DiagnosticsBundle, contents []byte) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.bundles[bundle.AgentID] = append(s.bundles[bundle.AgentID], memoryBundle{bundle: bundle, contents: contents})
+	s.prune(bundle.AgentID, time.Now())
+	return nil
+}
+
+func (s *memoryStore) Bundles(agentID string) ([]*model.DiagnosticsBundle, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.prune(agentID, time.Now())
+	result := []*model.DiagnosticsBundle{}
+	for _, b := range s.bundles[agentID] {
+		result = append(result, b.bundle)
+	}
+	return result, nil
+}
+
+func (s *memoryStore) Bundle(agentID, bundleID string) (*model.DiagnosticsBundle, []byte, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.prune(agentID, time.Now())
+	for _, b := range s.bundles[agentID] {
+		if b.bundle.ID == bundleID {
+			return b.bundle, b.contents, nil
+		}
+	}
+	return nil, nil, ErrBundleNotFound
+}
+
+func (s *memoryStore) Prune() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := time.Now()
+	for agentID := range s.bundles {
+		s.prune(agentID, now)
+	}
+	return nil
+}
+
+// prune removes the bundles for the agent that exceed the retention limits. It must be called with the lock held.
+func (s *memoryStore) prune(agentID string, now time.Time) {
+	entries := s.bundles[agentID]
+	bundles := make([]*model.DiagnosticsBundle, 0, len(entries))
+	for _, b := range entries {
+		bundles = append(bundles, b.bundle)
+	}
+	sortBundles(bundles)
+	keep, _ := s.split(bundles, now)
+	if len(keep) == 0 {
+		delete(s.bundles, agentID)
+		return
+	}
+
+	kept := make([]memoryBundle, 0, len(keep))
+	for _, bundle := range keep {
+		for _, b := range entries {
+			if b.bundle == bundle {
+				kept = append(kept, b)
+				break
+			}
+		}
+	}
+	s.bundles[agentID] = kept
+}
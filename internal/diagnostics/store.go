@@ -0,0 +1,173 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diagnostics stores the diagnostics bundles collected from agents and enforces retention limits.
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+const (
+	// DefaultMaxBundles is the default number of bundles kept for each agent
+	DefaultMaxBundles = 5
+	// DefaultMaxAge is the default amount of time a bundle is kept before it is removed
+	DefaultMaxAge = 7 * 24 * time.Hour
+)
+
+// ErrBundleNotFound is returned when the requested bundle does not exist or has expired
+var ErrBundleNotFound = errors.New("diagnostics bundle not found")
+
+// Store stores diagnostics bundles. Bundles that exceed the retention limits are removed when a new bundle is saved
+// and when Prune is called.
+type Store interface {
+	// Save stores the bundle and its contents, removing older bundles for the agent that exceed the retention limits
+	Save(bundle *model.DiagnosticsBundle, contents []byte) error
+
+	// Bundles returns the bundles for the agent, oldest first
+	Bundles(agentID string) ([]*model.DiagnosticsBundle, error)
+
+	// Bundle returns the bundle and its contents or ErrBundleNotFound if it does not exist
+	Bundle(agentID, bundleID string) (*model.DiagnosticsBundle, []byte, error)
+
+	// Prune removes all bundles that are older than the maximum age
+	Prune() error
+}
+
+// Settings configures the Store
+type Settings struct {
+	// Directory is where bundles are stored. If empty, bundles are stored in memory.
+	Directory string
+
+	// MaxBundles is the maximum number of bundles kept for each agent, defaulting to DefaultMaxBundles
+	MaxBundles int
+
+	// MaxAge is the maximum amount of time a bundle is kept, defaulting to DefaultMaxAge
+	MaxAge time.Duration
+
+	Logger *zap.Logger
+}
+
+// NewStore returns a new Store using the specified settings
+func NewStore(settings Settings) Store {
+	r := retention{
+		maxBundles: settings.MaxBundles,
+		maxAge:     settings.MaxAge,
+	}
+	if r.maxBundles <= 0 {
+		r.maxBundles = DefaultMaxBundles
+	}
+	if r.maxAge <= 0 {
+		r.maxAge = DefaultMaxAge
+	}
+	logger := settings.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	if settings.Directory == "" {
+		return newMemoryStore(r)
+	}
+	return newFileStore(settings.Directory, r, logger)
+}
+
+// NewBundle creates a gzipped tar archive containing the specified files and returns a bundle describing it
+func NewBundle(id, agentID string, files map[string][]byte) (*model.DiagnosticsBundle, []byte, error) {
+	now := time.Now()
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buffer bytes.Buffer
+	gz := gzip.NewWriter(&buffer)
+	tw := tar.NewWriter(gz)
+	for _, name := range names {
+		contents := files[name]
+		header := &tar.Header{
+			Name:    name,
+			Mode:    0600,
+			Size:    int64(len(contents)),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, nil, err
+		}
+		if _, err := tw.Write(contents); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	bundle := &model.DiagnosticsBundle{
+		ID:        id,
+		AgentID:   agentID,
+		CreatedAt: now,
+		Size:      int64(buffer.Len()),
+		Files:     names,
+	}
+	return bundle, buffer.Bytes(), nil
+}
+
+// ----------------------------------------------------------------------
+
+type retention struct {
+	maxBundles int
+	maxAge     time.Duration
+}
+
+// split separates bundles that should be kept from bundles that have exceeded the retention limits. The bundles must
+// be sorted oldest first.
+func (r retention) split(bundles []*model.DiagnosticsBundle, now time.Time) (keep, remove []*model.DiagnosticsBundle) {
+	cutoff := now.Add(-r.maxAge)
+	for _, bundle := range bundles {
+		if bundle.CreatedAt.Before(cutoff) {
+			remove = append(remove, bundle)
+		} else {
+			keep = append(keep, bundle)
+		}
+	}
+	if excess := len(keep) - r.maxBundles; excess > 0 {
+		remove = append(remove, keep[:excess]...)
+		keep = keep[excess:]
+	}
+	return keep, remove
+}
+
+func sortBundles(bundles []*model.DiagnosticsBundle) {
+	sort.SliceStable(bundles, func(i, j int) bool {
+		return bundles[i].CreatedAt.Before(bundles[j].CreatedAt)
+	})
+}
+
+// validID returns true if the id can safely be used as a file name
+func validID(id string) bool {
+	return id != "" && id != "." && id != ".." && filepath.Base(id) == id
+}
@@ -0,0 +1,127 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestNewBundle(t *testing.T) {
+	bundle, contents, err := NewBundle("b1", "a1", map[string][]byte{
+		"config/collector.yaml": []byte("receivers:"),
+		"agent.yaml":            []byte("id: a1"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "b1", bundle.ID)
+	require.Equal(t, "a1", bundle.AgentID)
+	require.Equal(t, []string{"agent.yaml", "config/collector.yaml"}, bundle.Files)
+	require.Equal(t, int64(len(contents)), bundle.Size)
+
+	gz, err := gzip.NewReader(bytes.NewReader(contents))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[header.Name] = string(data)
+	}
+	require.Equal(t, map[string]string{
+		"agent.yaml":            "id: a1",
+		"config/collector.yaml": "receivers:",
+	}, files)
+}
+
+func TestStore(t *testing.T) {
+	stores := map[string]func(t *testing.T) Store{
+		"memory": func(t *testing.T) Store {
+			return NewStore(Settings{MaxBundles: 2, MaxAge: time.Hour})
+		},
+		"file": func(t *testing.T) Store {
+			return NewStore(Settings{Directory: t.TempDir(), MaxBundles: 2, MaxAge: time.Hour})
+		},
+	}
+
+	bundle := func(id string, age time.Duration) *model.DiagnosticsBundle {
+		return &model.DiagnosticsBundle{ID: id, AgentID: "a1", CreatedAt: time.Now().Add(-age)}
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			t.Run("save and read bundles", func(t *testing.T) {
+				s := newStore(t)
+				require.NoError(t, s.Save(bundle("b1", time.Minute), []byte("one")))
+
+				b, contents, err := s.Bundle("a1", "b1")
+				require.NoError(t, err)
+				require.Equal(t, "b1", b.ID)
+				require.Equal(t, []byte("one"), contents)
+
+				_, _, err = s.Bundle("a1", "missing")
+				require.ErrorIs(t, err, ErrBundleNotFound)
+				_, _, err = s.Bundle("a2", "b1")
+				require.ErrorIs(t, err, ErrBundleNotFound)
+			})
+
+			t.Run("keeps the newest bundles", func(t *testing.T) {
+				s := newStore(t)
+				require.NoError(t, s.Save(bundle("b1", 3*time.Minute), []byte("one")))
+				require.NoError(t, s.Save(bundle("b2", 2*time.Minute), []byte("two")))
+				require.NoError(t, s.Save(bundle("b3", time.Minute), []byte("three")))
+
+				bundles, err := s.Bundles("a1")
+				require.NoError(t, err)
+				require.Len(t, bundles, 2)
+				require.Equal(t, "b2", bundles[0].ID)
+				require.Equal(t, "b3", bundles[1].ID)
+
+				_, _, err = s.Bundle("a1", "b1")
+				require.ErrorIs(t, err, ErrBundleNotFound)
+			})
+
+			t.Run("removes expired bundles", func(t *testing.T) {
+				s := newStore(t)
+				require.NoError(t, s.Save(bundle("old", 2*time.Hour), []byte("old")))
+				require.NoError(t, s.Prune())
+
+				bundles, err := s.Bundles("a1")
+				require.NoError(t, err)
+				require.Len(t, bundles, 0)
+			})
+
+			t.Run("rejects invalid ids", func(t *testing.T) {
+				s := newStore(t)
+				_, _, err := s.Bundle("..", "b1")
+				require.ErrorIs(t, err, ErrBundleNotFound)
+				_, _, err = s.Bundle("a1", "../b1")
+				require.ErrorIs(t, err, ErrBundleNotFound)
+			})
+		})
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// BackpressurePolicy determines what happens when an event is sent to a subscriber that has not received the events
+// already sent to it
+type BackpressurePolicy int
+
+const (
+	// BackpressureBlock waits until the subscriber receives the event. A subscriber that stops receiving events will
+	// block Send for all subscribers.
+	BackpressureBlock BackpressurePolicy = iota
+
+	// BackpressureDropOldest drops the oldest event waiting to be received to make room for the new event
+	BackpressureDropOldest
+
+	// BackpressureDropNewest drops the new event
+	BackpressureDropNewest
+
+	// BackpressureCoalesce replaces an event with the same key that is waiting to be received, falling back to dropping
+	// the oldest event. Use WithCoalesceKey to specify the key.
+	BackpressureCoalesce
+
+	// BackpressureDisconnect unsubscribes the subscriber and closes its channel
+	BackpressureDisconnect
+)
+
+// String returns the name of the policy
+func (p BackpressurePolicy) String() string {
+	switch p {
+	case BackpressureBlock:
+		return "block"
+	case BackpressureDropOldest:
+		return "drop-oldest"
+	case BackpressureDropNewest:
+		return "drop-newest"
+	case BackpressureCoalesce:
+		return "coalesce"
+	case BackpressureDisconnect:
+		return "disconnect"
+	}
+	return "unknown"
+}
+
+// SubscriptionStats are the counters of a single subscription. Use WithStats to provide the stats when subscribing.
+type SubscriptionStats struct {
+	sent         int64
+	delivered    int64
+	dropped      int64
+	coalesced    int64
+	disconnected int32
+
+	// pending is set by the subscription to report events that were delivered to a buffered channel but not yet
+	// received
+	pending func() int
+}
+
+// Sent returns the number of events sent to the subscriber
+func (s *SubscriptionStats) Sent() int64 {
+	return atomic.LoadInt64(&s.sent)
+}
+
+// Delivered returns the number of events delivered to the channel of the subscriber
+func (s *SubscriptionStats) Delivered() int64 {
+	return atomic.LoadInt64(&s.delivered)
+}
+
+// Dropped returns the number of events dropped because the subscriber was not keeping up
+func (s *SubscriptionStats) Dropped() int64 {
+	return atomic.LoadInt64(&s.dropped)
+}
+
+// Coalesced returns the number of events replaced by a newer event with the same key
+func (s *SubscriptionStats) Coalesced() int64 {
+	return atomic.LoadInt64(&s.coalesced)
+}
+
+// Lag returns the number of events sent to the subscriber that it has not received yet
+func (s *SubscriptionStats) Lag() int64 {
+	lag := s.Sent() - s.Delivered() - s.Dropped() - s.Coalesced()
+	if s.pending != nil {
+		lag += int64(s.pending())
+	}
+	return lag
+}
+
+// Disconnected returns true if the subscriber was disconnected because it was not keeping up
+func (s *SubscriptionStats) Disconnected() bool {
+	return atomic.LoadInt32(&s.disconnected) == 1
+}
+
+// the counters are updated using these nil-safe methods because stats are optional for blocking subscriptions
+
+func (s *SubscriptionStats) addSent(delta int64) {
+	if s != nil {
+		atomic.AddInt64(&s.sent, delta)
+	}
+}
+
+func (s *SubscriptionStats) addDelivered(delta int64) {
+	if s != nil {
+		atomic.AddInt64(&s.delivered, delta)
+	}
+}
+
+func (s *SubscriptionStats) addDropped(delta int64) {
+	if s != nil {
+		atomic.AddInt64(&s.dropped, delta)
+	}
+}
+
+func (s *SubscriptionStats) addCoalesced(delta int64) {
+	if s != nil {
+		atomic.AddInt64(&s.coalesced, delta)
+	}
+}
+
+// disconnector is implemented by subscribers that can be disconnected. The source removes disconnected subscribers.
+type disconnector interface {
+	disconnected() bool
+}
+
+// ----------------------------------------------------------------------
+// queue subscriptions
+
+type queuedEvent[T any] struct {
+	key   string
+	event T
+}
+
+// queueSubscription buffers events in a queue that is never blocked by the subscriber. A separate goroutine delivers
+// the events to the channel, applying the backpressure policy when the queue is full.
+type queueSubscription[T any] struct {
+	policy BackpressurePolicy
+	size   int
+	key    func(T) string
+	stats  *SubscriptionStats
+
+	mtx    sync.Mutex
+	queue  []queuedEvent[T]
+	closed bool
+
+	// notify has a buffer of 1 and is signaled when an event is added to the queue
+	notify  chan struct{}
+	channel chan T
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+func newQueueSubscription[T any](opts subscriptionOptions[T]) *queueSubscription[T] {
+	size := opts.bufferSize
+	if size <= 0 {
+		size = subscriberChannelBufferSize
+	}
+	channel := opts.channel
+	if channel == nil {
+		// unbuffered so that delivered events have been received by the subscriber
+		channel = make(chan T)
+	}
+	stats := opts.stats
+	if stats == nil {
+		stats = &SubscriptionStats{}
+	}
+	stats.pending = func() int { return len(channel) }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &queueSubscription[T]{
+		policy:  opts.policy,
+		size:    size,
+		key:     opts.key,
+		stats:   stats,
+		notify:  make(chan struct{}, 1),
+		channel: channel,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+	go s.deliver()
+	return s
+}
+
+func (s *queueSubscription[T]) Receive(event T) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.closed {
+		return
+	}
+	s.stats.addSent(1)
+
+	item := queuedEvent[T]{event: event}
+	if s.key != nil {
+		item.key = s.key(event)
+		if s.policy == BackpressureCoalesce {
+			for i := range s.queue {
+				if s.queue[i].key == item.key {
+					s.queue[i] = item
+					s.stats.addCoalesced(1)
+					return
+				}
+			}
+		}
+	}
+
+	if len(s.queue) >= s.size {
+		switch s.policy {
+		case BackpressureDropNewest:
+			s.stats.addDropped(1)
+			return
+
+		case BackpressureDisconnect:
+			// everything that was not delivered is dropped
+			s.stats.addDropped(int64(len(s.queue)) + 1)
+			s.queue = nil
+			s.closed = true
+			atomic.StoreInt32(&s.stats.disconnected, 1)
+			s.cancel()
+			return
+
+		default:
+			// BackpressureDropOldest and BackpressureCoalesce without a matching key
+			s.queue = s.queue[1:]
+			s.stats.addDropped(1)
+		}
+	}
+
+	s.queue = append(s.queue, item)
+	select {
+	case s.notify <- exists:
+	default:
+	}
+}
+
+// deliver sends events from the queue to the channel until the subscription is closed
+func (s *queueSubscription[T]) deliver() {
+	defer close(s.channel)
+	for {
+		event, ok := s.next()
+		if !ok {
+			select {
+			case <-s.notify:
+				continue
+			case <-s.ctx.Done():
+				return
+			}
+		}
+		select {
+		case s.channel <- event:
+			s.stats.addDelivered(1)
+		case <-s.ctx.Done():
+			s.stats.addDropped(1)
+			return
+		}
+	}
+}
+
+// next removes the next event from the queue
+func (s *queueSubscription[T]) next() (event T, ok bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if len(s.queue) == 0 {
+		return event, false
+	}
+	event = s.queue[0].event
+	s.queue = s.queue[1:]
+	return event, true
+}
+
+func (s *queueSubscription[T]) Channel() <-chan T {
+	return s.channel
+}
+
+func (s *queueSubscription[T]) Close() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.closed = true
+	s.cancel()
+}
+
+func (s *queueSubscription[T]) disconnected() bool {
+	return s.stats.Disconnected()
+}
+
+var _ Subscriber[int] = (*queueSubscription[int])(nil)
+var _ disconnector = (*queueSubscription[int])(nil)
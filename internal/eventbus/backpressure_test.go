@@ -0,0 +1,217 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireQueueLen waits until the queue of a queueSubscription has the specified length
+func requireQueueLen(t *testing.T, subscriber Subscriber[int], length int) {
+	s, ok := subscriber.(*queueSubscription[int])
+	require.True(t, ok, "expected a queueSubscription")
+	require.Eventually(t, func() bool {
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+		return len(s.queue) == length
+	}, time.Second, time.Millisecond)
+}
+
+// readAvailable reads events from the channel until none are received for a short time or the channel is closed
+func readAvailable(channel <-chan int) (events []int, closed bool) {
+	for {
+		select {
+		case event, ok := <-channel:
+			if !ok {
+				return events, true
+			}
+			events = append(events, event)
+		case <-time.After(50 * time.Millisecond):
+			return events, false
+		}
+	}
+}
+
+func TestBackpressurePolicies(t *testing.T) {
+	coalesceKey := func(event int) string { return fmt.Sprint(event % 3) }
+
+	tests := []struct {
+		name          string
+		options       []SubscriptionOption[int]
+		send          []int
+		expect        []int
+		expectDropped int64
+		expectMerged  int64
+	}{
+		{
+			name:          "drop oldest",
+			options:       []SubscriptionOption[int]{WithBackpressure[int](BackpressureDropOldest)},
+			send:          []int{2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expect:        []int{1, 8, 9, 10},
+			expectDropped: 6,
+		},
+		{
+			name:          "drop newest",
+			options:       []SubscriptionOption[int]{WithBackpressure[int](BackpressureDropNewest)},
+			send:          []int{2, 3, 4, 5, 6, 7, 8, 9, 10},
+			expect:        []int{1, 2, 3, 4},
+			expectDropped: 6,
+		},
+		{
+			name:         "coalesce",
+			options:      []SubscriptionOption[int]{WithCoalesceKey(coalesceKey)},
+			send:         []int{2, 3, 4, 5, 6},
+			expect:       []int{1, 5, 6, 4},
+			expectMerged: 2,
+		},
+		{
+			name: "coalesce drops oldest without a matching key",
+			options: []SubscriptionOption[int]{WithCoalesceKey(func(event int) string {
+				return fmt.Sprint(event)
+			})},
+			send:          []int{2, 3, 4, 5},
+			expect:        []int{1, 3, 4, 5},
+			expectDropped: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stats := &SubscriptionStats{}
+			options := append([]SubscriptionOption[int]{WithBufferSize[int](3), WithStats[int](stats)}, test.options...)
+			subscriber := newSubscription(options)
+			defer subscriber.Close()
+
+			// the first event is waiting to be delivered on the channel and is no longer in the queue
+			subscriber.Receive(1)
+			requireQueueLen(t, subscriber, 0)
+
+			for _, event := range test.send {
+				subscriber.Receive(event)
+			}
+			sent := int64(len(test.send) + 1)
+			require.Equal(t, sent, stats.Sent())
+			require.Equal(t, test.expectDropped, stats.Dropped())
+			require.Equal(t, test.expectMerged, stats.Coalesced())
+			require.Equal(t, int64(len(test.expect)), stats.Lag())
+
+			events, closed := readAvailable(subscriber.Channel())
+			require.False(t, closed)
+			require.Equal(t, test.expect, events)
+			require.Equal(t, int64(len(test.expect)), stats.Delivered())
+			require.Equal(t, int64(0), stats.Lag())
+			require.False(t, stats.Disconnected())
+		})
+	}
+}
+
+func TestBackpressureDisconnect(t *testing.T) {
+	bus := NewSource[int]()
+	stats := &SubscriptionStats{}
+	channel, unsubscribe := Subscribe(bus, WithBackpressure[int](BackpressureDisconnect), WithBufferSize[int](2), WithStats[int](stats))
+	defer unsubscribe()
+	require.Equal(t, 1, bus.Subscribers())
+
+	for i := 1; i <= 10; i++ {
+		bus.Send(i)
+	}
+
+	require.True(t, stats.Disconnected())
+	require.Equal(t, 0, bus.Subscribers())
+	// the subscriber is removed once the queue overflows and doesn't receive the remaining events
+	require.Less(t, stats.Sent(), int64(10))
+
+	// at most the event waiting on the channel was delivered before the channel was closed
+	events, closed := readAvailable(channel)
+	require.True(t, closed)
+	require.LessOrEqual(t, len(events), 1)
+	require.Equal(t, stats.Sent(), stats.Dropped()+stats.Delivered())
+	require.Equal(t, int64(0), stats.Lag())
+}
+
+func TestBackpressureBlockStats(t *testing.T) {
+	bus := NewSource[int]()
+	stats := &SubscriptionStats{}
+	channel, unsubscribe := Subscribe(bus, WithStats[int](stats))
+	defer unsubscribe()
+
+	for i := 1; i <= 3; i++ {
+		bus.Send(i)
+	}
+	require.Equal(t, int64(3), stats.Sent())
+	require.Equal(t, int64(3), stats.Delivered())
+	require.Equal(t, int64(3), stats.Lag())
+
+	require.Equal(t, 1, <-channel)
+	require.Equal(t, int64(2), stats.Lag())
+	require.Equal(t, int64(0), stats.Dropped())
+}
+
+func TestBackpressureSlowSubscriberNoStall(t *testing.T) {
+	policies := []SubscriptionOption[int]{
+		WithBackpressure[int](BackpressureDropOldest),
+		WithBackpressure[int](BackpressureDropNewest),
+		WithCoalesceKey(func(event int) string { return fmt.Sprint(event % 5) }),
+		WithBackpressure[int](BackpressureDisconnect),
+	}
+	for _, policy := range policies {
+		opts := makeSubscriptionOptions([]SubscriptionOption[int]{policy})
+		t.Run(opts.policy.String(), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			bus := NewSource[int]()
+
+			// subscribes with the default blocking policy and keeps reading
+			reader := newTestSubscriber(ctx)
+			unsubscribeReader := reader.Subscribe(bus)
+			defer unsubscribeReader()
+			go reader.run()
+
+			// subscribes and never reads
+			stats := &SubscriptionStats{}
+			_, unsubscribeStalled := Subscribe(bus, policy, WithStats[int](stats))
+			defer unsubscribeStalled()
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				for i := 1; i <= 1000; i++ {
+					bus.Send(i)
+				}
+			}()
+
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "Send stalled on the subscriber that stopped reading")
+			}
+
+			reader.requireTotal(t, 500500)
+			if opts.policy == BackpressureDisconnect {
+				require.True(t, stats.Disconnected())
+				require.Equal(t, 1, bus.Subscribers())
+			} else {
+				require.Equal(t, int64(1000), stats.Sent())
+			}
+			require.LessOrEqual(t, stats.Lag(), int64(subscriberChannelBufferSize+1))
+			require.Greater(t, stats.Dropped()+stats.Coalesced(), int64(0))
+		})
+	}
+}
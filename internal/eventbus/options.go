@@ -20,6 +20,10 @@ type subscriptionOptions[T any] struct {
 	channel           chan T
 	unbounded         bool
 	unboundedInterval time.Duration
+	policy            BackpressurePolicy
+	bufferSize        int
+	key               func(T) string
+	stats             *SubscriptionStats
 }
 
 func makeSubscriptionOptions[T any](options []SubscriptionOption[T]) subscriptionOptions[T] {
@@ -49,3 +53,35 @@ func WithUnboundedChannel[T any](interval time.Duration) SubscriptionOption[T] {
 		opts.unboundedInterval = interval
 	}
 }
+
+// WithBackpressure specifies what happens when an event is sent to a subscriber that is not keeping up. The default is
+// BackpressureBlock. BackpressureCoalesce requires a key and should be specified using WithCoalesceKey.
+func WithBackpressure[T any](policy BackpressurePolicy) SubscriptionOption[T] {
+	return func(opts *subscriptionOptions[T]) {
+		opts.policy = policy
+	}
+}
+
+// WithCoalesceKey specifies that an event replaces any event with the same key that is waiting to be received by the
+// subscriber. If the subscriber is full and no event has the same key, the oldest event is dropped.
+func WithCoalesceKey[T any](key func(event T) string) SubscriptionOption[T] {
+	return func(opts *subscriptionOptions[T]) {
+		opts.policy = BackpressureCoalesce
+		opts.key = key
+	}
+}
+
+// WithBufferSize specifies the number of events that can be waiting to be received by the subscriber before the
+// backpressure policy applies. The default is 10.
+func WithBufferSize[T any](size int) SubscriptionOption[T] {
+	return func(opts *subscriptionOptions[T]) {
+		opts.bufferSize = size
+	}
+}
+
+// WithStats specifies SubscriptionStats that will be updated as events are sent to the subscriber.
+func WithStats[T any](stats *SubscriptionStats) SubscriptionOption[T] {
+	return func(opts *subscriptionOptions[T]) {
+		opts.stats = stats
+	}
+}
@@ -76,6 +76,7 @@ type subscription[T any] struct {
 	channel chan T
 	ctx     context.Context
 	cancel  context.CancelFunc
+	stats   *SubscriptionStats
 }
 
 func newSubscription[T any](options []SubscriptionOption[T]) Subscriber[T] {
@@ -83,23 +84,37 @@ func newSubscription[T any](options []SubscriptionOption[T]) Subscriber[T] {
 	if opts.unbounded {
 		return newUnboundedSubscription[T](opts.unboundedInterval)
 	}
+	if opts.policy != BackpressureBlock {
+		return newQueueSubscription(opts)
+	}
 	channel := opts.channel
 	if channel == nil {
-		channel = make(chan T, subscriberChannelBufferSize)
+		size := opts.bufferSize
+		if size <= 0 {
+			size = subscriberChannelBufferSize
+		}
+		channel = make(chan T, size)
+	}
+	if opts.stats != nil {
+		opts.stats.pending = func() int { return len(channel) }
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	return &subscription[T]{
 		channel: channel,
 		ctx:     ctx,
 		cancel:  cancel,
+		stats:   opts.stats,
 	}
 }
 
 func (s *subscription[T]) Receive(event T) {
+	s.stats.addSent(1)
 	select {
 	case <-s.ctx.Done():
+		s.stats.addDropped(1)
 		close(s.channel)
 	case s.channel <- event:
+		s.stats.addDelivered(1)
 	}
 }
 
@@ -146,6 +161,13 @@ func (s *filterSubscription[T, R]) Close() {
 	s.subscription.Close()
 }
 
+func (s *filterSubscription[T, R]) disconnected() bool {
+	if d, ok := s.subscription.(disconnector); ok {
+		return d.disconnected()
+	}
+	return false
+}
+
 var _ Subscriber[int] = (*filterSubscription[int, int])(nil)
 
 // ----------------------------------------------------------------------
@@ -246,9 +268,19 @@ func (s *source[T]) SubscribeUntilDone(ctx context.Context, subscriber Subscribe
 func (s *source[T]) Send(event T) {
 	for _, sub := range s.subscriberList() {
 		sub.Receive(event)
+		if d, ok := sub.(disconnector); ok && d.disconnected() {
+			s.remove(sub)
+		}
 	}
 }
 
+// remove removes a subscriber that was disconnected. The subscriber has already closed itself.
+func (s *source[T]) remove(subscriber Subscriber[T]) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.subscribers, subscriber)
+}
+
 func (s *source[T]) subscriberList() []Subscriber[T] {
 	s.mtx.RLock()
 	defer s.mtx.RUnlock()
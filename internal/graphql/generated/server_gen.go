@@ -15,9 +15,9 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
-	model1 "github.com/observiq/bindplane-op/internal/graphql/model"
+	"github.com/observiq/bindplane-op/internal/graphql/model"
 	"github.com/observiq/bindplane-op/internal/store/search"
-	"github.com/observiq/bindplane-op/model"
+	model1 "github.com/observiq/bindplane-op/model"
 	"github.com/observiq/bindplane-op/model/otel"
 	gqlparser "github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
@@ -42,16 +42,22 @@ type Config struct {
 
 type ResolverRoot interface {
 	Agent() AgentResolver
+	AgentCommand() AgentCommandResolver
+	AgentGroup() AgentGroupResolver
 	AgentSelector() AgentSelectorResolver
 	Configuration() ConfigurationResolver
+	Connector() ConnectorResolver
+	ConnectorType() ConnectorTypeResolver
 	Destination() DestinationResolver
 	DestinationType() DestinationTypeResolver
 	Metadata() MetadataResolver
+	Mutation() MutationResolver
 	ParameterDefinition() ParameterDefinitionResolver
 	Processor() ProcessorResolver
 	ProcessorType() ProcessorTypeResolver
 	Query() QueryResolver
 	RelevantIfCondition() RelevantIfConditionResolver
+	ResourceReference() ResourceReferenceResolver
 	Source() SourceResolver
 	SourceType() SourceTypeResolver
 	Subscription() SubscriptionResolver
@@ -63,6 +69,7 @@ type DirectiveRoot struct {
 type ComplexityRoot struct {
 	Agent struct {
 		Architecture          func(childComplexity int) int
+		Commands              func(childComplexity int) int
 		Configuration         func(childComplexity int) int
 		ConfigurationResource func(childComplexity int) int
 		ConnectedAt           func(childComplexity int) int
@@ -87,12 +94,59 @@ type ComplexityRoot struct {
 		ChangeType func(childComplexity int) int
 	}
 
+	AgentCommand struct {
+		AgentID   func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Message   func(childComplexity int) int
+		Status    func(childComplexity int) int
+		Type      func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+	}
+
 	AgentConfiguration struct {
 		Collector func(childComplexity int) int
 		Logging   func(childComplexity int) int
 		Manager   func(childComplexity int) int
 	}
 
+	AgentConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	AgentEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	AgentGroup struct {
+		APIVersion   func(childComplexity int) int
+		Dependencies func(childComplexity int) int
+		Dependents   func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		Metadata     func(childComplexity int) int
+		Spec         func(childComplexity int) int
+		Summary      func(childComplexity int) int
+	}
+
+	AgentGroupCount struct {
+		Count func(childComplexity int) int
+		Value func(childComplexity int) int
+	}
+
+	AgentGroupSpec struct {
+		Query    func(childComplexity int) int
+		Selector func(childComplexity int) int
+	}
+
+	AgentGroupSummary struct {
+		Agents   func(childComplexity int) int
+		Name     func(childComplexity int) int
+		Statuses func(childComplexity int) int
+		Versions func(childComplexity int) int
+	}
+
 	AgentSelector struct {
 		MatchLabels func(childComplexity int) int
 	}
@@ -109,10 +163,12 @@ type ComplexityRoot struct {
 	}
 
 	Configuration struct {
-		APIVersion func(childComplexity int) int
-		Kind       func(childComplexity int) int
-		Metadata   func(childComplexity int) int
-		Spec       func(childComplexity int) int
+		APIVersion   func(childComplexity int) int
+		Dependencies func(childComplexity int) int
+		Dependents   func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		Metadata     func(childComplexity int) int
+		Spec         func(childComplexity int) int
 	}
 
 	ConfigurationChange struct {
@@ -120,9 +176,21 @@ type ComplexityRoot struct {
 		EventType     func(childComplexity int) int
 	}
 
+	ConfigurationConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	ConfigurationEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	ConfigurationSpec struct {
+		AgentGroup   func(childComplexity int) int
 		ContentType  func(childComplexity int) int
 		Destinations func(childComplexity int) int
+		Processors   func(childComplexity int) int
 		Raw          func(childComplexity int) int
 		Selector     func(childComplexity int) int
 		Sources      func(childComplexity int) int
@@ -134,32 +202,85 @@ type ComplexityRoot struct {
 		Suggestions    func(childComplexity int) int
 	}
 
-	Destination struct {
+	Connector struct {
 		APIVersion func(childComplexity int) int
 		Kind       func(childComplexity int) int
 		Metadata   func(childComplexity int) int
 		Spec       func(childComplexity int) int
 	}
 
-	DestinationType struct {
+	ConnectorType struct {
 		APIVersion func(childComplexity int) int
 		Kind       func(childComplexity int) int
 		Metadata   func(childComplexity int) int
 		Spec       func(childComplexity int) int
 	}
 
+	Destination struct {
+		APIVersion   func(childComplexity int) int
+		Dependencies func(childComplexity int) int
+		Dependents   func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		Metadata     func(childComplexity int) int
+		Spec         func(childComplexity int) int
+	}
+
+	DestinationChange struct {
+		Destination func(childComplexity int) int
+		EventType   func(childComplexity int) int
+	}
+
+	DestinationConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	DestinationEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	DestinationType struct {
+		APIVersion   func(childComplexity int) int
+		Dependencies func(childComplexity int) int
+		Dependents   func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		Metadata     func(childComplexity int) int
+		Spec         func(childComplexity int) int
+	}
+
+	DestinationTypeConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	DestinationTypeEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	DestinationWithType struct {
 		Destination     func(childComplexity int) int
 		DestinationType func(childComplexity int) int
 	}
 
 	Metadata struct {
-		Description func(childComplexity int) int
-		DisplayName func(childComplexity int) int
-		ID          func(childComplexity int) int
-		Icon        func(childComplexity int) int
-		Labels      func(childComplexity int) int
-		Name        func(childComplexity int) int
+		Description     func(childComplexity int) int
+		DisplayName     func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Icon            func(childComplexity int) int
+		Labels          func(childComplexity int) int
+		Name            func(childComplexity int) int
+		ResourceVersion func(childComplexity int) int
+	}
+
+	Mutation struct {
+		ExecuteAgentCommand func(childComplexity int, typeArg string, ids []string, selector *string, group *string) int
+	}
+
+	PageInfo struct {
+		EndCursor   func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
 	}
 
 	Parameter struct {
@@ -171,7 +292,11 @@ type ComplexityRoot struct {
 		Default     func(childComplexity int) int
 		Description func(childComplexity int) int
 		Label       func(childComplexity int) int
+		Max         func(childComplexity int) int
+		Min         func(childComplexity int) int
 		Name        func(childComplexity int) int
+		Pattern     func(childComplexity int) int
+		Properties  func(childComplexity int) int
 		RelevantIf  func(childComplexity int) int
 		Required    func(childComplexity int) int
 		Type        func(childComplexity int) int
@@ -184,44 +309,89 @@ type ComplexityRoot struct {
 	}
 
 	Processor struct {
-		APIVersion func(childComplexity int) int
-		Kind       func(childComplexity int) int
-		Metadata   func(childComplexity int) int
-		Spec       func(childComplexity int) int
+		APIVersion   func(childComplexity int) int
+		Dependencies func(childComplexity int) int
+		Dependents   func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		Metadata     func(childComplexity int) int
+		Spec         func(childComplexity int) int
+	}
+
+	ProcessorChange struct {
+		EventType func(childComplexity int) int
+		Processor func(childComplexity int) int
+	}
+
+	ProcessorConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	ProcessorEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	ProcessorType struct {
-		APIVersion func(childComplexity int) int
-		Kind       func(childComplexity int) int
-		Metadata   func(childComplexity int) int
-		Spec       func(childComplexity int) int
+		APIVersion   func(childComplexity int) int
+		Dependencies func(childComplexity int) int
+		Dependents   func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		Metadata     func(childComplexity int) int
+		Spec         func(childComplexity int) int
+	}
+
+	ProcessorTypeConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	ProcessorTypeEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	Query struct {
-		Agent               func(childComplexity int, id string) int
-		Agents              func(childComplexity int, selector *string, query *string) int
-		Components          func(childComplexity int) int
-		Configuration       func(childComplexity int, name string) int
-		Configurations      func(childComplexity int, selector *string, query *string) int
-		Destination         func(childComplexity int, name string) int
-		DestinationType     func(childComplexity int, name string) int
-		DestinationTypes    func(childComplexity int) int
-		DestinationWithType func(childComplexity int, name string) int
-		Destinations        func(childComplexity int) int
-		Processor           func(childComplexity int, name string) int
-		ProcessorType       func(childComplexity int, name string) int
-		ProcessorTypes      func(childComplexity int) int
-		Processors          func(childComplexity int) int
-		Source              func(childComplexity int, name string) int
-		SourceType          func(childComplexity int, name string) int
-		SourceTypes         func(childComplexity int) int
-		Sources             func(childComplexity int) int
+		Agent                      func(childComplexity int, id string) int
+		AgentGroup                 func(childComplexity int, name string) int
+		AgentGroups                func(childComplexity int) int
+		Agents                     func(childComplexity int, selector *string, query *string) int
+		AgentsConnection           func(childComplexity int, first *int, after *string, sort *string, order *model.SortOrder, selector *string, query *string) int
+		Components                 func(childComplexity int) int
+		Configuration              func(childComplexity int, name string) int
+		Configurations             func(childComplexity int, selector *string, query *string) int
+		ConfigurationsConnection   func(childComplexity int, first *int, after *string, sort *string, order *model.SortOrder, selector *string, query *string) int
+		Destination                func(childComplexity int, name string) int
+		DestinationType            func(childComplexity int, name string) int
+		DestinationTypes           func(childComplexity int) int
+		DestinationTypesConnection func(childComplexity int, first *int, after *string, sort *string, order *model.SortOrder, selector *string) int
+		DestinationWithType        func(childComplexity int, name string) int
+		Destinations               func(childComplexity int) int
+		DestinationsConnection     func(childComplexity int, first *int, after *string, sort *string, order *model.SortOrder, selector *string) int
+		Processor                  func(childComplexity int, name string) int
+		ProcessorType              func(childComplexity int, name string) int
+		ProcessorTypes             func(childComplexity int) int
+		ProcessorTypesConnection   func(childComplexity int, first *int, after *string, sort *string, order *model.SortOrder, selector *string) int
+		Processors                 func(childComplexity int) int
+		ProcessorsConnection       func(childComplexity int, first *int, after *string, sort *string, order *model.SortOrder, selector *string) int
+		Source                     func(childComplexity int, name string) int
+		SourceType                 func(childComplexity int, name string) int
+		SourceTypes                func(childComplexity int) int
+		SourceTypesConnection      func(childComplexity int, first *int, after *string, sort *string, order *model.SortOrder, selector *string) int
+		Sources                    func(childComplexity int) int
+		SourcesConnection          func(childComplexity int, first *int, after *string, sort *string, order *model.SortOrder, selector *string) int
 	}
 
 	RelevantIfCondition struct {
-		Name     func(childComplexity int) int
-		Operator func(childComplexity int) int
-		Value    func(childComplexity int) int
+		Conditions func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Operator   func(childComplexity int) int
+		Value      func(childComplexity int) int
+	}
+
+	ResourceChange struct {
+		EventType func(childComplexity int) int
+		Resource  func(childComplexity int) int
 	}
 
 	ResourceConfiguration struct {
@@ -231,6 +401,16 @@ type ComplexityRoot struct {
 		Type       func(childComplexity int) int
 	}
 
+	ResourceDependents struct {
+		AgentIDs  func(childComplexity int) int
+		Resources func(childComplexity int) int
+	}
+
+	ResourceReference struct {
+		Kind func(childComplexity int) int
+		Name func(childComplexity int) int
+	}
+
 	ResourceTypeSpec struct {
 		Parameters         func(childComplexity int) int
 		SupportedPlatforms func(childComplexity int) int
@@ -239,22 +419,56 @@ type ComplexityRoot struct {
 	}
 
 	Source struct {
-		APIVersion func(childComplexity int) int
-		Kind       func(childComplexity int) int
-		Metadata   func(childComplexity int) int
-		Spec       func(childComplexity int) int
+		APIVersion   func(childComplexity int) int
+		Dependencies func(childComplexity int) int
+		Dependents   func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		Metadata     func(childComplexity int) int
+		Spec         func(childComplexity int) int
+	}
+
+	SourceChange struct {
+		EventType func(childComplexity int) int
+		Source    func(childComplexity int) int
+	}
+
+	SourceConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	SourceEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	SourceType struct {
-		APIVersion func(childComplexity int) int
-		Kind       func(childComplexity int) int
-		Metadata   func(childComplexity int) int
-		Spec       func(childComplexity int) int
+		APIVersion   func(childComplexity int) int
+		Dependencies func(childComplexity int) int
+		Dependents   func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		Metadata     func(childComplexity int) int
+		Spec         func(childComplexity int) int
+	}
+
+	SourceTypeConnection struct {
+		Edges    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	SourceTypeEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	Subscription struct {
 		AgentChanges         func(childComplexity int, selector *string, query *string) int
 		ConfigurationChanges func(childComplexity int, selector *string, query *string) int
+		DestinationChanges   func(childComplexity int, selector *string) int
+		ProcessorChanges     func(childComplexity int, selector *string) int
+		ResourceChanges      func(childComplexity int, kinds []string, selector *string) int
+		ResourceTypeChanges  func(childComplexity int) int
+		SourceChanges        func(childComplexity int, selector *string) int
 	}
 
 	Suggestion struct {
@@ -264,69 +478,128 @@ type ComplexityRoot struct {
 }
 
 type AgentResolver interface {
-	Labels(ctx context.Context, obj *model.Agent) (map[string]interface{}, error)
+	Labels(ctx context.Context, obj *model1.Agent) (map[string]interface{}, error)
 
-	Status(ctx context.Context, obj *model.Agent) (int, error)
+	Status(ctx context.Context, obj *model1.Agent) (int, error)
 
-	Configuration(ctx context.Context, obj *model.Agent) (*model1.AgentConfiguration, error)
-	ConfigurationResource(ctx context.Context, obj *model.Agent) (*model.Configuration, error)
+	Configuration(ctx context.Context, obj *model1.Agent) (*model.AgentConfiguration, error)
+	ConfigurationResource(ctx context.Context, obj *model1.Agent) (*model1.Configuration, error)
+}
+type AgentCommandResolver interface {
+	Type(ctx context.Context, obj *model1.AgentCommand) (string, error)
+	Status(ctx context.Context, obj *model1.AgentCommand) (string, error)
+}
+type AgentGroupResolver interface {
+	Kind(ctx context.Context, obj *model1.AgentGroup) (string, error)
+
+	Summary(ctx context.Context, obj *model1.AgentGroup) (*model1.AgentGroupSummary, error)
+	Dependents(ctx context.Context, obj *model1.AgentGroup) (*model1.ResourceDependents, error)
+	Dependencies(ctx context.Context, obj *model1.AgentGroup) ([]*model1.ResourceReference, error)
 }
 type AgentSelectorResolver interface {
-	MatchLabels(ctx context.Context, obj *model.AgentSelector) (map[string]interface{}, error)
+	MatchLabels(ctx context.Context, obj *model1.AgentSelector) (map[string]interface{}, error)
 }
 type ConfigurationResolver interface {
-	Kind(ctx context.Context, obj *model.Configuration) (string, error)
+	Kind(ctx context.Context, obj *model1.Configuration) (string, error)
+
+	Dependents(ctx context.Context, obj *model1.Configuration) (*model1.ResourceDependents, error)
+	Dependencies(ctx context.Context, obj *model1.Configuration) ([]*model1.ResourceReference, error)
+}
+type ConnectorResolver interface {
+	Kind(ctx context.Context, obj *model1.Connector) (string, error)
+}
+type ConnectorTypeResolver interface {
+	Kind(ctx context.Context, obj *model1.ConnectorType) (string, error)
 }
 type DestinationResolver interface {
-	Kind(ctx context.Context, obj *model.Destination) (string, error)
+	Kind(ctx context.Context, obj *model1.Destination) (string, error)
+
+	Dependents(ctx context.Context, obj *model1.Destination) (*model1.ResourceDependents, error)
+	Dependencies(ctx context.Context, obj *model1.Destination) ([]*model1.ResourceReference, error)
 }
 type DestinationTypeResolver interface {
-	Kind(ctx context.Context, obj *model.DestinationType) (string, error)
+	Kind(ctx context.Context, obj *model1.DestinationType) (string, error)
+
+	Dependents(ctx context.Context, obj *model1.DestinationType) (*model1.ResourceDependents, error)
+	Dependencies(ctx context.Context, obj *model1.DestinationType) ([]*model1.ResourceReference, error)
 }
 type MetadataResolver interface {
-	Labels(ctx context.Context, obj *model.Metadata) (map[string]interface{}, error)
+	Labels(ctx context.Context, obj *model1.Metadata) (map[string]interface{}, error)
+}
+type MutationResolver interface {
+	ExecuteAgentCommand(ctx context.Context, typeArg string, ids []string, selector *string, group *string) ([]*model1.AgentCommand, error)
 }
 type ParameterDefinitionResolver interface {
-	Type(ctx context.Context, obj *model.ParameterDefinition) (model1.ParameterType, error)
+	Type(ctx context.Context, obj *model1.ParameterDefinition) (model.ParameterType, error)
 }
 type ProcessorResolver interface {
-	Kind(ctx context.Context, obj *model.Processor) (string, error)
+	Kind(ctx context.Context, obj *model1.Processor) (string, error)
+
+	Dependents(ctx context.Context, obj *model1.Processor) (*model1.ResourceDependents, error)
+	Dependencies(ctx context.Context, obj *model1.Processor) ([]*model1.ResourceReference, error)
 }
 type ProcessorTypeResolver interface {
-	Kind(ctx context.Context, obj *model.ProcessorType) (string, error)
+	Kind(ctx context.Context, obj *model1.ProcessorType) (string, error)
+
+	Dependents(ctx context.Context, obj *model1.ProcessorType) (*model1.ResourceDependents, error)
+	Dependencies(ctx context.Context, obj *model1.ProcessorType) ([]*model1.ResourceReference, error)
 }
 type QueryResolver interface {
-	Agents(ctx context.Context, selector *string, query *string) (*model1.Agents, error)
-	Agent(ctx context.Context, id string) (*model.Agent, error)
-	Configurations(ctx context.Context, selector *string, query *string) (*model1.Configurations, error)
-	Configuration(ctx context.Context, name string) (*model.Configuration, error)
-	Sources(ctx context.Context) ([]*model.Source, error)
-	Source(ctx context.Context, name string) (*model.Source, error)
-	SourceTypes(ctx context.Context) ([]*model.SourceType, error)
-	SourceType(ctx context.Context, name string) (*model.SourceType, error)
-	Processors(ctx context.Context) ([]*model.Processor, error)
-	Processor(ctx context.Context, name string) (*model.Processor, error)
-	ProcessorTypes(ctx context.Context) ([]*model.ProcessorType, error)
-	ProcessorType(ctx context.Context, name string) (*model.ProcessorType, error)
-	Destinations(ctx context.Context) ([]*model.Destination, error)
-	Destination(ctx context.Context, name string) (*model.Destination, error)
-	DestinationWithType(ctx context.Context, name string) (*model1.DestinationWithType, error)
-	DestinationTypes(ctx context.Context) ([]*model.DestinationType, error)
-	DestinationType(ctx context.Context, name string) (*model.DestinationType, error)
-	Components(ctx context.Context) (*model1.Components, error)
+	Agents(ctx context.Context, selector *string, query *string) (*model.Agents, error)
+	Agent(ctx context.Context, id string) (*model1.Agent, error)
+	AgentGroups(ctx context.Context) ([]*model1.AgentGroup, error)
+	AgentGroup(ctx context.Context, name string) (*model1.AgentGroup, error)
+	Configurations(ctx context.Context, selector *string, query *string) (*model.Configurations, error)
+	Configuration(ctx context.Context, name string) (*model1.Configuration, error)
+	Sources(ctx context.Context) ([]*model1.Source, error)
+	Source(ctx context.Context, name string) (*model1.Source, error)
+	SourceTypes(ctx context.Context) ([]*model1.SourceType, error)
+	SourceType(ctx context.Context, name string) (*model1.SourceType, error)
+	Processors(ctx context.Context) ([]*model1.Processor, error)
+	Processor(ctx context.Context, name string) (*model1.Processor, error)
+	ProcessorTypes(ctx context.Context) ([]*model1.ProcessorType, error)
+	ProcessorType(ctx context.Context, name string) (*model1.ProcessorType, error)
+	Destinations(ctx context.Context) ([]*model1.Destination, error)
+	Destination(ctx context.Context, name string) (*model1.Destination, error)
+	DestinationWithType(ctx context.Context, name string) (*model.DestinationWithType, error)
+	DestinationTypes(ctx context.Context) ([]*model1.DestinationType, error)
+	DestinationType(ctx context.Context, name string) (*model1.DestinationType, error)
+	Components(ctx context.Context) (*model.Components, error)
+	AgentsConnection(ctx context.Context, first *int, after *string, sort *string, order *model.SortOrder, selector *string, query *string) (*model.AgentConnection, error)
+	ConfigurationsConnection(ctx context.Context, first *int, after *string, sort *string, order *model.SortOrder, selector *string, query *string) (*model.ConfigurationConnection, error)
+	SourcesConnection(ctx context.Context, first *int, after *string, sort *string, order *model.SortOrder, selector *string) (*model.SourceConnection, error)
+	SourceTypesConnection(ctx context.Context, first *int, after *string, sort *string, order *model.SortOrder, selector *string) (*model.SourceTypeConnection, error)
+	ProcessorsConnection(ctx context.Context, first *int, after *string, sort *string, order *model.SortOrder, selector *string) (*model.ProcessorConnection, error)
+	ProcessorTypesConnection(ctx context.Context, first *int, after *string, sort *string, order *model.SortOrder, selector *string) (*model.ProcessorTypeConnection, error)
+	DestinationsConnection(ctx context.Context, first *int, after *string, sort *string, order *model.SortOrder, selector *string) (*model.DestinationConnection, error)
+	DestinationTypesConnection(ctx context.Context, first *int, after *string, sort *string, order *model.SortOrder, selector *string) (*model.DestinationTypeConnection, error)
 }
 type RelevantIfConditionResolver interface {
-	Operator(ctx context.Context, obj *model.RelevantIfCondition) (model1.RelevantIfOperatorType, error)
+	Operator(ctx context.Context, obj *model1.RelevantIfCondition) (model.RelevantIfOperatorType, error)
+}
+type ResourceReferenceResolver interface {
+	Kind(ctx context.Context, obj *model1.ResourceReference) (string, error)
 }
 type SourceResolver interface {
-	Kind(ctx context.Context, obj *model.Source) (string, error)
+	Kind(ctx context.Context, obj *model1.Source) (string, error)
+
+	Dependents(ctx context.Context, obj *model1.Source) (*model1.ResourceDependents, error)
+	Dependencies(ctx context.Context, obj *model1.Source) ([]*model1.ResourceReference, error)
 }
 type SourceTypeResolver interface {
-	Kind(ctx context.Context, obj *model.SourceType) (string, error)
+	Kind(ctx context.Context, obj *model1.SourceType) (string, error)
+
+	Dependents(ctx context.Context, obj *model1.SourceType) (*model1.ResourceDependents, error)
+	Dependencies(ctx context.Context, obj *model1.SourceType) ([]*model1.ResourceReference, error)
 }
 type SubscriptionResolver interface {
-	AgentChanges(ctx context.Context, selector *string, query *string) (<-chan []*model1.AgentChange, error)
-	ConfigurationChanges(ctx context.Context, selector *string, query *string) (<-chan []*model1.ConfigurationChange, error)
+	AgentChanges(ctx context.Context, selector *string, query *string) (<-chan []*model.AgentChange, error)
+	ConfigurationChanges(ctx context.Context, selector *string, query *string) (<-chan []*model.ConfigurationChange, error)
+	SourceChanges(ctx context.Context, selector *string) (<-chan []*model.SourceChange, error)
+	ProcessorChanges(ctx context.Context, selector *string) (<-chan []*model.ProcessorChange, error)
+	DestinationChanges(ctx context.Context, selector *string) (<-chan []*model.DestinationChange, error)
+	ResourceTypeChanges(ctx context.Context) (<-chan []*model.ResourceChange, error)
+	ResourceChanges(ctx context.Context, kinds []string, selector *string) (<-chan []*model.ResourceChange, error)
 }
 
 type executableSchema struct {
@@ -351,6 +624,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Agent.Architecture(childComplexity), true
 
+	case "Agent.commands":
+		if e.complexity.Agent.Commands == nil {
+			break
+		}
+
+		return e.complexity.Agent.Commands(childComplexity), true
+
 	case "Agent.configuration":
 		if e.complexity.Agent.Configuration == nil {
 			break
@@ -484,6 +764,55 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AgentChange.ChangeType(childComplexity), true
 
+	case "AgentCommand.agentId":
+		if e.complexity.AgentCommand.AgentID == nil {
+			break
+		}
+
+		return e.complexity.AgentCommand.AgentID(childComplexity), true
+
+	case "AgentCommand.createdAt":
+		if e.complexity.AgentCommand.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.AgentCommand.CreatedAt(childComplexity), true
+
+	case "AgentCommand.id":
+		if e.complexity.AgentCommand.ID == nil {
+			break
+		}
+
+		return e.complexity.AgentCommand.ID(childComplexity), true
+
+	case "AgentCommand.message":
+		if e.complexity.AgentCommand.Message == nil {
+			break
+		}
+
+		return e.complexity.AgentCommand.Message(childComplexity), true
+
+	case "AgentCommand.status":
+		if e.complexity.AgentCommand.Status == nil {
+			break
+		}
+
+		return e.complexity.AgentCommand.Status(childComplexity), true
+
+	case "AgentCommand.type":
+		if e.complexity.AgentCommand.Type == nil {
+			break
+		}
+
+		return e.complexity.AgentCommand.Type(childComplexity), true
+
+	case "AgentCommand.updatedAt":
+		if e.complexity.AgentCommand.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.AgentCommand.UpdatedAt(childComplexity), true
+
 	case "AgentConfiguration.Collector":
 		if e.complexity.AgentConfiguration.Collector == nil {
 			break
@@ -505,6 +834,139 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AgentConfiguration.Manager(childComplexity), true
 
+	case "AgentConnection.edges":
+		if e.complexity.AgentConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.AgentConnection.Edges(childComplexity), true
+
+	case "AgentConnection.pageInfo":
+		if e.complexity.AgentConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.AgentConnection.PageInfo(childComplexity), true
+
+	case "AgentEdge.cursor":
+		if e.complexity.AgentEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.AgentEdge.Cursor(childComplexity), true
+
+	case "AgentEdge.node":
+		if e.complexity.AgentEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.AgentEdge.Node(childComplexity), true
+
+	case "AgentGroup.apiVersion":
+		if e.complexity.AgentGroup.APIVersion == nil {
+			break
+		}
+
+		return e.complexity.AgentGroup.APIVersion(childComplexity), true
+
+	case "AgentGroup.dependencies":
+		if e.complexity.AgentGroup.Dependencies == nil {
+			break
+		}
+
+		return e.complexity.AgentGroup.Dependencies(childComplexity), true
+
+	case "AgentGroup.dependents":
+		if e.complexity.AgentGroup.Dependents == nil {
+			break
+		}
+
+		return e.complexity.AgentGroup.Dependents(childComplexity), true
+
+	case "AgentGroup.kind":
+		if e.complexity.AgentGroup.Kind == nil {
+			break
+		}
+
+		return e.complexity.AgentGroup.Kind(childComplexity), true
+
+	case "AgentGroup.metadata":
+		if e.complexity.AgentGroup.Metadata == nil {
+			break
+		}
+
+		return e.complexity.AgentGroup.Metadata(childComplexity), true
+
+	case "AgentGroup.spec":
+		if e.complexity.AgentGroup.Spec == nil {
+			break
+		}
+
+		return e.complexity.AgentGroup.Spec(childComplexity), true
+
+	case "AgentGroup.summary":
+		if e.complexity.AgentGroup.Summary == nil {
+			break
+		}
+
+		return e.complexity.AgentGroup.Summary(childComplexity), true
+
+	case "AgentGroupCount.count":
+		if e.complexity.AgentGroupCount.Count == nil {
+			break
+		}
+
+		return e.complexity.AgentGroupCount.Count(childComplexity), true
+
+	case "AgentGroupCount.value":
+		if e.complexity.AgentGroupCount.Value == nil {
+			break
+		}
+
+		return e.complexity.AgentGroupCount.Value(childComplexity), true
+
+	case "AgentGroupSpec.query":
+		if e.complexity.AgentGroupSpec.Query == nil {
+			break
+		}
+
+		return e.complexity.AgentGroupSpec.Query(childComplexity), true
+
+	case "AgentGroupSpec.selector":
+		if e.complexity.AgentGroupSpec.Selector == nil {
+			break
+		}
+
+		return e.complexity.AgentGroupSpec.Selector(childComplexity), true
+
+	case "AgentGroupSummary.agents":
+		if e.complexity.AgentGroupSummary.Agents == nil {
+			break
+		}
+
+		return e.complexity.AgentGroupSummary.Agents(childComplexity), true
+
+	case "AgentGroupSummary.name":
+		if e.complexity.AgentGroupSummary.Name == nil {
+			break
+		}
+
+		return e.complexity.AgentGroupSummary.Name(childComplexity), true
+
+	case "AgentGroupSummary.statuses":
+		if e.complexity.AgentGroupSummary.Statuses == nil {
+			break
+		}
+
+		return e.complexity.AgentGroupSummary.Statuses(childComplexity), true
+
+	case "AgentGroupSummary.versions":
+		if e.complexity.AgentGroupSummary.Versions == nil {
+			break
+		}
+
+		return e.complexity.AgentGroupSummary.Versions(childComplexity), true
+
 	case "AgentSelector.matchLabels":
 		if e.complexity.AgentSelector.MatchLabels == nil {
 			break
@@ -554,6 +1016,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Configuration.APIVersion(childComplexity), true
 
+	case "Configuration.dependencies":
+		if e.complexity.Configuration.Dependencies == nil {
+			break
+		}
+
+		return e.complexity.Configuration.Dependencies(childComplexity), true
+
+	case "Configuration.dependents":
+		if e.complexity.Configuration.Dependents == nil {
+			break
+		}
+
+		return e.complexity.Configuration.Dependents(childComplexity), true
+
 	case "Configuration.kind":
 		if e.complexity.Configuration.Kind == nil {
 			break
@@ -589,6 +1065,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ConfigurationChange.EventType(childComplexity), true
 
+	case "ConfigurationConnection.edges":
+		if e.complexity.ConfigurationConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.ConfigurationConnection.Edges(childComplexity), true
+
+	case "ConfigurationConnection.pageInfo":
+		if e.complexity.ConfigurationConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.ConfigurationConnection.PageInfo(childComplexity), true
+
+	case "ConfigurationEdge.cursor":
+		if e.complexity.ConfigurationEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.ConfigurationEdge.Cursor(childComplexity), true
+
+	case "ConfigurationEdge.node":
+		if e.complexity.ConfigurationEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.ConfigurationEdge.Node(childComplexity), true
+
+	case "ConfigurationSpec.agentGroup":
+		if e.complexity.ConfigurationSpec.AgentGroup == nil {
+			break
+		}
+
+		return e.complexity.ConfigurationSpec.AgentGroup(childComplexity), true
+
 	case "ConfigurationSpec.contentType":
 		if e.complexity.ConfigurationSpec.ContentType == nil {
 			break
@@ -603,6 +1114,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ConfigurationSpec.Destinations(childComplexity), true
 
+	case "ConfigurationSpec.processors":
+		if e.complexity.ConfigurationSpec.Processors == nil {
+			break
+		}
+
+		return e.complexity.ConfigurationSpec.Processors(childComplexity), true
+
 	case "ConfigurationSpec.raw":
 		if e.complexity.ConfigurationSpec.Raw == nil {
 			break
@@ -645,34 +1163,146 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Configurations.Suggestions(childComplexity), true
 
-	case "Destination.apiVersion":
-		if e.complexity.Destination.APIVersion == nil {
+	case "Connector.apiVersion":
+		if e.complexity.Connector.APIVersion == nil {
 			break
 		}
 
-		return e.complexity.Destination.APIVersion(childComplexity), true
+		return e.complexity.Connector.APIVersion(childComplexity), true
 
-	case "Destination.kind":
-		if e.complexity.Destination.Kind == nil {
+	case "Connector.kind":
+		if e.complexity.Connector.Kind == nil {
 			break
 		}
 
-		return e.complexity.Destination.Kind(childComplexity), true
+		return e.complexity.Connector.Kind(childComplexity), true
 
-	case "Destination.metadata":
-		if e.complexity.Destination.Metadata == nil {
+	case "Connector.metadata":
+		if e.complexity.Connector.Metadata == nil {
 			break
 		}
 
-		return e.complexity.Destination.Metadata(childComplexity), true
+		return e.complexity.Connector.Metadata(childComplexity), true
 
-	case "Destination.spec":
+	case "Connector.spec":
+		if e.complexity.Connector.Spec == nil {
+			break
+		}
+
+		return e.complexity.Connector.Spec(childComplexity), true
+
+	case "ConnectorType.apiVersion":
+		if e.complexity.ConnectorType.APIVersion == nil {
+			break
+		}
+
+		return e.complexity.ConnectorType.APIVersion(childComplexity), true
+
+	case "ConnectorType.kind":
+		if e.complexity.ConnectorType.Kind == nil {
+			break
+		}
+
+		return e.complexity.ConnectorType.Kind(childComplexity), true
+
+	case "ConnectorType.metadata":
+		if e.complexity.ConnectorType.Metadata == nil {
+			break
+		}
+
+		return e.complexity.ConnectorType.Metadata(childComplexity), true
+
+	case "ConnectorType.spec":
+		if e.complexity.ConnectorType.Spec == nil {
+			break
+		}
+
+		return e.complexity.ConnectorType.Spec(childComplexity), true
+
+	case "Destination.apiVersion":
+		if e.complexity.Destination.APIVersion == nil {
+			break
+		}
+
+		return e.complexity.Destination.APIVersion(childComplexity), true
+
+	case "Destination.dependencies":
+		if e.complexity.Destination.Dependencies == nil {
+			break
+		}
+
+		return e.complexity.Destination.Dependencies(childComplexity), true
+
+	case "Destination.dependents":
+		if e.complexity.Destination.Dependents == nil {
+			break
+		}
+
+		return e.complexity.Destination.Dependents(childComplexity), true
+
+	case "Destination.kind":
+		if e.complexity.Destination.Kind == nil {
+			break
+		}
+
+		return e.complexity.Destination.Kind(childComplexity), true
+
+	case "Destination.metadata":
+		if e.complexity.Destination.Metadata == nil {
+			break
+		}
+
+		return e.complexity.Destination.Metadata(childComplexity), true
+
+	case "Destination.spec":
 		if e.complexity.Destination.Spec == nil {
 			break
 		}
 
 		return e.complexity.Destination.Spec(childComplexity), true
 
+	case "DestinationChange.destination":
+		if e.complexity.DestinationChange.Destination == nil {
+			break
+		}
+
+		return e.complexity.DestinationChange.Destination(childComplexity), true
+
+	case "DestinationChange.eventType":
+		if e.complexity.DestinationChange.EventType == nil {
+			break
+		}
+
+		return e.complexity.DestinationChange.EventType(childComplexity), true
+
+	case "DestinationConnection.edges":
+		if e.complexity.DestinationConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.DestinationConnection.Edges(childComplexity), true
+
+	case "DestinationConnection.pageInfo":
+		if e.complexity.DestinationConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.DestinationConnection.PageInfo(childComplexity), true
+
+	case "DestinationEdge.cursor":
+		if e.complexity.DestinationEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.DestinationEdge.Cursor(childComplexity), true
+
+	case "DestinationEdge.node":
+		if e.complexity.DestinationEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.DestinationEdge.Node(childComplexity), true
+
 	case "DestinationType.apiVersion":
 		if e.complexity.DestinationType.APIVersion == nil {
 			break
@@ -680,6 +1310,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DestinationType.APIVersion(childComplexity), true
 
+	case "DestinationType.dependencies":
+		if e.complexity.DestinationType.Dependencies == nil {
+			break
+		}
+
+		return e.complexity.DestinationType.Dependencies(childComplexity), true
+
+	case "DestinationType.dependents":
+		if e.complexity.DestinationType.Dependents == nil {
+			break
+		}
+
+		return e.complexity.DestinationType.Dependents(childComplexity), true
+
 	case "DestinationType.kind":
 		if e.complexity.DestinationType.Kind == nil {
 			break
@@ -701,6 +1345,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DestinationType.Spec(childComplexity), true
 
+	case "DestinationTypeConnection.edges":
+		if e.complexity.DestinationTypeConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.DestinationTypeConnection.Edges(childComplexity), true
+
+	case "DestinationTypeConnection.pageInfo":
+		if e.complexity.DestinationTypeConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.DestinationTypeConnection.PageInfo(childComplexity), true
+
+	case "DestinationTypeEdge.cursor":
+		if e.complexity.DestinationTypeEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.DestinationTypeEdge.Cursor(childComplexity), true
+
+	case "DestinationTypeEdge.node":
+		if e.complexity.DestinationTypeEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.DestinationTypeEdge.Node(childComplexity), true
+
 	case "DestinationWithType.destination":
 		if e.complexity.DestinationWithType.Destination == nil {
 			break
@@ -757,6 +1429,39 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Metadata.Name(childComplexity), true
 
+	case "Metadata.resourceVersion":
+		if e.complexity.Metadata.ResourceVersion == nil {
+			break
+		}
+
+		return e.complexity.Metadata.ResourceVersion(childComplexity), true
+
+	case "Mutation.executeAgentCommand":
+		if e.complexity.Mutation.ExecuteAgentCommand == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_executeAgentCommand_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ExecuteAgentCommand(childComplexity, args["type"].(string), args["ids"].([]string), args["selector"].(*string), args["group"].(*string)), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
 	case "Parameter.name":
 		if e.complexity.Parameter.Name == nil {
 			break
@@ -792,6 +1497,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ParameterDefinition.Label(childComplexity), true
 
+	case "ParameterDefinition.max":
+		if e.complexity.ParameterDefinition.Max == nil {
+			break
+		}
+
+		return e.complexity.ParameterDefinition.Max(childComplexity), true
+
+	case "ParameterDefinition.min":
+		if e.complexity.ParameterDefinition.Min == nil {
+			break
+		}
+
+		return e.complexity.ParameterDefinition.Min(childComplexity), true
+
 	case "ParameterDefinition.name":
 		if e.complexity.ParameterDefinition.Name == nil {
 			break
@@ -799,6 +1518,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ParameterDefinition.Name(childComplexity), true
 
+	case "ParameterDefinition.pattern":
+		if e.complexity.ParameterDefinition.Pattern == nil {
+			break
+		}
+
+		return e.complexity.ParameterDefinition.Pattern(childComplexity), true
+
+	case "ParameterDefinition.properties":
+		if e.complexity.ParameterDefinition.Properties == nil {
+			break
+		}
+
+		return e.complexity.ParameterDefinition.Properties(childComplexity), true
+
 	case "ParameterDefinition.relevantIf":
 		if e.complexity.ParameterDefinition.RelevantIf == nil {
 			break
@@ -848,6 +1581,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Processor.APIVersion(childComplexity), true
 
+	case "Processor.dependencies":
+		if e.complexity.Processor.Dependencies == nil {
+			break
+		}
+
+		return e.complexity.Processor.Dependencies(childComplexity), true
+
+	case "Processor.dependents":
+		if e.complexity.Processor.Dependents == nil {
+			break
+		}
+
+		return e.complexity.Processor.Dependents(childComplexity), true
+
 	case "Processor.kind":
 		if e.complexity.Processor.Kind == nil {
 			break
@@ -869,6 +1616,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Processor.Spec(childComplexity), true
 
+	case "ProcessorChange.eventType":
+		if e.complexity.ProcessorChange.EventType == nil {
+			break
+		}
+
+		return e.complexity.ProcessorChange.EventType(childComplexity), true
+
+	case "ProcessorChange.processor":
+		if e.complexity.ProcessorChange.Processor == nil {
+			break
+		}
+
+		return e.complexity.ProcessorChange.Processor(childComplexity), true
+
+	case "ProcessorConnection.edges":
+		if e.complexity.ProcessorConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.ProcessorConnection.Edges(childComplexity), true
+
+	case "ProcessorConnection.pageInfo":
+		if e.complexity.ProcessorConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.ProcessorConnection.PageInfo(childComplexity), true
+
+	case "ProcessorEdge.cursor":
+		if e.complexity.ProcessorEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.ProcessorEdge.Cursor(childComplexity), true
+
+	case "ProcessorEdge.node":
+		if e.complexity.ProcessorEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.ProcessorEdge.Node(childComplexity), true
+
 	case "ProcessorType.apiVersion":
 		if e.complexity.ProcessorType.APIVersion == nil {
 			break
@@ -876,6 +1665,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ProcessorType.APIVersion(childComplexity), true
 
+	case "ProcessorType.dependencies":
+		if e.complexity.ProcessorType.Dependencies == nil {
+			break
+		}
+
+		return e.complexity.ProcessorType.Dependencies(childComplexity), true
+
+	case "ProcessorType.dependents":
+		if e.complexity.ProcessorType.Dependents == nil {
+			break
+		}
+
+		return e.complexity.ProcessorType.Dependents(childComplexity), true
+
 	case "ProcessorType.kind":
 		if e.complexity.ProcessorType.Kind == nil {
 			break
@@ -897,6 +1700,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ProcessorType.Spec(childComplexity), true
 
+	case "ProcessorTypeConnection.edges":
+		if e.complexity.ProcessorTypeConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.ProcessorTypeConnection.Edges(childComplexity), true
+
+	case "ProcessorTypeConnection.pageInfo":
+		if e.complexity.ProcessorTypeConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.ProcessorTypeConnection.PageInfo(childComplexity), true
+
+	case "ProcessorTypeEdge.cursor":
+		if e.complexity.ProcessorTypeEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.ProcessorTypeEdge.Cursor(childComplexity), true
+
+	case "ProcessorTypeEdge.node":
+		if e.complexity.ProcessorTypeEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.ProcessorTypeEdge.Node(childComplexity), true
+
 	case "Query.agent":
 		if e.complexity.Query.Agent == nil {
 			break
@@ -909,6 +1740,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Agent(childComplexity, args["id"].(string)), true
 
+	case "Query.agentGroup":
+		if e.complexity.Query.AgentGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Query_agentGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AgentGroup(childComplexity, args["name"].(string)), true
+
+	case "Query.agentGroups":
+		if e.complexity.Query.AgentGroups == nil {
+			break
+		}
+
+		return e.complexity.Query.AgentGroups(childComplexity), true
+
 	case "Query.agents":
 		if e.complexity.Query.Agents == nil {
 			break
@@ -921,6 +1771,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Agents(childComplexity, args["selector"].(*string), args["query"].(*string)), true
 
+	case "Query.agentsConnection":
+		if e.complexity.Query.AgentsConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_agentsConnection_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AgentsConnection(childComplexity, args["first"].(*int), args["after"].(*string), args["sort"].(*string), args["order"].(*model.SortOrder), args["selector"].(*string), args["query"].(*string)), true
+
 	case "Query.components":
 		if e.complexity.Query.Components == nil {
 			break
@@ -952,6 +1814,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Configurations(childComplexity, args["selector"].(*string), args["query"].(*string)), true
 
+	case "Query.configurationsConnection":
+		if e.complexity.Query.ConfigurationsConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_configurationsConnection_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ConfigurationsConnection(childComplexity, args["first"].(*int), args["after"].(*string), args["sort"].(*string), args["order"].(*model.SortOrder), args["selector"].(*string), args["query"].(*string)), true
+
 	case "Query.destination":
 		if e.complexity.Query.Destination == nil {
 			break
@@ -983,6 +1857,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.DestinationTypes(childComplexity), true
 
+	case "Query.destinationTypesConnection":
+		if e.complexity.Query.DestinationTypesConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_destinationTypesConnection_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DestinationTypesConnection(childComplexity, args["first"].(*int), args["after"].(*string), args["sort"].(*string), args["order"].(*model.SortOrder), args["selector"].(*string)), true
+
 	case "Query.destinationWithType":
 		if e.complexity.Query.DestinationWithType == nil {
 			break
@@ -1002,6 +1888,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Destinations(childComplexity), true
 
+	case "Query.destinationsConnection":
+		if e.complexity.Query.DestinationsConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_destinationsConnection_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DestinationsConnection(childComplexity, args["first"].(*int), args["after"].(*string), args["sort"].(*string), args["order"].(*model.SortOrder), args["selector"].(*string)), true
+
 	case "Query.processor":
 		if e.complexity.Query.Processor == nil {
 			break
@@ -1033,6 +1931,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.ProcessorTypes(childComplexity), true
 
+	case "Query.processorTypesConnection":
+		if e.complexity.Query.ProcessorTypesConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_processorTypesConnection_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ProcessorTypesConnection(childComplexity, args["first"].(*int), args["after"].(*string), args["sort"].(*string), args["order"].(*model.SortOrder), args["selector"].(*string)), true
+
 	case "Query.processors":
 		if e.complexity.Query.Processors == nil {
 			break
@@ -1040,6 +1950,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Processors(childComplexity), true
 
+	case "Query.processorsConnection":
+		if e.complexity.Query.ProcessorsConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_processorsConnection_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ProcessorsConnection(childComplexity, args["first"].(*int), args["after"].(*string), args["sort"].(*string), args["order"].(*model.SortOrder), args["selector"].(*string)), true
+
 	case "Query.source":
 		if e.complexity.Query.Source == nil {
 			break
@@ -1071,12 +1993,43 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.SourceTypes(childComplexity), true
 
-	case "Query.sources":
-		if e.complexity.Query.Sources == nil {
+	case "Query.sourceTypesConnection":
+		if e.complexity.Query.SourceTypesConnection == nil {
 			break
 		}
 
-		return e.complexity.Query.Sources(childComplexity), true
+		args, err := ec.field_Query_sourceTypesConnection_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SourceTypesConnection(childComplexity, args["first"].(*int), args["after"].(*string), args["sort"].(*string), args["order"].(*model.SortOrder), args["selector"].(*string)), true
+
+	case "Query.sources":
+		if e.complexity.Query.Sources == nil {
+			break
+		}
+
+		return e.complexity.Query.Sources(childComplexity), true
+
+	case "Query.sourcesConnection":
+		if e.complexity.Query.SourcesConnection == nil {
+			break
+		}
+
+		args, err := ec.field_Query_sourcesConnection_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SourcesConnection(childComplexity, args["first"].(*int), args["after"].(*string), args["sort"].(*string), args["order"].(*model.SortOrder), args["selector"].(*string)), true
+
+	case "RelevantIfCondition.conditions":
+		if e.complexity.RelevantIfCondition.Conditions == nil {
+			break
+		}
+
+		return e.complexity.RelevantIfCondition.Conditions(childComplexity), true
 
 	case "RelevantIfCondition.name":
 		if e.complexity.RelevantIfCondition.Name == nil {
@@ -1099,6 +2052,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RelevantIfCondition.Value(childComplexity), true
 
+	case "ResourceChange.eventType":
+		if e.complexity.ResourceChange.EventType == nil {
+			break
+		}
+
+		return e.complexity.ResourceChange.EventType(childComplexity), true
+
+	case "ResourceChange.resource":
+		if e.complexity.ResourceChange.Resource == nil {
+			break
+		}
+
+		return e.complexity.ResourceChange.Resource(childComplexity), true
+
 	case "ResourceConfiguration.name":
 		if e.complexity.ResourceConfiguration.Name == nil {
 			break
@@ -1127,6 +2094,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ResourceConfiguration.Type(childComplexity), true
 
+	case "ResourceDependents.agentIds":
+		if e.complexity.ResourceDependents.AgentIDs == nil {
+			break
+		}
+
+		return e.complexity.ResourceDependents.AgentIDs(childComplexity), true
+
+	case "ResourceDependents.resources":
+		if e.complexity.ResourceDependents.Resources == nil {
+			break
+		}
+
+		return e.complexity.ResourceDependents.Resources(childComplexity), true
+
+	case "ResourceReference.kind":
+		if e.complexity.ResourceReference.Kind == nil {
+			break
+		}
+
+		return e.complexity.ResourceReference.Kind(childComplexity), true
+
+	case "ResourceReference.name":
+		if e.complexity.ResourceReference.Name == nil {
+			break
+		}
+
+		return e.complexity.ResourceReference.Name(childComplexity), true
+
 	case "ResourceTypeSpec.parameters":
 		if e.complexity.ResourceTypeSpec.Parameters == nil {
 			break
@@ -1162,6 +2157,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Source.APIVersion(childComplexity), true
 
+	case "Source.dependencies":
+		if e.complexity.Source.Dependencies == nil {
+			break
+		}
+
+		return e.complexity.Source.Dependencies(childComplexity), true
+
+	case "Source.dependents":
+		if e.complexity.Source.Dependents == nil {
+			break
+		}
+
+		return e.complexity.Source.Dependents(childComplexity), true
+
 	case "Source.kind":
 		if e.complexity.Source.Kind == nil {
 			break
@@ -1183,6 +2192,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Source.Spec(childComplexity), true
 
+	case "SourceChange.eventType":
+		if e.complexity.SourceChange.EventType == nil {
+			break
+		}
+
+		return e.complexity.SourceChange.EventType(childComplexity), true
+
+	case "SourceChange.source":
+		if e.complexity.SourceChange.Source == nil {
+			break
+		}
+
+		return e.complexity.SourceChange.Source(childComplexity), true
+
+	case "SourceConnection.edges":
+		if e.complexity.SourceConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.SourceConnection.Edges(childComplexity), true
+
+	case "SourceConnection.pageInfo":
+		if e.complexity.SourceConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.SourceConnection.PageInfo(childComplexity), true
+
+	case "SourceEdge.cursor":
+		if e.complexity.SourceEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.SourceEdge.Cursor(childComplexity), true
+
+	case "SourceEdge.node":
+		if e.complexity.SourceEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SourceEdge.Node(childComplexity), true
+
 	case "SourceType.apiVersion":
 		if e.complexity.SourceType.APIVersion == nil {
 			break
@@ -1190,6 +2241,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SourceType.APIVersion(childComplexity), true
 
+	case "SourceType.dependencies":
+		if e.complexity.SourceType.Dependencies == nil {
+			break
+		}
+
+		return e.complexity.SourceType.Dependencies(childComplexity), true
+
+	case "SourceType.dependents":
+		if e.complexity.SourceType.Dependents == nil {
+			break
+		}
+
+		return e.complexity.SourceType.Dependents(childComplexity), true
+
 	case "SourceType.kind":
 		if e.complexity.SourceType.Kind == nil {
 			break
@@ -1211,6 +2276,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.SourceType.Spec(childComplexity), true
 
+	case "SourceTypeConnection.edges":
+		if e.complexity.SourceTypeConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.SourceTypeConnection.Edges(childComplexity), true
+
+	case "SourceTypeConnection.pageInfo":
+		if e.complexity.SourceTypeConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.SourceTypeConnection.PageInfo(childComplexity), true
+
+	case "SourceTypeEdge.cursor":
+		if e.complexity.SourceTypeEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.SourceTypeEdge.Cursor(childComplexity), true
+
+	case "SourceTypeEdge.node":
+		if e.complexity.SourceTypeEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SourceTypeEdge.Node(childComplexity), true
+
 	case "Subscription.agentChanges":
 		if e.complexity.Subscription.AgentChanges == nil {
 			break
@@ -1235,6 +2328,61 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Subscription.ConfigurationChanges(childComplexity, args["selector"].(*string), args["query"].(*string)), true
 
+	case "Subscription.destinationChanges":
+		if e.complexity.Subscription.DestinationChanges == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_destinationChanges_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.DestinationChanges(childComplexity, args["selector"].(*string)), true
+
+	case "Subscription.processorChanges":
+		if e.complexity.Subscription.ProcessorChanges == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_processorChanges_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.ProcessorChanges(childComplexity, args["selector"].(*string)), true
+
+	case "Subscription.resourceChanges":
+		if e.complexity.Subscription.ResourceChanges == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_resourceChanges_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.ResourceChanges(childComplexity, args["kinds"].([]string), args["selector"].(*string)), true
+
+	case "Subscription.resourceTypeChanges":
+		if e.complexity.Subscription.ResourceTypeChanges == nil {
+			break
+		}
+
+		return e.complexity.Subscription.ResourceTypeChanges(childComplexity), true
+
+	case "Subscription.sourceChanges":
+		if e.complexity.Subscription.SourceChanges == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_sourceChanges_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.SourceChanges(childComplexity, args["selector"].(*string)), true
+
 	case "Suggestion.label":
 		if e.complexity.Suggestion.Label == nil {
 			break
@@ -1271,6 +2419,21 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
 			return &graphql.Response{
 				Data: buf.Bytes(),
 			}
@@ -1356,6 +2519,9 @@ type Agent {
 
   # resource of the configuration in use by this agent
   configurationResource: Configuration
+
+  # commands sent to this agent, most recent last
+  commands: [AgentCommand!]
 }
 
 type AgentConfiguration {
@@ -1364,6 +2530,16 @@ type AgentConfiguration {
   Manager: Map
 }
 
+type AgentCommand {
+  id: ID!
+  agentId: ID!
+  type: String!
+  status: String!
+  message: String
+  createdAt: Time!
+  updatedAt: Time!
+}
+
 # ----------------------------------------------------------------------
 # shared resource models
 
@@ -1374,6 +2550,7 @@ type Metadata {
   description: String
   icon: String
   labels: Map
+  resourceVersion: Int
 }
 
 type AgentSelector {
@@ -1383,11 +2560,13 @@ type AgentSelector {
 # ----------------------------------------------------------------------
 # configuration model
 
-type Configuration {
+type Configuration implements Resource {
   apiVersion: String!
   kind: String!
   metadata: Metadata!
   spec: ConfigurationSpec!
+  dependents: ResourceDependents!
+  dependencies: [ResourceReference!]!
 }
 
 type ConfigurationSpec {
@@ -1395,7 +2574,9 @@ type ConfigurationSpec {
   raw: String
   sources: [ResourceConfiguration!]
   destinations: [ResourceConfiguration!]
+  processors: [ResourceConfiguration!]
   selector: AgentSelector
+  agentGroup: String
 }
 
 type ResourceConfiguration {
@@ -1410,6 +2591,49 @@ type Parameter {
   value: Any!
 }
 
+# ----------------------------------------------------------------------
+# agent group model
+
+type AgentGroup implements Resource {
+  apiVersion: String!
+  kind: String!
+  metadata: Metadata!
+  spec: AgentGroupSpec!
+  summary: AgentGroupSummary!
+  dependents: ResourceDependents!
+  dependencies: [ResourceReference!]!
+}
+
+type AgentGroupSpec {
+  selector: AgentSelector
+  query: String
+}
+
+type AgentGroupSummary {
+  name: String!
+  agents: Int!
+  statuses: [AgentGroupCount!]!
+  versions: [AgentGroupCount!]!
+}
+
+type AgentGroupCount {
+  value: String!
+  count: Int!
+}
+
+# ----------------------------------------------------------------------
+# resource dependents and dependencies
+
+type ResourceReference {
+  kind: String!
+  name: String!
+}
+
+type ResourceDependents {
+  resources: [ResourceReference!]!
+  agentIds: [ID!]!
+}
+
 # ----------------------------------------------------------------------
 # configurations query result
 
@@ -1433,6 +2657,99 @@ type Suggestion {
   query: String!
 }
 
+# ----------------------------------------------------------------------
+# connection query results for paging through lists. Use pageInfo.endCursor as the after argument to get the next page.
+
+enum SortOrder {
+  ASC
+  DESC
+}
+
+type PageInfo {
+  hasNextPage: Boolean!
+  endCursor: String
+}
+
+type AgentEdge {
+  cursor: String!
+  node: Agent!
+}
+
+type AgentConnection {
+  edges: [AgentEdge!]!
+  pageInfo: PageInfo!
+}
+
+type ConfigurationEdge {
+  cursor: String!
+  node: Configuration!
+}
+
+type ConfigurationConnection {
+  edges: [ConfigurationEdge!]!
+  pageInfo: PageInfo!
+}
+
+type SourceEdge {
+  cursor: String!
+  node: Source!
+}
+
+type SourceConnection {
+  edges: [SourceEdge!]!
+  pageInfo: PageInfo!
+}
+
+type SourceTypeEdge {
+  cursor: String!
+  node: SourceType!
+}
+
+type SourceTypeConnection {
+  edges: [SourceTypeEdge!]!
+  pageInfo: PageInfo!
+}
+
+type ProcessorEdge {
+  cursor: String!
+  node: Processor!
+}
+
+type ProcessorConnection {
+  edges: [ProcessorEdge!]!
+  pageInfo: PageInfo!
+}
+
+type ProcessorTypeEdge {
+  cursor: String!
+  node: ProcessorType!
+}
+
+type ProcessorTypeConnection {
+  edges: [ProcessorTypeEdge!]!
+  pageInfo: PageInfo!
+}
+
+type DestinationEdge {
+  cursor: String!
+  node: Destination!
+}
+
+type DestinationConnection {
+  edges: [DestinationEdge!]!
+  pageInfo: PageInfo!
+}
+
+type DestinationTypeEdge {
+  cursor: String!
+  node: DestinationType!
+}
+
+type DestinationTypeConnection {
+  edges: [DestinationTypeEdge!]!
+  pageInfo: PageInfo!
+}
+
 # ----------------------------------------------------------------------
 # agentChanges subscription result
 
@@ -1461,24 +2778,64 @@ type ConfigurationChange {
   eventType: EventType!
 }
 
+type SourceChange {
+  source: Source!
+  eventType: EventType!
+}
+
+type ProcessorChange {
+  processor: Processor!
+  eventType: EventType!
+}
+
+type DestinationChange {
+  destination: Destination!
+  eventType: EventType!
+}
+
+# implemented by configurations, sources, processors, destinations, and their resource types
+interface Resource {
+  apiVersion: String!
+  kind: String!
+  metadata: Metadata!
+}
+
+type ResourceChange {
+  resource: Resource!
+  eventType: EventType!
+}
+
 # ----------------------------------------------------------------------
 # resource types
 
-type SourceType {
+type SourceType implements Resource {
+  apiVersion: String!
+  metadata: Metadata!
+  kind: String!
+  spec: ResourceTypeSpec!
+  dependents: ResourceDependents!
+  dependencies: [ResourceReference!]!
+}
+
+type ProcessorType implements Resource {
   apiVersion: String!
   metadata: Metadata!
   kind: String!
   spec: ResourceTypeSpec!
+  dependents: ResourceDependents!
+  dependencies: [ResourceReference!]!
 }
 
-type ProcessorType {
+type DestinationType implements Resource {
   apiVersion: String!
   metadata: Metadata!
   kind: String!
   spec: ResourceTypeSpec!
+  dependents: ResourceDependents!
+  dependencies: [ResourceReference!]!
 }
 
-type DestinationType {
+type ConnectorType implements Resource {
   apiVersion: String!
   metadata: Metadata!
   kind: String!
@@ -1509,6 +2866,15 @@ enum ParameterType {
   enums
   map
   yaml
+  float
+  duration
+  byteSize
+  regex
+  timezone
+  filePath
+  hostPort
+  object
+  objects
 }
 
 type ParameterDefinition {
@@ -1521,6 +2887,11 @@ type ParameterDefinition {
 
   validValues: [String!]
 
+  min: Float
+  max: Float
+  pattern: String
+  properties: [ParameterDefinition!]
+
   default: Any
   relevantIf: [RelevantIfCondition!]
 }
@@ -1528,31 +2899,53 @@ type ParameterDefinition {
 type RelevantIfCondition {
   name: String!
   operator: RelevantIfOperatorType!
-  value: Any!
+  value: Any
+  # conditions combined by the all and any operators
+  conditions: [RelevantIfCondition!]
 }
 
 enum RelevantIfOperatorType {
   equals
+  notEquals
+  in
+  notIn
+  containsAny
+  exists
+  all
+  any
 }
 
 # ----------------------------------------------------------------------
 # sources, processors, and destinations
 
-type Source {
+type Source implements Resource {
+  apiVersion: String!
+  kind: String!
+  metadata: Metadata!
+  spec: ParameterizedSpec!
+  dependents: ResourceDependents!
+  dependencies: [ResourceReference!]!
+}
+
+type Processor implements Resource {
   apiVersion: String!
   kind: String!
   metadata: Metadata!
   spec: ParameterizedSpec!
+  dependents: ResourceDependents!
+  dependencies: [ResourceReference!]!
 }
 
-type Processor {
+type Destination implements Resource {
   apiVersion: String!
   kind: String!
   metadata: Metadata!
   spec: ParameterizedSpec!
+  dependents: ResourceDependents!
+  dependencies: [ResourceReference!]!
 }
 
-type Destination {
+type Connector implements Resource {
   apiVersion: String!
   kind: String!
   metadata: Metadata!
@@ -1581,6 +2974,9 @@ type Query {
   agents(selector: String, query: String): Agents!
   agent(id: ID!): Agent
 
+  agentGroups: [AgentGroup!]!
+  agentGroup(name: String!): AgentGroup
+
   configurations(selector: String, query: String): Configurations!
   configuration(name: String!): Configuration
 
@@ -1604,6 +3000,25 @@ type Query {
   destinationType(name: String!): DestinationType
 
   components: Components!
+
+  # paged lists. sort is name, id, or description for resources and name, id, or version for agents.
+  agentsConnection(first: Int, after: String, sort: String, order: SortOrder, selector: String, query: String): AgentConnection!
+  configurationsConnection(first: Int, after: String, sort: String, order: SortOrder, selector: String, query: String): ConfigurationConnection!
+  sourcesConnection(first: Int, after: String, sort: String, order: SortOrder, selector: String): SourceConnection!
+  sourceTypesConnection(first: Int, after: String, sort: String, order: SortOrder, selector: String): SourceTypeConnection!
+  processorsConnection(first: Int, after: String, sort: String, order: SortOrder, selector: String): ProcessorConnection!
+  processorTypesConnection(first: Int, after: String, sort: String, order: SortOrder, selector: String): ProcessorTypeConnection!
+  destinationsConnection(first: Int, after: String, sort: String, order: SortOrder, selector: String): DestinationConnection!
+  destinationTypesConnection(first: Int, after: String, sort: String, order: SortOrder, selector: String): DestinationTypeConnection!
+}
+
+# ----------------------------------------------------------------------
+# mutations
+
+type Mutation {
+  # send a command to the agents with the specified ids, the agents matching the selector, and the agents in the group.
+  # agents that cannot receive the command are reported as errors and omitted from the result.
+  executeAgentCommand(type: String!, ids: [ID!], selector: String, group: String): [AgentCommand!]!
 }
 
 # ----------------------------------------------------------------------
@@ -1612,6 +3027,16 @@ type Query {
 type Subscription {
   agentChanges(selector: String, query: String): [AgentChange!]!
   configurationChanges(selector: String, query: String): [ConfigurationChange!]!
+  sourceChanges(selector: String): [SourceChange!]!
+  processorChanges(selector: String): [ProcessorChange!]!
+  destinationChanges(selector: String): [DestinationChange!]!
+
+  # changes to source types, processor types, destination types, and connector types
+  resourceTypeChanges: [ResourceChange!]!
+
+  # changes to resources of the specified kinds, e.g. ["Source", "Destination"]. All kinds are included if kinds is not
+  # specified.
+  resourceChanges(kinds: [String!], selector: String): [ResourceChange!]!
 }
 `, BuiltIn: false},
 }
@@ -1621,6 +3046,48 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) field_Mutation_executeAgentCommand_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["type"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["type"] = arg0
+	var arg1 []string
+	if tmp, ok := rawArgs["ids"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ids"))
+		arg1, err = ec.unmarshalOID2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["ids"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["group"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("group"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["group"] = arg3
+	return args, nil
+}
+
 func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1636,6 +3103,21 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_agentGroup_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_agent_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1651,6 +3133,66 @@ func (ec *executionContext) field_Query_agent_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_agentsConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["sort"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sort"] = arg2
+	var arg3 *model.SortOrder
+	if tmp, ok := rawArgs["order"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+		arg3, err = ec.unmarshalOSortOrder2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSortOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["order"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["query"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg5
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_agents_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1690,6 +3232,66 @@ func (ec *executionContext) field_Query_configuration_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_configurationsConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["sort"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sort"] = arg2
+	var arg3 *model.SortOrder
+	if tmp, ok := rawArgs["order"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+		arg3, err = ec.unmarshalOSortOrder2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSortOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["order"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["query"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg5
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_configurations_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1729,6 +3331,57 @@ func (ec *executionContext) field_Query_destinationType_args(ctx context.Context
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_destinationTypesConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["sort"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sort"] = arg2
+	var arg3 *model.SortOrder
+	if tmp, ok := rawArgs["order"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+		arg3, err = ec.unmarshalOSortOrder2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSortOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["order"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg4
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_destinationWithType_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1759,22 +3412,58 @@ func (ec *executionContext) field_Query_destination_args(ctx context.Context, ra
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_processorType_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_destinationsConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+	var arg0 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["name"] = arg0
+	args["first"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["sort"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sort"] = arg2
+	var arg3 *model.SortOrder
+	if tmp, ok := rawArgs["order"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+		arg3, err = ec.unmarshalOSortOrder2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSortOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["order"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg4
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_processor_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_processorType_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -1789,22 +3478,58 @@ func (ec *executionContext) field_Query_processor_args(ctx context.Context, rawA
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_sourceType_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_processorTypesConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+	var arg0 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["name"] = arg0
+	args["first"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["sort"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sort"] = arg2
+	var arg3 *model.SortOrder
+	if tmp, ok := rawArgs["order"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+		arg3, err = ec.unmarshalOSortOrder2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSortOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["order"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg4
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_source_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_processor_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
@@ -1819,95 +3544,347 @@ func (ec *executionContext) field_Query_source_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
-func (ec *executionContext) field_Subscription_agentChanges_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_processorsConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *string
-	if tmp, ok := rawArgs["selector"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
-		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+	var arg0 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["selector"] = arg0
+	args["first"] = arg0
 	var arg1 *string
-	if tmp, ok := rawArgs["query"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
 		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["query"] = arg1
-	return args, nil
-}
-
-func (ec *executionContext) field_Subscription_configurationChanges_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *string
-	if tmp, ok := rawArgs["selector"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
-		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+	args["after"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["sort"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["selector"] = arg0
-	var arg1 *string
-	if tmp, ok := rawArgs["query"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
-		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+	args["sort"] = arg2
+	var arg3 *model.SortOrder
+	if tmp, ok := rawArgs["order"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+		arg3, err = ec.unmarshalOSortOrder2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSortOrder(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["query"] = arg1
+	args["order"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg4
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_sourceType_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
+	args["name"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_sourceTypesConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+	var arg0 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
-
-// endregion ***************************** args.gotpl *****************************
-
-// region    ************************** directives.gotpl **************************
-
-// endregion ************************** directives.gotpl **************************
-
-// region    **************************** field.gotpl *****************************
-
-func (ec *executionContext) _Agent_id(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Agent_id(ctx, field)
-	if err != nil {
+	args["first"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["sort"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sort"] = arg2
+	var arg3 *model.SortOrder
+	if tmp, ok := rawArgs["order"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+		arg3, err = ec.unmarshalOSortOrder2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSortOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["order"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_source_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_sourcesConnection_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["sort"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sort"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sort"] = arg2
+	var arg3 *model.SortOrder
+	if tmp, ok := rawArgs["order"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("order"))
+		arg3, err = ec.unmarshalOSortOrder2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSortOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["order"] = arg3
+	var arg4 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg4, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_agentChanges_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["query"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_configurationChanges_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["query"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("query"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["query"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_destinationChanges_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_processorChanges_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_resourceChanges_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["kinds"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kinds"))
+		arg0, err = ec.unmarshalOString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["kinds"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_sourceChanges_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _Agent_id(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Agent_id(ctx, field)
+	if err != nil {
 		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
@@ -1949,7 +3926,7 @@ func (ec *executionContext) fieldContext_Agent_id(ctx context.Context, field gra
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_architecture(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_architecture(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_architecture(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -1990,7 +3967,7 @@ func (ec *executionContext) fieldContext_Agent_architecture(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_hostName(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_hostName(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_hostName(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2031,7 +4008,7 @@ func (ec *executionContext) fieldContext_Agent_hostName(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_labels(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_labels(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_labels(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2072,7 +4049,7 @@ func (ec *executionContext) fieldContext_Agent_labels(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_platform(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_platform(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_platform(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2113,7 +4090,7 @@ func (ec *executionContext) fieldContext_Agent_platform(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_operatingSystem(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_operatingSystem(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_operatingSystem(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2154,7 +4131,7 @@ func (ec *executionContext) fieldContext_Agent_operatingSystem(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_version(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_version(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_version(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2195,7 +4172,7 @@ func (ec *executionContext) fieldContext_Agent_version(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_name(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_name(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_name(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2239,7 +4216,7 @@ func (ec *executionContext) fieldContext_Agent_name(ctx context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_home(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_home(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_home(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2280,7 +4257,7 @@ func (ec *executionContext) fieldContext_Agent_home(ctx context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_macAddress(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_macAddress(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_macAddress(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2321,7 +4298,7 @@ func (ec *executionContext) fieldContext_Agent_macAddress(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_remoteAddress(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_remoteAddress(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_remoteAddress(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2362,7 +4339,7 @@ func (ec *executionContext) fieldContext_Agent_remoteAddress(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_type(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_type(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_type(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2403,7 +4380,7 @@ func (ec *executionContext) fieldContext_Agent_type(ctx context.Context, field g
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_status(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_status(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_status(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2447,7 +4424,7 @@ func (ec *executionContext) fieldContext_Agent_status(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_errorMessage(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_errorMessage(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_errorMessage(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2488,7 +4465,7 @@ func (ec *executionContext) fieldContext_Agent_errorMessage(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_connectedAt(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_connectedAt(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_connectedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2529,7 +4506,7 @@ func (ec *executionContext) fieldContext_Agent_connectedAt(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_disconnectedAt(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_disconnectedAt(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_disconnectedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2570,7 +4547,7 @@ func (ec *executionContext) fieldContext_Agent_disconnectedAt(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_configuration(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_configuration(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_configuration(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2593,7 +4570,7 @@ func (ec *executionContext) _Agent_configuration(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model1.AgentConfiguration)
+	res := resTmp.(*model.AgentConfiguration)
 	fc.Result = res
 	return ec.marshalOAgentConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentConfiguration(ctx, field.Selections, res)
 }
@@ -2619,7 +4596,7 @@ func (ec *executionContext) fieldContext_Agent_configuration(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _Agent_configurationResource(ctx context.Context, field graphql.CollectedField, obj *model.Agent) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_configurationResource(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Agent_configurationResource(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2642,7 +4619,7 @@ func (ec *executionContext) _Agent_configurationResource(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Configuration)
+	res := resTmp.(*model1.Configuration)
 	fc.Result = res
 	return ec.marshalOConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfiguration(ctx, field.Selections, res)
 }
@@ -2663,6 +4640,10 @@ func (ec *executionContext) fieldContext_Agent_configurationResource(ctx context
 				return ec.fieldContext_Configuration_metadata(ctx, field)
 			case "spec":
 				return ec.fieldContext_Configuration_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Configuration_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Configuration_dependencies(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Configuration", field.Name)
 		},
@@ -2670,7 +4651,64 @@ func (ec *executionContext) fieldContext_Agent_configurationResource(ctx context
 	return fc, nil
 }
 
-func (ec *executionContext) _AgentChange_agent(ctx context.Context, field graphql.CollectedField, obj *model1.AgentChange) (ret graphql.Marshaler) {
+func (ec *executionContext) _Agent_commands(ctx context.Context, field graphql.CollectedField, obj *model1.Agent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Agent_commands(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Commands, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.AgentCommand)
+	fc.Result = res
+	return ec.marshalOAgentCommand2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentCommandᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Agent_commands(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Agent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AgentCommand_id(ctx, field)
+			case "agentId":
+				return ec.fieldContext_AgentCommand_agentId(ctx, field)
+			case "type":
+				return ec.fieldContext_AgentCommand_type(ctx, field)
+			case "status":
+				return ec.fieldContext_AgentCommand_status(ctx, field)
+			case "message":
+				return ec.fieldContext_AgentCommand_message(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_AgentCommand_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_AgentCommand_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AgentCommand", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AgentChange_agent(ctx context.Context, field graphql.CollectedField, obj *model.AgentChange) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_AgentChange_agent(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2696,7 +4734,7 @@ func (ec *executionContext) _AgentChange_agent(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Agent)
+	res := resTmp.(*model1.Agent)
 	fc.Result = res
 	return ec.marshalNAgent2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgent(ctx, field.Selections, res)
 }
@@ -2745,6 +4783,8 @@ func (ec *executionContext) fieldContext_AgentChange_agent(ctx context.Context,
 				return ec.fieldContext_Agent_configuration(ctx, field)
 			case "configurationResource":
 				return ec.fieldContext_Agent_configurationResource(ctx, field)
+			case "commands":
+				return ec.fieldContext_Agent_commands(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Agent", field.Name)
 		},
@@ -2752,7 +4792,7 @@ func (ec *executionContext) fieldContext_AgentChange_agent(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _AgentChange_changeType(ctx context.Context, field graphql.CollectedField, obj *model1.AgentChange) (ret graphql.Marshaler) {
+func (ec *executionContext) _AgentChange_changeType(ctx context.Context, field graphql.CollectedField, obj *model.AgentChange) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_AgentChange_changeType(ctx, field)
 	if err != nil {
 		return graphql.Null
@@ -2778,7 +4818,7 @@ func (ec *executionContext) _AgentChange_changeType(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model1.AgentChangeType)
+	res := resTmp.(model.AgentChangeType)
 	fc.Result = res
 	return ec.marshalNAgentChangeType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChangeType(ctx, field.Selections, res)
 }
@@ -2796,8 +4836,8 @@ func (ec *executionContext) fieldContext_AgentChange_changeType(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _AgentConfiguration_Collector(ctx context.Context, field graphql.CollectedField, obj *model1.AgentConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AgentConfiguration_Collector(ctx, field)
+func (ec *executionContext) _AgentCommand_id(ctx context.Context, field graphql.CollectedField, obj *model1.AgentCommand) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentCommand_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2810,35 +4850,38 @@ func (ec *executionContext) _AgentConfiguration_Collector(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Collector, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AgentConfiguration_Collector(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentCommand_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AgentConfiguration",
+		Object:     "AgentCommand",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AgentConfiguration_Logging(ctx context.Context, field graphql.CollectedField, obj *model1.AgentConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AgentConfiguration_Logging(ctx, field)
+func (ec *executionContext) _AgentCommand_agentId(ctx context.Context, field graphql.CollectedField, obj *model1.AgentCommand) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentCommand_agentId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2851,35 +4894,38 @@ func (ec *executionContext) _AgentConfiguration_Logging(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Logging, nil
+		return obj.AgentID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AgentConfiguration_Logging(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentCommand_agentId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AgentConfiguration",
+		Object:     "AgentCommand",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AgentConfiguration_Manager(ctx context.Context, field graphql.CollectedField, obj *model1.AgentConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AgentConfiguration_Manager(ctx, field)
+func (ec *executionContext) _AgentCommand_type(ctx context.Context, field graphql.CollectedField, obj *model1.AgentCommand) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentCommand_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2892,35 +4938,38 @@ func (ec *executionContext) _AgentConfiguration_Manager(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Manager, nil
+		return ec.resolvers.AgentCommand().Type(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]interface{})
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AgentConfiguration_Manager(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentCommand_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AgentConfiguration",
+		Object:     "AgentCommand",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _AgentSelector_matchLabels(ctx context.Context, field graphql.CollectedField, obj *model.AgentSelector) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_AgentSelector_matchLabels(ctx, field)
+func (ec *executionContext) _AgentCommand_status(ctx context.Context, field graphql.CollectedField, obj *model1.AgentCommand) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentCommand_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2933,35 +4982,38 @@ func (ec *executionContext) _AgentSelector_matchLabels(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.AgentSelector().MatchLabels(rctx, obj)
+		return ec.resolvers.AgentCommand().Status(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]interface{})
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_AgentSelector_matchLabels(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentCommand_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "AgentSelector",
+		Object:     "AgentCommand",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Agents_query(ctx context.Context, field graphql.CollectedField, obj *model1.Agents) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Agents_query(ctx, field)
+func (ec *executionContext) _AgentCommand_message(ctx context.Context, field graphql.CollectedField, obj *model1.AgentCommand) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentCommand_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2974,7 +5026,7 @@ func (ec *executionContext) _Agents_query(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Query, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2983,14 +5035,14 @@ func (ec *executionContext) _Agents_query(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Agents_query(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentCommand_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Agents",
+		Object:     "AgentCommand",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3001,8 +5053,8 @@ func (ec *executionContext) fieldContext_Agents_query(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Agents_agents(ctx context.Context, field graphql.CollectedField, obj *model1.Agents) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Agents_agents(ctx, field)
+func (ec *executionContext) _AgentCommand_createdAt(ctx context.Context, field graphql.CollectedField, obj *model1.AgentCommand) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentCommand_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3015,7 +5067,7 @@ func (ec *executionContext) _Agents_agents(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Agents, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3027,64 +5079,26 @@ func (ec *executionContext) _Agents_agents(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Agent)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNAgent2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentᚄ(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Agents_agents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentCommand_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Agents",
+		Object:     "AgentCommand",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Agent_id(ctx, field)
-			case "architecture":
-				return ec.fieldContext_Agent_architecture(ctx, field)
-			case "hostName":
-				return ec.fieldContext_Agent_hostName(ctx, field)
-			case "labels":
-				return ec.fieldContext_Agent_labels(ctx, field)
-			case "platform":
-				return ec.fieldContext_Agent_platform(ctx, field)
-			case "operatingSystem":
-				return ec.fieldContext_Agent_operatingSystem(ctx, field)
-			case "version":
-				return ec.fieldContext_Agent_version(ctx, field)
-			case "name":
-				return ec.fieldContext_Agent_name(ctx, field)
-			case "home":
-				return ec.fieldContext_Agent_home(ctx, field)
-			case "macAddress":
-				return ec.fieldContext_Agent_macAddress(ctx, field)
-			case "remoteAddress":
-				return ec.fieldContext_Agent_remoteAddress(ctx, field)
-			case "type":
-				return ec.fieldContext_Agent_type(ctx, field)
-			case "status":
-				return ec.fieldContext_Agent_status(ctx, field)
-			case "errorMessage":
-				return ec.fieldContext_Agent_errorMessage(ctx, field)
-			case "connectedAt":
-				return ec.fieldContext_Agent_connectedAt(ctx, field)
-			case "disconnectedAt":
-				return ec.fieldContext_Agent_disconnectedAt(ctx, field)
-			case "configuration":
-				return ec.fieldContext_Agent_configuration(ctx, field)
-			case "configurationResource":
-				return ec.fieldContext_Agent_configurationResource(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Agent", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Agents_suggestions(ctx context.Context, field graphql.CollectedField, obj *model1.Agents) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Agents_suggestions(ctx, field)
+func (ec *executionContext) _AgentCommand_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model1.AgentCommand) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentCommand_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3097,41 +5111,38 @@ func (ec *executionContext) _Agents_suggestions(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Suggestions, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*search.Suggestion)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOSuggestion2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋstoreᚋsearchᚐSuggestionᚄ(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Agents_suggestions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentCommand_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Agents",
+		Object:     "AgentCommand",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "label":
-				return ec.fieldContext_Suggestion_label(ctx, field)
-			case "query":
-				return ec.fieldContext_Suggestion_query(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Suggestion", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Components_sources(ctx context.Context, field graphql.CollectedField, obj *model1.Components) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Components_sources(ctx, field)
+func (ec *executionContext) _AgentConfiguration_Collector(ctx context.Context, field graphql.CollectedField, obj *model.AgentConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentConfiguration_Collector(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3144,48 +5155,35 @@ func (ec *executionContext) _Components_sources(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Sources, nil
+		return obj.Collector, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Source)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNSource2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Components_sources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentConfiguration_Collector(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Components",
+		Object:     "AgentConfiguration",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_Source_apiVersion(ctx, field)
-			case "kind":
-				return ec.fieldContext_Source_kind(ctx, field)
-			case "metadata":
-				return ec.fieldContext_Source_metadata(ctx, field)
-			case "spec":
-				return ec.fieldContext_Source_spec(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Components_destinations(ctx context.Context, field graphql.CollectedField, obj *model1.Components) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Components_destinations(ctx, field)
+func (ec *executionContext) _AgentConfiguration_Logging(ctx context.Context, field graphql.CollectedField, obj *model.AgentConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentConfiguration_Logging(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3198,48 +5196,35 @@ func (ec *executionContext) _Components_destinations(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Destinations, nil
+		return obj.Logging, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Destination)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNDestination2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Components_destinations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentConfiguration_Logging(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Components",
+		Object:     "AgentConfiguration",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_Destination_apiVersion(ctx, field)
-			case "kind":
-				return ec.fieldContext_Destination_kind(ctx, field)
-			case "metadata":
-				return ec.fieldContext_Destination_metadata(ctx, field)
-			case "spec":
-				return ec.fieldContext_Destination_spec(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Destination", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Configuration_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model.Configuration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Configuration_apiVersion(ctx, field)
+func (ec *executionContext) _AgentConfiguration_Manager(ctx context.Context, field graphql.CollectedField, obj *model.AgentConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentConfiguration_Manager(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3252,38 +5237,35 @@ func (ec *executionContext) _Configuration_apiVersion(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.APIVersion, nil
+		return obj.Manager, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(map[string]interface{})
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Configuration_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentConfiguration_Manager(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Configuration",
+		Object:     "AgentConfiguration",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Configuration_kind(ctx context.Context, field graphql.CollectedField, obj *model.Configuration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Configuration_kind(ctx, field)
+func (ec *executionContext) _AgentConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.AgentConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3296,7 +5278,7 @@ func (ec *executionContext) _Configuration_kind(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Configuration().Kind(rctx, obj)
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3308,26 +5290,32 @@ func (ec *executionContext) _Configuration_kind(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.AgentEdge)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNAgentEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Configuration_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Configuration",
+		Object:     "AgentConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_AgentEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_AgentEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AgentEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Configuration_metadata(ctx context.Context, field graphql.CollectedField, obj *model.Configuration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Configuration_metadata(ctx, field)
+func (ec *executionContext) _AgentConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.AgentConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3340,7 +5328,7 @@ func (ec *executionContext) _Configuration_metadata(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Metadata, nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3352,40 +5340,32 @@ func (ec *executionContext) _Configuration_metadata(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Metadata)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Configuration_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Configuration",
+		Object:     "AgentConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Metadata_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Metadata_name(ctx, field)
-			case "displayName":
-				return ec.fieldContext_Metadata_displayName(ctx, field)
-			case "description":
-				return ec.fieldContext_Metadata_description(ctx, field)
-			case "icon":
-				return ec.fieldContext_Metadata_icon(ctx, field)
-			case "labels":
-				return ec.fieldContext_Metadata_labels(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Configuration_spec(ctx context.Context, field graphql.CollectedField, obj *model.Configuration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Configuration_spec(ctx, field)
+func (ec *executionContext) _AgentEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.AgentEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3398,7 +5378,7 @@ func (ec *executionContext) _Configuration_spec(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Spec, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3410,38 +5390,26 @@ func (ec *executionContext) _Configuration_spec(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ConfigurationSpec)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNConfigurationSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfigurationSpec(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Configuration_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Configuration",
+		Object:     "AgentEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "contentType":
-				return ec.fieldContext_ConfigurationSpec_contentType(ctx, field)
-			case "raw":
-				return ec.fieldContext_ConfigurationSpec_raw(ctx, field)
-			case "sources":
-				return ec.fieldContext_ConfigurationSpec_sources(ctx, field)
-			case "destinations":
-				return ec.fieldContext_ConfigurationSpec_destinations(ctx, field)
-			case "selector":
-				return ec.fieldContext_ConfigurationSpec_selector(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ConfigurationSpec", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConfigurationChange_configuration(ctx context.Context, field graphql.CollectedField, obj *model1.ConfigurationChange) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConfigurationChange_configuration(ctx, field)
+func (ec *executionContext) _AgentEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.AgentEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3454,7 +5422,7 @@ func (ec *executionContext) _ConfigurationChange_configuration(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Configuration, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3466,36 +5434,66 @@ func (ec *executionContext) _ConfigurationChange_configuration(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Configuration)
+	res := resTmp.(*model1.Agent)
 	fc.Result = res
-	return ec.marshalNConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfiguration(ctx, field.Selections, res)
+	return ec.marshalNAgent2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConfigurationChange_configuration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConfigurationChange",
+		Object:     "AgentEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_Configuration_apiVersion(ctx, field)
-			case "kind":
-				return ec.fieldContext_Configuration_kind(ctx, field)
-			case "metadata":
-				return ec.fieldContext_Configuration_metadata(ctx, field)
-			case "spec":
-				return ec.fieldContext_Configuration_spec(ctx, field)
+			case "id":
+				return ec.fieldContext_Agent_id(ctx, field)
+			case "architecture":
+				return ec.fieldContext_Agent_architecture(ctx, field)
+			case "hostName":
+				return ec.fieldContext_Agent_hostName(ctx, field)
+			case "labels":
+				return ec.fieldContext_Agent_labels(ctx, field)
+			case "platform":
+				return ec.fieldContext_Agent_platform(ctx, field)
+			case "operatingSystem":
+				return ec.fieldContext_Agent_operatingSystem(ctx, field)
+			case "version":
+				return ec.fieldContext_Agent_version(ctx, field)
+			case "name":
+				return ec.fieldContext_Agent_name(ctx, field)
+			case "home":
+				return ec.fieldContext_Agent_home(ctx, field)
+			case "macAddress":
+				return ec.fieldContext_Agent_macAddress(ctx, field)
+			case "remoteAddress":
+				return ec.fieldContext_Agent_remoteAddress(ctx, field)
+			case "type":
+				return ec.fieldContext_Agent_type(ctx, field)
+			case "status":
+				return ec.fieldContext_Agent_status(ctx, field)
+			case "errorMessage":
+				return ec.fieldContext_Agent_errorMessage(ctx, field)
+			case "connectedAt":
+				return ec.fieldContext_Agent_connectedAt(ctx, field)
+			case "disconnectedAt":
+				return ec.fieldContext_Agent_disconnectedAt(ctx, field)
+			case "configuration":
+				return ec.fieldContext_Agent_configuration(ctx, field)
+			case "configurationResource":
+				return ec.fieldContext_Agent_configurationResource(ctx, field)
+			case "commands":
+				return ec.fieldContext_Agent_commands(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Configuration", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Agent", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConfigurationChange_eventType(ctx context.Context, field graphql.CollectedField, obj *model1.ConfigurationChange) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConfigurationChange_eventType(ctx, field)
+func (ec *executionContext) _AgentGroup_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroup_apiVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3508,7 +5506,7 @@ func (ec *executionContext) _ConfigurationChange_eventType(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EventType, nil
+		return obj.APIVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3520,26 +5518,26 @@ func (ec *executionContext) _ConfigurationChange_eventType(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model1.EventType)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNEventType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐEventType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConfigurationChange_eventType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroup_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConfigurationChange",
+		Object:     "AgentGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type EventType does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConfigurationSpec_contentType(ctx context.Context, field graphql.CollectedField, obj *model.ConfigurationSpec) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConfigurationSpec_contentType(ctx, field)
+func (ec *executionContext) _AgentGroup_kind(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroup_kind(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3552,26 +5550,29 @@ func (ec *executionContext) _ConfigurationSpec_contentType(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContentType, nil
+		return ec.resolvers.AgentGroup().Kind(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConfigurationSpec_contentType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroup_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConfigurationSpec",
+		Object:     "AgentGroup",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -3579,8 +5580,8 @@ func (ec *executionContext) fieldContext_ConfigurationSpec_contentType(ctx conte
 	return fc, nil
 }
 
-func (ec *executionContext) _ConfigurationSpec_raw(ctx context.Context, field graphql.CollectedField, obj *model.ConfigurationSpec) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConfigurationSpec_raw(ctx, field)
+func (ec *executionContext) _AgentGroup_metadata(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroup_metadata(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3593,35 +5594,54 @@ func (ec *executionContext) _ConfigurationSpec_raw(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Raw, nil
+		return obj.Metadata, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model1.Metadata)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConfigurationSpec_raw(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroup_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConfigurationSpec",
+		Object:     "AgentGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Metadata_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Metadata_name(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Metadata_displayName(ctx, field)
+			case "description":
+				return ec.fieldContext_Metadata_description(ctx, field)
+			case "icon":
+				return ec.fieldContext_Metadata_icon(ctx, field)
+			case "labels":
+				return ec.fieldContext_Metadata_labels(ctx, field)
+			case "resourceVersion":
+				return ec.fieldContext_Metadata_resourceVersion(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConfigurationSpec_sources(ctx context.Context, field graphql.CollectedField, obj *model.ConfigurationSpec) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConfigurationSpec_sources(ctx, field)
+func (ec *executionContext) _AgentGroup_spec(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroup_spec(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3634,45 +5654,44 @@ func (ec *executionContext) _ConfigurationSpec_sources(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Sources, nil
+		return obj.Spec, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.ResourceConfiguration)
+	res := resTmp.(model1.AgentGroupSpec)
 	fc.Result = res
-	return ec.marshalOResourceConfiguration2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceConfigurationᚄ(ctx, field.Selections, res)
+	return ec.marshalNAgentGroupSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroupSpec(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConfigurationSpec_sources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroup_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConfigurationSpec",
+		Object:     "AgentGroup",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext_ResourceConfiguration_name(ctx, field)
-			case "type":
-				return ec.fieldContext_ResourceConfiguration_type(ctx, field)
-			case "parameters":
-				return ec.fieldContext_ResourceConfiguration_parameters(ctx, field)
-			case "processors":
-				return ec.fieldContext_ResourceConfiguration_processors(ctx, field)
+			case "selector":
+				return ec.fieldContext_AgentGroupSpec_selector(ctx, field)
+			case "query":
+				return ec.fieldContext_AgentGroupSpec_query(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ResourceConfiguration", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AgentGroupSpec", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConfigurationSpec_destinations(ctx context.Context, field graphql.CollectedField, obj *model.ConfigurationSpec) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConfigurationSpec_destinations(ctx, field)
+func (ec *executionContext) _AgentGroup_summary(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroup_summary(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3685,45 +5704,48 @@ func (ec *executionContext) _ConfigurationSpec_destinations(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Destinations, nil
+		return ec.resolvers.AgentGroup().Summary(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.ResourceConfiguration)
+	res := resTmp.(*model1.AgentGroupSummary)
 	fc.Result = res
-	return ec.marshalOResourceConfiguration2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceConfigurationᚄ(ctx, field.Selections, res)
+	return ec.marshalNAgentGroupSummary2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroupSummary(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConfigurationSpec_destinations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroup_summary(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConfigurationSpec",
+		Object:     "AgentGroup",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "name":
-				return ec.fieldContext_ResourceConfiguration_name(ctx, field)
-			case "type":
-				return ec.fieldContext_ResourceConfiguration_type(ctx, field)
-			case "parameters":
-				return ec.fieldContext_ResourceConfiguration_parameters(ctx, field)
-			case "processors":
-				return ec.fieldContext_ResourceConfiguration_processors(ctx, field)
+				return ec.fieldContext_AgentGroupSummary_name(ctx, field)
+			case "agents":
+				return ec.fieldContext_AgentGroupSummary_agents(ctx, field)
+			case "statuses":
+				return ec.fieldContext_AgentGroupSummary_statuses(ctx, field)
+			case "versions":
+				return ec.fieldContext_AgentGroupSummary_versions(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ResourceConfiguration", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AgentGroupSummary", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConfigurationSpec_selector(ctx context.Context, field graphql.CollectedField, obj *model.ConfigurationSpec) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ConfigurationSpec_selector(ctx, field)
+func (ec *executionContext) _AgentGroup_dependents(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroup_dependents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3736,39 +5758,44 @@ func (ec *executionContext) _ConfigurationSpec_selector(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Selector, nil
+		return ec.resolvers.AgentGroup().Dependents(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(model.AgentSelector)
+	res := resTmp.(*model1.ResourceDependents)
 	fc.Result = res
-	return ec.marshalOAgentSelector2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentSelector(ctx, field.Selections, res)
+	return ec.marshalNResourceDependents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceDependents(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ConfigurationSpec_selector(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroup_dependents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConfigurationSpec",
+		Object:     "AgentGroup",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "matchLabels":
-				return ec.fieldContext_AgentSelector_matchLabels(ctx, field)
+			case "resources":
+				return ec.fieldContext_ResourceDependents_resources(ctx, field)
+			case "agentIds":
+				return ec.fieldContext_ResourceDependents_agentIds(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type AgentSelector", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceDependents", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Configurations_query(ctx context.Context, field graphql.CollectedField, obj *model1.Configurations) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Configurations_query(ctx, field)
+func (ec *executionContext) _AgentGroup_dependencies(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroup) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroup_dependencies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3781,23 +5808,76 @@ func (ec *executionContext) _Configurations_query(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Query, nil
+		return ec.resolvers.AgentGroup().Dependencies(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model1.ResourceReference)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNResourceReference2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceReferenceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Configurations_query(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroup_dependencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Configurations",
+		Object:     "AgentGroup",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext_ResourceReference_kind(ctx, field)
+			case "name":
+				return ec.fieldContext_ResourceReference_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceReference", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _AgentGroupCount_value(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroupCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroupCount_value(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_AgentGroupCount_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "AgentGroupCount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3808,8 +5888,8 @@ func (ec *executionContext) fieldContext_Configurations_query(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _Configurations_configurations(ctx context.Context, field graphql.CollectedField, obj *model1.Configurations) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Configurations_configurations(ctx, field)
+func (ec *executionContext) _AgentGroupCount_count(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroupCount) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroupCount_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3822,7 +5902,7 @@ func (ec *executionContext) _Configurations_configurations(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Configurations, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3834,36 +5914,26 @@ func (ec *executionContext) _Configurations_configurations(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Configuration)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNConfiguration2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfigurationᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Configurations_configurations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroupCount_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Configurations",
+		Object:     "AgentGroupCount",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_Configuration_apiVersion(ctx, field)
-			case "kind":
-				return ec.fieldContext_Configuration_kind(ctx, field)
-			case "metadata":
-				return ec.fieldContext_Configuration_metadata(ctx, field)
-			case "spec":
-				return ec.fieldContext_Configuration_spec(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Configuration", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Configurations_suggestions(ctx context.Context, field graphql.CollectedField, obj *model1.Configurations) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Configurations_suggestions(ctx, field)
+func (ec *executionContext) _AgentGroupSpec_selector(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroupSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroupSpec_selector(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3876,7 +5946,7 @@ func (ec *executionContext) _Configurations_suggestions(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Suggestions, nil
+		return obj.Selector, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3885,32 +5955,30 @@ func (ec *executionContext) _Configurations_suggestions(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*search.Suggestion)
+	res := resTmp.(model1.AgentSelector)
 	fc.Result = res
-	return ec.marshalOSuggestion2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋstoreᚋsearchᚐSuggestionᚄ(ctx, field.Selections, res)
+	return ec.marshalOAgentSelector2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentSelector(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Configurations_suggestions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroupSpec_selector(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Configurations",
+		Object:     "AgentGroupSpec",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "label":
-				return ec.fieldContext_Suggestion_label(ctx, field)
-			case "query":
-				return ec.fieldContext_Suggestion_query(ctx, field)
+			case "matchLabels":
+				return ec.fieldContext_AgentSelector_matchLabels(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Suggestion", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AgentSelector", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Destination_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model.Destination) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Destination_apiVersion(ctx, field)
+func (ec *executionContext) _AgentGroupSpec_query(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroupSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroupSpec_query(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3923,26 +5991,23 @@ func (ec *executionContext) _Destination_apiVersion(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.APIVersion, nil
+		return obj.Query, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Destination_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroupSpec_query(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Destination",
+		Object:     "AgentGroupSpec",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3953,8 +6018,8 @@ func (ec *executionContext) fieldContext_Destination_apiVersion(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _Destination_kind(ctx context.Context, field graphql.CollectedField, obj *model.Destination) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Destination_kind(ctx, field)
+func (ec *executionContext) _AgentGroupSummary_name(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroupSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroupSummary_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3967,7 +6032,7 @@ func (ec *executionContext) _Destination_kind(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Destination().Kind(rctx, obj)
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3984,12 +6049,12 @@ func (ec *executionContext) _Destination_kind(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Destination_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroupSummary_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Destination",
+		Object:     "AgentGroupSummary",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -3997,8 +6062,8 @@ func (ec *executionContext) fieldContext_Destination_kind(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Destination_metadata(ctx context.Context, field graphql.CollectedField, obj *model.Destination) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Destination_metadata(ctx, field)
+func (ec *executionContext) _AgentGroupSummary_agents(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroupSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroupSummary_agents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4011,7 +6076,7 @@ func (ec *executionContext) _Destination_metadata(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Metadata, nil
+		return obj.Agents, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4023,40 +6088,26 @@ func (ec *executionContext) _Destination_metadata(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Metadata)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Destination_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroupSummary_agents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Destination",
+		Object:     "AgentGroupSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Metadata_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Metadata_name(ctx, field)
-			case "displayName":
-				return ec.fieldContext_Metadata_displayName(ctx, field)
-			case "description":
-				return ec.fieldContext_Metadata_description(ctx, field)
-			case "icon":
-				return ec.fieldContext_Metadata_icon(ctx, field)
-			case "labels":
-				return ec.fieldContext_Metadata_labels(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Destination_spec(ctx context.Context, field graphql.CollectedField, obj *model.Destination) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Destination_spec(ctx, field)
+func (ec *executionContext) _AgentGroupSummary_statuses(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroupSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroupSummary_statuses(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4069,7 +6120,7 @@ func (ec *executionContext) _Destination_spec(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Spec, nil
+		return obj.Statuses, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4081,32 +6132,32 @@ func (ec *executionContext) _Destination_spec(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ParameterizedSpec)
+	res := resTmp.([]*model1.AgentGroupCount)
 	fc.Result = res
-	return ec.marshalNParameterizedSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterizedSpec(ctx, field.Selections, res)
+	return ec.marshalNAgentGroupCount2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroupCountᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Destination_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroupSummary_statuses(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Destination",
+		Object:     "AgentGroupSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "type":
-				return ec.fieldContext_ParameterizedSpec_type(ctx, field)
-			case "parameters":
-				return ec.fieldContext_ParameterizedSpec_parameters(ctx, field)
+			case "value":
+				return ec.fieldContext_AgentGroupCount_value(ctx, field)
+			case "count":
+				return ec.fieldContext_AgentGroupCount_count(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ParameterizedSpec", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AgentGroupCount", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DestinationType_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model.DestinationType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DestinationType_apiVersion(ctx, field)
+func (ec *executionContext) _AgentGroupSummary_versions(ctx context.Context, field graphql.CollectedField, obj *model1.AgentGroupSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentGroupSummary_versions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4119,7 +6170,7 @@ func (ec *executionContext) _DestinationType_apiVersion(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.APIVersion, nil
+		return obj.Versions, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4131,26 +6182,32 @@ func (ec *executionContext) _DestinationType_apiVersion(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model1.AgentGroupCount)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNAgentGroupCount2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroupCountᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DestinationType_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentGroupSummary_versions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DestinationType",
+		Object:     "AgentGroupSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "value":
+				return ec.fieldContext_AgentGroupCount_value(ctx, field)
+			case "count":
+				return ec.fieldContext_AgentGroupCount_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AgentGroupCount", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DestinationType_metadata(ctx context.Context, field graphql.CollectedField, obj *model.DestinationType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DestinationType_metadata(ctx, field)
+func (ec *executionContext) _AgentSelector_matchLabels(ctx context.Context, field graphql.CollectedField, obj *model1.AgentSelector) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_AgentSelector_matchLabels(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4163,52 +6220,35 @@ func (ec *executionContext) _DestinationType_metadata(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Metadata, nil
+		return ec.resolvers.AgentSelector().MatchLabels(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Metadata)
+	res := resTmp.(map[string]interface{})
 	fc.Result = res
-	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
+	return ec.marshalOMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DestinationType_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_AgentSelector_matchLabels(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DestinationType",
+		Object:     "AgentSelector",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Metadata_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Metadata_name(ctx, field)
-			case "displayName":
-				return ec.fieldContext_Metadata_displayName(ctx, field)
-			case "description":
-				return ec.fieldContext_Metadata_description(ctx, field)
-			case "icon":
-				return ec.fieldContext_Metadata_icon(ctx, field)
-			case "labels":
-				return ec.fieldContext_Metadata_labels(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DestinationType_kind(ctx context.Context, field graphql.CollectedField, obj *model.DestinationType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DestinationType_kind(ctx, field)
+func (ec *executionContext) _Agents_query(ctx context.Context, field graphql.CollectedField, obj *model.Agents) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Agents_query(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4221,29 +6261,26 @@ func (ec *executionContext) _DestinationType_kind(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.DestinationType().Kind(rctx, obj)
+		return obj.Query, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DestinationType_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Agents_query(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DestinationType",
+		Object:     "Agents",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -4251,8 +6288,8 @@ func (ec *executionContext) fieldContext_DestinationType_kind(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _DestinationType_spec(ctx context.Context, field graphql.CollectedField, obj *model.DestinationType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DestinationType_spec(ctx, field)
+func (ec *executionContext) _Agents_agents(ctx context.Context, field graphql.CollectedField, obj *model.Agents) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Agents_agents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4265,7 +6302,7 @@ func (ec *executionContext) _DestinationType_spec(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Spec, nil
+		return obj.Agents, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4277,36 +6314,66 @@ func (ec *executionContext) _DestinationType_spec(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ResourceTypeSpec)
+	res := resTmp.([]*model1.Agent)
 	fc.Result = res
-	return ec.marshalNResourceTypeSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceTypeSpec(ctx, field.Selections, res)
+	return ec.marshalNAgent2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DestinationType_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Agents_agents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DestinationType",
+		Object:     "Agents",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "id":
+				return ec.fieldContext_Agent_id(ctx, field)
+			case "architecture":
+				return ec.fieldContext_Agent_architecture(ctx, field)
+			case "hostName":
+				return ec.fieldContext_Agent_hostName(ctx, field)
+			case "labels":
+				return ec.fieldContext_Agent_labels(ctx, field)
+			case "platform":
+				return ec.fieldContext_Agent_platform(ctx, field)
+			case "operatingSystem":
+				return ec.fieldContext_Agent_operatingSystem(ctx, field)
 			case "version":
-				return ec.fieldContext_ResourceTypeSpec_version(ctx, field)
-			case "parameters":
-				return ec.fieldContext_ResourceTypeSpec_parameters(ctx, field)
-			case "supportedPlatforms":
-				return ec.fieldContext_ResourceTypeSpec_supportedPlatforms(ctx, field)
-			case "telemetryTypes":
-				return ec.fieldContext_ResourceTypeSpec_telemetryTypes(ctx, field)
+				return ec.fieldContext_Agent_version(ctx, field)
+			case "name":
+				return ec.fieldContext_Agent_name(ctx, field)
+			case "home":
+				return ec.fieldContext_Agent_home(ctx, field)
+			case "macAddress":
+				return ec.fieldContext_Agent_macAddress(ctx, field)
+			case "remoteAddress":
+				return ec.fieldContext_Agent_remoteAddress(ctx, field)
+			case "type":
+				return ec.fieldContext_Agent_type(ctx, field)
+			case "status":
+				return ec.fieldContext_Agent_status(ctx, field)
+			case "errorMessage":
+				return ec.fieldContext_Agent_errorMessage(ctx, field)
+			case "connectedAt":
+				return ec.fieldContext_Agent_connectedAt(ctx, field)
+			case "disconnectedAt":
+				return ec.fieldContext_Agent_disconnectedAt(ctx, field)
+			case "configuration":
+				return ec.fieldContext_Agent_configuration(ctx, field)
+			case "configurationResource":
+				return ec.fieldContext_Agent_configurationResource(ctx, field)
+			case "commands":
+				return ec.fieldContext_Agent_commands(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ResourceTypeSpec", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Agent", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DestinationWithType_destination(ctx context.Context, field graphql.CollectedField, obj *model1.DestinationWithType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DestinationWithType_destination(ctx, field)
+func (ec *executionContext) _Agents_suggestions(ctx context.Context, field graphql.CollectedField, obj *model.Agents) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Agents_suggestions(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4319,7 +6386,7 @@ func (ec *executionContext) _DestinationWithType_destination(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Destination, nil
+		return obj.Suggestions, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4328,36 +6395,32 @@ func (ec *executionContext) _DestinationWithType_destination(ctx context.Context
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Destination)
+	res := resTmp.([]*search.Suggestion)
 	fc.Result = res
-	return ec.marshalODestination2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestination(ctx, field.Selections, res)
+	return ec.marshalOSuggestion2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋstoreᚋsearchᚐSuggestionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DestinationWithType_destination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Agents_suggestions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DestinationWithType",
+		Object:     "Agents",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_Destination_apiVersion(ctx, field)
-			case "kind":
-				return ec.fieldContext_Destination_kind(ctx, field)
-			case "metadata":
-				return ec.fieldContext_Destination_metadata(ctx, field)
-			case "spec":
-				return ec.fieldContext_Destination_spec(ctx, field)
+			case "label":
+				return ec.fieldContext_Suggestion_label(ctx, field)
+			case "query":
+				return ec.fieldContext_Suggestion_query(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Destination", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Suggestion", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DestinationWithType_destinationType(ctx context.Context, field graphql.CollectedField, obj *model1.DestinationWithType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DestinationWithType_destinationType(ctx, field)
+func (ec *executionContext) _Components_sources(ctx context.Context, field graphql.CollectedField, obj *model.Components) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Components_sources(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4370,45 +6433,52 @@ func (ec *executionContext) _DestinationWithType_destinationType(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DestinationType, nil
+		return obj.Sources, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DestinationType)
+	res := resTmp.([]*model1.Source)
 	fc.Result = res
-	return ec.marshalODestinationType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationType(ctx, field.Selections, res)
+	return ec.marshalNSource2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DestinationWithType_destinationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Components_sources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DestinationWithType",
+		Object:     "Components",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "apiVersion":
-				return ec.fieldContext_DestinationType_apiVersion(ctx, field)
-			case "metadata":
-				return ec.fieldContext_DestinationType_metadata(ctx, field)
+				return ec.fieldContext_Source_apiVersion(ctx, field)
 			case "kind":
-				return ec.fieldContext_DestinationType_kind(ctx, field)
+				return ec.fieldContext_Source_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Source_metadata(ctx, field)
 			case "spec":
-				return ec.fieldContext_DestinationType_spec(ctx, field)
+				return ec.fieldContext_Source_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Source_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Source_dependencies(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DestinationType", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Metadata_id(ctx context.Context, field graphql.CollectedField, obj *model.Metadata) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Metadata_id(ctx, field)
+func (ec *executionContext) _Components_destinations(ctx context.Context, field graphql.CollectedField, obj *model.Components) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Components_destinations(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4421,7 +6491,7 @@ func (ec *executionContext) _Metadata_id(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Destinations, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4433,26 +6503,40 @@ func (ec *executionContext) _Metadata_id(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model1.Destination)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNDestination2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Metadata_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Components_destinations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Metadata",
+		Object:     "Components",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Destination_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Destination_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Destination_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Destination_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Destination_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Destination_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Destination", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Metadata_name(ctx context.Context, field graphql.CollectedField, obj *model.Metadata) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Metadata_name(ctx, field)
+func (ec *executionContext) _Configuration_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model1.Configuration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Configuration_apiVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4465,7 +6549,7 @@ func (ec *executionContext) _Metadata_name(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.APIVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4482,9 +6566,9 @@ func (ec *executionContext) _Metadata_name(ctx context.Context, field graphql.Co
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Metadata_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Configuration_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Metadata",
+		Object:     "Configuration",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -4495,8 +6579,8 @@ func (ec *executionContext) fieldContext_Metadata_name(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _Metadata_displayName(ctx context.Context, field graphql.CollectedField, obj *model.Metadata) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Metadata_displayName(ctx, field)
+func (ec *executionContext) _Configuration_kind(ctx context.Context, field graphql.CollectedField, obj *model1.Configuration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Configuration_kind(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4509,26 +6593,29 @@ func (ec *executionContext) _Metadata_displayName(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DisplayName, nil
+		return ec.resolvers.Configuration().Kind(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Metadata_displayName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Configuration_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Metadata",
+		Object:     "Configuration",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -4536,8 +6623,8 @@ func (ec *executionContext) fieldContext_Metadata_displayName(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _Metadata_description(ctx context.Context, field graphql.CollectedField, obj *model.Metadata) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Metadata_description(ctx, field)
+func (ec *executionContext) _Configuration_metadata(ctx context.Context, field graphql.CollectedField, obj *model1.Configuration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Configuration_metadata(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4550,35 +6637,54 @@ func (ec *executionContext) _Metadata_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Metadata, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model1.Metadata)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Metadata_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Configuration_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Metadata",
+		Object:     "Configuration",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Metadata_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Metadata_name(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Metadata_displayName(ctx, field)
+			case "description":
+				return ec.fieldContext_Metadata_description(ctx, field)
+			case "icon":
+				return ec.fieldContext_Metadata_icon(ctx, field)
+			case "labels":
+				return ec.fieldContext_Metadata_labels(ctx, field)
+			case "resourceVersion":
+				return ec.fieldContext_Metadata_resourceVersion(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Metadata_icon(ctx context.Context, field graphql.CollectedField, obj *model.Metadata) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Metadata_icon(ctx, field)
+func (ec *executionContext) _Configuration_spec(ctx context.Context, field graphql.CollectedField, obj *model1.Configuration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Configuration_spec(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4591,35 +6697,54 @@ func (ec *executionContext) _Metadata_icon(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Icon, nil
+		return obj.Spec, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model1.ConfigurationSpec)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNConfigurationSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfigurationSpec(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Metadata_icon(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Configuration_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Metadata",
+		Object:     "Configuration",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "contentType":
+				return ec.fieldContext_ConfigurationSpec_contentType(ctx, field)
+			case "raw":
+				return ec.fieldContext_ConfigurationSpec_raw(ctx, field)
+			case "sources":
+				return ec.fieldContext_ConfigurationSpec_sources(ctx, field)
+			case "destinations":
+				return ec.fieldContext_ConfigurationSpec_destinations(ctx, field)
+			case "processors":
+				return ec.fieldContext_ConfigurationSpec_processors(ctx, field)
+			case "selector":
+				return ec.fieldContext_ConfigurationSpec_selector(ctx, field)
+			case "agentGroup":
+				return ec.fieldContext_ConfigurationSpec_agentGroup(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ConfigurationSpec", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Metadata_labels(ctx context.Context, field graphql.CollectedField, obj *model.Metadata) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Metadata_labels(ctx, field)
+func (ec *executionContext) _Configuration_dependents(ctx context.Context, field graphql.CollectedField, obj *model1.Configuration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Configuration_dependents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4632,35 +6757,44 @@ func (ec *executionContext) _Metadata_labels(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Metadata().Labels(rctx, obj)
+		return ec.resolvers.Configuration().Dependents(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]interface{})
+	res := resTmp.(*model1.ResourceDependents)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalNResourceDependents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceDependents(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Metadata_labels(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Configuration_dependents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Metadata",
+		Object:     "Configuration",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			switch field.Name {
+			case "resources":
+				return ec.fieldContext_ResourceDependents_resources(ctx, field)
+			case "agentIds":
+				return ec.fieldContext_ResourceDependents_agentIds(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceDependents", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Parameter_name(ctx context.Context, field graphql.CollectedField, obj *model.Parameter) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Parameter_name(ctx, field)
+func (ec *executionContext) _Configuration_dependencies(ctx context.Context, field graphql.CollectedField, obj *model1.Configuration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Configuration_dependencies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4673,7 +6807,7 @@ func (ec *executionContext) _Parameter_name(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Configuration().Dependencies(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4685,26 +6819,32 @@ func (ec *executionContext) _Parameter_name(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model1.ResourceReference)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNResourceReference2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceReferenceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Parameter_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Configuration_dependencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Parameter",
+		Object:     "Configuration",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext_ResourceReference_kind(ctx, field)
+			case "name":
+				return ec.fieldContext_ResourceReference_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceReference", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Parameter_value(ctx context.Context, field graphql.CollectedField, obj *model.Parameter) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Parameter_value(ctx, field)
+func (ec *executionContext) _ConfigurationChange_configuration(ctx context.Context, field graphql.CollectedField, obj *model.ConfigurationChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationChange_configuration(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4717,7 +6857,7 @@ func (ec *executionContext) _Parameter_value(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Configuration, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4729,26 +6869,40 @@ func (ec *executionContext) _Parameter_value(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(interface{})
+	res := resTmp.(*model1.Configuration)
 	fc.Result = res
-	return ec.marshalNAny2interface(ctx, field.Selections, res)
+	return ec.marshalNConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfiguration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Parameter_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfigurationChange_configuration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Parameter",
+		Object:     "ConfigurationChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Any does not have child fields")
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Configuration_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Configuration_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Configuration_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Configuration_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Configuration_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Configuration_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Configuration", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ParameterDefinition_name(ctx context.Context, field graphql.CollectedField, obj *model.ParameterDefinition) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ParameterDefinition_name(ctx, field)
+func (ec *executionContext) _ConfigurationChange_eventType(ctx context.Context, field graphql.CollectedField, obj *model.ConfigurationChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationChange_eventType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4761,7 +6915,7 @@ func (ec *executionContext) _ParameterDefinition_name(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.EventType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4773,26 +6927,26 @@ func (ec *executionContext) _ParameterDefinition_name(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.EventType)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNEventType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐEventType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ParameterDefinition_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfigurationChange_eventType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ParameterDefinition",
+		Object:     "ConfigurationChange",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type EventType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ParameterDefinition_label(ctx context.Context, field graphql.CollectedField, obj *model.ParameterDefinition) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ParameterDefinition_label(ctx, field)
+func (ec *executionContext) _ConfigurationConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.ConfigurationConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4805,7 +6959,7 @@ func (ec *executionContext) _ParameterDefinition_label(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Label, nil
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4817,26 +6971,32 @@ func (ec *executionContext) _ParameterDefinition_label(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.ConfigurationEdge)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNConfigurationEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ParameterDefinition_label(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfigurationConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ParameterDefinition",
+		Object:     "ConfigurationConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_ConfigurationEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_ConfigurationEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ConfigurationEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ParameterDefinition_description(ctx context.Context, field graphql.CollectedField, obj *model.ParameterDefinition) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ParameterDefinition_description(ctx, field)
+func (ec *executionContext) _ConfigurationConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ConfigurationConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4849,7 +7009,7 @@ func (ec *executionContext) _ParameterDefinition_description(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4861,26 +7021,32 @@ func (ec *executionContext) _ParameterDefinition_description(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ParameterDefinition_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfigurationConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ParameterDefinition",
+		Object:     "ConfigurationConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ParameterDefinition_required(ctx context.Context, field graphql.CollectedField, obj *model.ParameterDefinition) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ParameterDefinition_required(ctx, field)
+func (ec *executionContext) _ConfigurationEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.ConfigurationEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4893,7 +7059,7 @@ func (ec *executionContext) _ParameterDefinition_required(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Required, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4905,26 +7071,26 @@ func (ec *executionContext) _ParameterDefinition_required(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ParameterDefinition_required(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfigurationEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ParameterDefinition",
+		Object:     "ConfigurationEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ParameterDefinition_type(ctx context.Context, field graphql.CollectedField, obj *model.ParameterDefinition) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ParameterDefinition_type(ctx, field)
+func (ec *executionContext) _ConfigurationEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.ConfigurationEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4937,7 +7103,7 @@ func (ec *executionContext) _ParameterDefinition_type(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ParameterDefinition().Type(rctx, obj)
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4949,26 +7115,40 @@ func (ec *executionContext) _ParameterDefinition_type(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model1.ParameterType)
+	res := resTmp.(*model1.Configuration)
 	fc.Result = res
-	return ec.marshalNParameterType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐParameterType(ctx, field.Selections, res)
+	return ec.marshalNConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfiguration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ParameterDefinition_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfigurationEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ParameterDefinition",
+		Object:     "ConfigurationEdge",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ParameterType does not have child fields")
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Configuration_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Configuration_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Configuration_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Configuration_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Configuration_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Configuration_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Configuration", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ParameterDefinition_validValues(ctx context.Context, field graphql.CollectedField, obj *model.ParameterDefinition) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ParameterDefinition_validValues(ctx, field)
+func (ec *executionContext) _ConfigurationSpec_contentType(ctx context.Context, field graphql.CollectedField, obj *model1.ConfigurationSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationSpec_contentType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4981,7 +7161,7 @@ func (ec *executionContext) _ParameterDefinition_validValues(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ValidValues, nil
+		return obj.ContentType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4990,14 +7170,14 @@ func (ec *executionContext) _ParameterDefinition_validValues(ctx context.Context
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ParameterDefinition_validValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfigurationSpec_contentType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ParameterDefinition",
+		Object:     "ConfigurationSpec",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5008,8 +7188,8 @@ func (ec *executionContext) fieldContext_ParameterDefinition_validValues(ctx con
 	return fc, nil
 }
 
-func (ec *executionContext) _ParameterDefinition_default(ctx context.Context, field graphql.CollectedField, obj *model.ParameterDefinition) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ParameterDefinition_default(ctx, field)
+func (ec *executionContext) _ConfigurationSpec_raw(ctx context.Context, field graphql.CollectedField, obj *model1.ConfigurationSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationSpec_raw(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5022,7 +7202,7 @@ func (ec *executionContext) _ParameterDefinition_default(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Default, nil
+		return obj.Raw, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5031,26 +7211,26 @@ func (ec *executionContext) _ParameterDefinition_default(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(interface{})
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOAny2interface(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ParameterDefinition_default(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfigurationSpec_raw(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ParameterDefinition",
+		Object:     "ConfigurationSpec",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Any does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ParameterDefinition_relevantIf(ctx context.Context, field graphql.CollectedField, obj *model.ParameterDefinition) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ParameterDefinition_relevantIf(ctx, field)
+func (ec *executionContext) _ConfigurationSpec_sources(ctx context.Context, field graphql.CollectedField, obj *model1.ConfigurationSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationSpec_sources(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5063,7 +7243,7 @@ func (ec *executionContext) _ParameterDefinition_relevantIf(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RelevantIf, nil
+		return obj.Sources, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5072,34 +7252,36 @@ func (ec *executionContext) _ParameterDefinition_relevantIf(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]model.RelevantIfCondition)
+	res := resTmp.([]model1.ResourceConfiguration)
 	fc.Result = res
-	return ec.marshalORelevantIfCondition2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐRelevantIfConditionᚄ(ctx, field.Selections, res)
+	return ec.marshalOResourceConfiguration2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceConfigurationᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ParameterDefinition_relevantIf(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfigurationSpec_sources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ParameterDefinition",
+		Object:     "ConfigurationSpec",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "name":
-				return ec.fieldContext_RelevantIfCondition_name(ctx, field)
-			case "operator":
-				return ec.fieldContext_RelevantIfCondition_operator(ctx, field)
-			case "value":
-				return ec.fieldContext_RelevantIfCondition_value(ctx, field)
+				return ec.fieldContext_ResourceConfiguration_name(ctx, field)
+			case "type":
+				return ec.fieldContext_ResourceConfiguration_type(ctx, field)
+			case "parameters":
+				return ec.fieldContext_ResourceConfiguration_parameters(ctx, field)
+			case "processors":
+				return ec.fieldContext_ResourceConfiguration_processors(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RelevantIfCondition", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceConfiguration", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ParameterizedSpec_type(ctx context.Context, field graphql.CollectedField, obj *model.ParameterizedSpec) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ParameterizedSpec_type(ctx, field)
+func (ec *executionContext) _ConfigurationSpec_destinations(ctx context.Context, field graphql.CollectedField, obj *model1.ConfigurationSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationSpec_destinations(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5112,38 +7294,45 @@ func (ec *executionContext) _ParameterizedSpec_type(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Destinations, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]model1.ResourceConfiguration)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOResourceConfiguration2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceConfigurationᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ParameterizedSpec_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfigurationSpec_destinations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ParameterizedSpec",
+		Object:     "ConfigurationSpec",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_ResourceConfiguration_name(ctx, field)
+			case "type":
+				return ec.fieldContext_ResourceConfiguration_type(ctx, field)
+			case "parameters":
+				return ec.fieldContext_ResourceConfiguration_parameters(ctx, field)
+			case "processors":
+				return ec.fieldContext_ResourceConfiguration_processors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceConfiguration", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ParameterizedSpec_parameters(ctx context.Context, field graphql.CollectedField, obj *model.ParameterizedSpec) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ParameterizedSpec_parameters(ctx, field)
+func (ec *executionContext) _ConfigurationSpec_processors(ctx context.Context, field graphql.CollectedField, obj *model1.ConfigurationSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationSpec_processors(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5156,7 +7345,7 @@ func (ec *executionContext) _ParameterizedSpec_parameters(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Parameters, nil
+		return obj.Processors, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5165,32 +7354,268 @@ func (ec *executionContext) _ParameterizedSpec_parameters(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]model.Parameter)
+	res := resTmp.([]model1.ResourceConfiguration)
 	fc.Result = res
-	return ec.marshalOParameter2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterᚄ(ctx, field.Selections, res)
+	return ec.marshalOResourceConfiguration2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceConfigurationᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ParameterizedSpec_parameters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfigurationSpec_processors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ParameterizedSpec",
+		Object:     "ConfigurationSpec",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "name":
-				return ec.fieldContext_Parameter_name(ctx, field)
-			case "value":
-				return ec.fieldContext_Parameter_value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Parameter", field.Name)
-		},
-	}
+				return ec.fieldContext_ResourceConfiguration_name(ctx, field)
+			case "type":
+				return ec.fieldContext_ResourceConfiguration_type(ctx, field)
+			case "parameters":
+				return ec.fieldContext_ResourceConfiguration_parameters(ctx, field)
+			case "processors":
+				return ec.fieldContext_ResourceConfiguration_processors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceConfiguration", field.Name)
+		},
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Processor_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Processor_apiVersion(ctx, field)
+func (ec *executionContext) _ConfigurationSpec_selector(ctx context.Context, field graphql.CollectedField, obj *model1.ConfigurationSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationSpec_selector(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Selector, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(model1.AgentSelector)
+	fc.Result = res
+	return ec.marshalOAgentSelector2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentSelector(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ConfigurationSpec_selector(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConfigurationSpec",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "matchLabels":
+				return ec.fieldContext_AgentSelector_matchLabels(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AgentSelector", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ConfigurationSpec_agentGroup(ctx context.Context, field graphql.CollectedField, obj *model1.ConfigurationSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConfigurationSpec_agentGroup(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AgentGroup, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ConfigurationSpec_agentGroup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ConfigurationSpec",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Configurations_query(ctx context.Context, field graphql.CollectedField, obj *model.Configurations) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Configurations_query(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Query, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Configurations_query(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Configurations",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Configurations_configurations(ctx context.Context, field graphql.CollectedField, obj *model.Configurations) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Configurations_configurations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Configurations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.Configuration)
+	fc.Result = res
+	return ec.marshalNConfiguration2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfigurationᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Configurations_configurations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Configurations",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Configuration_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Configuration_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Configuration_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Configuration_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Configuration_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Configuration_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Configuration", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Configurations_suggestions(ctx context.Context, field graphql.CollectedField, obj *model.Configurations) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Configurations_suggestions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Suggestions, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*search.Suggestion)
+	fc.Result = res
+	return ec.marshalOSuggestion2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋstoreᚋsearchᚐSuggestionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Configurations_suggestions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Configurations",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "label":
+				return ec.fieldContext_Suggestion_label(ctx, field)
+			case "query":
+				return ec.fieldContext_Suggestion_query(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Suggestion", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Connector_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model1.Connector) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Connector_apiVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5220,9 +7645,9 @@ func (ec *executionContext) _Processor_apiVersion(ctx context.Context, field gra
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Processor_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Connector_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Connector",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5233,8 +7658,8 @@ func (ec *executionContext) fieldContext_Processor_apiVersion(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _Processor_kind(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Processor_kind(ctx, field)
+func (ec *executionContext) _Connector_kind(ctx context.Context, field graphql.CollectedField, obj *model1.Connector) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Connector_kind(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5247,7 +7672,7 @@ func (ec *executionContext) _Processor_kind(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Processor().Kind(rctx, obj)
+		return ec.resolvers.Connector().Kind(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5264,9 +7689,9 @@ func (ec *executionContext) _Processor_kind(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Processor_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Connector_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Connector",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
@@ -5277,8 +7702,8 @@ func (ec *executionContext) fieldContext_Processor_kind(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Processor_metadata(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Processor_metadata(ctx, field)
+func (ec *executionContext) _Connector_metadata(ctx context.Context, field graphql.CollectedField, obj *model1.Connector) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Connector_metadata(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5303,14 +7728,14 @@ func (ec *executionContext) _Processor_metadata(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Metadata)
+	res := resTmp.(model1.Metadata)
 	fc.Result = res
 	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Processor_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Connector_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Connector",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5328,6 +7753,8 @@ func (ec *executionContext) fieldContext_Processor_metadata(ctx context.Context,
 				return ec.fieldContext_Metadata_icon(ctx, field)
 			case "labels":
 				return ec.fieldContext_Metadata_labels(ctx, field)
+			case "resourceVersion":
+				return ec.fieldContext_Metadata_resourceVersion(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
 		},
@@ -5335,8 +7762,8 @@ func (ec *executionContext) fieldContext_Processor_metadata(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Processor_spec(ctx context.Context, field graphql.CollectedField, obj *model.Processor) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Processor_spec(ctx, field)
+func (ec *executionContext) _Connector_spec(ctx context.Context, field graphql.CollectedField, obj *model1.Connector) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Connector_spec(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5361,14 +7788,14 @@ func (ec *executionContext) _Processor_spec(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ParameterizedSpec)
+	res := resTmp.(model1.ParameterizedSpec)
 	fc.Result = res
 	return ec.marshalNParameterizedSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterizedSpec(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Processor_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Connector_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Processor",
+		Object:     "Connector",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5385,8 +7812,8 @@ func (ec *executionContext) fieldContext_Processor_spec(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _ProcessorType_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProcessorType_apiVersion(ctx, field)
+func (ec *executionContext) _ConnectorType_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model1.ConnectorType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConnectorType_apiVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5416,9 +7843,9 @@ func (ec *executionContext) _ProcessorType_apiVersion(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProcessorType_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectorType_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProcessorType",
+		Object:     "ConnectorType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5429,8 +7856,8 @@ func (ec *executionContext) fieldContext_ProcessorType_apiVersion(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _ProcessorType_metadata(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProcessorType_metadata(ctx, field)
+func (ec *executionContext) _ConnectorType_metadata(ctx context.Context, field graphql.CollectedField, obj *model1.ConnectorType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConnectorType_metadata(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5455,14 +7882,14 @@ func (ec *executionContext) _ProcessorType_metadata(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Metadata)
+	res := resTmp.(model1.Metadata)
 	fc.Result = res
 	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProcessorType_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectorType_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProcessorType",
+		Object:     "ConnectorType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5480,6 +7907,8 @@ func (ec *executionContext) fieldContext_ProcessorType_metadata(ctx context.Cont
 				return ec.fieldContext_Metadata_icon(ctx, field)
 			case "labels":
 				return ec.fieldContext_Metadata_labels(ctx, field)
+			case "resourceVersion":
+				return ec.fieldContext_Metadata_resourceVersion(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
 		},
@@ -5487,8 +7916,8 @@ func (ec *executionContext) fieldContext_ProcessorType_metadata(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _ProcessorType_kind(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProcessorType_kind(ctx, field)
+func (ec *executionContext) _ConnectorType_kind(ctx context.Context, field graphql.CollectedField, obj *model1.ConnectorType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConnectorType_kind(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5501,7 +7930,7 @@ func (ec *executionContext) _ProcessorType_kind(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ProcessorType().Kind(rctx, obj)
+		return ec.resolvers.ConnectorType().Kind(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5518,9 +7947,9 @@ func (ec *executionContext) _ProcessorType_kind(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProcessorType_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectorType_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProcessorType",
+		Object:     "ConnectorType",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
@@ -5531,8 +7960,8 @@ func (ec *executionContext) fieldContext_ProcessorType_kind(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _ProcessorType_spec(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ProcessorType_spec(ctx, field)
+func (ec *executionContext) _ConnectorType_spec(ctx context.Context, field graphql.CollectedField, obj *model1.ConnectorType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ConnectorType_spec(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5557,14 +7986,14 @@ func (ec *executionContext) _ProcessorType_spec(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ResourceTypeSpec)
+	res := resTmp.(model1.ResourceTypeSpec)
 	fc.Result = res
 	return ec.marshalNResourceTypeSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceTypeSpec(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ProcessorType_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConnectorType_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProcessorType",
+		Object:     "ConnectorType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5585,8 +8014,8 @@ func (ec *executionContext) fieldContext_ProcessorType_spec(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_agents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_agents(ctx, field)
+func (ec *executionContext) _Destination_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model1.Destination) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Destination_apiVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5599,7 +8028,7 @@ func (ec *executionContext) _Query_agents(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Agents(rctx, fc.Args["selector"].(*string), fc.Args["query"].(*string))
+		return obj.APIVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5611,45 +8040,26 @@ func (ec *executionContext) _Query_agents(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model1.Agents)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNAgents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgents(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_agents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Destination_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Destination",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "query":
-				return ec.fieldContext_Agents_query(ctx, field)
-			case "agents":
-				return ec.fieldContext_Agents_agents(ctx, field)
-			case "suggestions":
-				return ec.fieldContext_Agents_suggestions(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Agents", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_agents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_agent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_agent(ctx, field)
+func (ec *executionContext) _Destination_kind(ctx context.Context, field graphql.CollectedField, obj *model1.Destination) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Destination_kind(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5662,84 +8072,38 @@ func (ec *executionContext) _Query_agent(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Agent(rctx, fc.Args["id"].(string))
+		return ec.resolvers.Destination().Kind(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Agent)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOAgent2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgent(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_agent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Destination_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Destination",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Agent_id(ctx, field)
-			case "architecture":
-				return ec.fieldContext_Agent_architecture(ctx, field)
-			case "hostName":
-				return ec.fieldContext_Agent_hostName(ctx, field)
-			case "labels":
-				return ec.fieldContext_Agent_labels(ctx, field)
-			case "platform":
-				return ec.fieldContext_Agent_platform(ctx, field)
-			case "operatingSystem":
-				return ec.fieldContext_Agent_operatingSystem(ctx, field)
-			case "version":
-				return ec.fieldContext_Agent_version(ctx, field)
-			case "name":
-				return ec.fieldContext_Agent_name(ctx, field)
-			case "home":
-				return ec.fieldContext_Agent_home(ctx, field)
-			case "macAddress":
-				return ec.fieldContext_Agent_macAddress(ctx, field)
-			case "remoteAddress":
-				return ec.fieldContext_Agent_remoteAddress(ctx, field)
-			case "type":
-				return ec.fieldContext_Agent_type(ctx, field)
-			case "status":
-				return ec.fieldContext_Agent_status(ctx, field)
-			case "errorMessage":
-				return ec.fieldContext_Agent_errorMessage(ctx, field)
-			case "connectedAt":
-				return ec.fieldContext_Agent_connectedAt(ctx, field)
-			case "disconnectedAt":
-				return ec.fieldContext_Agent_disconnectedAt(ctx, field)
-			case "configuration":
-				return ec.fieldContext_Agent_configuration(ctx, field)
-			case "configurationResource":
-				return ec.fieldContext_Agent_configurationResource(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Agent", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_agent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_configurations(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_configurations(ctx, field)
+func (ec *executionContext) _Destination_metadata(ctx context.Context, field graphql.CollectedField, obj *model1.Destination) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Destination_metadata(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5752,7 +8116,7 @@ func (ec *executionContext) _Query_configurations(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Configurations(rctx, fc.Args["selector"].(*string), fc.Args["query"].(*string))
+		return obj.Metadata, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5764,45 +8128,42 @@ func (ec *executionContext) _Query_configurations(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model1.Configurations)
+	res := resTmp.(model1.Metadata)
 	fc.Result = res
-	return ec.marshalNConfigurations2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurations(ctx, field.Selections, res)
+	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_configurations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Destination_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Destination",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "query":
-				return ec.fieldContext_Configurations_query(ctx, field)
-			case "configurations":
-				return ec.fieldContext_Configurations_configurations(ctx, field)
-			case "suggestions":
-				return ec.fieldContext_Configurations_suggestions(ctx, field)
+			case "id":
+				return ec.fieldContext_Metadata_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Metadata_name(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Metadata_displayName(ctx, field)
+			case "description":
+				return ec.fieldContext_Metadata_description(ctx, field)
+			case "icon":
+				return ec.fieldContext_Metadata_icon(ctx, field)
+			case "labels":
+				return ec.fieldContext_Metadata_labels(ctx, field)
+			case "resourceVersion":
+				return ec.fieldContext_Metadata_resourceVersion(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Configurations", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_configurations_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_configuration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_configuration(ctx, field)
+func (ec *executionContext) _Destination_spec(ctx context.Context, field graphql.CollectedField, obj *model1.Destination) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Destination_spec(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5815,56 +8176,44 @@ func (ec *executionContext) _Query_configuration(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Configuration(rctx, fc.Args["name"].(string))
+		return obj.Spec, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Configuration)
+	res := resTmp.(model1.ParameterizedSpec)
 	fc.Result = res
-	return ec.marshalOConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfiguration(ctx, field.Selections, res)
+	return ec.marshalNParameterizedSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterizedSpec(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_configuration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Destination_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Destination",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_Configuration_apiVersion(ctx, field)
-			case "kind":
-				return ec.fieldContext_Configuration_kind(ctx, field)
-			case "metadata":
-				return ec.fieldContext_Configuration_metadata(ctx, field)
-			case "spec":
-				return ec.fieldContext_Configuration_spec(ctx, field)
+			case "type":
+				return ec.fieldContext_ParameterizedSpec_type(ctx, field)
+			case "parameters":
+				return ec.fieldContext_ParameterizedSpec_parameters(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Configuration", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ParameterizedSpec", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_configuration_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_sources(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_sources(ctx, field)
+func (ec *executionContext) _Destination_dependents(ctx context.Context, field graphql.CollectedField, obj *model1.Destination) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Destination_dependents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5877,7 +8226,7 @@ func (ec *executionContext) _Query_sources(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Sources(rctx)
+		return ec.resolvers.Destination().Dependents(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5889,36 +8238,32 @@ func (ec *executionContext) _Query_sources(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Source)
+	res := resTmp.(*model1.ResourceDependents)
 	fc.Result = res
-	return ec.marshalNSource2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceᚄ(ctx, field.Selections, res)
+	return ec.marshalNResourceDependents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceDependents(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_sources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Destination_dependents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Destination",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_Source_apiVersion(ctx, field)
-			case "kind":
-				return ec.fieldContext_Source_kind(ctx, field)
-			case "metadata":
-				return ec.fieldContext_Source_metadata(ctx, field)
-			case "spec":
-				return ec.fieldContext_Source_spec(ctx, field)
+			case "resources":
+				return ec.fieldContext_ResourceDependents_resources(ctx, field)
+			case "agentIds":
+				return ec.fieldContext_ResourceDependents_agentIds(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceDependents", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_source(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_source(ctx, field)
+func (ec *executionContext) _Destination_dependencies(ctx context.Context, field graphql.CollectedField, obj *model1.Destination) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Destination_dependencies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5931,56 +8276,44 @@ func (ec *executionContext) _Query_source(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Source(rctx, fc.Args["name"].(string))
+		return ec.resolvers.Destination().Dependencies(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Source)
+	res := resTmp.([]*model1.ResourceReference)
 	fc.Result = res
-	return ec.marshalOSource2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSource(ctx, field.Selections, res)
+	return ec.marshalNResourceReference2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceReferenceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_source(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Destination_dependencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Destination",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_Source_apiVersion(ctx, field)
 			case "kind":
-				return ec.fieldContext_Source_kind(ctx, field)
-			case "metadata":
-				return ec.fieldContext_Source_metadata(ctx, field)
-			case "spec":
-				return ec.fieldContext_Source_spec(ctx, field)
+				return ec.fieldContext_ResourceReference_kind(ctx, field)
+			case "name":
+				return ec.fieldContext_ResourceReference_name(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceReference", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_source_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_sourceTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_sourceTypes(ctx, field)
+func (ec *executionContext) _DestinationChange_destination(ctx context.Context, field graphql.CollectedField, obj *model.DestinationChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationChange_destination(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5993,7 +8326,7 @@ func (ec *executionContext) _Query_sourceTypes(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().SourceTypes(rctx)
+		return obj.Destination, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6005,36 +8338,40 @@ func (ec *executionContext) _Query_sourceTypes(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.SourceType)
+	res := resTmp.(*model1.Destination)
 	fc.Result = res
-	return ec.marshalNSourceType2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNDestination2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestination(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_sourceTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationChange_destination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationChange",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "apiVersion":
-				return ec.fieldContext_SourceType_apiVersion(ctx, field)
-			case "metadata":
-				return ec.fieldContext_SourceType_metadata(ctx, field)
+				return ec.fieldContext_Destination_apiVersion(ctx, field)
 			case "kind":
-				return ec.fieldContext_SourceType_kind(ctx, field)
+				return ec.fieldContext_Destination_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Destination_metadata(ctx, field)
 			case "spec":
-				return ec.fieldContext_SourceType_spec(ctx, field)
+				return ec.fieldContext_Destination_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Destination_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Destination_dependencies(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SourceType", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Destination", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_sourceType(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_sourceType(ctx, field)
+func (ec *executionContext) _DestinationChange_eventType(ctx context.Context, field graphql.CollectedField, obj *model.DestinationChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationChange_eventType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6047,56 +8384,38 @@ func (ec *executionContext) _Query_sourceType(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().SourceType(rctx, fc.Args["name"].(string))
+		return obj.EventType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.SourceType)
+	res := resTmp.(model.EventType)
 	fc.Result = res
-	return ec.marshalOSourceType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceType(ctx, field.Selections, res)
+	return ec.marshalNEventType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐEventType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_sourceType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationChange_eventType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationChange",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_SourceType_apiVersion(ctx, field)
-			case "metadata":
-				return ec.fieldContext_SourceType_metadata(ctx, field)
-			case "kind":
-				return ec.fieldContext_SourceType_kind(ctx, field)
-			case "spec":
-				return ec.fieldContext_SourceType_spec(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SourceType", field.Name)
+			return nil, errors.New("field of type EventType does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_sourceType_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_processors(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_processors(ctx, field)
+func (ec *executionContext) _DestinationConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.DestinationConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6109,7 +8428,7 @@ func (ec *executionContext) _Query_processors(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Processors(rctx)
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6121,36 +8440,32 @@ func (ec *executionContext) _Query_processors(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Processor)
+	res := resTmp.([]*model.DestinationEdge)
 	fc.Result = res
-	return ec.marshalNProcessor2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorᚄ(ctx, field.Selections, res)
+	return ec.marshalNDestinationEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_processors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_Processor_apiVersion(ctx, field)
-			case "kind":
-				return ec.fieldContext_Processor_kind(ctx, field)
-			case "metadata":
-				return ec.fieldContext_Processor_metadata(ctx, field)
-			case "spec":
-				return ec.fieldContext_Processor_spec(ctx, field)
+			case "cursor":
+				return ec.fieldContext_DestinationEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_DestinationEdge_node(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Processor", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type DestinationEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_processor(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_processor(ctx, field)
+func (ec *executionContext) _DestinationConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.DestinationConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6163,56 +8478,44 @@ func (ec *executionContext) _Query_processor(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Processor(rctx, fc.Args["name"].(string))
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Processor)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalOProcessor2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessor(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_processor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_Processor_apiVersion(ctx, field)
-			case "kind":
-				return ec.fieldContext_Processor_kind(ctx, field)
-			case "metadata":
-				return ec.fieldContext_Processor_metadata(ctx, field)
-			case "spec":
-				return ec.fieldContext_Processor_spec(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Processor", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_processor_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_processorTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_processorTypes(ctx, field)
+func (ec *executionContext) _DestinationEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.DestinationEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6225,7 +8528,7 @@ func (ec *executionContext) _Query_processorTypes(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ProcessorTypes(rctx)
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6237,36 +8540,26 @@ func (ec *executionContext) _Query_processorTypes(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ProcessorType)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNProcessorType2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_processorTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationEdge",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_ProcessorType_apiVersion(ctx, field)
-			case "metadata":
-				return ec.fieldContext_ProcessorType_metadata(ctx, field)
-			case "kind":
-				return ec.fieldContext_ProcessorType_kind(ctx, field)
-			case "spec":
-				return ec.fieldContext_ProcessorType_spec(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ProcessorType", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_processorType(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_processorType(ctx, field)
+func (ec *executionContext) _DestinationEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.DestinationEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6279,56 +8572,52 @@ func (ec *executionContext) _Query_processorType(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ProcessorType(rctx, fc.Args["name"].(string))
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ProcessorType)
+	res := resTmp.(*model1.Destination)
 	fc.Result = res
-	return ec.marshalOProcessorType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorType(ctx, field.Selections, res)
+	return ec.marshalNDestination2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestination(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_processorType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationEdge",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "apiVersion":
-				return ec.fieldContext_ProcessorType_apiVersion(ctx, field)
-			case "metadata":
-				return ec.fieldContext_ProcessorType_metadata(ctx, field)
+				return ec.fieldContext_Destination_apiVersion(ctx, field)
 			case "kind":
-				return ec.fieldContext_ProcessorType_kind(ctx, field)
+				return ec.fieldContext_Destination_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Destination_metadata(ctx, field)
 			case "spec":
-				return ec.fieldContext_ProcessorType_spec(ctx, field)
+				return ec.fieldContext_Destination_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Destination_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Destination_dependencies(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ProcessorType", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Destination", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_processorType_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_destinations(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_destinations(ctx, field)
+func (ec *executionContext) _DestinationType_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model1.DestinationType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationType_apiVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6341,7 +8630,7 @@ func (ec *executionContext) _Query_destinations(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Destinations(rctx)
+		return obj.APIVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6353,36 +8642,26 @@ func (ec *executionContext) _Query_destinations(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Destination)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNDestination2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_destinations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationType_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationType",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_Destination_apiVersion(ctx, field)
-			case "kind":
-				return ec.fieldContext_Destination_kind(ctx, field)
-			case "metadata":
-				return ec.fieldContext_Destination_metadata(ctx, field)
-			case "spec":
-				return ec.fieldContext_Destination_spec(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Destination", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_destination(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_destination(ctx, field)
+func (ec *executionContext) _DestinationType_metadata(ctx context.Context, field graphql.CollectedField, obj *model1.DestinationType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationType_metadata(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6395,56 +8674,54 @@ func (ec *executionContext) _Query_destination(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Destination(rctx, fc.Args["name"].(string))
+		return obj.Metadata, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Destination)
+	res := resTmp.(model1.Metadata)
 	fc.Result = res
-	return ec.marshalODestination2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestination(ctx, field.Selections, res)
+	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_destination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationType_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationType",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_Destination_apiVersion(ctx, field)
-			case "kind":
-				return ec.fieldContext_Destination_kind(ctx, field)
-			case "metadata":
-				return ec.fieldContext_Destination_metadata(ctx, field)
-			case "spec":
-				return ec.fieldContext_Destination_spec(ctx, field)
+			case "id":
+				return ec.fieldContext_Metadata_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Metadata_name(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Metadata_displayName(ctx, field)
+			case "description":
+				return ec.fieldContext_Metadata_description(ctx, field)
+			case "icon":
+				return ec.fieldContext_Metadata_icon(ctx, field)
+			case "labels":
+				return ec.fieldContext_Metadata_labels(ctx, field)
+			case "resourceVersion":
+				return ec.fieldContext_Metadata_resourceVersion(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Destination", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_destination_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_destinationWithType(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_destinationWithType(ctx, field)
+func (ec *executionContext) _DestinationType_kind(ctx context.Context, field graphql.CollectedField, obj *model1.DestinationType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationType_kind(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6457,7 +8734,7 @@ func (ec *executionContext) _Query_destinationWithType(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().DestinationWithType(rctx, fc.Args["name"].(string))
+		return ec.resolvers.DestinationType().Kind(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6469,43 +8746,26 @@ func (ec *executionContext) _Query_destinationWithType(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model1.DestinationWithType)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNDestinationWithType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationWithType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_destinationWithType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationType_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationType",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "destination":
-				return ec.fieldContext_DestinationWithType_destination(ctx, field)
-			case "destinationType":
-				return ec.fieldContext_DestinationWithType_destinationType(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DestinationWithType", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_destinationWithType_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_destinationTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_destinationTypes(ctx, field)
+func (ec *executionContext) _DestinationType_spec(ctx context.Context, field graphql.CollectedField, obj *model1.DestinationType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationType_spec(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6518,7 +8778,7 @@ func (ec *executionContext) _Query_destinationTypes(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().DestinationTypes(rctx)
+		return obj.Spec, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6530,36 +8790,36 @@ func (ec *executionContext) _Query_destinationTypes(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.DestinationType)
+	res := resTmp.(model1.ResourceTypeSpec)
 	fc.Result = res
-	return ec.marshalNDestinationType2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNResourceTypeSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceTypeSpec(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_destinationTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationType_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationType",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_DestinationType_apiVersion(ctx, field)
-			case "metadata":
-				return ec.fieldContext_DestinationType_metadata(ctx, field)
-			case "kind":
-				return ec.fieldContext_DestinationType_kind(ctx, field)
-			case "spec":
-				return ec.fieldContext_DestinationType_spec(ctx, field)
+			case "version":
+				return ec.fieldContext_ResourceTypeSpec_version(ctx, field)
+			case "parameters":
+				return ec.fieldContext_ResourceTypeSpec_parameters(ctx, field)
+			case "supportedPlatforms":
+				return ec.fieldContext_ResourceTypeSpec_supportedPlatforms(ctx, field)
+			case "telemetryTypes":
+				return ec.fieldContext_ResourceTypeSpec_telemetryTypes(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DestinationType", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceTypeSpec", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_destinationType(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_destinationType(ctx, field)
+func (ec *executionContext) _DestinationType_dependents(ctx context.Context, field graphql.CollectedField, obj *model1.DestinationType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationType_dependents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6572,56 +8832,44 @@ func (ec *executionContext) _Query_destinationType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().DestinationType(rctx, fc.Args["name"].(string))
+		return ec.resolvers.DestinationType().Dependents(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DestinationType)
+	res := resTmp.(*model1.ResourceDependents)
 	fc.Result = res
-	return ec.marshalODestinationType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationType(ctx, field.Selections, res)
+	return ec.marshalNResourceDependents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceDependents(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_destinationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationType_dependents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationType",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "apiVersion":
-				return ec.fieldContext_DestinationType_apiVersion(ctx, field)
-			case "metadata":
-				return ec.fieldContext_DestinationType_metadata(ctx, field)
-			case "kind":
-				return ec.fieldContext_DestinationType_kind(ctx, field)
-			case "spec":
-				return ec.fieldContext_DestinationType_spec(ctx, field)
+			case "resources":
+				return ec.fieldContext_ResourceDependents_resources(ctx, field)
+			case "agentIds":
+				return ec.fieldContext_ResourceDependents_agentIds(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DestinationType", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceDependents", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_destinationType_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_components(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_components(ctx, field)
+func (ec *executionContext) _DestinationType_dependencies(ctx context.Context, field graphql.CollectedField, obj *model1.DestinationType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationType_dependencies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6634,7 +8882,7 @@ func (ec *executionContext) _Query_components(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Components(rctx)
+		return ec.resolvers.DestinationType().Dependencies(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6646,32 +8894,32 @@ func (ec *executionContext) _Query_components(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model1.Components)
+	res := resTmp.([]*model1.ResourceReference)
 	fc.Result = res
-	return ec.marshalNComponents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐComponents(ctx, field.Selections, res)
+	return ec.marshalNResourceReference2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceReferenceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_components(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationType_dependencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationType",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "sources":
-				return ec.fieldContext_Components_sources(ctx, field)
-			case "destinations":
-				return ec.fieldContext_Components_destinations(ctx, field)
+			case "kind":
+				return ec.fieldContext_ResourceReference_kind(ctx, field)
+			case "name":
+				return ec.fieldContext_ResourceReference_name(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Components", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceReference", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _DestinationTypeConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.DestinationTypeConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationTypeConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6684,68 +8932,44 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*model.DestinationTypeEdge)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNDestinationTypeEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationTypeEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationTypeConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationTypeConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "cursor":
+				return ec.fieldContext_DestinationTypeEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_DestinationTypeEdge_node(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type DestinationTypeEdge", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _DestinationTypeConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.DestinationTypeConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationTypeConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6758,49 +8982,44 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationTypeConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DestinationTypeConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelevantIfCondition_name(ctx context.Context, field graphql.CollectedField, obj *model.RelevantIfCondition) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelevantIfCondition_name(ctx, field)
+func (ec *executionContext) _DestinationTypeEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.DestinationTypeEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationTypeEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6813,7 +9032,7 @@ func (ec *executionContext) _RelevantIfCondition_name(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6830,9 +9049,9 @@ func (ec *executionContext) _RelevantIfCondition_name(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelevantIfCondition_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationTypeEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelevantIfCondition",
+		Object:     "DestinationTypeEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6843,8 +9062,8 @@ func (ec *executionContext) fieldContext_RelevantIfCondition_name(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _RelevantIfCondition_operator(ctx context.Context, field graphql.CollectedField, obj *model.RelevantIfCondition) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelevantIfCondition_operator(ctx, field)
+func (ec *executionContext) _DestinationTypeEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.DestinationTypeEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationTypeEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6857,7 +9076,7 @@ func (ec *executionContext) _RelevantIfCondition_operator(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.RelevantIfCondition().Operator(rctx, obj)
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6869,26 +9088,40 @@ func (ec *executionContext) _RelevantIfCondition_operator(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model1.RelevantIfOperatorType)
+	res := resTmp.(*model1.DestinationType)
 	fc.Result = res
-	return ec.marshalNRelevantIfOperatorType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐRelevantIfOperatorType(ctx, field.Selections, res)
+	return ec.marshalNDestinationType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelevantIfCondition_operator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationTypeEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelevantIfCondition",
+		Object:     "DestinationTypeEdge",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type RelevantIfOperatorType does not have child fields")
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_DestinationType_apiVersion(ctx, field)
+			case "metadata":
+				return ec.fieldContext_DestinationType_metadata(ctx, field)
+			case "kind":
+				return ec.fieldContext_DestinationType_kind(ctx, field)
+			case "spec":
+				return ec.fieldContext_DestinationType_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_DestinationType_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_DestinationType_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DestinationType", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelevantIfCondition_value(ctx context.Context, field graphql.CollectedField, obj *model.RelevantIfCondition) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelevantIfCondition_value(ctx, field)
+func (ec *executionContext) _DestinationWithType_destination(ctx context.Context, field graphql.CollectedField, obj *model.DestinationWithType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationWithType_destination(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6901,38 +9134,49 @@ func (ec *executionContext) _RelevantIfCondition_value(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Destination, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(any)
+	res := resTmp.(*model1.Destination)
 	fc.Result = res
-	return ec.marshalNAny2interface(ctx, field.Selections, res)
+	return ec.marshalODestination2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestination(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelevantIfCondition_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationWithType_destination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelevantIfCondition",
+		Object:     "DestinationWithType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Any does not have child fields")
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Destination_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Destination_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Destination_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Destination_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Destination_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Destination_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Destination", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResourceConfiguration_name(ctx context.Context, field graphql.CollectedField, obj *model.ResourceConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ResourceConfiguration_name(ctx, field)
+func (ec *executionContext) _DestinationWithType_destinationType(ctx context.Context, field graphql.CollectedField, obj *model.DestinationWithType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DestinationWithType_destinationType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6945,7 +9189,7 @@ func (ec *executionContext) _ResourceConfiguration_name(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.DestinationType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6954,26 +9198,40 @@ func (ec *executionContext) _ResourceConfiguration_name(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model1.DestinationType)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalODestinationType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ResourceConfiguration_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DestinationWithType_destinationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResourceConfiguration",
+		Object:     "DestinationWithType",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_DestinationType_apiVersion(ctx, field)
+			case "metadata":
+				return ec.fieldContext_DestinationType_metadata(ctx, field)
+			case "kind":
+				return ec.fieldContext_DestinationType_kind(ctx, field)
+			case "spec":
+				return ec.fieldContext_DestinationType_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_DestinationType_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_DestinationType_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DestinationType", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResourceConfiguration_type(ctx context.Context, field graphql.CollectedField, obj *model.ResourceConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ResourceConfiguration_type(ctx, field)
+func (ec *executionContext) _Metadata_id(ctx context.Context, field graphql.CollectedField, obj *model1.Metadata) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Metadata_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6986,35 +9244,38 @@ func (ec *executionContext) _ResourceConfiguration_type(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ResourceConfiguration_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Metadata_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResourceConfiguration",
+		Object:     "Metadata",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResourceConfiguration_parameters(ctx context.Context, field graphql.CollectedField, obj *model.ResourceConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ResourceConfiguration_parameters(ctx, field)
+func (ec *executionContext) _Metadata_name(ctx context.Context, field graphql.CollectedField, obj *model1.Metadata) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Metadata_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7027,41 +9288,38 @@ func (ec *executionContext) _ResourceConfiguration_parameters(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Parameters, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.Parameter)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOParameter2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ResourceConfiguration_parameters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Metadata_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResourceConfiguration",
+		Object:     "Metadata",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_Parameter_name(ctx, field)
-			case "value":
-				return ec.fieldContext_Parameter_value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Parameter", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResourceConfiguration_processors(ctx context.Context, field graphql.CollectedField, obj *model.ResourceConfiguration) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ResourceConfiguration_processors(ctx, field)
+func (ec *executionContext) _Metadata_displayName(ctx context.Context, field graphql.CollectedField, obj *model1.Metadata) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Metadata_displayName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7074,7 +9332,7 @@ func (ec *executionContext) _ResourceConfiguration_processors(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Processors, nil
+		return obj.DisplayName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7083,36 +9341,26 @@ func (ec *executionContext) _ResourceConfiguration_processors(ctx context.Contex
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]model.ResourceConfiguration)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOResourceConfiguration2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceConfigurationᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ResourceConfiguration_processors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Metadata_displayName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResourceConfiguration",
+		Object:     "Metadata",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_ResourceConfiguration_name(ctx, field)
-			case "type":
-				return ec.fieldContext_ResourceConfiguration_type(ctx, field)
-			case "parameters":
-				return ec.fieldContext_ResourceConfiguration_parameters(ctx, field)
-			case "processors":
-				return ec.fieldContext_ResourceConfiguration_processors(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ResourceConfiguration", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResourceTypeSpec_version(ctx context.Context, field graphql.CollectedField, obj *model.ResourceTypeSpec) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ResourceTypeSpec_version(ctx, field)
+func (ec *executionContext) _Metadata_description(ctx context.Context, field graphql.CollectedField, obj *model1.Metadata) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Metadata_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7125,26 +9373,23 @@ func (ec *executionContext) _ResourceTypeSpec_version(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Version, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ResourceTypeSpec_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Metadata_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResourceTypeSpec",
+		Object:     "Metadata",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7155,8 +9400,8 @@ func (ec *executionContext) fieldContext_ResourceTypeSpec_version(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _ResourceTypeSpec_parameters(ctx context.Context, field graphql.CollectedField, obj *model.ResourceTypeSpec) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ResourceTypeSpec_parameters(ctx, field)
+func (ec *executionContext) _Metadata_icon(ctx context.Context, field graphql.CollectedField, obj *model1.Metadata) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Metadata_icon(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7169,56 +9414,35 @@ func (ec *executionContext) _ResourceTypeSpec_parameters(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Parameters, nil
+		return obj.Icon, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.ParameterDefinition)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNParameterDefinition2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterDefinitionᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ResourceTypeSpec_parameters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Metadata_icon(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResourceTypeSpec",
+		Object:     "Metadata",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_ParameterDefinition_name(ctx, field)
-			case "label":
-				return ec.fieldContext_ParameterDefinition_label(ctx, field)
-			case "description":
-				return ec.fieldContext_ParameterDefinition_description(ctx, field)
-			case "required":
-				return ec.fieldContext_ParameterDefinition_required(ctx, field)
-			case "type":
-				return ec.fieldContext_ParameterDefinition_type(ctx, field)
-			case "validValues":
-				return ec.fieldContext_ParameterDefinition_validValues(ctx, field)
-			case "default":
-				return ec.fieldContext_ParameterDefinition_default(ctx, field)
-			case "relevantIf":
-				return ec.fieldContext_ParameterDefinition_relevantIf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ParameterDefinition", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResourceTypeSpec_supportedPlatforms(ctx context.Context, field graphql.CollectedField, obj *model.ResourceTypeSpec) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ResourceTypeSpec_supportedPlatforms(ctx, field)
+func (ec *executionContext) _Metadata_labels(ctx context.Context, field graphql.CollectedField, obj *model1.Metadata) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Metadata_labels(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7231,38 +9455,35 @@ func (ec *executionContext) _ResourceTypeSpec_supportedPlatforms(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SupportedPlatforms, nil
+		return ec.resolvers.Metadata().Labels(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(map[string]interface{})
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ResourceTypeSpec_supportedPlatforms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Metadata_labels(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResourceTypeSpec",
+		Object:     "Metadata",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ResourceTypeSpec_telemetryTypes(ctx context.Context, field graphql.CollectedField, obj *model.ResourceTypeSpec) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ResourceTypeSpec_telemetryTypes(ctx, field)
+func (ec *executionContext) _Metadata_resourceVersion(ctx context.Context, field graphql.CollectedField, obj *model1.Metadata) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Metadata_resourceVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7275,38 +9496,35 @@ func (ec *executionContext) _ResourceTypeSpec_telemetryTypes(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TelemetryTypes(), nil
+		return obj.ResourceVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]otel.PipelineType)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNPipelineType2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalOInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ResourceTypeSpec_telemetryTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Metadata_resourceVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ResourceTypeSpec",
+		Object:     "Metadata",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type PipelineType does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_apiVersion(ctx, field)
+func (ec *executionContext) _Mutation_executeAgentCommand(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_executeAgentCommand(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7319,7 +9537,7 @@ func (ec *executionContext) _Source_apiVersion(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.APIVersion, nil
+		return ec.resolvers.Mutation().ExecuteAgentCommand(rctx, fc.Args["type"].(string), fc.Args["ids"].([]string), fc.Args["selector"].(*string), fc.Args["group"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7331,26 +9549,53 @@ func (ec *executionContext) _Source_apiVersion(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model1.AgentCommand)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNAgentCommand2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentCommandᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_executeAgentCommand(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_AgentCommand_id(ctx, field)
+			case "agentId":
+				return ec.fieldContext_AgentCommand_agentId(ctx, field)
+			case "type":
+				return ec.fieldContext_AgentCommand_type(ctx, field)
+			case "status":
+				return ec.fieldContext_AgentCommand_status(ctx, field)
+			case "message":
+				return ec.fieldContext_AgentCommand_message(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_AgentCommand_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_AgentCommand_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AgentCommand", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_executeAgentCommand_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_kind(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_kind(ctx, field)
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7363,7 +9608,7 @@ func (ec *executionContext) _Source_kind(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Source().Kind(rctx, obj)
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7375,26 +9620,26 @@ func (ec *executionContext) _Source_kind(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "PageInfo",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_metadata(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_metadata(ctx, field)
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_endCursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7407,52 +9652,35 @@ func (ec *executionContext) _Source_metadata(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Metadata, nil
+		return obj.EndCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Metadata)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PageInfo_endCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "PageInfo",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Metadata_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Metadata_name(ctx, field)
-			case "displayName":
-				return ec.fieldContext_Metadata_displayName(ctx, field)
-			case "description":
-				return ec.fieldContext_Metadata_description(ctx, field)
-			case "icon":
-				return ec.fieldContext_Metadata_icon(ctx, field)
-			case "labels":
-				return ec.fieldContext_Metadata_labels(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Source_spec(ctx context.Context, field graphql.CollectedField, obj *model.Source) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Source_spec(ctx, field)
+func (ec *executionContext) _Parameter_name(ctx context.Context, field graphql.CollectedField, obj *model1.Parameter) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Parameter_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7465,7 +9693,7 @@ func (ec *executionContext) _Source_spec(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Spec, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7477,32 +9705,26 @@ func (ec *executionContext) _Source_spec(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ParameterizedSpec)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNParameterizedSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterizedSpec(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Source_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Parameter_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Source",
+		Object:     "Parameter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "type":
-				return ec.fieldContext_ParameterizedSpec_type(ctx, field)
-			case "parameters":
-				return ec.fieldContext_ParameterizedSpec_parameters(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ParameterizedSpec", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SourceType_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model.SourceType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SourceType_apiVersion(ctx, field)
+func (ec *executionContext) _Parameter_value(ctx context.Context, field graphql.CollectedField, obj *model1.Parameter) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Parameter_value(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7515,7 +9737,7 @@ func (ec *executionContext) _SourceType_apiVersion(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.APIVersion, nil
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7527,26 +9749,26 @@ func (ec *executionContext) _SourceType_apiVersion(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SourceType_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Parameter_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SourceType",
+		Object:     "Parameter",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SourceType_metadata(ctx context.Context, field graphql.CollectedField, obj *model.SourceType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SourceType_metadata(ctx, field)
+func (ec *executionContext) _ParameterDefinition_name(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterDefinition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterDefinition_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7559,7 +9781,7 @@ func (ec *executionContext) _SourceType_metadata(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Metadata, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7571,40 +9793,26 @@ func (ec *executionContext) _SourceType_metadata(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Metadata)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SourceType_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterDefinition_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SourceType",
+		Object:     "ParameterDefinition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Metadata_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Metadata_name(ctx, field)
-			case "displayName":
-				return ec.fieldContext_Metadata_displayName(ctx, field)
-			case "description":
-				return ec.fieldContext_Metadata_description(ctx, field)
-			case "icon":
-				return ec.fieldContext_Metadata_icon(ctx, field)
-			case "labels":
-				return ec.fieldContext_Metadata_labels(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _SourceType_kind(ctx context.Context, field graphql.CollectedField, obj *model.SourceType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SourceType_kind(ctx, field)
+func (ec *executionContext) _ParameterDefinition_label(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterDefinition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterDefinition_label(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7617,7 +9825,7 @@ func (ec *executionContext) _SourceType_kind(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.SourceType().Kind(rctx, obj)
+		return obj.Label, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7634,12 +9842,12 @@ func (ec *executionContext) _SourceType_kind(ctx context.Context, field graphql.
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SourceType_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterDefinition_label(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SourceType",
+		Object:     "ParameterDefinition",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -7647,8 +9855,8 @@ func (ec *executionContext) fieldContext_SourceType_kind(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _SourceType_spec(ctx context.Context, field graphql.CollectedField, obj *model.SourceType) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_SourceType_spec(ctx, field)
+func (ec *executionContext) _ParameterDefinition_description(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterDefinition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterDefinition_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7661,7 +9869,7 @@ func (ec *executionContext) _SourceType_spec(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Spec, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7673,186 +9881,114 @@ func (ec *executionContext) _SourceType_spec(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.ResourceTypeSpec)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNResourceTypeSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceTypeSpec(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_SourceType_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterDefinition_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SourceType",
+		Object:     "ParameterDefinition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "version":
-				return ec.fieldContext_ResourceTypeSpec_version(ctx, field)
-			case "parameters":
-				return ec.fieldContext_ResourceTypeSpec_parameters(ctx, field)
-			case "supportedPlatforms":
-				return ec.fieldContext_ResourceTypeSpec_supportedPlatforms(ctx, field)
-			case "telemetryTypes":
-				return ec.fieldContext_ResourceTypeSpec_telemetryTypes(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ResourceTypeSpec", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_agentChanges(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_agentChanges(ctx, field)
+func (ec *executionContext) _ParameterDefinition_required(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterDefinition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterDefinition_required(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().AgentChanges(rctx, fc.Args["selector"].(*string), fc.Args["query"].(*string))
+		return obj.Required, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan []*model1.AgentChange):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNAgentChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChangeᚄ(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_agentChanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterDefinition_required(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "ParameterDefinition",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "agent":
-				return ec.fieldContext_AgentChange_agent(ctx, field)
-			case "changeType":
-				return ec.fieldContext_AgentChange_changeType(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AgentChange", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_agentChanges_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_configurationChanges(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_configurationChanges(ctx, field)
+func (ec *executionContext) _ParameterDefinition_type(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterDefinition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterDefinition_type(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().ConfigurationChanges(rctx, fc.Args["selector"].(*string), fc.Args["query"].(*string))
+		return ec.resolvers.ParameterDefinition().Type(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan []*model1.ConfigurationChange):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNConfigurationChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationChangeᚄ(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(model.ParameterType)
+	fc.Result = res
+	return ec.marshalNParameterType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐParameterType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_configurationChanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterDefinition_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "ParameterDefinition",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "configuration":
-				return ec.fieldContext_ConfigurationChange_configuration(ctx, field)
-			case "eventType":
-				return ec.fieldContext_ConfigurationChange_eventType(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ConfigurationChange", field.Name)
+			return nil, errors.New("field of type ParameterType does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_configurationChanges_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Suggestion_label(ctx context.Context, field graphql.CollectedField, obj *search.Suggestion) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Suggestion_label(ctx, field)
+func (ec *executionContext) _ParameterDefinition_validValues(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterDefinition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterDefinition_validValues(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7865,26 +10001,23 @@ func (ec *executionContext) _Suggestion_label(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Label, nil
+		return obj.ValidValues, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Suggestion_label(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterDefinition_validValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Suggestion",
+		Object:     "ParameterDefinition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7895,8 +10028,8 @@ func (ec *executionContext) fieldContext_Suggestion_label(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Suggestion_query(ctx context.Context, field graphql.CollectedField, obj *search.Suggestion) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Suggestion_query(ctx, field)
+func (ec *executionContext) _ParameterDefinition_min(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterDefinition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterDefinition_min(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7909,38 +10042,35 @@ func (ec *executionContext) _Suggestion_query(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Query, nil
+		return obj.Min, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Suggestion_query(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterDefinition_min(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Suggestion",
+		Object:     "ParameterDefinition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_name(ctx, field)
+func (ec *executionContext) _ParameterDefinition_max(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterDefinition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterDefinition_max(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7953,38 +10083,35 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Max, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterDefinition_max(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "ParameterDefinition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_description(ctx, field)
+func (ec *executionContext) _ParameterDefinition_pattern(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterDefinition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterDefinition_pattern(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7997,7 +10124,7 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Pattern, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8006,16 +10133,16 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterDefinition_pattern(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "ParameterDefinition",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -8024,8 +10151,8 @@ func (ec *executionContext) fieldContext___Directive_description(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+func (ec *executionContext) _ParameterDefinition_properties(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterDefinition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterDefinition_properties(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8038,38 +10165,61 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return obj.Properties, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.([]model1.ParameterDefinition)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOParameterDefinition2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterDefinitionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterDefinition_properties(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "ParameterDefinition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_ParameterDefinition_name(ctx, field)
+			case "label":
+				return ec.fieldContext_ParameterDefinition_label(ctx, field)
+			case "description":
+				return ec.fieldContext_ParameterDefinition_description(ctx, field)
+			case "required":
+				return ec.fieldContext_ParameterDefinition_required(ctx, field)
+			case "type":
+				return ec.fieldContext_ParameterDefinition_type(ctx, field)
+			case "validValues":
+				return ec.fieldContext_ParameterDefinition_validValues(ctx, field)
+			case "min":
+				return ec.fieldContext_ParameterDefinition_min(ctx, field)
+			case "max":
+				return ec.fieldContext_ParameterDefinition_max(ctx, field)
+			case "pattern":
+				return ec.fieldContext_ParameterDefinition_pattern(ctx, field)
+			case "properties":
+				return ec.fieldContext_ParameterDefinition_properties(ctx, field)
+			case "default":
+				return ec.fieldContext_ParameterDefinition_default(ctx, field)
+			case "relevantIf":
+				return ec.fieldContext_ParameterDefinition_relevantIf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ParameterDefinition", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_args(ctx, field)
+func (ec *executionContext) _ParameterDefinition_default(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterDefinition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterDefinition_default(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8082,48 +10232,35 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.Default, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterDefinition_default(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "ParameterDefinition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+func (ec *executionContext) _ParameterDefinition_relevantIf(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterDefinition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterDefinition_relevantIf(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8136,38 +10273,45 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRepeatable, nil
+		return obj.RelevantIf, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.([]model1.RelevantIfCondition)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalORelevantIfCondition2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐRelevantIfConditionᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterDefinition_relevantIf(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "ParameterDefinition",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_RelevantIfCondition_name(ctx, field)
+			case "operator":
+				return ec.fieldContext_RelevantIfCondition_operator(ctx, field)
+			case "value":
+				return ec.fieldContext_RelevantIfCondition_value(ctx, field)
+			case "conditions":
+				return ec.fieldContext_RelevantIfCondition_conditions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelevantIfCondition", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+func (ec *executionContext) _ParameterizedSpec_type(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterizedSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterizedSpec_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8180,7 +10324,7 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8197,9 +10341,9 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterizedSpec_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "ParameterizedSpec",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8210,8 +10354,8 @@ func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+func (ec *executionContext) _ParameterizedSpec_parameters(ctx context.Context, field graphql.CollectedField, obj *model1.ParameterizedSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ParameterizedSpec_parameters(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8224,7 +10368,7 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Parameters, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8233,26 +10377,32 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]model1.Parameter)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOParameter2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ParameterizedSpec_parameters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "ParameterizedSpec",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_Parameter_name(ctx, field)
+			case "value":
+				return ec.fieldContext_Parameter_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Parameter", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+func (ec *executionContext) _Processor_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model1.Processor) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Processor_apiVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8265,7 +10415,7 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.APIVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8277,26 +10427,26 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Processor_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Processor",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+func (ec *executionContext) _Processor_kind(ctx context.Context, field graphql.CollectedField, obj *model1.Processor) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Processor_kind(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8309,26 +10459,29 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return ec.resolvers.Processor().Kind(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Processor_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Processor",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
 		},
@@ -8336,8 +10489,8 @@ func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx conte
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_name(ctx, field)
+func (ec *executionContext) _Processor_metadata(ctx context.Context, field graphql.CollectedField, obj *model1.Processor) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Processor_metadata(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8350,7 +10503,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Metadata, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8362,26 +10515,42 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model1.Metadata)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Processor_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Processor",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Metadata_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Metadata_name(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Metadata_displayName(ctx, field)
+			case "description":
+				return ec.fieldContext_Metadata_description(ctx, field)
+			case "icon":
+				return ec.fieldContext_Metadata_icon(ctx, field)
+			case "labels":
+				return ec.fieldContext_Metadata_labels(ctx, field)
+			case "resourceVersion":
+				return ec.fieldContext_Metadata_resourceVersion(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_description(ctx, field)
+func (ec *executionContext) _Processor_spec(ctx context.Context, field graphql.CollectedField, obj *model1.Processor) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Processor_spec(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8394,35 +10563,44 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Spec, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model1.ParameterizedSpec)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNParameterizedSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterizedSpec(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Processor_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Processor",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "type":
+				return ec.fieldContext_ParameterizedSpec_type(ctx, field)
+			case "parameters":
+				return ec.fieldContext_ParameterizedSpec_parameters(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ParameterizedSpec", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_args(ctx, field)
+func (ec *executionContext) _Processor_dependents(ctx context.Context, field graphql.CollectedField, obj *model1.Processor) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Processor_dependents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8435,7 +10613,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return ec.resolvers.Processor().Dependents(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8447,36 +10625,32 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*model1.ResourceDependents)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNResourceDependents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceDependents(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Processor_dependents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Processor",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "resources":
+				return ec.fieldContext_ResourceDependents_resources(ctx, field)
+			case "agentIds":
+				return ec.fieldContext_ResourceDependents_agentIds(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceDependents", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_type(ctx, field)
+func (ec *executionContext) _Processor_dependencies(ctx context.Context, field graphql.CollectedField, obj *model1.Processor) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Processor_dependencies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8489,7 +10663,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.Processor().Dependencies(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8501,48 +10675,32 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*model1.ResourceReference)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNResourceReference2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceReferenceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Processor_dependencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Processor",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
+				return ec.fieldContext_ResourceReference_kind(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+				return ec.fieldContext_ResourceReference_name(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceReference", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+func (ec *executionContext) _ProcessorChange_processor(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorChange_processor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8555,7 +10713,7 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.Processor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8567,26 +10725,40 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model1.Processor)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNProcessor2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorChange_processor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "ProcessorChange",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Processor_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Processor_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Processor_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Processor_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Processor_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Processor_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Processor", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+func (ec *executionContext) _ProcessorChange_eventType(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorChange_eventType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8599,35 +10771,38 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.EventType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.EventType)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNEventType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐEventType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorChange_eventType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "ProcessorChange",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type EventType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+func (ec *executionContext) _ProcessorConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8640,7 +10815,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8652,26 +10827,32 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.ProcessorEdge)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNProcessorEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "ProcessorConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_ProcessorEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_ProcessorEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProcessorEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+func (ec *executionContext) _ProcessorConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8684,35 +10865,44 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "ProcessorConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+func (ec *executionContext) _ProcessorEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8725,7 +10915,7 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8737,48 +10927,26 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "ProcessorEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+func (ec *executionContext) _ProcessorEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8791,35 +10959,52 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model1.Processor)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNProcessor2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessor(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "ProcessorEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Processor_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Processor_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Processor_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Processor_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Processor_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Processor_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Processor", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_description(ctx, field)
+func (ec *executionContext) _ProcessorType_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model1.ProcessorType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorType_apiVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8832,25 +11017,28 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.APIVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorType_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "ProcessorType",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -8859,8 +11047,8 @@ func (ec *executionContext) fieldContext___Schema_description(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_types(ctx, field)
+func (ec *executionContext) _ProcessorType_metadata(ctx context.Context, field graphql.CollectedField, obj *model1.ProcessorType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorType_metadata(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8873,7 +11061,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return obj.Metadata, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8885,48 +11073,42 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(model1.Metadata)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorType_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "ProcessorType",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
+			case "id":
+				return ec.fieldContext_Metadata_id(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
+				return ec.fieldContext_Metadata_name(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Metadata_displayName(ctx, field)
 			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+				return ec.fieldContext_Metadata_description(ctx, field)
+			case "icon":
+				return ec.fieldContext_Metadata_icon(ctx, field)
+			case "labels":
+				return ec.fieldContext_Metadata_labels(ctx, field)
+			case "resourceVersion":
+				return ec.fieldContext_Metadata_resourceVersion(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+func (ec *executionContext) _ProcessorType_kind(ctx context.Context, field graphql.CollectedField, obj *model1.ProcessorType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorType_kind(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8939,7 +11121,7 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return ec.resolvers.ProcessorType().Kind(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8951,48 +11133,26 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorType_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "ProcessorType",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+func (ec *executionContext) _ProcessorType_spec(ctx context.Context, field graphql.CollectedField, obj *model1.ProcessorType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorType_spec(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9005,57 +11165,48 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return obj.Spec, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(model1.ResourceTypeSpec)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNResourceTypeSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceTypeSpec(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorType_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "ProcessorType",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "version":
+				return ec.fieldContext_ResourceTypeSpec_version(ctx, field)
+			case "parameters":
+				return ec.fieldContext_ResourceTypeSpec_parameters(ctx, field)
+			case "supportedPlatforms":
+				return ec.fieldContext_ResourceTypeSpec_supportedPlatforms(ctx, field)
+			case "telemetryTypes":
+				return ec.fieldContext_ResourceTypeSpec_telemetryTypes(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceTypeSpec", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+func (ec *executionContext) _ProcessorType_dependents(ctx context.Context, field graphql.CollectedField, obj *model1.ProcessorType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorType_dependents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9068,57 +11219,44 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return ec.resolvers.ProcessorType().Dependents(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model1.ResourceDependents)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNResourceDependents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceDependents(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorType_dependents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "ProcessorType",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "resources":
+				return ec.fieldContext_ResourceDependents_resources(ctx, field)
+			case "agentIds":
+				return ec.fieldContext_ResourceDependents_agentIds(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceDependents", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+func (ec *executionContext) _ProcessorType_dependencies(ctx context.Context, field graphql.CollectedField, obj *model1.ProcessorType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorType_dependencies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9131,7 +11269,7 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return ec.resolvers.ProcessorType().Dependencies(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9143,38 +11281,32 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.([]*model1.ResourceReference)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalNResourceReference2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceReferenceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorType_dependencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "ProcessorType",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "kind":
+				return ec.fieldContext_ResourceReference_kind(ctx, field)
 			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+				return ec.fieldContext_ResourceReference_name(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ResourceReference", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_kind(ctx, field)
+func (ec *executionContext) _ProcessorTypeConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorTypeConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorTypeConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9187,7 +11319,7 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9199,26 +11331,32 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.ProcessorTypeEdge)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalNProcessorTypeEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorTypeEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorTypeConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ProcessorTypeConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_ProcessorTypeEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_ProcessorTypeEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProcessorTypeEdge", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_name(ctx, field)
+func (ec *executionContext) _ProcessorTypeConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorTypeConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorTypeConnection_pageInfo(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9231,35 +11369,44 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.PageInfo)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorTypeConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ProcessorTypeConnection",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_description(ctx, field)
+func (ec *executionContext) _ProcessorTypeEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorTypeEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorTypeEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9272,25 +11419,28 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorTypeEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ProcessorTypeEdge",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -9299,8 +11449,8 @@ func (ec *executionContext) fieldContext___Type_description(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_fields(ctx, field)
+func (ec *executionContext) _ProcessorTypeEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.ProcessorTypeEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ProcessorTypeEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9313,60 +11463,52 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(*model1.ProcessorType)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalNProcessorType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProcessorTypeEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "ProcessorTypeEdge",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			case "apiVersion":
+				return ec.fieldContext_ProcessorType_apiVersion(ctx, field)
+			case "metadata":
+				return ec.fieldContext_ProcessorType_metadata(ctx, field)
+			case "kind":
+				return ec.fieldContext_ProcessorType_kind(ctx, field)
+			case "spec":
+				return ec.fieldContext_ProcessorType_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_ProcessorType_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_ProcessorType_dependencies(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ProcessorType", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+func (ec *executionContext) _Query_agents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_agents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9379,57 +11521,57 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return ec.resolvers.Query().Agents(rctx, fc.Args["selector"].(*string), fc.Args["query"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*model.Agents)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNAgents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgents(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_agents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "query":
+				return ec.fieldContext_Agents_query(ctx, field)
+			case "agents":
+				return ec.fieldContext_Agents_agents(ctx, field)
+			case "suggestions":
+				return ec.fieldContext_Agents_suggestions(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Agents", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_agents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+func (ec *executionContext) _Query_agent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_agent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9442,7 +11584,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return ec.resolvers.Query().Agent(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9451,48 +11593,77 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*model1.Agent)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalOAgent2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgent(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_agent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
+			case "id":
+				return ec.fieldContext_Agent_id(ctx, field)
+			case "architecture":
+				return ec.fieldContext_Agent_architecture(ctx, field)
+			case "hostName":
+				return ec.fieldContext_Agent_hostName(ctx, field)
+			case "labels":
+				return ec.fieldContext_Agent_labels(ctx, field)
+			case "platform":
+				return ec.fieldContext_Agent_platform(ctx, field)
+			case "operatingSystem":
+				return ec.fieldContext_Agent_operatingSystem(ctx, field)
+			case "version":
+				return ec.fieldContext_Agent_version(ctx, field)
 			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+				return ec.fieldContext_Agent_name(ctx, field)
+			case "home":
+				return ec.fieldContext_Agent_home(ctx, field)
+			case "macAddress":
+				return ec.fieldContext_Agent_macAddress(ctx, field)
+			case "remoteAddress":
+				return ec.fieldContext_Agent_remoteAddress(ctx, field)
+			case "type":
+				return ec.fieldContext_Agent_type(ctx, field)
+			case "status":
+				return ec.fieldContext_Agent_status(ctx, field)
+			case "errorMessage":
+				return ec.fieldContext_Agent_errorMessage(ctx, field)
+			case "connectedAt":
+				return ec.fieldContext_Agent_connectedAt(ctx, field)
+			case "disconnectedAt":
+				return ec.fieldContext_Agent_disconnectedAt(ctx, field)
+			case "configuration":
+				return ec.fieldContext_Agent_configuration(ctx, field)
+			case "configurationResource":
+				return ec.fieldContext_Agent_configurationResource(ctx, field)
+			case "commands":
+				return ec.fieldContext_Agent_commands(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Agent", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_agent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+func (ec *executionContext) _Query_agentGroups(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_agentGroups(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9505,56 +11676,54 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		return ec.resolvers.Query().AgentGroups(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.([]*model1.AgentGroup)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNAgentGroup2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroupᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_agentGroups(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			case "apiVersion":
+				return ec.fieldContext_AgentGroup_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_AgentGroup_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_AgentGroup_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_AgentGroup_spec(ctx, field)
+			case "summary":
+				return ec.fieldContext_AgentGroup_summary(ctx, field)
+			case "dependents":
+				return ec.fieldContext_AgentGroup_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_AgentGroup_dependencies(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AgentGroup", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+func (ec *executionContext) _Query_agentGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_agentGroup(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9567,7 +11736,7 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return ec.resolvers.Query().AgentGroup(rctx, fc.Args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9576,36 +11745,53 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*model1.AgentGroup)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOAgentGroup2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroup(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_agentGroup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "apiVersion":
+				return ec.fieldContext_AgentGroup_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_AgentGroup_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_AgentGroup_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_AgentGroup_spec(ctx, field)
+			case "summary":
+				return ec.fieldContext_AgentGroup_summary(ctx, field)
+			case "dependents":
+				return ec.fieldContext_AgentGroup_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_AgentGroup_dependencies(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type AgentGroup", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_agentGroup_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+func (ec *executionContext) _Query_configurations(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_configurations(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9618,57 +11804,57 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return ec.resolvers.Query().Configurations(rctx, fc.Args["selector"].(*string), fc.Args["query"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.Configurations)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNConfigurations2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurations(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_configurations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "query":
+				return ec.fieldContext_Configurations_query(ctx, field)
+			case "configurations":
+				return ec.fieldContext_Configurations_configurations(ctx, field)
+			case "suggestions":
+				return ec.fieldContext_Configurations_suggestions(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Configurations", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_configurations_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+func (ec *executionContext) _Query_configuration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_configuration(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9681,7 +11867,7 @@ func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpecifiedByURL(), nil
+		return ec.resolvers.Query().Configuration(rctx, fc.Args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9690,114 +11876,8616 @@ func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model1.Configuration)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfiguration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_configuration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Configuration_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Configuration_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Configuration_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Configuration_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Configuration_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Configuration_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Configuration", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_configuration_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
-
-// endregion ************************** interface.gotpl ***************************
-
-// region    **************************** object.gotpl ****************************
-
-var agentImplementors = []string{"Agent"}
-
-func (ec *executionContext) _Agent(ctx context.Context, sel ast.SelectionSet, obj *model.Agent) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, agentImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Agent")
-		case "id":
-
-			out.Values[i] = ec._Agent_id(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "architecture":
-
-			out.Values[i] = ec._Agent_architecture(ctx, field, obj)
-
-		case "hostName":
-
-			out.Values[i] = ec._Agent_hostName(ctx, field, obj)
-
-		case "labels":
-			field := field
+func (ec *executionContext) _Query_sources(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_sources(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Sources(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.Source)
+	fc.Result = res
+	return ec.marshalNSource2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceᚄ(ctx, field.Selections, res)
+}
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Agent_labels(ctx, field, obj)
-				return res
+func (ec *executionContext) fieldContext_Query_sources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Source_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Source_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Source_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Source_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Source_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Source_dependencies(ctx, field)
 			}
+			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
+		},
+	}
+	return fc, nil
+}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
-
-			})
-		case "platform":
-
-			out.Values[i] = ec._Agent_platform(ctx, field, obj)
-
-		case "operatingSystem":
+func (ec *executionContext) _Query_source(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_source(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Source(rctx, fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model1.Source)
+	fc.Result = res
+	return ec.marshalOSource2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSource(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_source(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Source_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Source_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Source_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Source_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Source_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Source_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_source_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_sourceTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_sourceTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SourceTypes(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.SourceType)
+	fc.Result = res
+	return ec.marshalNSourceType2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_sourceTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_SourceType_apiVersion(ctx, field)
+			case "metadata":
+				return ec.fieldContext_SourceType_metadata(ctx, field)
+			case "kind":
+				return ec.fieldContext_SourceType_kind(ctx, field)
+			case "spec":
+				return ec.fieldContext_SourceType_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_SourceType_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_SourceType_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SourceType", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_sourceType(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_sourceType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SourceType(rctx, fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model1.SourceType)
+	fc.Result = res
+	return ec.marshalOSourceType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_sourceType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_SourceType_apiVersion(ctx, field)
+			case "metadata":
+				return ec.fieldContext_SourceType_metadata(ctx, field)
+			case "kind":
+				return ec.fieldContext_SourceType_kind(ctx, field)
+			case "spec":
+				return ec.fieldContext_SourceType_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_SourceType_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_SourceType_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SourceType", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sourceType_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_processors(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_processors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Processors(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.Processor)
+	fc.Result = res
+	return ec.marshalNProcessor2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_processors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Processor_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Processor_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Processor_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Processor_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Processor_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Processor_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Processor", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_processor(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_processor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Processor(rctx, fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model1.Processor)
+	fc.Result = res
+	return ec.marshalOProcessor2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessor(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_processor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Processor_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Processor_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Processor_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Processor_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Processor_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Processor_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Processor", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_processor_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_processorTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_processorTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ProcessorTypes(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.ProcessorType)
+	fc.Result = res
+	return ec.marshalNProcessorType2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_processorTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_ProcessorType_apiVersion(ctx, field)
+			case "metadata":
+				return ec.fieldContext_ProcessorType_metadata(ctx, field)
+			case "kind":
+				return ec.fieldContext_ProcessorType_kind(ctx, field)
+			case "spec":
+				return ec.fieldContext_ProcessorType_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_ProcessorType_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_ProcessorType_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProcessorType", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_processorType(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_processorType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ProcessorType(rctx, fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model1.ProcessorType)
+	fc.Result = res
+	return ec.marshalOProcessorType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_processorType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_ProcessorType_apiVersion(ctx, field)
+			case "metadata":
+				return ec.fieldContext_ProcessorType_metadata(ctx, field)
+			case "kind":
+				return ec.fieldContext_ProcessorType_kind(ctx, field)
+			case "spec":
+				return ec.fieldContext_ProcessorType_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_ProcessorType_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_ProcessorType_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProcessorType", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_processorType_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_destinations(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_destinations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Destinations(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.Destination)
+	fc.Result = res
+	return ec.marshalNDestination2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_destinations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Destination_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Destination_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Destination_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Destination_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Destination_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Destination_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Destination", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_destination(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_destination(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Destination(rctx, fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model1.Destination)
+	fc.Result = res
+	return ec.marshalODestination2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestination(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_destination(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Destination_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Destination_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Destination_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Destination_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Destination_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Destination_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Destination", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_destination_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_destinationWithType(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_destinationWithType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().DestinationWithType(rctx, fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.DestinationWithType)
+	fc.Result = res
+	return ec.marshalNDestinationWithType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationWithType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_destinationWithType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "destination":
+				return ec.fieldContext_DestinationWithType_destination(ctx, field)
+			case "destinationType":
+				return ec.fieldContext_DestinationWithType_destinationType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DestinationWithType", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_destinationWithType_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_destinationTypes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_destinationTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().DestinationTypes(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.DestinationType)
+	fc.Result = res
+	return ec.marshalNDestinationType2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_destinationTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_DestinationType_apiVersion(ctx, field)
+			case "metadata":
+				return ec.fieldContext_DestinationType_metadata(ctx, field)
+			case "kind":
+				return ec.fieldContext_DestinationType_kind(ctx, field)
+			case "spec":
+				return ec.fieldContext_DestinationType_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_DestinationType_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_DestinationType_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DestinationType", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_destinationType(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_destinationType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().DestinationType(rctx, fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model1.DestinationType)
+	fc.Result = res
+	return ec.marshalODestinationType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_destinationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_DestinationType_apiVersion(ctx, field)
+			case "metadata":
+				return ec.fieldContext_DestinationType_metadata(ctx, field)
+			case "kind":
+				return ec.fieldContext_DestinationType_kind(ctx, field)
+			case "spec":
+				return ec.fieldContext_DestinationType_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_DestinationType_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_DestinationType_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DestinationType", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_destinationType_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_components(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_components(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Components(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Components)
+	fc.Result = res
+	return ec.marshalNComponents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐComponents(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_components(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "sources":
+				return ec.fieldContext_Components_sources(ctx, field)
+			case "destinations":
+				return ec.fieldContext_Components_destinations(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Components", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_agentsConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_agentsConnection(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AgentsConnection(rctx, fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["sort"].(*string), fc.Args["order"].(*model.SortOrder), fc.Args["selector"].(*string), fc.Args["query"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.AgentConnection)
+	fc.Result = res
+	return ec.marshalNAgentConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_agentsConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_AgentConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_AgentConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AgentConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_agentsConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_configurationsConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_configurationsConnection(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ConfigurationsConnection(rctx, fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["sort"].(*string), fc.Args["order"].(*model.SortOrder), fc.Args["selector"].(*string), fc.Args["query"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ConfigurationConnection)
+	fc.Result = res
+	return ec.marshalNConfigurationConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_configurationsConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_ConfigurationConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_ConfigurationConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ConfigurationConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_configurationsConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_sourcesConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_sourcesConnection(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SourcesConnection(rctx, fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["sort"].(*string), fc.Args["order"].(*model.SortOrder), fc.Args["selector"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.SourceConnection)
+	fc.Result = res
+	return ec.marshalNSourceConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_sourcesConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_SourceConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_SourceConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SourceConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sourcesConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_sourceTypesConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_sourceTypesConnection(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SourceTypesConnection(rctx, fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["sort"].(*string), fc.Args["order"].(*model.SortOrder), fc.Args["selector"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.SourceTypeConnection)
+	fc.Result = res
+	return ec.marshalNSourceTypeConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceTypeConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_sourceTypesConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_SourceTypeConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_SourceTypeConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SourceTypeConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sourceTypesConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_processorsConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_processorsConnection(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ProcessorsConnection(rctx, fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["sort"].(*string), fc.Args["order"].(*model.SortOrder), fc.Args["selector"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ProcessorConnection)
+	fc.Result = res
+	return ec.marshalNProcessorConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_processorsConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_ProcessorConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_ProcessorConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProcessorConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_processorsConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_processorTypesConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_processorTypesConnection(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ProcessorTypesConnection(rctx, fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["sort"].(*string), fc.Args["order"].(*model.SortOrder), fc.Args["selector"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ProcessorTypeConnection)
+	fc.Result = res
+	return ec.marshalNProcessorTypeConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorTypeConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_processorTypesConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_ProcessorTypeConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_ProcessorTypeConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProcessorTypeConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_processorTypesConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_destinationsConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_destinationsConnection(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().DestinationsConnection(rctx, fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["sort"].(*string), fc.Args["order"].(*model.SortOrder), fc.Args["selector"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.DestinationConnection)
+	fc.Result = res
+	return ec.marshalNDestinationConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_destinationsConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_DestinationConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_DestinationConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DestinationConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_destinationsConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_destinationTypesConnection(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_destinationTypesConnection(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().DestinationTypesConnection(rctx, fc.Args["first"].(*int), fc.Args["after"].(*string), fc.Args["sort"].(*string), fc.Args["order"].(*model.SortOrder), fc.Args["selector"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.DestinationTypeConnection)
+	fc.Result = res
+	return ec.marshalNDestinationTypeConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationTypeConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_destinationTypesConnection(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_DestinationTypeConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_DestinationTypeConnection_pageInfo(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DestinationTypeConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_destinationTypesConnection_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(fc.Args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelevantIfCondition_name(ctx context.Context, field graphql.CollectedField, obj *model1.RelevantIfCondition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelevantIfCondition_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RelevantIfCondition_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelevantIfCondition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelevantIfCondition_operator(ctx context.Context, field graphql.CollectedField, obj *model1.RelevantIfCondition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelevantIfCondition_operator(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.RelevantIfCondition().Operator(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.RelevantIfOperatorType)
+	fc.Result = res
+	return ec.marshalNRelevantIfOperatorType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐRelevantIfOperatorType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RelevantIfCondition_operator(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelevantIfCondition",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RelevantIfOperatorType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelevantIfCondition_value(ctx context.Context, field graphql.CollectedField, obj *model1.RelevantIfCondition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelevantIfCondition_value(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(any)
+	fc.Result = res
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RelevantIfCondition_value(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelevantIfCondition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Any does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelevantIfCondition_conditions(ctx context.Context, field graphql.CollectedField, obj *model1.RelevantIfCondition) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelevantIfCondition_conditions(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Conditions, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model1.RelevantIfCondition)
+	fc.Result = res
+	return ec.marshalORelevantIfCondition2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐRelevantIfConditionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RelevantIfCondition_conditions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelevantIfCondition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_RelevantIfCondition_name(ctx, field)
+			case "operator":
+				return ec.fieldContext_RelevantIfCondition_operator(ctx, field)
+			case "value":
+				return ec.fieldContext_RelevantIfCondition_value(ctx, field)
+			case "conditions":
+				return ec.fieldContext_RelevantIfCondition_conditions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelevantIfCondition", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceChange_resource(ctx context.Context, field graphql.CollectedField, obj *model.ResourceChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceChange_resource(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Resource, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model1.Resource)
+	fc.Result = res
+	return ec.marshalNResource2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResource(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceChange_resource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("FieldContext.Child cannot be called on type INTERFACE")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceChange_eventType(ctx context.Context, field graphql.CollectedField, obj *model.ResourceChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceChange_eventType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EventType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.EventType)
+	fc.Result = res
+	return ec.marshalNEventType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐEventType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceChange_eventType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type EventType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceConfiguration_name(ctx context.Context, field graphql.CollectedField, obj *model1.ResourceConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceConfiguration_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceConfiguration_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceConfiguration_type(ctx context.Context, field graphql.CollectedField, obj *model1.ResourceConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceConfiguration_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceConfiguration_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceConfiguration_parameters(ctx context.Context, field graphql.CollectedField, obj *model1.ResourceConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceConfiguration_parameters(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Parameters, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model1.Parameter)
+	fc.Result = res
+	return ec.marshalOParameter2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceConfiguration_parameters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_Parameter_name(ctx, field)
+			case "value":
+				return ec.fieldContext_Parameter_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Parameter", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceConfiguration_processors(ctx context.Context, field graphql.CollectedField, obj *model1.ResourceConfiguration) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceConfiguration_processors(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Processors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]model1.ResourceConfiguration)
+	fc.Result = res
+	return ec.marshalOResourceConfiguration2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceConfigurationᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceConfiguration_processors(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceConfiguration",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_ResourceConfiguration_name(ctx, field)
+			case "type":
+				return ec.fieldContext_ResourceConfiguration_type(ctx, field)
+			case "parameters":
+				return ec.fieldContext_ResourceConfiguration_parameters(ctx, field)
+			case "processors":
+				return ec.fieldContext_ResourceConfiguration_processors(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceConfiguration", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceDependents_resources(ctx context.Context, field graphql.CollectedField, obj *model1.ResourceDependents) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceDependents_resources(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Resources, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.ResourceReference)
+	fc.Result = res
+	return ec.marshalNResourceReference2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceReferenceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceDependents_resources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceDependents",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext_ResourceReference_kind(ctx, field)
+			case "name":
+				return ec.fieldContext_ResourceReference_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceReference", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceDependents_agentIds(ctx context.Context, field graphql.CollectedField, obj *model1.ResourceDependents) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceDependents_agentIds(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AgentIDs, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNID2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceDependents_agentIds(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceDependents",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceReference_kind(ctx context.Context, field graphql.CollectedField, obj *model1.ResourceReference) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceReference_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ResourceReference().Kind(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceReference_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceReference",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceReference_name(ctx context.Context, field graphql.CollectedField, obj *model1.ResourceReference) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceReference_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceReference_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceReference",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceTypeSpec_version(ctx context.Context, field graphql.CollectedField, obj *model1.ResourceTypeSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceTypeSpec_version(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceTypeSpec_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceTypeSpec",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceTypeSpec_parameters(ctx context.Context, field graphql.CollectedField, obj *model1.ResourceTypeSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceTypeSpec_parameters(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Parameters, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model1.ParameterDefinition)
+	fc.Result = res
+	return ec.marshalNParameterDefinition2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterDefinitionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceTypeSpec_parameters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceTypeSpec",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_ParameterDefinition_name(ctx, field)
+			case "label":
+				return ec.fieldContext_ParameterDefinition_label(ctx, field)
+			case "description":
+				return ec.fieldContext_ParameterDefinition_description(ctx, field)
+			case "required":
+				return ec.fieldContext_ParameterDefinition_required(ctx, field)
+			case "type":
+				return ec.fieldContext_ParameterDefinition_type(ctx, field)
+			case "validValues":
+				return ec.fieldContext_ParameterDefinition_validValues(ctx, field)
+			case "min":
+				return ec.fieldContext_ParameterDefinition_min(ctx, field)
+			case "max":
+				return ec.fieldContext_ParameterDefinition_max(ctx, field)
+			case "pattern":
+				return ec.fieldContext_ParameterDefinition_pattern(ctx, field)
+			case "properties":
+				return ec.fieldContext_ParameterDefinition_properties(ctx, field)
+			case "default":
+				return ec.fieldContext_ParameterDefinition_default(ctx, field)
+			case "relevantIf":
+				return ec.fieldContext_ParameterDefinition_relevantIf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ParameterDefinition", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceTypeSpec_supportedPlatforms(ctx context.Context, field graphql.CollectedField, obj *model1.ResourceTypeSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceTypeSpec_supportedPlatforms(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SupportedPlatforms, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceTypeSpec_supportedPlatforms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceTypeSpec",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ResourceTypeSpec_telemetryTypes(ctx context.Context, field graphql.CollectedField, obj *model1.ResourceTypeSpec) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceTypeSpec_telemetryTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TelemetryTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]otel.PipelineType)
+	fc.Result = res
+	return ec.marshalNPipelineType2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ResourceTypeSpec_telemetryTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ResourceTypeSpec",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PipelineType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Source_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model1.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_apiVersion(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.APIVersion, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Source_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Source_kind(ctx context.Context, field graphql.CollectedField, obj *model1.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Source().Kind(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Source_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Source_metadata(ctx context.Context, field graphql.CollectedField, obj *model1.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_metadata(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Metadata, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model1.Metadata)
+	fc.Result = res
+	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Source_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Metadata_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Metadata_name(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Metadata_displayName(ctx, field)
+			case "description":
+				return ec.fieldContext_Metadata_description(ctx, field)
+			case "icon":
+				return ec.fieldContext_Metadata_icon(ctx, field)
+			case "labels":
+				return ec.fieldContext_Metadata_labels(ctx, field)
+			case "resourceVersion":
+				return ec.fieldContext_Metadata_resourceVersion(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Source_spec(ctx context.Context, field graphql.CollectedField, obj *model1.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_spec(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Spec, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model1.ParameterizedSpec)
+	fc.Result = res
+	return ec.marshalNParameterizedSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterizedSpec(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Source_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "type":
+				return ec.fieldContext_ParameterizedSpec_type(ctx, field)
+			case "parameters":
+				return ec.fieldContext_ParameterizedSpec_parameters(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ParameterizedSpec", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Source_dependents(ctx context.Context, field graphql.CollectedField, obj *model1.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_dependents(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Source().Dependents(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model1.ResourceDependents)
+	fc.Result = res
+	return ec.marshalNResourceDependents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceDependents(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Source_dependents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "resources":
+				return ec.fieldContext_ResourceDependents_resources(ctx, field)
+			case "agentIds":
+				return ec.fieldContext_ResourceDependents_agentIds(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceDependents", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Source_dependencies(ctx context.Context, field graphql.CollectedField, obj *model1.Source) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Source_dependencies(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Source().Dependencies(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.ResourceReference)
+	fc.Result = res
+	return ec.marshalNResourceReference2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceReferenceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Source_dependencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Source",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext_ResourceReference_kind(ctx, field)
+			case "name":
+				return ec.fieldContext_ResourceReference_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceReference", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceChange_source(ctx context.Context, field graphql.CollectedField, obj *model.SourceChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceChange_source(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Source, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model1.Source)
+	fc.Result = res
+	return ec.marshalNSource2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSource(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceChange_source(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Source_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Source_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Source_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Source_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Source_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Source_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceChange_eventType(ctx context.Context, field graphql.CollectedField, obj *model.SourceChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceChange_eventType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EventType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.EventType)
+	fc.Result = res
+	return ec.marshalNEventType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐEventType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceChange_eventType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type EventType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.SourceConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceConnection_edges(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.SourceEdge)
+	fc.Result = res
+	return ec.marshalNSourceEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_SourceEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_SourceEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SourceEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.SourceConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceConnection_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.SourceEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceEdge_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.SourceEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceEdge_node(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model1.Source)
+	fc.Result = res
+	return ec.marshalNSource2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSource(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_Source_apiVersion(ctx, field)
+			case "kind":
+				return ec.fieldContext_Source_kind(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Source_metadata(ctx, field)
+			case "spec":
+				return ec.fieldContext_Source_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_Source_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_Source_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Source", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceType_apiVersion(ctx context.Context, field graphql.CollectedField, obj *model1.SourceType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceType_apiVersion(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.APIVersion, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceType_apiVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceType_metadata(ctx context.Context, field graphql.CollectedField, obj *model1.SourceType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceType_metadata(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Metadata, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model1.Metadata)
+	fc.Result = res
+	return ec.marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceType_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Metadata_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Metadata_name(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Metadata_displayName(ctx, field)
+			case "description":
+				return ec.fieldContext_Metadata_description(ctx, field)
+			case "icon":
+				return ec.fieldContext_Metadata_icon(ctx, field)
+			case "labels":
+				return ec.fieldContext_Metadata_labels(ctx, field)
+			case "resourceVersion":
+				return ec.fieldContext_Metadata_resourceVersion(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Metadata", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceType_kind(ctx context.Context, field graphql.CollectedField, obj *model1.SourceType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceType_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SourceType().Kind(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceType_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceType",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceType_spec(ctx context.Context, field graphql.CollectedField, obj *model1.SourceType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceType_spec(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Spec, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model1.ResourceTypeSpec)
+	fc.Result = res
+	return ec.marshalNResourceTypeSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceTypeSpec(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceType_spec(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceType",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "version":
+				return ec.fieldContext_ResourceTypeSpec_version(ctx, field)
+			case "parameters":
+				return ec.fieldContext_ResourceTypeSpec_parameters(ctx, field)
+			case "supportedPlatforms":
+				return ec.fieldContext_ResourceTypeSpec_supportedPlatforms(ctx, field)
+			case "telemetryTypes":
+				return ec.fieldContext_ResourceTypeSpec_telemetryTypes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceTypeSpec", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceType_dependents(ctx context.Context, field graphql.CollectedField, obj *model1.SourceType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceType_dependents(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SourceType().Dependents(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model1.ResourceDependents)
+	fc.Result = res
+	return ec.marshalNResourceDependents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceDependents(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceType_dependents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceType",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "resources":
+				return ec.fieldContext_ResourceDependents_resources(ctx, field)
+			case "agentIds":
+				return ec.fieldContext_ResourceDependents_agentIds(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceDependents", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceType_dependencies(ctx context.Context, field graphql.CollectedField, obj *model1.SourceType) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceType_dependencies(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SourceType().Dependencies(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model1.ResourceReference)
+	fc.Result = res
+	return ec.marshalNResourceReference2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceReferenceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceType_dependencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceType",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext_ResourceReference_kind(ctx, field)
+			case "name":
+				return ec.fieldContext_ResourceReference_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceReference", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceTypeConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.SourceTypeConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceTypeConnection_edges(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.SourceTypeEdge)
+	fc.Result = res
+	return ec.marshalNSourceTypeEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceTypeEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceTypeConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceTypeConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cursor":
+				return ec.fieldContext_SourceTypeEdge_cursor(ctx, field)
+			case "node":
+				return ec.fieldContext_SourceTypeEdge_node(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SourceTypeEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceTypeConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.SourceTypeConnection) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceTypeConnection_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceTypeConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceTypeConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceTypeEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.SourceTypeEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceTypeEdge_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceTypeEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceTypeEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SourceTypeEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.SourceTypeEdge) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SourceTypeEdge_node(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model1.SourceType)
+	fc.Result = res
+	return ec.marshalNSourceType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SourceTypeEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SourceTypeEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "apiVersion":
+				return ec.fieldContext_SourceType_apiVersion(ctx, field)
+			case "metadata":
+				return ec.fieldContext_SourceType_metadata(ctx, field)
+			case "kind":
+				return ec.fieldContext_SourceType_kind(ctx, field)
+			case "spec":
+				return ec.fieldContext_SourceType_spec(ctx, field)
+			case "dependents":
+				return ec.fieldContext_SourceType_dependents(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_SourceType_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SourceType", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_agentChanges(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_agentChanges(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().AgentChanges(rctx, fc.Args["selector"].(*string), fc.Args["query"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan []*model.AgentChange):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNAgentChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChangeᚄ(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_agentChanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "agent":
+				return ec.fieldContext_AgentChange_agent(ctx, field)
+			case "changeType":
+				return ec.fieldContext_AgentChange_changeType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AgentChange", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_agentChanges_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_configurationChanges(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_configurationChanges(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ConfigurationChanges(rctx, fc.Args["selector"].(*string), fc.Args["query"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan []*model.ConfigurationChange):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNConfigurationChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationChangeᚄ(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_configurationChanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "configuration":
+				return ec.fieldContext_ConfigurationChange_configuration(ctx, field)
+			case "eventType":
+				return ec.fieldContext_ConfigurationChange_eventType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ConfigurationChange", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_configurationChanges_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_sourceChanges(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_sourceChanges(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().SourceChanges(rctx, fc.Args["selector"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan []*model.SourceChange):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNSourceChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceChangeᚄ(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_sourceChanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "source":
+				return ec.fieldContext_SourceChange_source(ctx, field)
+			case "eventType":
+				return ec.fieldContext_SourceChange_eventType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SourceChange", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_sourceChanges_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_processorChanges(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_processorChanges(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ProcessorChanges(rctx, fc.Args["selector"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan []*model.ProcessorChange):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNProcessorChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorChangeᚄ(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_processorChanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "processor":
+				return ec.fieldContext_ProcessorChange_processor(ctx, field)
+			case "eventType":
+				return ec.fieldContext_ProcessorChange_eventType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProcessorChange", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_processorChanges_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_destinationChanges(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_destinationChanges(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().DestinationChanges(rctx, fc.Args["selector"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan []*model.DestinationChange):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNDestinationChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationChangeᚄ(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_destinationChanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "destination":
+				return ec.fieldContext_DestinationChange_destination(ctx, field)
+			case "eventType":
+				return ec.fieldContext_DestinationChange_eventType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DestinationChange", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_destinationChanges_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_resourceTypeChanges(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_resourceTypeChanges(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ResourceTypeChanges(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan []*model.ResourceChange):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNResourceChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐResourceChangeᚄ(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_resourceTypeChanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "resource":
+				return ec.fieldContext_ResourceChange_resource(ctx, field)
+			case "eventType":
+				return ec.fieldContext_ResourceChange_eventType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceChange", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_resourceChanges(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_resourceChanges(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ResourceChanges(rctx, fc.Args["kinds"].([]string), fc.Args["selector"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan []*model.ResourceChange):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNResourceChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐResourceChangeᚄ(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_resourceChanges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "resource":
+				return ec.fieldContext_ResourceChange_resource(ctx, field)
+			case "eventType":
+				return ec.fieldContext_ResourceChange_eventType(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceChange", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_resourceChanges_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Suggestion_label(ctx context.Context, field graphql.CollectedField, obj *search.Suggestion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Suggestion_label(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Label, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Suggestion_label(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Suggestion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Suggestion_query(ctx context.Context, field graphql.CollectedField, obj *search.Suggestion) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Suggestion_query(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Query, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Suggestion_query(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Suggestion",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsRepeatable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_types(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_fields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SpecifiedByURL(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+func (ec *executionContext) _Resource(ctx context.Context, sel ast.SelectionSet, obj model1.Resource) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case *model1.Configuration:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Configuration(ctx, sel, obj)
+	case *model1.AgentGroup:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._AgentGroup(ctx, sel, obj)
+	case *model1.SourceType:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._SourceType(ctx, sel, obj)
+	case *model1.ProcessorType:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._ProcessorType(ctx, sel, obj)
+	case *model1.DestinationType:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DestinationType(ctx, sel, obj)
+	case *model1.ConnectorType:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._ConnectorType(ctx, sel, obj)
+	case *model1.Source:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Source(ctx, sel, obj)
+	case *model1.Processor:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Processor(ctx, sel, obj)
+	case *model1.Destination:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Destination(ctx, sel, obj)
+	case *model1.Connector:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._Connector(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var agentImplementors = []string{"Agent"}
+
+func (ec *executionContext) _Agent(ctx context.Context, sel ast.SelectionSet, obj *model1.Agent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agentImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Agent")
+		case "id":
+
+			out.Values[i] = ec._Agent_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "architecture":
+
+			out.Values[i] = ec._Agent_architecture(ctx, field, obj)
+
+		case "hostName":
+
+			out.Values[i] = ec._Agent_hostName(ctx, field, obj)
+
+		case "labels":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Agent_labels(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "platform":
+
+			out.Values[i] = ec._Agent_platform(ctx, field, obj)
+
+		case "operatingSystem":
 
 			out.Values[i] = ec._Agent_operatingSystem(ctx, field, obj)
 
-		case "version":
+		case "version":
+
+			out.Values[i] = ec._Agent_version(ctx, field, obj)
+
+		case "name":
+
+			out.Values[i] = ec._Agent_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "home":
+
+			out.Values[i] = ec._Agent_home(ctx, field, obj)
+
+		case "macAddress":
+
+			out.Values[i] = ec._Agent_macAddress(ctx, field, obj)
+
+		case "remoteAddress":
+
+			out.Values[i] = ec._Agent_remoteAddress(ctx, field, obj)
+
+		case "type":
+
+			out.Values[i] = ec._Agent_type(ctx, field, obj)
+
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Agent_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "errorMessage":
+
+			out.Values[i] = ec._Agent_errorMessage(ctx, field, obj)
+
+		case "connectedAt":
+
+			out.Values[i] = ec._Agent_connectedAt(ctx, field, obj)
+
+		case "disconnectedAt":
+
+			out.Values[i] = ec._Agent_disconnectedAt(ctx, field, obj)
+
+		case "configuration":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Agent_configuration(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "configurationResource":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Agent_configurationResource(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "commands":
+
+			out.Values[i] = ec._Agent_commands(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var agentChangeImplementors = []string{"AgentChange"}
+
+func (ec *executionContext) _AgentChange(ctx context.Context, sel ast.SelectionSet, obj *model.AgentChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agentChangeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgentChange")
+		case "agent":
+
+			out.Values[i] = ec._AgentChange_agent(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "changeType":
+
+			out.Values[i] = ec._AgentChange_changeType(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var agentCommandImplementors = []string{"AgentCommand"}
+
+func (ec *executionContext) _AgentCommand(ctx context.Context, sel ast.SelectionSet, obj *model1.AgentCommand) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agentCommandImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgentCommand")
+		case "id":
+
+			out.Values[i] = ec._AgentCommand_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "agentId":
+
+			out.Values[i] = ec._AgentCommand_agentId(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "type":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AgentCommand_type(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "status":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AgentCommand_status(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "message":
+
+			out.Values[i] = ec._AgentCommand_message(ctx, field, obj)
+
+		case "createdAt":
+
+			out.Values[i] = ec._AgentCommand_createdAt(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "updatedAt":
+
+			out.Values[i] = ec._AgentCommand_updatedAt(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var agentConfigurationImplementors = []string{"AgentConfiguration"}
+
+func (ec *executionContext) _AgentConfiguration(ctx context.Context, sel ast.SelectionSet, obj *model.AgentConfiguration) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agentConfigurationImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgentConfiguration")
+		case "Collector":
+
+			out.Values[i] = ec._AgentConfiguration_Collector(ctx, field, obj)
+
+		case "Logging":
+
+			out.Values[i] = ec._AgentConfiguration_Logging(ctx, field, obj)
+
+		case "Manager":
+
+			out.Values[i] = ec._AgentConfiguration_Manager(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var agentConnectionImplementors = []string{"AgentConnection"}
+
+func (ec *executionContext) _AgentConnection(ctx context.Context, sel ast.SelectionSet, obj *model.AgentConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agentConnectionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgentConnection")
+		case "edges":
+
+			out.Values[i] = ec._AgentConnection_edges(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+
+			out.Values[i] = ec._AgentConnection_pageInfo(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var agentEdgeImplementors = []string{"AgentEdge"}
+
+func (ec *executionContext) _AgentEdge(ctx context.Context, sel ast.SelectionSet, obj *model.AgentEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agentEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgentEdge")
+		case "cursor":
+
+			out.Values[i] = ec._AgentEdge_cursor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+
+			out.Values[i] = ec._AgentEdge_node(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var agentGroupImplementors = []string{"AgentGroup", "Resource"}
+
+func (ec *executionContext) _AgentGroup(ctx context.Context, sel ast.SelectionSet, obj *model1.AgentGroup) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agentGroupImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgentGroup")
+		case "apiVersion":
+
+			out.Values[i] = ec._AgentGroup_apiVersion(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "kind":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AgentGroup_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "metadata":
+
+			out.Values[i] = ec._AgentGroup_metadata(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "spec":
+
+			out.Values[i] = ec._AgentGroup_spec(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "summary":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AgentGroup_summary(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "dependents":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AgentGroup_dependents(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "dependencies":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AgentGroup_dependencies(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var agentGroupCountImplementors = []string{"AgentGroupCount"}
+
+func (ec *executionContext) _AgentGroupCount(ctx context.Context, sel ast.SelectionSet, obj *model1.AgentGroupCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agentGroupCountImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgentGroupCount")
+		case "value":
+
+			out.Values[i] = ec._AgentGroupCount_value(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "count":
+
+			out.Values[i] = ec._AgentGroupCount_count(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var agentGroupSpecImplementors = []string{"AgentGroupSpec"}
+
+func (ec *executionContext) _AgentGroupSpec(ctx context.Context, sel ast.SelectionSet, obj *model1.AgentGroupSpec) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agentGroupSpecImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgentGroupSpec")
+		case "selector":
+
+			out.Values[i] = ec._AgentGroupSpec_selector(ctx, field, obj)
+
+		case "query":
+
+			out.Values[i] = ec._AgentGroupSpec_query(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var agentGroupSummaryImplementors = []string{"AgentGroupSummary"}
+
+func (ec *executionContext) _AgentGroupSummary(ctx context.Context, sel ast.SelectionSet, obj *model1.AgentGroupSummary) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agentGroupSummaryImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgentGroupSummary")
+		case "name":
+
+			out.Values[i] = ec._AgentGroupSummary_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "agents":
+
+			out.Values[i] = ec._AgentGroupSummary_agents(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "statuses":
+
+			out.Values[i] = ec._AgentGroupSummary_statuses(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "versions":
+
+			out.Values[i] = ec._AgentGroupSummary_versions(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var agentSelectorImplementors = []string{"AgentSelector"}
+
+func (ec *executionContext) _AgentSelector(ctx context.Context, sel ast.SelectionSet, obj *model1.AgentSelector) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agentSelectorImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AgentSelector")
+		case "matchLabels":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AgentSelector_matchLabels(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var agentsImplementors = []string{"Agents"}
+
+func (ec *executionContext) _Agents(ctx context.Context, sel ast.SelectionSet, obj *model.Agents) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, agentsImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Agents")
+		case "query":
+
+			out.Values[i] = ec._Agents_query(ctx, field, obj)
+
+		case "agents":
+
+			out.Values[i] = ec._Agents_agents(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "suggestions":
+
+			out.Values[i] = ec._Agents_suggestions(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var componentsImplementors = []string{"Components"}
+
+func (ec *executionContext) _Components(ctx context.Context, sel ast.SelectionSet, obj *model.Components) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, componentsImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Components")
+		case "sources":
+
+			out.Values[i] = ec._Components_sources(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "destinations":
+
+			out.Values[i] = ec._Components_destinations(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var configurationImplementors = []string{"Configuration", "Resource"}
+
+func (ec *executionContext) _Configuration(ctx context.Context, sel ast.SelectionSet, obj *model1.Configuration) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, configurationImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Configuration")
+		case "apiVersion":
+
+			out.Values[i] = ec._Configuration_apiVersion(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "kind":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Configuration_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "metadata":
+
+			out.Values[i] = ec._Configuration_metadata(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "spec":
+
+			out.Values[i] = ec._Configuration_spec(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "dependents":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Configuration_dependents(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "dependencies":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Configuration_dependencies(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var configurationChangeImplementors = []string{"ConfigurationChange"}
+
+func (ec *executionContext) _ConfigurationChange(ctx context.Context, sel ast.SelectionSet, obj *model.ConfigurationChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, configurationChangeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ConfigurationChange")
+		case "configuration":
+
+			out.Values[i] = ec._ConfigurationChange_configuration(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "eventType":
+
+			out.Values[i] = ec._ConfigurationChange_eventType(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var configurationConnectionImplementors = []string{"ConfigurationConnection"}
+
+func (ec *executionContext) _ConfigurationConnection(ctx context.Context, sel ast.SelectionSet, obj *model.ConfigurationConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, configurationConnectionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ConfigurationConnection")
+		case "edges":
+
+			out.Values[i] = ec._ConfigurationConnection_edges(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+
+			out.Values[i] = ec._ConfigurationConnection_pageInfo(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var configurationEdgeImplementors = []string{"ConfigurationEdge"}
+
+func (ec *executionContext) _ConfigurationEdge(ctx context.Context, sel ast.SelectionSet, obj *model.ConfigurationEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, configurationEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ConfigurationEdge")
+		case "cursor":
+
+			out.Values[i] = ec._ConfigurationEdge_cursor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+
+			out.Values[i] = ec._ConfigurationEdge_node(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var configurationSpecImplementors = []string{"ConfigurationSpec"}
+
+func (ec *executionContext) _ConfigurationSpec(ctx context.Context, sel ast.SelectionSet, obj *model1.ConfigurationSpec) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, configurationSpecImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ConfigurationSpec")
+		case "contentType":
+
+			out.Values[i] = ec._ConfigurationSpec_contentType(ctx, field, obj)
+
+		case "raw":
+
+			out.Values[i] = ec._ConfigurationSpec_raw(ctx, field, obj)
+
+		case "sources":
+
+			out.Values[i] = ec._ConfigurationSpec_sources(ctx, field, obj)
+
+		case "destinations":
+
+			out.Values[i] = ec._ConfigurationSpec_destinations(ctx, field, obj)
+
+		case "processors":
+
+			out.Values[i] = ec._ConfigurationSpec_processors(ctx, field, obj)
+
+		case "selector":
+
+			out.Values[i] = ec._ConfigurationSpec_selector(ctx, field, obj)
+
+		case "agentGroup":
+
+			out.Values[i] = ec._ConfigurationSpec_agentGroup(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var configurationsImplementors = []string{"Configurations"}
+
+func (ec *executionContext) _Configurations(ctx context.Context, sel ast.SelectionSet, obj *model.Configurations) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, configurationsImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Configurations")
+		case "query":
+
+			out.Values[i] = ec._Configurations_query(ctx, field, obj)
+
+		case "configurations":
+
+			out.Values[i] = ec._Configurations_configurations(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "suggestions":
+
+			out.Values[i] = ec._Configurations_suggestions(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var connectorImplementors = []string{"Connector", "Resource"}
+
+func (ec *executionContext) _Connector(ctx context.Context, sel ast.SelectionSet, obj *model1.Connector) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, connectorImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Connector")
+		case "apiVersion":
+
+			out.Values[i] = ec._Connector_apiVersion(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "kind":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Connector_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "metadata":
+
+			out.Values[i] = ec._Connector_metadata(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "spec":
+
+			out.Values[i] = ec._Connector_spec(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var connectorTypeImplementors = []string{"ConnectorType", "Resource"}
+
+func (ec *executionContext) _ConnectorType(ctx context.Context, sel ast.SelectionSet, obj *model1.ConnectorType) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, connectorTypeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ConnectorType")
+		case "apiVersion":
+
+			out.Values[i] = ec._ConnectorType_apiVersion(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "metadata":
+
+			out.Values[i] = ec._ConnectorType_metadata(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "kind":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ConnectorType_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "spec":
+
+			out.Values[i] = ec._ConnectorType_spec(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var destinationImplementors = []string{"Destination", "Resource"}
+
+func (ec *executionContext) _Destination(ctx context.Context, sel ast.SelectionSet, obj *model1.Destination) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, destinationImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Destination")
+		case "apiVersion":
+
+			out.Values[i] = ec._Destination_apiVersion(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "kind":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Destination_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "metadata":
+
+			out.Values[i] = ec._Destination_metadata(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "spec":
+
+			out.Values[i] = ec._Destination_spec(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "dependents":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Destination_dependents(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "dependencies":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Destination_dependencies(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var destinationChangeImplementors = []string{"DestinationChange"}
+
+func (ec *executionContext) _DestinationChange(ctx context.Context, sel ast.SelectionSet, obj *model.DestinationChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, destinationChangeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DestinationChange")
+		case "destination":
+
+			out.Values[i] = ec._DestinationChange_destination(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "eventType":
+
+			out.Values[i] = ec._DestinationChange_eventType(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var destinationConnectionImplementors = []string{"DestinationConnection"}
+
+func (ec *executionContext) _DestinationConnection(ctx context.Context, sel ast.SelectionSet, obj *model.DestinationConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, destinationConnectionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DestinationConnection")
+		case "edges":
+
+			out.Values[i] = ec._DestinationConnection_edges(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+
+			out.Values[i] = ec._DestinationConnection_pageInfo(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var destinationEdgeImplementors = []string{"DestinationEdge"}
+
+func (ec *executionContext) _DestinationEdge(ctx context.Context, sel ast.SelectionSet, obj *model.DestinationEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, destinationEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DestinationEdge")
+		case "cursor":
+
+			out.Values[i] = ec._DestinationEdge_cursor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+
+			out.Values[i] = ec._DestinationEdge_node(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var destinationTypeImplementors = []string{"DestinationType", "Resource"}
+
+func (ec *executionContext) _DestinationType(ctx context.Context, sel ast.SelectionSet, obj *model1.DestinationType) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, destinationTypeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DestinationType")
+		case "apiVersion":
+
+			out.Values[i] = ec._DestinationType_apiVersion(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "metadata":
+
+			out.Values[i] = ec._DestinationType_metadata(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "kind":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DestinationType_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "spec":
+
+			out.Values[i] = ec._DestinationType_spec(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "dependents":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DestinationType_dependents(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "dependencies":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DestinationType_dependencies(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var destinationTypeConnectionImplementors = []string{"DestinationTypeConnection"}
+
+func (ec *executionContext) _DestinationTypeConnection(ctx context.Context, sel ast.SelectionSet, obj *model.DestinationTypeConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, destinationTypeConnectionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DestinationTypeConnection")
+		case "edges":
+
+			out.Values[i] = ec._DestinationTypeConnection_edges(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+
+			out.Values[i] = ec._DestinationTypeConnection_pageInfo(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var destinationTypeEdgeImplementors = []string{"DestinationTypeEdge"}
+
+func (ec *executionContext) _DestinationTypeEdge(ctx context.Context, sel ast.SelectionSet, obj *model.DestinationTypeEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, destinationTypeEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DestinationTypeEdge")
+		case "cursor":
+
+			out.Values[i] = ec._DestinationTypeEdge_cursor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+
+			out.Values[i] = ec._DestinationTypeEdge_node(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var destinationWithTypeImplementors = []string{"DestinationWithType"}
+
+func (ec *executionContext) _DestinationWithType(ctx context.Context, sel ast.SelectionSet, obj *model.DestinationWithType) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, destinationWithTypeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DestinationWithType")
+		case "destination":
+
+			out.Values[i] = ec._DestinationWithType_destination(ctx, field, obj)
+
+		case "destinationType":
+
+			out.Values[i] = ec._DestinationWithType_destinationType(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var metadataImplementors = []string{"Metadata"}
+
+func (ec *executionContext) _Metadata(ctx context.Context, sel ast.SelectionSet, obj *model1.Metadata) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, metadataImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Metadata")
+		case "id":
+
+			out.Values[i] = ec._Metadata_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+
+			out.Values[i] = ec._Metadata_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "displayName":
+
+			out.Values[i] = ec._Metadata_displayName(ctx, field, obj)
+
+		case "description":
+
+			out.Values[i] = ec._Metadata_description(ctx, field, obj)
+
+		case "icon":
+
+			out.Values[i] = ec._Metadata_icon(ctx, field, obj)
+
+		case "labels":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Metadata_labels(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "resourceVersion":
+
+			out.Values[i] = ec._Metadata_resourceVersion(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "executeAgentCommand":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_executeAgentCommand(ctx, field)
+			})
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var pageInfoImplementors = []string{"PageInfo"}
+
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *model.PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
+
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "endCursor":
+
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var parameterImplementors = []string{"Parameter"}
+
+func (ec *executionContext) _Parameter(ctx context.Context, sel ast.SelectionSet, obj *model1.Parameter) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, parameterImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Parameter")
+		case "name":
+
+			out.Values[i] = ec._Parameter_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "value":
+
+			out.Values[i] = ec._Parameter_value(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var parameterDefinitionImplementors = []string{"ParameterDefinition"}
+
+func (ec *executionContext) _ParameterDefinition(ctx context.Context, sel ast.SelectionSet, obj *model1.ParameterDefinition) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, parameterDefinitionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ParameterDefinition")
+		case "name":
+
+			out.Values[i] = ec._ParameterDefinition_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "label":
+
+			out.Values[i] = ec._ParameterDefinition_label(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "description":
+
+			out.Values[i] = ec._ParameterDefinition_description(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "required":
+
+			out.Values[i] = ec._ParameterDefinition_required(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "type":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ParameterDefinition_type(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "validValues":
+
+			out.Values[i] = ec._ParameterDefinition_validValues(ctx, field, obj)
+
+		case "min":
+
+			out.Values[i] = ec._ParameterDefinition_min(ctx, field, obj)
+
+		case "max":
+
+			out.Values[i] = ec._ParameterDefinition_max(ctx, field, obj)
+
+		case "pattern":
+
+			out.Values[i] = ec._ParameterDefinition_pattern(ctx, field, obj)
+
+		case "properties":
+
+			out.Values[i] = ec._ParameterDefinition_properties(ctx, field, obj)
+
+		case "default":
+
+			out.Values[i] = ec._ParameterDefinition_default(ctx, field, obj)
+
+		case "relevantIf":
+
+			out.Values[i] = ec._ParameterDefinition_relevantIf(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var parameterizedSpecImplementors = []string{"ParameterizedSpec"}
+
+func (ec *executionContext) _ParameterizedSpec(ctx context.Context, sel ast.SelectionSet, obj *model1.ParameterizedSpec) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, parameterizedSpecImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ParameterizedSpec")
+		case "type":
+
+			out.Values[i] = ec._ParameterizedSpec_type(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "parameters":
+
+			out.Values[i] = ec._ParameterizedSpec_parameters(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var processorImplementors = []string{"Processor", "Resource"}
+
+func (ec *executionContext) _Processor(ctx context.Context, sel ast.SelectionSet, obj *model1.Processor) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, processorImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Processor")
+		case "apiVersion":
+
+			out.Values[i] = ec._Processor_apiVersion(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "kind":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Processor_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "metadata":
+
+			out.Values[i] = ec._Processor_metadata(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "spec":
+
+			out.Values[i] = ec._Processor_spec(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "dependents":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Processor_dependents(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "dependencies":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Processor_dependencies(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var processorChangeImplementors = []string{"ProcessorChange"}
+
+func (ec *executionContext) _ProcessorChange(ctx context.Context, sel ast.SelectionSet, obj *model.ProcessorChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, processorChangeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProcessorChange")
+		case "processor":
+
+			out.Values[i] = ec._ProcessorChange_processor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "eventType":
+
+			out.Values[i] = ec._ProcessorChange_eventType(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var processorConnectionImplementors = []string{"ProcessorConnection"}
+
+func (ec *executionContext) _ProcessorConnection(ctx context.Context, sel ast.SelectionSet, obj *model.ProcessorConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, processorConnectionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProcessorConnection")
+		case "edges":
+
+			out.Values[i] = ec._ProcessorConnection_edges(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+
+			out.Values[i] = ec._ProcessorConnection_pageInfo(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var processorEdgeImplementors = []string{"ProcessorEdge"}
+
+func (ec *executionContext) _ProcessorEdge(ctx context.Context, sel ast.SelectionSet, obj *model.ProcessorEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, processorEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProcessorEdge")
+		case "cursor":
+
+			out.Values[i] = ec._ProcessorEdge_cursor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+
+			out.Values[i] = ec._ProcessorEdge_node(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var processorTypeImplementors = []string{"ProcessorType", "Resource"}
+
+func (ec *executionContext) _ProcessorType(ctx context.Context, sel ast.SelectionSet, obj *model1.ProcessorType) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, processorTypeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProcessorType")
+		case "apiVersion":
+
+			out.Values[i] = ec._ProcessorType_apiVersion(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "metadata":
+
+			out.Values[i] = ec._ProcessorType_metadata(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "kind":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProcessorType_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "spec":
+
+			out.Values[i] = ec._ProcessorType_spec(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "dependents":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProcessorType_dependents(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "dependencies":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ProcessorType_dependencies(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var processorTypeConnectionImplementors = []string{"ProcessorTypeConnection"}
+
+func (ec *executionContext) _ProcessorTypeConnection(ctx context.Context, sel ast.SelectionSet, obj *model.ProcessorTypeConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, processorTypeConnectionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProcessorTypeConnection")
+		case "edges":
+
+			out.Values[i] = ec._ProcessorTypeConnection_edges(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+
+			out.Values[i] = ec._ProcessorTypeConnection_pageInfo(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var processorTypeEdgeImplementors = []string{"ProcessorTypeEdge"}
+
+func (ec *executionContext) _ProcessorTypeEdge(ctx context.Context, sel ast.SelectionSet, obj *model.ProcessorTypeEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, processorTypeEdgeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProcessorTypeEdge")
+		case "cursor":
+
+			out.Values[i] = ec._ProcessorTypeEdge_cursor(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+
+			out.Values[i] = ec._ProcessorTypeEdge_node(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "agents":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_agents(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "agent":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_agent(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "agentGroups":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_agentGroups(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "agentGroup":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_agentGroup(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "configurations":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_configurations(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "configuration":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_configuration(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "sources":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_sources(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "source":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_source(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "sourceTypes":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_sourceTypes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "sourceType":
+			field := field
 
-			out.Values[i] = ec._Agent_version(ctx, field, obj)
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_sourceType(ctx, field)
+				return res
+			}
 
-		case "name":
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
 
-			out.Values[i] = ec._Agent_name(ctx, field, obj)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "processors":
+			field := field
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_processors(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-		case "home":
 
-			out.Values[i] = ec._Agent_home(ctx, field, obj)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
 
-		case "macAddress":
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "processor":
+			field := field
 
-			out.Values[i] = ec._Agent_macAddress(ctx, field, obj)
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_processor(ctx, field)
+				return res
+			}
 
-		case "remoteAddress":
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
 
-			out.Values[i] = ec._Agent_remoteAddress(ctx, field, obj)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "processorTypes":
+			field := field
 
-		case "type":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_processorTypes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "processorType":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_processorType(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "destinations":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_destinations(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "destination":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_destination(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "destinationWithType":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_destinationWithType(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "destinationTypes":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_destinationTypes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "destinationType":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_destinationType(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "components":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_components(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "agentsConnection":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_agentsConnection(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
 
-			out.Values[i] = ec._Agent_type(ctx, field, obj)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
 
-		case "status":
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "configurationsConnection":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -9806,30 +20494,21 @@ func (ec *executionContext) _Agent(ctx context.Context, sel ast.SelectionSet, ob
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Agent_status(ctx, field, obj)
+				res = ec._Query_configurationsConnection(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
 			})
-		case "errorMessage":
-
-			out.Values[i] = ec._Agent_errorMessage(ctx, field, obj)
-
-		case "connectedAt":
-
-			out.Values[i] = ec._Agent_connectedAt(ctx, field, obj)
-
-		case "disconnectedAt":
-
-			out.Values[i] = ec._Agent_disconnectedAt(ctx, field, obj)
-
-		case "configuration":
+		case "sourcesConnection":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -9838,15 +20517,21 @@ func (ec *executionContext) _Agent(ctx context.Context, sel ast.SelectionSet, ob
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Agent_configuration(ctx, field, obj)
+				res = ec._Query_sourcesConnection(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
 			})
-		case "configurationResource":
+		case "sourceTypesConnection":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -9855,104 +20540,44 @@ func (ec *executionContext) _Agent(ctx context.Context, sel ast.SelectionSet, ob
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Agent_configurationResource(ctx, field, obj)
+				res = ec._Query_sourceTypesConnection(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
 			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var agentChangeImplementors = []string{"AgentChange"}
-
-func (ec *executionContext) _AgentChange(ctx context.Context, sel ast.SelectionSet, obj *model1.AgentChange) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, agentChangeImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("AgentChange")
-		case "agent":
-
-			out.Values[i] = ec._AgentChange_agent(ctx, field, obj)
+		case "processorsConnection":
+			field := field
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_processorsConnection(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-		case "changeType":
 
-			out.Values[i] = ec._AgentChange_changeType(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var agentConfigurationImplementors = []string{"AgentConfiguration"}
-
-func (ec *executionContext) _AgentConfiguration(ctx context.Context, sel ast.SelectionSet, obj *model1.AgentConfiguration) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, agentConfigurationImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("AgentConfiguration")
-		case "Collector":
-
-			out.Values[i] = ec._AgentConfiguration_Collector(ctx, field, obj)
-
-		case "Logging":
-
-			out.Values[i] = ec._AgentConfiguration_Logging(ctx, field, obj)
-
-		case "Manager":
-
-			out.Values[i] = ec._AgentConfiguration_Manager(ctx, field, obj)
-
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
 
-var agentSelectorImplementors = []string{"AgentSelector"}
-
-func (ec *executionContext) _AgentSelector(ctx context.Context, sel ast.SelectionSet, obj *model.AgentSelector) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, agentSelectorImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("AgentSelector")
-		case "matchLabels":
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "processorTypesConnection":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -9961,85 +20586,78 @@ func (ec *executionContext) _AgentSelector(ctx context.Context, sel ast.Selectio
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._AgentSelector_matchLabels(ctx, field, obj)
+				res = ec._Query_processorTypesConnection(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
 			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var agentsImplementors = []string{"Agents"}
-
-func (ec *executionContext) _Agents(ctx context.Context, sel ast.SelectionSet, obj *model1.Agents) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, agentsImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Agents")
-		case "query":
-
-			out.Values[i] = ec._Agents_query(ctx, field, obj)
-
-		case "agents":
-
-			out.Values[i] = ec._Agents_agents(ctx, field, obj)
+		case "destinationsConnection":
+			field := field
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_destinationsConnection(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-		case "suggestions":
 
-			out.Values[i] = ec._Agents_suggestions(ctx, field, obj)
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "destinationTypesConnection":
+			field := field
 
-var componentsImplementors = []string{"Components"}
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_destinationTypesConnection(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) _Components(ctx context.Context, sel ast.SelectionSet, obj *model1.Components) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, componentsImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Components")
-		case "sources":
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
 
-			out.Values[i] = ec._Components_sources(ctx, field, obj)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "__type":
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "destinations":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
 
-			out.Values[i] = ec._Components_destinations(ctx, field, obj)
+		case "__schema":
+
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10051,24 +20669,24 @@ func (ec *executionContext) _Components(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var configurationImplementors = []string{"Configuration"}
+var relevantIfConditionImplementors = []string{"RelevantIfCondition"}
 
-func (ec *executionContext) _Configuration(ctx context.Context, sel ast.SelectionSet, obj *model.Configuration) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, configurationImplementors)
+func (ec *executionContext) _RelevantIfCondition(ctx context.Context, sel ast.SelectionSet, obj *model1.RelevantIfCondition) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relevantIfConditionImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Configuration")
-		case "apiVersion":
+			out.Values[i] = graphql.MarshalString("RelevantIfCondition")
+		case "name":
 
-			out.Values[i] = ec._Configuration_apiVersion(ctx, field, obj)
+			out.Values[i] = ec._RelevantIfCondition_name(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "kind":
+		case "operator":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -10077,7 +20695,7 @@ func (ec *executionContext) _Configuration(ctx context.Context, sel ast.Selectio
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Configuration_kind(ctx, field, obj)
+				res = ec._RelevantIfCondition_operator(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -10088,20 +20706,14 @@ func (ec *executionContext) _Configuration(ctx context.Context, sel ast.Selectio
 				return innerFunc(ctx)
 
 			})
-		case "metadata":
+		case "value":
 
-			out.Values[i] = ec._Configuration_metadata(ctx, field, obj)
+			out.Values[i] = ec._RelevantIfCondition_value(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "spec":
+		case "conditions":
 
-			out.Values[i] = ec._Configuration_spec(ctx, field, obj)
+			out.Values[i] = ec._RelevantIfCondition_conditions(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10113,26 +20725,26 @@ func (ec *executionContext) _Configuration(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var configurationChangeImplementors = []string{"ConfigurationChange"}
+var resourceChangeImplementors = []string{"ResourceChange"}
 
-func (ec *executionContext) _ConfigurationChange(ctx context.Context, sel ast.SelectionSet, obj *model1.ConfigurationChange) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, configurationChangeImplementors)
+func (ec *executionContext) _ResourceChange(ctx context.Context, sel ast.SelectionSet, obj *model.ResourceChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceChangeImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ConfigurationChange")
-		case "configuration":
+			out.Values[i] = graphql.MarshalString("ResourceChange")
+		case "resource":
 
-			out.Values[i] = ec._ConfigurationChange_configuration(ctx, field, obj)
+			out.Values[i] = ec._ResourceChange_resource(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
 		case "eventType":
 
-			out.Values[i] = ec._ConfigurationChange_eventType(ctx, field, obj)
+			out.Values[i] = ec._ResourceChange_eventType(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -10148,35 +20760,31 @@ func (ec *executionContext) _ConfigurationChange(ctx context.Context, sel ast.Se
 	return out
 }
 
-var configurationSpecImplementors = []string{"ConfigurationSpec"}
+var resourceConfigurationImplementors = []string{"ResourceConfiguration"}
 
-func (ec *executionContext) _ConfigurationSpec(ctx context.Context, sel ast.SelectionSet, obj *model.ConfigurationSpec) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, configurationSpecImplementors)
+func (ec *executionContext) _ResourceConfiguration(ctx context.Context, sel ast.SelectionSet, obj *model1.ResourceConfiguration) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceConfigurationImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ConfigurationSpec")
-		case "contentType":
-
-			out.Values[i] = ec._ConfigurationSpec_contentType(ctx, field, obj)
-
-		case "raw":
+			out.Values[i] = graphql.MarshalString("ResourceConfiguration")
+		case "name":
 
-			out.Values[i] = ec._ConfigurationSpec_raw(ctx, field, obj)
+			out.Values[i] = ec._ResourceConfiguration_name(ctx, field, obj)
 
-		case "sources":
+		case "type":
 
-			out.Values[i] = ec._ConfigurationSpec_sources(ctx, field, obj)
+			out.Values[i] = ec._ResourceConfiguration_type(ctx, field, obj)
 
-		case "destinations":
+		case "parameters":
 
-			out.Values[i] = ec._ConfigurationSpec_destinations(ctx, field, obj)
+			out.Values[i] = ec._ResourceConfiguration_parameters(ctx, field, obj)
 
-		case "selector":
+		case "processors":
 
-			out.Values[i] = ec._ConfigurationSpec_selector(ctx, field, obj)
+			out.Values[i] = ec._ResourceConfiguration_processors(ctx, field, obj)
 
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -10189,31 +20797,30 @@ func (ec *executionContext) _ConfigurationSpec(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var configurationsImplementors = []string{"Configurations"}
+var resourceDependentsImplementors = []string{"ResourceDependents"}
 
-func (ec *executionContext) _Configurations(ctx context.Context, sel ast.SelectionSet, obj *model1.Configurations) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, configurationsImplementors)
+func (ec *executionContext) _ResourceDependents(ctx context.Context, sel ast.SelectionSet, obj *model1.ResourceDependents) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceDependentsImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Configurations")
-		case "query":
+			out.Values[i] = graphql.MarshalString("ResourceDependents")
+		case "resources":
 
-			out.Values[i] = ec._Configurations_query(ctx, field, obj)
-
-		case "configurations":
-
-			out.Values[i] = ec._Configurations_configurations(ctx, field, obj)
+			out.Values[i] = ec._ResourceDependents_resources(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "suggestions":
+		case "agentIds":
 
-			out.Values[i] = ec._Configurations_suggestions(ctx, field, obj)
+			out.Values[i] = ec._ResourceDependents_agentIds(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10225,23 +20832,16 @@ func (ec *executionContext) _Configurations(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var destinationImplementors = []string{"Destination"}
+var resourceReferenceImplementors = []string{"ResourceReference"}
 
-func (ec *executionContext) _Destination(ctx context.Context, sel ast.SelectionSet, obj *model.Destination) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, destinationImplementors)
+func (ec *executionContext) _ResourceReference(ctx context.Context, sel ast.SelectionSet, obj *model1.ResourceReference) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceReferenceImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Destination")
-		case "apiVersion":
-
-			out.Values[i] = ec._Destination_apiVersion(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
+			out.Values[i] = graphql.MarshalString("ResourceReference")
 		case "kind":
 			field := field
 
@@ -10251,7 +20851,7 @@ func (ec *executionContext) _Destination(ctx context.Context, sel ast.SelectionS
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Destination_kind(ctx, field, obj)
+				res = ec._ResourceReference_kind(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -10262,19 +20862,61 @@ func (ec *executionContext) _Destination(ctx context.Context, sel ast.SelectionS
 				return innerFunc(ctx)
 
 			})
-		case "metadata":
+		case "name":
 
-			out.Values[i] = ec._Destination_metadata(ctx, field, obj)
+			out.Values[i] = ec._ResourceReference_name(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "spec":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._Destination_spec(ctx, field, obj)
+var resourceTypeSpecImplementors = []string{"ResourceTypeSpec"}
+
+func (ec *executionContext) _ResourceTypeSpec(ctx context.Context, sel ast.SelectionSet, obj *model1.ResourceTypeSpec) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceTypeSpecImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ResourceTypeSpec")
+		case "version":
+
+			out.Values[i] = ec._ResourceTypeSpec_version(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
+			}
+		case "parameters":
+
+			out.Values[i] = ec._ResourceTypeSpec_parameters(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "supportedPlatforms":
+
+			out.Values[i] = ec._ResourceTypeSpec_supportedPlatforms(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "telemetryTypes":
+
+			out.Values[i] = ec._ResourceTypeSpec_telemetryTypes(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -10287,31 +20929,58 @@ func (ec *executionContext) _Destination(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var destinationTypeImplementors = []string{"DestinationType"}
+var sourceImplementors = []string{"Source", "Resource"}
 
-func (ec *executionContext) _DestinationType(ctx context.Context, sel ast.SelectionSet, obj *model.DestinationType) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, destinationTypeImplementors)
+func (ec *executionContext) _Source(ctx context.Context, sel ast.SelectionSet, obj *model1.Source) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sourceImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DestinationType")
+			out.Values[i] = graphql.MarshalString("Source")
 		case "apiVersion":
 
-			out.Values[i] = ec._DestinationType_apiVersion(ctx, field, obj)
+			out.Values[i] = ec._Source_apiVersion(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
+		case "kind":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Source_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
 		case "metadata":
 
-			out.Values[i] = ec._DestinationType_metadata(ctx, field, obj)
+			out.Values[i] = ec._Source_metadata(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "kind":
+		case "spec":
+
+			out.Values[i] = ec._Source_spec(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "dependents":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -10320,7 +20989,7 @@ func (ec *executionContext) _DestinationType(ctx context.Context, sel ast.Select
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._DestinationType_kind(ctx, field, obj)
+				res = ec._Source_dependents(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -10331,13 +21000,26 @@ func (ec *executionContext) _DestinationType(ctx context.Context, sel ast.Select
 				return innerFunc(ctx)
 
 			})
-		case "spec":
-
-			out.Values[i] = ec._DestinationType_spec(ctx, field, obj)
+		case "dependencies":
+			field := field
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Source_dependencies(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10349,24 +21031,30 @@ func (ec *executionContext) _DestinationType(ctx context.Context, sel ast.Select
 	return out
 }
 
-var destinationWithTypeImplementors = []string{"DestinationWithType"}
+var sourceChangeImplementors = []string{"SourceChange"}
 
-func (ec *executionContext) _DestinationWithType(ctx context.Context, sel ast.SelectionSet, obj *model1.DestinationWithType) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, destinationWithTypeImplementors)
+func (ec *executionContext) _SourceChange(ctx context.Context, sel ast.SelectionSet, obj *model.SourceChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sourceChangeImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DestinationWithType")
-		case "destination":
+			out.Values[i] = graphql.MarshalString("SourceChange")
+		case "source":
 
-			out.Values[i] = ec._DestinationWithType_destination(ctx, field, obj)
+			out.Values[i] = ec._SourceChange_source(ctx, field, obj)
 
-		case "destinationType":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "eventType":
 
-			out.Values[i] = ec._DestinationWithType_destinationType(ctx, field, obj)
+			out.Values[i] = ec._SourceChange_eventType(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10378,59 +21066,30 @@ func (ec *executionContext) _DestinationWithType(ctx context.Context, sel ast.Se
 	return out
 }
 
-var metadataImplementors = []string{"Metadata"}
+var sourceConnectionImplementors = []string{"SourceConnection"}
 
-func (ec *executionContext) _Metadata(ctx context.Context, sel ast.SelectionSet, obj *model.Metadata) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, metadataImplementors)
+func (ec *executionContext) _SourceConnection(ctx context.Context, sel ast.SelectionSet, obj *model.SourceConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sourceConnectionImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Metadata")
-		case "id":
-
-			out.Values[i] = ec._Metadata_id(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "name":
-
-			out.Values[i] = ec._Metadata_name(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "displayName":
-
-			out.Values[i] = ec._Metadata_displayName(ctx, field, obj)
-
-		case "description":
-
-			out.Values[i] = ec._Metadata_description(ctx, field, obj)
-
-		case "icon":
-
-			out.Values[i] = ec._Metadata_icon(ctx, field, obj)
-
-		case "labels":
-			field := field
-
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Metadata_labels(ctx, field, obj)
-				return res
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SourceConnection")
+		case "edges":
+
+			out.Values[i] = ec._SourceConnection_edges(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "pageInfo":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+			out.Values[i] = ec._SourceConnection_pageInfo(ctx, field, obj)
 
-			})
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10442,26 +21101,26 @@ func (ec *executionContext) _Metadata(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var parameterImplementors = []string{"Parameter"}
+var sourceEdgeImplementors = []string{"SourceEdge"}
 
-func (ec *executionContext) _Parameter(ctx context.Context, sel ast.SelectionSet, obj *model.Parameter) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, parameterImplementors)
+func (ec *executionContext) _SourceEdge(ctx context.Context, sel ast.SelectionSet, obj *model.SourceEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sourceEdgeImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Parameter")
-		case "name":
+			out.Values[i] = graphql.MarshalString("SourceEdge")
+		case "cursor":
 
-			out.Values[i] = ec._Parameter_name(ctx, field, obj)
+			out.Values[i] = ec._SourceEdge_cursor(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "value":
+		case "node":
 
-			out.Values[i] = ec._Parameter_value(ctx, field, obj)
+			out.Values[i] = ec._SourceEdge_node(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -10477,45 +21136,58 @@ func (ec *executionContext) _Parameter(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var parameterDefinitionImplementors = []string{"ParameterDefinition"}
+var sourceTypeImplementors = []string{"SourceType", "Resource"}
 
-func (ec *executionContext) _ParameterDefinition(ctx context.Context, sel ast.SelectionSet, obj *model.ParameterDefinition) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, parameterDefinitionImplementors)
+func (ec *executionContext) _SourceType(ctx context.Context, sel ast.SelectionSet, obj *model1.SourceType) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sourceTypeImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ParameterDefinition")
-		case "name":
+			out.Values[i] = graphql.MarshalString("SourceType")
+		case "apiVersion":
 
-			out.Values[i] = ec._ParameterDefinition_name(ctx, field, obj)
+			out.Values[i] = ec._SourceType_apiVersion(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "label":
+		case "metadata":
 
-			out.Values[i] = ec._ParameterDefinition_label(ctx, field, obj)
+			out.Values[i] = ec._SourceType_metadata(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "description":
-
-			out.Values[i] = ec._ParameterDefinition_description(ctx, field, obj)
+		case "kind":
+			field := field
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SourceType_kind(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
 			}
-		case "required":
 
-			out.Values[i] = ec._ParameterDefinition_required(ctx, field, obj)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
+		case "spec":
+
+			out.Values[i] = ec._SourceType_spec(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "type":
+		case "dependents":
 			field := field
 
 			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
@@ -10524,7 +21196,7 @@ func (ec *executionContext) _ParameterDefinition(ctx context.Context, sel ast.Se
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._ParameterDefinition_type(ctx, field, obj)
+				res = ec._SourceType_dependents(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -10535,18 +21207,26 @@ func (ec *executionContext) _ParameterDefinition(ctx context.Context, sel ast.Se
 				return innerFunc(ctx)
 
 			})
-		case "validValues":
-
-			out.Values[i] = ec._ParameterDefinition_validValues(ctx, field, obj)
-
-		case "default":
-
-			out.Values[i] = ec._ParameterDefinition_default(ctx, field, obj)
+		case "dependencies":
+			field := field
 
-		case "relevantIf":
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SourceType_dependencies(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
 
-			out.Values[i] = ec._ParameterDefinition_relevantIf(ctx, field, obj)
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
 
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10558,27 +21238,30 @@ func (ec *executionContext) _ParameterDefinition(ctx context.Context, sel ast.Se
 	return out
 }
 
-var parameterizedSpecImplementors = []string{"ParameterizedSpec"}
+var sourceTypeConnectionImplementors = []string{"SourceTypeConnection"}
 
-func (ec *executionContext) _ParameterizedSpec(ctx context.Context, sel ast.SelectionSet, obj *model.ParameterizedSpec) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, parameterizedSpecImplementors)
+func (ec *executionContext) _SourceTypeConnection(ctx context.Context, sel ast.SelectionSet, obj *model.SourceTypeConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sourceTypeConnectionImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ParameterizedSpec")
-		case "type":
+			out.Values[i] = graphql.MarshalString("SourceTypeConnection")
+		case "edges":
 
-			out.Values[i] = ec._ParameterizedSpec_type(ctx, field, obj)
+			out.Values[i] = ec._SourceTypeConnection_edges(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "parameters":
+		case "pageInfo":
 
-			out.Values[i] = ec._ParameterizedSpec_parameters(ctx, field, obj)
+			out.Values[i] = ec._SourceTypeConnection_pageInfo(ctx, field, obj)
 
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10590,56 +21273,96 @@ func (ec *executionContext) _ParameterizedSpec(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var processorImplementors = []string{"Processor"}
+var sourceTypeEdgeImplementors = []string{"SourceTypeEdge"}
 
-func (ec *executionContext) _Processor(ctx context.Context, sel ast.SelectionSet, obj *model.Processor) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, processorImplementors)
+func (ec *executionContext) _SourceTypeEdge(ctx context.Context, sel ast.SelectionSet, obj *model.SourceTypeEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sourceTypeEdgeImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Processor")
-		case "apiVersion":
+			out.Values[i] = graphql.MarshalString("SourceTypeEdge")
+		case "cursor":
 
-			out.Values[i] = ec._Processor_apiVersion(ctx, field, obj)
+			out.Values[i] = ec._SourceTypeEdge_cursor(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "kind":
-			field := field
+		case "node":
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Processor_kind(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
+			out.Values[i] = ec._SourceTypeEdge_node(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+var subscriptionImplementors = []string{"Subscription"}
 
-			})
-		case "metadata":
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
 
-			out.Values[i] = ec._Processor_metadata(ctx, field, obj)
+	switch fields[0].Name {
+	case "agentChanges":
+		return ec._Subscription_agentChanges(ctx, fields[0])
+	case "configurationChanges":
+		return ec._Subscription_configurationChanges(ctx, fields[0])
+	case "sourceChanges":
+		return ec._Subscription_sourceChanges(ctx, fields[0])
+	case "processorChanges":
+		return ec._Subscription_processorChanges(ctx, fields[0])
+	case "destinationChanges":
+		return ec._Subscription_destinationChanges(ctx, fields[0])
+	case "resourceTypeChanges":
+		return ec._Subscription_resourceTypeChanges(ctx, fields[0])
+	case "resourceChanges":
+		return ec._Subscription_resourceChanges(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+var suggestionImplementors = []string{"Suggestion"}
+
+func (ec *executionContext) _Suggestion(ctx context.Context, sel ast.SelectionSet, obj *search.Suggestion) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, suggestionImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Suggestion")
+		case "label":
+
+			out.Values[i] = ec._Suggestion_label(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "spec":
+		case "query":
 
-			out.Values[i] = ec._Processor_spec(ctx, field, obj)
+			out.Values[i] = ec._Suggestion_query(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -10652,57 +21375,91 @@ func (ec *executionContext) _Processor(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var processorTypeImplementors = []string{"ProcessorType"}
+var __DirectiveImplementors = []string{"__Directive"}
 
-func (ec *executionContext) _ProcessorType(ctx context.Context, sel ast.SelectionSet, obj *model.ProcessorType) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, processorTypeImplementors)
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ProcessorType")
-		case "apiVersion":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
 
-			out.Values[i] = ec._ProcessorType_apiVersion(ctx, field, obj)
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "metadata":
+		case "description":
 
-			out.Values[i] = ec._ProcessorType_metadata(ctx, field, obj)
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+
+		case "locations":
+
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "kind":
-			field := field
+		case "args":
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._ProcessorType_kind(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "isRepeatable":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
 
-			})
-		case "spec":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			out.Values[i] = ec._ProcessorType_spec(ctx, field, obj)
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+
+		case "isDeprecated":
+
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
+		case "deprecationReason":
+
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10714,1079 +21471,1350 @@ func (ec *executionContext) _ProcessorType(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var queryImplementors = []string{"Query"}
-
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+var __FieldImplementors = []string{"__Field"}
 
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "agents":
-			field := field
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_agents(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "description":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "agent":
-			field := field
-
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_agent(ctx, field)
-				return res
-			}
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+		case "args":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "configurations":
-			field := field
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_configurations(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "type":
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "isDeprecated":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "configuration":
-			field := field
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_configuration(ctx, field)
-				return res
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "deprecationReason":
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "sources":
-			field := field
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_sources(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
+var __InputValueImplementors = []string{"__InputValue"}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "source":
-			field := field
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_source(ctx, field)
-				return res
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "description":
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "sourceTypes":
-			field := field
+		case "type":
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_sourceTypes(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "defaultValue":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "sourceType":
-			field := field
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_sourceType(ctx, field)
-				return res
-			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+var __SchemaImplementors = []string{"__Schema"}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "processors":
-			field := field
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_processors(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+
+		case "types":
+
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "queryType":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "processor":
-			field := field
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_processor(ctx, field)
-				return res
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "mutationType":
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "processorTypes":
-			field := field
+		case "subscriptionType":
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_processorTypes(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+		case "directives":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "processorType":
-			field := field
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_processorType(ctx, field)
-				return res
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+var __TypeImplementors = []string{"__Type"}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "destinations":
-			field := field
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_destinations(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "name":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "destination":
-			field := field
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_destination(ctx, field)
-				return res
-			}
+		case "description":
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "destinationWithType":
-			field := field
+		case "fields":
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_destinationWithType(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+		case "interfaces":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "destinationTypes":
-			field := field
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_destinationTypes(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
+		case "possibleTypes":
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "destinationType":
-			field := field
+		case "enumValues":
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_destinationType(ctx, field)
-				return res
-			}
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+		case "inputFields":
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "components":
-			field := field
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_components(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+		case "ofType":
+
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+
+		case "specifiedByURL":
+
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNAgent2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.Agent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNAgent2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return rrm(innerCtx)
-			})
-		case "__type":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
+	return ret
+}
 
-		case "__schema":
+func (ec *executionContext) marshalNAgent2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgent(ctx context.Context, sel ast.SelectionSet, v *model1.Agent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Agent(ctx, sel, v)
+}
 
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
+func (ec *executionContext) marshalNAgentChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AgentChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAgentChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNAgentChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChange(ctx context.Context, sel ast.SelectionSet, v *model.AgentChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._AgentChange(ctx, sel, v)
 }
 
-var relevantIfConditionImplementors = []string{"RelevantIfCondition"}
-
-func (ec *executionContext) _RelevantIfCondition(ctx context.Context, sel ast.SelectionSet, obj *model.RelevantIfCondition) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, relevantIfConditionImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("RelevantIfCondition")
-		case "name":
-
-			out.Values[i] = ec._RelevantIfCondition_name(ctx, field, obj)
+func (ec *executionContext) unmarshalNAgentChangeType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChangeType(ctx context.Context, v interface{}) (model.AgentChangeType, error) {
+	var res model.AgentChangeType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "operator":
-			field := field
+func (ec *executionContext) marshalNAgentChangeType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChangeType(ctx context.Context, sel ast.SelectionSet, v model.AgentChangeType) graphql.Marshaler {
+	return v
+}
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._RelevantIfCondition_operator(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalNAgentCommand2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentCommandᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.AgentCommand) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNAgentCommand2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentCommand(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+	}
+	wg.Wait()
 
-			})
-		case "value":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec._RelevantIfCondition_value(ctx, field, obj)
+	return ret
+}
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNAgentCommand2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentCommand(ctx context.Context, sel ast.SelectionSet, v *model1.AgentCommand) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	return ec._AgentCommand(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAgentConnection2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentConnection(ctx context.Context, sel ast.SelectionSet, v model.AgentConnection) graphql.Marshaler {
+	return ec._AgentConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAgentConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentConnection(ctx context.Context, sel ast.SelectionSet, v *model.AgentConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._AgentConnection(ctx, sel, v)
 }
 
-var resourceConfigurationImplementors = []string{"ResourceConfiguration"}
+func (ec *executionContext) marshalNAgentEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.AgentEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAgentEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) _ResourceConfiguration(ctx context.Context, sel ast.SelectionSet, obj *model.ResourceConfiguration) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, resourceConfigurationImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ResourceConfiguration")
-		case "name":
+	}
+	wg.Wait()
 
-			out.Values[i] = ec._ResourceConfiguration_name(ctx, field, obj)
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-		case "type":
+	return ret
+}
 
-			out.Values[i] = ec._ResourceConfiguration_type(ctx, field, obj)
+func (ec *executionContext) marshalNAgentEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentEdge(ctx context.Context, sel ast.SelectionSet, v *model.AgentEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AgentEdge(ctx, sel, v)
+}
 
-		case "parameters":
+func (ec *executionContext) marshalNAgentGroup2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroupᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.AgentGroup) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAgentGroup2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroup(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec._ResourceConfiguration_parameters(ctx, field, obj)
+	}
+	wg.Wait()
 
-		case "processors":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec._ResourceConfiguration_processors(ctx, field, obj)
+	return ret
+}
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNAgentGroup2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroup(ctx context.Context, sel ast.SelectionSet, v *model1.AgentGroup) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._AgentGroup(ctx, sel, v)
 }
 
-var resourceTypeSpecImplementors = []string{"ResourceTypeSpec"}
-
-func (ec *executionContext) _ResourceTypeSpec(ctx context.Context, sel ast.SelectionSet, obj *model.ResourceTypeSpec) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, resourceTypeSpecImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ResourceTypeSpec")
-		case "version":
-
-			out.Values[i] = ec._ResourceTypeSpec_version(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "parameters":
-
-			out.Values[i] = ec._ResourceTypeSpec_parameters(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNAgentGroupCount2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroupCountᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.AgentGroupCount) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "supportedPlatforms":
+			ret[i] = ec.marshalNAgentGroupCount2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroupCount(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec._ResourceTypeSpec_supportedPlatforms(ctx, field, obj)
+	}
+	wg.Wait()
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "telemetryTypes":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec._ResourceTypeSpec_telemetryTypes(ctx, field, obj)
+	return ret
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNAgentGroupCount2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroupCount(ctx context.Context, sel ast.SelectionSet, v *model1.AgentGroupCount) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._AgentGroupCount(ctx, sel, v)
 }
 
-var sourceImplementors = []string{"Source"}
-
-func (ec *executionContext) _Source(ctx context.Context, sel ast.SelectionSet, obj *model.Source) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, sourceImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Source")
-		case "apiVersion":
-
-			out.Values[i] = ec._Source_apiVersion(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "kind":
-			field := field
-
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Source_kind(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			}
-
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
-
-			})
-		case "metadata":
+func (ec *executionContext) marshalNAgentGroupSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroupSpec(ctx context.Context, sel ast.SelectionSet, v model1.AgentGroupSpec) graphql.Marshaler {
+	return ec._AgentGroupSpec(ctx, sel, &v)
+}
 
-			out.Values[i] = ec._Source_metadata(ctx, field, obj)
+func (ec *executionContext) marshalNAgentGroupSummary2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroupSummary(ctx context.Context, sel ast.SelectionSet, v model1.AgentGroupSummary) graphql.Marshaler {
+	return ec._AgentGroupSummary(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "spec":
+func (ec *executionContext) marshalNAgentGroupSummary2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroupSummary(ctx context.Context, sel ast.SelectionSet, v *model1.AgentGroupSummary) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._AgentGroupSummary(ctx, sel, v)
+}
 
-			out.Values[i] = ec._Source_spec(ctx, field, obj)
+func (ec *executionContext) marshalNAgents2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgents(ctx context.Context, sel ast.SelectionSet, v model.Agents) graphql.Marshaler {
+	return ec._Agents(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNAgents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgents(ctx context.Context, sel ast.SelectionSet, v *model.Agents) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._Agents(ctx, sel, v)
 }
 
-var sourceTypeImplementors = []string{"SourceType"}
+func (ec *executionContext) unmarshalNAny2interface(ctx context.Context, v interface{}) (any, error) {
+	res, err := graphql.UnmarshalAny(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _SourceType(ctx context.Context, sel ast.SelectionSet, obj *model.SourceType) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, sourceTypeImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("SourceType")
-		case "apiVersion":
+func (ec *executionContext) marshalNAny2interface(ctx context.Context, sel ast.SelectionSet, v any) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	res := graphql.MarshalAny(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-			out.Values[i] = ec._SourceType_apiVersion(ctx, field, obj)
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "metadata":
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-			out.Values[i] = ec._SourceType_metadata(ctx, field, obj)
+func (ec *executionContext) marshalNComponents2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐComponents(ctx context.Context, sel ast.SelectionSet, v model.Components) graphql.Marshaler {
+	return ec._Components(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "kind":
-			field := field
+func (ec *executionContext) marshalNComponents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐComponents(ctx context.Context, sel ast.SelectionSet, v *model.Components) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Components(ctx, sel, v)
+}
 
-			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._SourceType_kind(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalNConfiguration2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfigurationᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.Configuration) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfiguration(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Concurrently(i, func() graphql.Marshaler {
-				return innerFunc(ctx)
+	}
+	wg.Wait()
 
-			})
-		case "spec":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec._SourceType_spec(ctx, field, obj)
+	return ret
+}
 
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfiguration(ctx context.Context, sel ast.SelectionSet, v *model1.Configuration) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._Configuration(ctx, sel, v)
 }
 
-var subscriptionImplementors = []string{"Subscription"}
-
-func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Subscription",
-	})
-	if len(fields) != 1 {
-		ec.Errorf(ctx, "must subscribe to exactly one stream")
-		return nil
+func (ec *executionContext) marshalNConfigurationChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ConfigurationChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNConfigurationChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	switch fields[0].Name {
-	case "agentChanges":
-		return ec._Subscription_agentChanges(ctx, fields[0])
-	case "configurationChanges":
-		return ec._Subscription_configurationChanges(ctx, fields[0])
-	default:
-		panic("unknown field " + strconv.Quote(fields[0].Name))
 	}
-}
-
-var suggestionImplementors = []string{"Suggestion"}
+	wg.Wait()
 
-func (ec *executionContext) _Suggestion(ctx context.Context, sel ast.SelectionSet, obj *search.Suggestion) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, suggestionImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Suggestion")
-		case "label":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec._Suggestion_label(ctx, field, obj)
+	return ret
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "query":
+func (ec *executionContext) marshalNConfigurationChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationChange(ctx context.Context, sel ast.SelectionSet, v *model.ConfigurationChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ConfigurationChange(ctx, sel, v)
+}
 
-			out.Values[i] = ec._Suggestion_query(ctx, field, obj)
+func (ec *executionContext) marshalNConfigurationConnection2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationConnection(ctx context.Context, sel ast.SelectionSet, v model.ConfigurationConnection) graphql.Marshaler {
+	return ec._ConfigurationConnection(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNConfigurationConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationConnection(ctx context.Context, sel ast.SelectionSet, v *model.ConfigurationConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._ConfigurationConnection(ctx, sel, v)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
-
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
+func (ec *executionContext) marshalNConfigurationEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ConfigurationEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNConfigurationEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+	}
+	wg.Wait()
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+	return ret
+}
 
-		case "locations":
+func (ec *executionContext) marshalNConfigurationEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationEdge(ctx context.Context, sel ast.SelectionSet, v *model.ConfigurationEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ConfigurationEdge(ctx, sel, v)
+}
 
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+func (ec *executionContext) marshalNConfigurationSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfigurationSpec(ctx context.Context, sel ast.SelectionSet, v model1.ConfigurationSpec) graphql.Marshaler {
+	return ec._ConfigurationSpec(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "args":
+func (ec *executionContext) marshalNConfigurations2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurations(ctx context.Context, sel ast.SelectionSet, v model.Configurations) graphql.Marshaler {
+	return ec._Configurations(ctx, sel, &v)
+}
 
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+func (ec *executionContext) marshalNConfigurations2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurations(ctx context.Context, sel ast.SelectionSet, v *model.Configurations) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Configurations(ctx, sel, v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNDestination2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.Destination) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "isRepeatable":
+			ret[i] = ec.marshalNDestination2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestination(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
+	}
+	wg.Wait()
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDestination2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestination(ctx context.Context, sel ast.SelectionSet, v *model1.Destination) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._Destination(ctx, sel, v)
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+func (ec *executionContext) marshalNDestinationChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DestinationChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNDestinationChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
+	}
+	wg.Wait()
 
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
+	return ret
+}
 
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+func (ec *executionContext) marshalNDestinationChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationChange(ctx context.Context, sel ast.SelectionSet, v *model.DestinationChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DestinationChange(ctx, sel, v)
+}
 
-		case "isDeprecated":
+func (ec *executionContext) marshalNDestinationConnection2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationConnection(ctx context.Context, sel ast.SelectionSet, v model.DestinationConnection) graphql.Marshaler {
+	return ec._DestinationConnection(ctx, sel, &v)
+}
 
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+func (ec *executionContext) marshalNDestinationConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationConnection(ctx context.Context, sel ast.SelectionSet, v *model.DestinationConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DestinationConnection(ctx, sel, v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNDestinationEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DestinationEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
+			ret[i] = ec.marshalNDestinationEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+	}
+	wg.Wait()
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDestinationEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationEdge(ctx context.Context, sel ast.SelectionSet, v *model.DestinationEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._DestinationEdge(ctx, sel, v)
 }
 
-var __FieldImplementors = []string{"__Field"}
-
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNDestinationType2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.DestinationType) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "description":
-
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
+			ret[i] = ec.marshalNDestinationType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-		case "args":
+	}
+	wg.Wait()
 
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "type":
+	return ret
+}
 
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
+func (ec *executionContext) marshalNDestinationType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationType(ctx context.Context, sel ast.SelectionSet, v *model1.DestinationType) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DestinationType(ctx, sel, v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "isDeprecated":
+func (ec *executionContext) marshalNDestinationTypeConnection2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationTypeConnection(ctx context.Context, sel ast.SelectionSet, v model.DestinationTypeConnection) graphql.Marshaler {
+	return ec._DestinationTypeConnection(ctx, sel, &v)
+}
 
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
+func (ec *executionContext) marshalNDestinationTypeConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationTypeConnection(ctx context.Context, sel ast.SelectionSet, v *model.DestinationTypeConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DestinationTypeConnection(ctx, sel, v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNDestinationTypeEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationTypeEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DestinationTypeEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
+			ret[i] = ec.marshalNDestinationTypeEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationTypeEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+	}
+	wg.Wait()
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDestinationTypeEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationTypeEdge(ctx context.Context, sel ast.SelectionSet, v *model.DestinationTypeEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._DestinationTypeEdge(ctx, sel, v)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
-
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-
-		case "type":
-
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "defaultValue":
-
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+func (ec *executionContext) marshalNDestinationWithType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationWithType(ctx context.Context, sel ast.SelectionSet, v model.DestinationWithType) graphql.Marshaler {
+	return ec._DestinationWithType(ctx, sel, &v)
+}
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNDestinationWithType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationWithType(ctx context.Context, sel ast.SelectionSet, v *model.DestinationWithType) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._DestinationWithType(ctx, sel, v)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
-
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+func (ec *executionContext) unmarshalNEventType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐEventType(ctx context.Context, v interface{}) (model.EventType, error) {
+	var res model.EventType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		case "types":
+func (ec *executionContext) marshalNEventType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐEventType(ctx context.Context, sel ast.SelectionSet, v model.EventType) graphql.Marshaler {
+	return v
+}
 
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "queryType":
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
+func (ec *executionContext) unmarshalNID2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "mutationType":
+func (ec *executionContext) marshalNID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
 
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-		case "subscriptionType":
+	return ret
+}
 
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		case "directives":
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
+func (ec *executionContext) marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx context.Context, sel ast.SelectionSet, v model1.Metadata) graphql.Marshaler {
+	return ec._Metadata(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *model.PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._PageInfo(ctx, sel, v)
 }
 
-var __TypeImplementors = []string{"__Type"}
-
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
+func (ec *executionContext) marshalNParameter2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameter(ctx context.Context, sel ast.SelectionSet, v model1.Parameter) graphql.Marshaler {
+	return ec._Parameter(ctx, sel, &v)
+}
 
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
+func (ec *executionContext) marshalNParameterDefinition2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterDefinition(ctx context.Context, sel ast.SelectionSet, v model1.ParameterDefinition) graphql.Marshaler {
+	return ec._ParameterDefinition(ctx, sel, &v)
+}
 
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNParameterDefinition2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterDefinitionᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.ParameterDefinition) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "name":
-
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-
-		case "description":
-
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-
-		case "fields":
-
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-
-		case "interfaces":
-
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-
-		case "possibleTypes":
-
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-
-		case "enumValues":
+			ret[i] = ec.marshalNParameterDefinition2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterDefinition(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+	}
+	wg.Wait()
 
-		case "inputFields":
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+	return ret
+}
 
-		case "ofType":
+func (ec *executionContext) unmarshalNParameterType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐParameterType(ctx context.Context, v interface{}) (model.ParameterType, error) {
+	var res model.ParameterType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+func (ec *executionContext) marshalNParameterType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐParameterType(ctx context.Context, sel ast.SelectionSet, v model.ParameterType) graphql.Marshaler {
+	return v
+}
 
-		case "specifiedByURL":
+func (ec *executionContext) marshalNParameterizedSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterizedSpec(ctx context.Context, sel ast.SelectionSet, v model1.ParameterizedSpec) graphql.Marshaler {
+	return ec._ParameterizedSpec(ctx, sel, &v)
+}
 
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+func (ec *executionContext) unmarshalNPipelineType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineType(ctx context.Context, v interface{}) (otel.PipelineType, error) {
+	tmp, err := graphql.UnmarshalString(v)
+	res := otel.PipelineType(tmp)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNPipelineType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineType(ctx context.Context, sel ast.SelectionSet, v otel.PipelineType) graphql.Marshaler {
+	res := graphql.MarshalString(string(v))
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res
 }
 
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) unmarshalNPipelineType2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineTypeᚄ(ctx context.Context, v interface{}) ([]otel.PipelineType, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]otel.PipelineType, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNPipelineType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineType(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
-func (ec *executionContext) marshalNAgent2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Agent) graphql.Marshaler {
+func (ec *executionContext) marshalNPipelineType2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []otel.PipelineType) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -11810,7 +22838,7 @@ func (ec *executionContext) marshalNAgent2ᚕᚖgithubᚗcomᚋobserviqᚋbindpl
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNAgent2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgent(ctx, sel, v[i])
+			ret[i] = ec.marshalNPipelineType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineType(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -11830,17 +22858,7 @@ func (ec *executionContext) marshalNAgent2ᚕᚖgithubᚗcomᚋobserviqᚋbindpl
 	return ret
 }
 
-func (ec *executionContext) marshalNAgent2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgent(ctx context.Context, sel ast.SelectionSet, v *model.Agent) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._Agent(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNAgentChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.AgentChange) graphql.Marshaler {
+func (ec *executionContext) marshalNProcessor2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.Processor) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -11864,7 +22882,7 @@ func (ec *executionContext) marshalNAgentChange2ᚕᚖgithubᚗcomᚋobserviqᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNAgentChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChange(ctx, sel, v[i])
+			ret[i] = ec.marshalNProcessor2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessor(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -11884,91 +22902,85 @@ func (ec *executionContext) marshalNAgentChange2ᚕᚖgithubᚗcomᚋobserviqᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNAgentChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChange(ctx context.Context, sel ast.SelectionSet, v *model1.AgentChange) graphql.Marshaler {
+func (ec *executionContext) marshalNProcessor2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessor(ctx context.Context, sel ast.SelectionSet, v *model1.Processor) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._AgentChange(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNAgentChangeType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChangeType(ctx context.Context, v interface{}) (model1.AgentChangeType, error) {
-	var res model1.AgentChangeType
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._Processor(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNAgentChangeType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentChangeType(ctx context.Context, sel ast.SelectionSet, v model1.AgentChangeType) graphql.Marshaler {
-	return v
-}
+func (ec *executionContext) marshalNProcessorChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ProcessorChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNProcessorChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) marshalNAgents2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgents(ctx context.Context, sel ast.SelectionSet, v model1.Agents) graphql.Marshaler {
-	return ec._Agents(ctx, sel, &v)
-}
+	}
+	wg.Wait()
 
-func (ec *executionContext) marshalNAgents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgents(ctx context.Context, sel ast.SelectionSet, v *model1.Agents) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
-		return graphql.Null
 	}
-	return ec._Agents(ctx, sel, v)
-}
 
-func (ec *executionContext) unmarshalNAny2interface(ctx context.Context, v interface{}) (any, error) {
-	res, err := graphql.UnmarshalAny(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNAny2interface(ctx context.Context, sel ast.SelectionSet, v any) graphql.Marshaler {
+func (ec *executionContext) marshalNProcessorChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorChange(ctx context.Context, sel ast.SelectionSet, v *model.ProcessorChange) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	res := graphql.MarshalAny(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
+	return ec._ProcessorChange(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNComponents2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐComponents(ctx context.Context, sel ast.SelectionSet, v model1.Components) graphql.Marshaler {
-	return ec._Components(ctx, sel, &v)
+func (ec *executionContext) marshalNProcessorConnection2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorConnection(ctx context.Context, sel ast.SelectionSet, v model.ProcessorConnection) graphql.Marshaler {
+	return ec._ProcessorConnection(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNComponents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐComponents(ctx context.Context, sel ast.SelectionSet, v *model1.Components) graphql.Marshaler {
+func (ec *executionContext) marshalNProcessorConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorConnection(ctx context.Context, sel ast.SelectionSet, v *model.ProcessorConnection) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Components(ctx, sel, v)
+	return ec._ProcessorConnection(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNConfiguration2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfigurationᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Configuration) graphql.Marshaler {
+func (ec *executionContext) marshalNProcessorEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ProcessorEdge) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -11992,7 +23004,7 @@ func (ec *executionContext) marshalNConfiguration2ᚕᚖgithubᚗcomᚋobserviq
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfiguration(ctx, sel, v[i])
+			ret[i] = ec.marshalNProcessorEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorEdge(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -12012,17 +23024,17 @@ func (ec *executionContext) marshalNConfiguration2ᚕᚖgithubᚗcomᚋobserviq
 	return ret
 }
 
-func (ec *executionContext) marshalNConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfiguration(ctx context.Context, sel ast.SelectionSet, v *model.Configuration) graphql.Marshaler {
+func (ec *executionContext) marshalNProcessorEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorEdge(ctx context.Context, sel ast.SelectionSet, v *model.ProcessorEdge) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Configuration(ctx, sel, v)
+	return ec._ProcessorEdge(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNConfigurationChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.ConfigurationChange) graphql.Marshaler {
+func (ec *executionContext) marshalNProcessorType2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.ProcessorType) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -12046,7 +23058,7 @@ func (ec *executionContext) marshalNConfigurationChange2ᚕᚖgithubᚗcomᚋobs
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNConfigurationChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationChange(ctx, sel, v[i])
+			ret[i] = ec.marshalNProcessorType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorType(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -12066,35 +23078,31 @@ func (ec *executionContext) marshalNConfigurationChange2ᚕᚖgithubᚗcomᚋobs
 	return ret
 }
 
-func (ec *executionContext) marshalNConfigurationChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurationChange(ctx context.Context, sel ast.SelectionSet, v *model1.ConfigurationChange) graphql.Marshaler {
+func (ec *executionContext) marshalNProcessorType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorType(ctx context.Context, sel ast.SelectionSet, v *model1.ProcessorType) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ConfigurationChange(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNConfigurationSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfigurationSpec(ctx context.Context, sel ast.SelectionSet, v model.ConfigurationSpec) graphql.Marshaler {
-	return ec._ConfigurationSpec(ctx, sel, &v)
+	return ec._ProcessorType(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNConfigurations2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurations(ctx context.Context, sel ast.SelectionSet, v model1.Configurations) graphql.Marshaler {
-	return ec._Configurations(ctx, sel, &v)
+func (ec *executionContext) marshalNProcessorTypeConnection2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorTypeConnection(ctx context.Context, sel ast.SelectionSet, v model.ProcessorTypeConnection) graphql.Marshaler {
+	return ec._ProcessorTypeConnection(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNConfigurations2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐConfigurations(ctx context.Context, sel ast.SelectionSet, v *model1.Configurations) graphql.Marshaler {
+func (ec *executionContext) marshalNProcessorTypeConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorTypeConnection(ctx context.Context, sel ast.SelectionSet, v *model.ProcessorTypeConnection) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Configurations(ctx, sel, v)
+	return ec._ProcessorTypeConnection(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNDestination2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Destination) graphql.Marshaler {
+func (ec *executionContext) marshalNProcessorTypeEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorTypeEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ProcessorTypeEdge) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -12118,7 +23126,7 @@ func (ec *executionContext) marshalNDestination2ᚕᚖgithubᚗcomᚋobserviqᚋ
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNDestination2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestination(ctx, sel, v[i])
+			ret[i] = ec.marshalNProcessorTypeEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorTypeEdge(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -12138,17 +23146,41 @@ func (ec *executionContext) marshalNDestination2ᚕᚖgithubᚗcomᚋobserviqᚋ
 	return ret
 }
 
-func (ec *executionContext) marshalNDestination2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestination(ctx context.Context, sel ast.SelectionSet, v *model.Destination) graphql.Marshaler {
+func (ec *executionContext) marshalNProcessorTypeEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐProcessorTypeEdge(ctx context.Context, sel ast.SelectionSet, v *model.ProcessorTypeEdge) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Destination(ctx, sel, v)
+	return ec._ProcessorTypeEdge(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRelevantIfCondition2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐRelevantIfCondition(ctx context.Context, sel ast.SelectionSet, v model1.RelevantIfCondition) graphql.Marshaler {
+	return ec._RelevantIfCondition(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNDestinationType2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DestinationType) graphql.Marshaler {
+func (ec *executionContext) unmarshalNRelevantIfOperatorType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐRelevantIfOperatorType(ctx context.Context, v interface{}) (model.RelevantIfOperatorType, error) {
+	var res model.RelevantIfOperatorType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRelevantIfOperatorType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐRelevantIfOperatorType(ctx context.Context, sel ast.SelectionSet, v model.RelevantIfOperatorType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNResource2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResource(ctx context.Context, sel ast.SelectionSet, v model1.Resource) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Resource(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNResourceChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐResourceChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ResourceChange) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -12172,7 +23204,7 @@ func (ec *executionContext) marshalNDestinationType2ᚕᚖgithubᚗcomᚋobservi
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNDestinationType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationType(ctx, sel, v[i])
+			ret[i] = ec.marshalNResourceChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐResourceChange(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -12192,83 +23224,35 @@ func (ec *executionContext) marshalNDestinationType2ᚕᚖgithubᚗcomᚋobservi
 	return ret
 }
 
-func (ec *executionContext) marshalNDestinationType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationType(ctx context.Context, sel ast.SelectionSet, v *model.DestinationType) graphql.Marshaler {
+func (ec *executionContext) marshalNResourceChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐResourceChange(ctx context.Context, sel ast.SelectionSet, v *model.ResourceChange) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._DestinationType(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNDestinationWithType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationWithType(ctx context.Context, sel ast.SelectionSet, v model1.DestinationWithType) graphql.Marshaler {
-	return ec._DestinationWithType(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNDestinationWithType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐDestinationWithType(ctx context.Context, sel ast.SelectionSet, v *model1.DestinationWithType) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._DestinationWithType(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNEventType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐEventType(ctx context.Context, v interface{}) (model1.EventType, error) {
-	var res model1.EventType
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNEventType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐEventType(ctx context.Context, sel ast.SelectionSet, v model1.EventType) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalID(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._ResourceChange(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
+func (ec *executionContext) marshalNResourceConfiguration2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceConfiguration(ctx context.Context, sel ast.SelectionSet, v model1.ResourceConfiguration) graphql.Marshaler {
+	return ec._ResourceConfiguration(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNResourceDependents2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceDependents(ctx context.Context, sel ast.SelectionSet, v model1.ResourceDependents) graphql.Marshaler {
+	return ec._ResourceDependents(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNResourceDependents2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceDependents(ctx context.Context, sel ast.SelectionSet, v *model1.ResourceDependents) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
-}
-
-func (ec *executionContext) marshalNMetadata2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐMetadata(ctx context.Context, sel ast.SelectionSet, v model.Metadata) graphql.Marshaler {
-	return ec._Metadata(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNParameter2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameter(ctx context.Context, sel ast.SelectionSet, v model.Parameter) graphql.Marshaler {
-	return ec._Parameter(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNParameterDefinition2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterDefinition(ctx context.Context, sel ast.SelectionSet, v model.ParameterDefinition) graphql.Marshaler {
-	return ec._ParameterDefinition(ctx, sel, &v)
+	return ec._ResourceDependents(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNParameterDefinition2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterDefinitionᚄ(ctx context.Context, sel ast.SelectionSet, v []model.ParameterDefinition) graphql.Marshaler {
+func (ec *executionContext) marshalNResourceReference2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceReferenceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.ResourceReference) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -12292,7 +23276,7 @@ func (ec *executionContext) marshalNParameterDefinition2ᚕgithubᚗcomᚋobserv
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNParameterDefinition2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterDefinition(ctx, sel, v[i])
+			ret[i] = ec.marshalNResourceReference2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceReference(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -12307,59 +23291,26 @@ func (ec *executionContext) marshalNParameterDefinition2ᚕgithubᚗcomᚋobserv
 		if e == graphql.Null {
 			return graphql.Null
 		}
-	}
-
-	return ret
-}
-
-func (ec *executionContext) unmarshalNParameterType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐParameterType(ctx context.Context, v interface{}) (model1.ParameterType, error) {
-	var res model1.ParameterType
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNParameterType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐParameterType(ctx context.Context, sel ast.SelectionSet, v model1.ParameterType) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) marshalNParameterizedSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterizedSpec(ctx context.Context, sel ast.SelectionSet, v model.ParameterizedSpec) graphql.Marshaler {
-	return ec._ParameterizedSpec(ctx, sel, &v)
-}
-
-func (ec *executionContext) unmarshalNPipelineType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineType(ctx context.Context, v interface{}) (otel.PipelineType, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := otel.PipelineType(tmp)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNPipelineType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineType(ctx context.Context, sel ast.SelectionSet, v otel.PipelineType) graphql.Marshaler {
-	res := graphql.MarshalString(string(v))
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNPipelineType2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineTypeᚄ(ctx context.Context, v interface{}) ([]otel.PipelineType, error) {
-	var vSlice []interface{}
-	if v != nil {
-		vSlice = graphql.CoerceList(v)
-	}
-	var err error
-	res := make([]otel.PipelineType, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNPipelineType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineType(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNResourceReference2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceReference(ctx context.Context, sel ast.SelectionSet, v *model1.ResourceReference) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res, nil
+	return ec._ResourceReference(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNPipelineType2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []otel.PipelineType) graphql.Marshaler {
+func (ec *executionContext) marshalNResourceTypeSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceTypeSpec(ctx context.Context, sel ast.SelectionSet, v model1.ResourceTypeSpec) graphql.Marshaler {
+	return ec._ResourceTypeSpec(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSource2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.Source) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -12383,7 +23334,7 @@ func (ec *executionContext) marshalNPipelineType2ᚕgithubᚗcomᚋobserviqᚋbi
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNPipelineType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚋotelᚐPipelineType(ctx, sel, v[i])
+			ret[i] = ec.marshalNSource2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSource(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -12403,7 +23354,17 @@ func (ec *executionContext) marshalNPipelineType2ᚕgithubᚗcomᚋobserviqᚋbi
 	return ret
 }
 
-func (ec *executionContext) marshalNProcessor2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Processor) graphql.Marshaler {
+func (ec *executionContext) marshalNSource2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSource(ctx context.Context, sel ast.SelectionSet, v *model1.Source) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Source(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSourceChange2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SourceChange) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -12427,7 +23388,7 @@ func (ec *executionContext) marshalNProcessor2ᚕᚖgithubᚗcomᚋobserviqᚋbi
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNProcessor2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessor(ctx, sel, v[i])
+			ret[i] = ec.marshalNSourceChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceChange(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -12447,17 +23408,31 @@ func (ec *executionContext) marshalNProcessor2ᚕᚖgithubᚗcomᚋobserviqᚋbi
 	return ret
 }
 
-func (ec *executionContext) marshalNProcessor2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessor(ctx context.Context, sel ast.SelectionSet, v *model.Processor) graphql.Marshaler {
+func (ec *executionContext) marshalNSourceChange2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceChange(ctx context.Context, sel ast.SelectionSet, v *model.SourceChange) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Processor(ctx, sel, v)
+	return ec._SourceChange(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSourceConnection2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceConnection(ctx context.Context, sel ast.SelectionSet, v model.SourceConnection) graphql.Marshaler {
+	return ec._SourceConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSourceConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceConnection(ctx context.Context, sel ast.SelectionSet, v *model.SourceConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SourceConnection(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNProcessorType2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ProcessorType) graphql.Marshaler {
+func (ec *executionContext) marshalNSourceEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SourceEdge) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -12481,7 +23456,7 @@ func (ec *executionContext) marshalNProcessorType2ᚕᚖgithubᚗcomᚋobserviq
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNProcessorType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorType(ctx, sel, v[i])
+			ret[i] = ec.marshalNSourceEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceEdge(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -12501,39 +23476,17 @@ func (ec *executionContext) marshalNProcessorType2ᚕᚖgithubᚗcomᚋobserviq
 	return ret
 }
 
-func (ec *executionContext) marshalNProcessorType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorType(ctx context.Context, sel ast.SelectionSet, v *model.ProcessorType) graphql.Marshaler {
+func (ec *executionContext) marshalNSourceEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceEdge(ctx context.Context, sel ast.SelectionSet, v *model.SourceEdge) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ProcessorType(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNRelevantIfCondition2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐRelevantIfCondition(ctx context.Context, sel ast.SelectionSet, v model.RelevantIfCondition) graphql.Marshaler {
-	return ec._RelevantIfCondition(ctx, sel, &v)
-}
-
-func (ec *executionContext) unmarshalNRelevantIfOperatorType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐRelevantIfOperatorType(ctx context.Context, v interface{}) (model1.RelevantIfOperatorType, error) {
-	var res model1.RelevantIfOperatorType
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNRelevantIfOperatorType2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐRelevantIfOperatorType(ctx context.Context, sel ast.SelectionSet, v model1.RelevantIfOperatorType) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) marshalNResourceConfiguration2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceConfiguration(ctx context.Context, sel ast.SelectionSet, v model.ResourceConfiguration) graphql.Marshaler {
-	return ec._ResourceConfiguration(ctx, sel, &v)
+	return ec._SourceEdge(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNResourceTypeSpec2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceTypeSpec(ctx context.Context, sel ast.SelectionSet, v model.ResourceTypeSpec) graphql.Marshaler {
-	return ec._ResourceTypeSpec(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNSource2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Source) graphql.Marshaler {
+func (ec *executionContext) marshalNSourceType2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.SourceType) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -12557,7 +23510,7 @@ func (ec *executionContext) marshalNSource2ᚕᚖgithubᚗcomᚋobserviqᚋbindp
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNSource2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSource(ctx, sel, v[i])
+			ret[i] = ec.marshalNSourceType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceType(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -12577,17 +23530,31 @@ func (ec *executionContext) marshalNSource2ᚕᚖgithubᚗcomᚋobserviqᚋbindp
 	return ret
 }
 
-func (ec *executionContext) marshalNSource2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSource(ctx context.Context, sel ast.SelectionSet, v *model.Source) graphql.Marshaler {
+func (ec *executionContext) marshalNSourceType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceType(ctx context.Context, sel ast.SelectionSet, v *model1.SourceType) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Source(ctx, sel, v)
+	return ec._SourceType(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSourceTypeConnection2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceTypeConnection(ctx context.Context, sel ast.SelectionSet, v model.SourceTypeConnection) graphql.Marshaler {
+	return ec._SourceTypeConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSourceTypeConnection2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceTypeConnection(ctx context.Context, sel ast.SelectionSet, v *model.SourceTypeConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SourceTypeConnection(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNSourceType2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SourceType) graphql.Marshaler {
+func (ec *executionContext) marshalNSourceTypeEdge2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceTypeEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SourceTypeEdge) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -12611,7 +23578,7 @@ func (ec *executionContext) marshalNSourceType2ᚕᚖgithubᚗcomᚋobserviqᚋb
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNSourceType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceType(ctx, sel, v[i])
+			ret[i] = ec.marshalNSourceTypeEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceTypeEdge(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -12631,14 +23598,14 @@ func (ec *executionContext) marshalNSourceType2ᚕᚖgithubᚗcomᚋobserviqᚋb
 	return ret
 }
 
-func (ec *executionContext) marshalNSourceType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceType(ctx context.Context, sel ast.SelectionSet, v *model.SourceType) graphql.Marshaler {
+func (ec *executionContext) marshalNSourceTypeEdge2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSourceTypeEdge(ctx context.Context, sel ast.SelectionSet, v *model.SourceTypeEdge) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._SourceType(ctx, sel, v)
+	return ec._SourceTypeEdge(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
@@ -12698,6 +23665,21 @@ func (ec *executionContext) marshalNSuggestion2ᚖgithubᚗcomᚋobserviqᚋbind
 	return ec._Suggestion(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
+	res, err := graphql.UnmarshalTime(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	res := graphql.MarshalTime(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -12951,25 +23933,79 @@ func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel a
 	return res
 }
 
-func (ec *executionContext) marshalOAgent2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgent(ctx context.Context, sel ast.SelectionSet, v *model.Agent) graphql.Marshaler {
+func (ec *executionContext) marshalOAgent2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgent(ctx context.Context, sel ast.SelectionSet, v *model1.Agent) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._Agent(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOAgentConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentConfiguration(ctx context.Context, sel ast.SelectionSet, v *model1.AgentConfiguration) graphql.Marshaler {
+func (ec *executionContext) marshalOAgentCommand2ᚕᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentCommandᚄ(ctx context.Context, sel ast.SelectionSet, v []*model1.AgentCommand) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAgentCommand2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentCommand(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalOAgentConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐAgentConfiguration(ctx context.Context, sel ast.SelectionSet, v *model.AgentConfiguration) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._AgentConfiguration(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOAgentSelector2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentSelector(ctx context.Context, sel ast.SelectionSet, v model.AgentSelector) graphql.Marshaler {
+func (ec *executionContext) marshalOAgentGroup2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentGroup(ctx context.Context, sel ast.SelectionSet, v *model1.AgentGroup) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AgentGroup(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOAgentSelector2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐAgentSelector(ctx context.Context, sel ast.SelectionSet, v model1.AgentSelector) graphql.Marshaler {
 	return ec._AgentSelector(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalOAny2interface(ctx context.Context, v interface{}) (interface{}, error) {
+func (ec *executionContext) unmarshalOAny2interface(ctx context.Context, v interface{}) (any, error) {
 	if v == nil {
 		return nil, nil
 	}
@@ -12977,7 +24013,7 @@ func (ec *executionContext) unmarshalOAny2interface(ctx context.Context, v inter
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOAny2interface(ctx context.Context, sel ast.SelectionSet, v interface{}) graphql.Marshaler {
+func (ec *executionContext) marshalOAny2interface(ctx context.Context, sel ast.SelectionSet, v any) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -13011,27 +24047,107 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
-func (ec *executionContext) marshalOConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfiguration(ctx context.Context, sel ast.SelectionSet, v *model.Configuration) graphql.Marshaler {
+func (ec *executionContext) marshalOConfiguration2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐConfiguration(ctx context.Context, sel ast.SelectionSet, v *model1.Configuration) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._Configuration(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalODestination2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestination(ctx context.Context, sel ast.SelectionSet, v *model.Destination) graphql.Marshaler {
+func (ec *executionContext) marshalODestination2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestination(ctx context.Context, sel ast.SelectionSet, v *model1.Destination) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._Destination(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalODestinationType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationType(ctx context.Context, sel ast.SelectionSet, v *model.DestinationType) graphql.Marshaler {
+func (ec *executionContext) marshalODestinationType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐDestinationType(ctx context.Context, sel ast.SelectionSet, v *model1.DestinationType) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._DestinationType(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v interface{}) (*float64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalFloatContext(*v)
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
+func (ec *executionContext) unmarshalOID2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOInt2int64(ctx context.Context, v interface{}) (int64, error) {
+	res, err := graphql.UnmarshalInt64(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2int64(ctx context.Context, sel ast.SelectionSet, v int64) graphql.Marshaler {
+	res := graphql.MarshalInt64(v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
 func (ec *executionContext) unmarshalOMap2map(ctx context.Context, v interface{}) (map[string]interface{}, error) {
 	if v == nil {
 		return nil, nil
@@ -13048,7 +24164,7 @@ func (ec *executionContext) marshalOMap2map(ctx context.Context, sel ast.Selecti
 	return res
 }
 
-func (ec *executionContext) marshalOParameter2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterᚄ(ctx context.Context, sel ast.SelectionSet, v []model.Parameter) graphql.Marshaler {
+func (ec *executionContext) marshalOParameter2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.Parameter) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -13095,21 +24211,68 @@ func (ec *executionContext) marshalOParameter2ᚕgithubᚗcomᚋobserviqᚋbindp
 	return ret
 }
 
-func (ec *executionContext) marshalOProcessor2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessor(ctx context.Context, sel ast.SelectionSet, v *model.Processor) graphql.Marshaler {
+func (ec *executionContext) marshalOParameterDefinition2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterDefinitionᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.ParameterDefinition) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNParameterDefinition2githubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐParameterDefinition(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalOProcessor2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessor(ctx context.Context, sel ast.SelectionSet, v *model1.Processor) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._Processor(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOProcessorType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorType(ctx context.Context, sel ast.SelectionSet, v *model.ProcessorType) graphql.Marshaler {
+func (ec *executionContext) marshalOProcessorType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐProcessorType(ctx context.Context, sel ast.SelectionSet, v *model1.ProcessorType) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._ProcessorType(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalORelevantIfCondition2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐRelevantIfConditionᚄ(ctx context.Context, sel ast.SelectionSet, v []model.RelevantIfCondition) graphql.Marshaler {
+func (ec *executionContext) marshalORelevantIfCondition2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐRelevantIfConditionᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.RelevantIfCondition) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -13156,7 +24319,7 @@ func (ec *executionContext) marshalORelevantIfCondition2ᚕgithubᚗcomᚋobserv
 	return ret
 }
 
-func (ec *executionContext) marshalOResourceConfiguration2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceConfigurationᚄ(ctx context.Context, sel ast.SelectionSet, v []model.ResourceConfiguration) graphql.Marshaler {
+func (ec *executionContext) marshalOResourceConfiguration2ᚕgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐResourceConfigurationᚄ(ctx context.Context, sel ast.SelectionSet, v []model1.ResourceConfiguration) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -13203,14 +24366,30 @@ func (ec *executionContext) marshalOResourceConfiguration2ᚕgithubᚗcomᚋobse
 	return ret
 }
 
-func (ec *executionContext) marshalOSource2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSource(ctx context.Context, sel ast.SelectionSet, v *model.Source) graphql.Marshaler {
+func (ec *executionContext) unmarshalOSortOrder2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSortOrder(ctx context.Context, v interface{}) (*model.SortOrder, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.SortOrder)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOSortOrder2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋinternalᚋgraphqlᚋmodelᚐSortOrder(ctx context.Context, sel ast.SelectionSet, v *model.SortOrder) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOSource2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSource(ctx context.Context, sel ast.SelectionSet, v *model1.Source) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
 	return ec._Source(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOSourceType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceType(ctx context.Context, sel ast.SelectionSet, v *model.SourceType) graphql.Marshaler {
+func (ec *executionContext) marshalOSourceType2ᚖgithubᚗcomᚋobserviqᚋbindplaneᚑopᚋmodelᚐSourceType(ctx context.Context, sel ast.SelectionSet, v *model1.SourceType) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
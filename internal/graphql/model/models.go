@@ -78,3 +78,51 @@ func ToEventType(eventType store.EventType) EventType {
 	}
 	return EventTypeUpdate
 }
+
+// ToSourceChanges converts store.Events for Source to an array of SourceChange for use with graphql
+func ToSourceChanges(events store.Events[*model.Source]) []*SourceChange {
+	result := []*SourceChange{}
+	for _, event := range events {
+		result = append(result, &SourceChange{
+			Source:    event.Item,
+			EventType: ToEventType(event.Type),
+		})
+	}
+	return result
+}
+
+// ToProcessorChanges converts store.Events for Processor to an array of ProcessorChange for use with graphql
+func ToProcessorChanges(events store.Events[*model.Processor]) []*ProcessorChange {
+	result := []*ProcessorChange{}
+	for _, event := range events {
+		result = append(result, &ProcessorChange{
+			Processor: event.Item,
+			EventType: ToEventType(event.Type),
+		})
+	}
+	return result
+}
+
+// ToDestinationChanges converts store.Events for Destination to an array of DestinationChange for use with graphql
+func ToDestinationChanges(events store.Events[*model.Destination]) []*DestinationChange {
+	result := []*DestinationChange{}
+	for _, event := range events {
+		result = append(result, &DestinationChange{
+			Destination: event.Item,
+			EventType:   ToEventType(event.Type),
+		})
+	}
+	return result
+}
+
+// AppendResourceChanges converts store.Events for any resource to ResourceChanges and appends them to the changes for
+// use with graphql
+func AppendResourceChanges[T model.Resource](changes []*ResourceChange, events store.Events[T]) []*ResourceChange {
+	for _, event := range events {
+		changes = append(changes, &ResourceChange{
+			Resource:  event.Item,
+			EventType: ToEventType(event.Type),
+		})
+	}
+	return changes
+}
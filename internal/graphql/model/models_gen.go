@@ -22,6 +22,16 @@ type AgentConfiguration struct {
 	Manager   map[string]interface{} `json:"Manager"`
 }
 
+type AgentConnection struct {
+	Edges    []*AgentEdge `json:"edges"`
+	PageInfo *PageInfo    `json:"pageInfo"`
+}
+
+type AgentEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *model.Agent `json:"node"`
+}
+
 type Agents struct {
 	Query       *string              `json:"query"`
 	Agents      []*model.Agent       `json:"agents"`
@@ -38,17 +48,112 @@ type ConfigurationChange struct {
 	EventType     EventType            `json:"eventType"`
 }
 
+type ConfigurationConnection struct {
+	Edges    []*ConfigurationEdge `json:"edges"`
+	PageInfo *PageInfo            `json:"pageInfo"`
+}
+
+type ConfigurationEdge struct {
+	Cursor string               `json:"cursor"`
+	Node   *model.Configuration `json:"node"`
+}
+
 type Configurations struct {
 	Query          *string                `json:"query"`
 	Configurations []*model.Configuration `json:"configurations"`
 	Suggestions    []*search.Suggestion   `json:"suggestions"`
 }
 
+type DestinationChange struct {
+	Destination *model.Destination `json:"destination"`
+	EventType   EventType          `json:"eventType"`
+}
+
+type DestinationConnection struct {
+	Edges    []*DestinationEdge `json:"edges"`
+	PageInfo *PageInfo          `json:"pageInfo"`
+}
+
+type DestinationEdge struct {
+	Cursor string             `json:"cursor"`
+	Node   *model.Destination `json:"node"`
+}
+
+type DestinationTypeConnection struct {
+	Edges    []*DestinationTypeEdge `json:"edges"`
+	PageInfo *PageInfo              `json:"pageInfo"`
+}
+
+type DestinationTypeEdge struct {
+	Cursor string                 `json:"cursor"`
+	Node   *model.DestinationType `json:"node"`
+}
+
 type DestinationWithType struct {
 	Destination     *model.Destination     `json:"destination"`
 	DestinationType *model.DestinationType `json:"destinationType"`
 }
 
+type PageInfo struct {
+	HasNextPage bool    `json:"hasNextPage"`
+	EndCursor   *string `json:"endCursor"`
+}
+
+type ProcessorChange struct {
+	Processor *model.Processor `json:"processor"`
+	EventType EventType        `json:"eventType"`
+}
+
+type ProcessorConnection struct {
+	Edges    []*ProcessorEdge `json:"edges"`
+	PageInfo *PageInfo        `json:"pageInfo"`
+}
+
+type ProcessorEdge struct {
+	Cursor string           `json:"cursor"`
+	Node   *model.Processor `json:"node"`
+}
+
+type ProcessorTypeConnection struct {
+	Edges    []*ProcessorTypeEdge `json:"edges"`
+	PageInfo *PageInfo            `json:"pageInfo"`
+}
+
+type ProcessorTypeEdge struct {
+	Cursor string               `json:"cursor"`
+	Node   *model.ProcessorType `json:"node"`
+}
+
+type ResourceChange struct {
+	Resource  model.Resource `json:"resource"`
+	EventType EventType      `json:"eventType"`
+}
+
+type SourceChange struct {
+	Source    *model.Source `json:"source"`
+	EventType EventType     `json:"eventType"`
+}
+
+type SourceConnection struct {
+	Edges    []*SourceEdge `json:"edges"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+}
+
+type SourceEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *model.Source `json:"node"`
+}
+
+type SourceTypeConnection struct {
+	Edges    []*SourceTypeEdge `json:"edges"`
+	PageInfo *PageInfo         `json:"pageInfo"`
+}
+
+type SourceTypeEdge struct {
+	Cursor string            `json:"cursor"`
+	Node   *model.SourceType `json:"node"`
+}
+
 type AgentChangeType string
 
 const (
@@ -138,14 +243,23 @@ func (e EventType) MarshalGQL(w io.Writer) {
 type ParameterType string
 
 const (
-	ParameterTypeString  ParameterType = "string"
-	ParameterTypeStrings ParameterType = "strings"
-	ParameterTypeInt     ParameterType = "int"
-	ParameterTypeBool    ParameterType = "bool"
-	ParameterTypeEnum    ParameterType = "enum"
-	ParameterTypeEnums   ParameterType = "enums"
-	ParameterTypeMap     ParameterType = "map"
-	ParameterTypeYaml    ParameterType = "yaml"
+	ParameterTypeString   ParameterType = "string"
+	ParameterTypeStrings  ParameterType = "strings"
+	ParameterTypeInt      ParameterType = "int"
+	ParameterTypeBool     ParameterType = "bool"
+	ParameterTypeEnum     ParameterType = "enum"
+	ParameterTypeEnums    ParameterType = "enums"
+	ParameterTypeMap      ParameterType = "map"
+	ParameterTypeYaml     ParameterType = "yaml"
+	ParameterTypeFloat    ParameterType = "float"
+	ParameterTypeDuration ParameterType = "duration"
+	ParameterTypeByteSize ParameterType = "byteSize"
+	ParameterTypeRegex    ParameterType = "regex"
+	ParameterTypeTimezone ParameterType = "timezone"
+	ParameterTypeFilePath ParameterType = "filePath"
+	ParameterTypeHostPort ParameterType = "hostPort"
+	ParameterTypeObject   ParameterType = "object"
+	ParameterTypeObjects  ParameterType = "objects"
 )
 
 var AllParameterType = []ParameterType{
@@ -157,11 +271,20 @@ var AllParameterType = []ParameterType{
 	ParameterTypeEnums,
 	ParameterTypeMap,
 	ParameterTypeYaml,
+	ParameterTypeFloat,
+	ParameterTypeDuration,
+	ParameterTypeByteSize,
+	ParameterTypeRegex,
+	ParameterTypeTimezone,
+	ParameterTypeFilePath,
+	ParameterTypeHostPort,
+	ParameterTypeObject,
+	ParameterTypeObjects,
 }
 
 func (e ParameterType) IsValid() bool {
 	switch e {
-	case ParameterTypeString, ParameterTypeStrings, ParameterTypeInt, ParameterTypeBool, ParameterTypeEnum, ParameterTypeEnums, ParameterTypeMap, ParameterTypeYaml:
+	case ParameterTypeString, ParameterTypeStrings, ParameterTypeInt, ParameterTypeBool, ParameterTypeEnum, ParameterTypeEnums, ParameterTypeMap, ParameterTypeYaml, ParameterTypeFloat, ParameterTypeDuration, ParameterTypeByteSize, ParameterTypeRegex, ParameterTypeTimezone, ParameterTypeFilePath, ParameterTypeHostPort, ParameterTypeObject, ParameterTypeObjects:
 		return true
 	}
 	return false
@@ -191,16 +314,30 @@ func (e ParameterType) MarshalGQL(w io.Writer) {
 type RelevantIfOperatorType string
 
 const (
-	RelevantIfOperatorTypeEquals RelevantIfOperatorType = "equals"
+	RelevantIfOperatorTypeEquals      RelevantIfOperatorType = "equals"
+	RelevantIfOperatorTypeNotEquals   RelevantIfOperatorType = "notEquals"
+	RelevantIfOperatorTypeIn          RelevantIfOperatorType = "in"
+	RelevantIfOperatorTypeNotIn       RelevantIfOperatorType = "notIn"
+	RelevantIfOperatorTypeContainsAny RelevantIfOperatorType = "containsAny"
+	RelevantIfOperatorTypeExists      RelevantIfOperatorType = "exists"
+	RelevantIfOperatorTypeAll         RelevantIfOperatorType = "all"
+	RelevantIfOperatorTypeAny         RelevantIfOperatorType = "any"
 )
 
 var AllRelevantIfOperatorType = []RelevantIfOperatorType{
 	RelevantIfOperatorTypeEquals,
+	RelevantIfOperatorTypeNotEquals,
+	RelevantIfOperatorTypeIn,
+	RelevantIfOperatorTypeNotIn,
+	RelevantIfOperatorTypeContainsAny,
+	RelevantIfOperatorTypeExists,
+	RelevantIfOperatorTypeAll,
+	RelevantIfOperatorTypeAny,
 }
 
 func (e RelevantIfOperatorType) IsValid() bool {
 	switch e {
-	case RelevantIfOperatorTypeEquals:
+	case RelevantIfOperatorTypeEquals, RelevantIfOperatorTypeNotEquals, RelevantIfOperatorTypeIn, RelevantIfOperatorTypeNotIn, RelevantIfOperatorTypeContainsAny, RelevantIfOperatorTypeExists, RelevantIfOperatorTypeAll, RelevantIfOperatorTypeAny:
 		return true
 	}
 	return false
@@ -226,3 +363,44 @@ func (e *RelevantIfOperatorType) UnmarshalGQL(v interface{}) error {
 func (e RelevantIfOperatorType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "ASC"
+	SortOrderDesc SortOrder = "DESC"
+)
+
+var AllSortOrder = []SortOrder{
+	SortOrderAsc,
+	SortOrderDesc,
+}
+
+func (e SortOrder) IsValid() bool {
+	switch e {
+	case SortOrderAsc, SortOrderDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortOrder) String() string {
+	return string(e)
+}
+
+func (e *SortOrder) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortOrder(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortOrder", str)
+	}
+	return nil
+}
+
+func (e SortOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -16,13 +16,17 @@ package graphql
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/observiq/bindplane-op/internal/eventbus"
+	model1 "github.com/observiq/bindplane-op/internal/graphql/model"
 	"github.com/observiq/bindplane-op/internal/server"
 	"github.com/observiq/bindplane-op/internal/store"
 	"github.com/observiq/bindplane-op/internal/store/search"
 	"github.com/observiq/bindplane-op/model"
 	"go.opentelemetry.io/otel"
+	"golang.org/x/exp/slices"
 )
 
 var tracer = otel.Tracer("graphql")
@@ -39,6 +43,10 @@ type Resolver struct {
 	updates   eventbus.Source[*store.Updates]
 }
 
+// subscriptionBufferSize is the number of changes that can be waiting for a graphql subscription. A subscription that
+// falls further behind is disconnected so that it can't stall the changes sent to other subscriptions.
+const subscriptionBufferSize = 100
+
 // NewResolver returns a new Resolver and starts a go routine
 // that sends agent updates to observers.
 func NewResolver(bindplane server.BindPlane) *Resolver {
@@ -113,6 +121,40 @@ func applyQueryToEvents[T model.Resource](query *search.Query, index search.Inde
 	return result
 }
 
+// resourceKinds are the kinds of resources that implement the Resource interface in the graphql schema
+var resourceKinds = []model.Kind{
+	model.KindConfiguration,
+	model.KindSource,
+	model.KindProcessor,
+	model.KindDestination,
+	model.KindSourceType,
+	model.KindProcessorType,
+	model.KindDestinationType,
+	model.KindConnector,
+	model.KindConnectorType,
+	model.KindAgentGroup,
+}
+
+// parseResourceKinds parses the kinds specified for resourceChanges. All resource kinds are included if none are
+// specified.
+func parseResourceKinds(kinds []string) (map[model.Kind]bool, error) {
+	result := map[model.Kind]bool{}
+	if len(kinds) == 0 {
+		for _, kind := range resourceKinds {
+			result[kind] = true
+		}
+		return result, nil
+	}
+	for _, name := range kinds {
+		kind := model.ParseKind(name)
+		if !slices.Contains(resourceKinds, kind) {
+			return nil, fmt.Errorf("unknown resource kind: %s", name)
+		}
+		result[kind] = true
+	}
+	return result, nil
+}
+
 func (r *Resolver) parseSelectorAndQuery(selector *string, query *string) (*model.Selector, *search.Query, error) {
 	var parsedSelector *model.Selector
 	if selector != nil {
@@ -158,3 +200,132 @@ func (r *Resolver) queryOptionsAndSuggestions(selector *string, query *string, i
 	}
 	return options, suggestions, nil
 }
+
+// pageRequest is a request for a page of a connection query
+type pageRequest struct {
+	// options are passed to the store to select the page. The limit is one more than first so that we know if there is
+	// a next page.
+	options []store.QueryOption
+	sort    string
+	first   int
+}
+
+// parsePageRequest parses the arguments of a connection query. The sort must be one of the specified sortFields.
+func (r *Resolver) parsePageRequest(first *int, after *string, sort *string, order *model1.SortOrder, selector *string, query *string, sortFields []string) (*pageRequest, error) {
+	parsedSelector, parsedQuery, err := r.parseSelectorAndQuery(selector, query)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pageRequest{}
+	if parsedSelector != nil {
+		req.options = append(req.options, store.WithSelector(*parsedSelector))
+	}
+	if parsedQuery != nil {
+		req.options = append(req.options, store.WithQuery(parsedQuery))
+	}
+
+	if sort != nil && *sort != "" {
+		if !slices.Contains(sortFields, *sort) {
+			return nil, fmt.Errorf("unsupported sort: %s", *sort)
+		}
+		req.sort = *sort
+	}
+	if order != nil && *order == model1.SortOrderDesc {
+		if req.sort == "" {
+			req.sort = "name"
+		}
+		req.sort = "-" + req.sort
+	}
+	if req.sort != "" {
+		req.options = append(req.options, store.WithSort(req.sort))
+	}
+
+	if after != nil && *after != "" {
+		req.options = append(req.options, store.WithCursor(*after))
+	}
+
+	if first != nil {
+		if *first < 0 {
+			return nil, errors.New("first must not be negative")
+		}
+		req.first = *first
+		if req.first > 0 {
+			req.options = append(req.options, store.WithLimit(req.first+1))
+		}
+	}
+	return req, nil
+}
+
+// toEdges converts a page of items to edges using newEdge and returns them with the page info
+func toEdges[T any, E any](items []T, req *pageRequest, cursor func(sort string, item T) string, newEdge func(cursor string, node T) E) ([]E, *model1.PageInfo) {
+	items, next := store.Page(items, req.first, func(item T) string { return cursor(req.sort, item) })
+
+	result := make([]E, 0, len(items))
+	pageInfo := &model1.PageInfo{HasNextPage: next != ""}
+	for _, item := range items {
+		itemCursor := cursor(req.sort, item)
+		result = append(result, newEdge(itemCursor, item))
+		pageInfo.EndCursor = &itemCursor
+	}
+	return result, pageInfo
+}
+
+// resourcesToEdges converts a page of resources to edges using newEdge and returns them with the page info
+func resourcesToEdges[R model.Resource, E any](items []R, req *pageRequest, newEdge func(cursor string, node R) E) ([]E, *model1.PageInfo) {
+	return toEdges(items, req, func(sort string, item R) string { return store.ResourceCursor(sort, item) }, newEdge)
+}
+
+// agentIDs returns the specified agent ids along with the ids of any agents matching the selector or in the group,
+// without duplicates
+func (r *Resolver) agentIDs(ctx context.Context, ids []string, selector *string, group *string) ([]string, error) {
+	if len(ids) == 0 && (selector == nil || *selector == "") && (group == nil || *group == "") {
+		return nil, errors.New("ids, selector, or group must be specified")
+	}
+	result := []string{}
+	seen := map[string]bool{}
+	add := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	for _, id := range ids {
+		add(id)
+	}
+	if selector != nil && *selector != "" {
+		sel, err := model.SelectorFromString(*selector)
+		if err != nil {
+			return nil, err
+		}
+		agents, err := r.bindplane.Store().Agents(ctx, store.WithSelector(sel))
+		if err != nil {
+			return nil, err
+		}
+		for _, agent := range agents {
+			add(agent.ID)
+		}
+	}
+	if group != nil && *group != "" {
+		agentGroup, err := r.bindplane.Store().AgentGroup(*group)
+		if err != nil {
+			return nil, err
+		}
+		if agentGroup == nil {
+			return nil, fmt.Errorf("agent group %s not found", *group)
+		}
+		for _, id := range agentGroup.AgentIDs(r.bindplane.Store().AgentIndex()) {
+			add(id)
+		}
+	}
+	return result, nil
+}
+
+// dependencies returns references to the resources used by the resource
+func (r *Resolver) dependencies(resource model.Resource) ([]*model.ResourceReference, error) {
+	dependencies, err := store.ResourceDependencies(r.bindplane.Store(), resource)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewResourceReferences(dependencies), nil
+}
@@ -20,7 +20,9 @@ package graphql
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/mitchellh/mapstructure"
 	"github.com/observiq/bindplane-op/internal/eventbus"
 	"github.com/observiq/bindplane-op/internal/graphql/generated"
@@ -59,6 +61,40 @@ func (r *agentResolver) ConfigurationResource(ctx context.Context, obj *model.Ag
 	return r.bindplane.Store().AgentConfiguration(obj.ID)
 }
 
+// Type is the resolver for the type field.
+func (r *agentCommandResolver) Type(ctx context.Context, obj *model.AgentCommand) (string, error) {
+	return string(obj.Type), nil
+}
+
+// Status is the resolver for the status field.
+func (r *agentCommandResolver) Status(ctx context.Context, obj *model.AgentCommand) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Kind is the resolver for the kind field.
+func (r *agentGroupResolver) Kind(ctx context.Context, obj *model.AgentGroup) (string, error) {
+	return string(obj.GetKind()), nil
+}
+
+// Summary is the resolver for the summary field.
+func (r *agentGroupResolver) Summary(ctx context.Context, obj *model.AgentGroup) (*model.AgentGroupSummary, error) {
+	agents, err := store.AgentGroupMembers(r.bindplane.Store(), obj)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewAgentGroupSummary(obj, agents), nil
+}
+
+// Dependents is the resolver for the dependents field.
+func (r *agentGroupResolver) Dependents(ctx context.Context, obj *model.AgentGroup) (*model.ResourceDependents, error) {
+	return store.ResourceDependents(r.bindplane.Store(), obj)
+}
+
+// Dependencies is the resolver for the dependencies field.
+func (r *agentGroupResolver) Dependencies(ctx context.Context, obj *model.AgentGroup) ([]*model.ResourceReference, error) {
+	return r.dependencies(obj)
+}
+
 // MatchLabels is the resolver for the matchLabels field.
 func (r *agentSelectorResolver) MatchLabels(ctx context.Context, obj *model.AgentSelector) (map[string]interface{}, error) {
 	labels := map[string]interface{}{}
@@ -73,16 +109,56 @@ func (r *configurationResolver) Kind(ctx context.Context, obj *model.Configurati
 	return string(obj.GetKind()), nil
 }
 
+// Dependents is the resolver for the dependents field.
+func (r *configurationResolver) Dependents(ctx context.Context, obj *model.Configuration) (*model.ResourceDependents, error) {
+	return store.ResourceDependents(r.bindplane.Store(), obj)
+}
+
+// Dependencies is the resolver for the dependencies field.
+func (r *configurationResolver) Dependencies(ctx context.Context, obj *model.Configuration) ([]*model.ResourceReference, error) {
+	return r.dependencies(obj)
+}
+
+// Kind is the resolver for the kind field.
+func (r *connectorResolver) Kind(ctx context.Context, obj *model.Connector) (string, error) {
+	return string(obj.GetKind()), nil
+}
+
+// Kind is the resolver for the kind field.
+func (r *connectorTypeResolver) Kind(ctx context.Context, obj *model.ConnectorType) (string, error) {
+	return string(obj.GetKind()), nil
+}
+
 // Kind is the resolver for the kind field.
 func (r *destinationResolver) Kind(ctx context.Context, obj *model.Destination) (string, error) {
 	return string(obj.GetKind()), nil
 }
 
+// Dependents is the resolver for the dependents field.
+func (r *destinationResolver) Dependents(ctx context.Context, obj *model.Destination) (*model.ResourceDependents, error) {
+	return store.ResourceDependents(r.bindplane.Store(), obj)
+}
+
+// Dependencies is the resolver for the dependencies field.
+func (r *destinationResolver) Dependencies(ctx context.Context, obj *model.Destination) ([]*model.ResourceReference, error) {
+	return r.dependencies(obj)
+}
+
 // Kind is the resolver for the kind field.
 func (r *destinationTypeResolver) Kind(ctx context.Context, obj *model.DestinationType) (string, error) {
 	return string(obj.GetKind()), nil
 }
 
+// Dependents is the resolver for the dependents field.
+func (r *destinationTypeResolver) Dependents(ctx context.Context, obj *model.DestinationType) (*model.ResourceDependents, error) {
+	return store.ResourceDependents(r.bindplane.Store(), obj)
+}
+
+// Dependencies is the resolver for the dependencies field.
+func (r *destinationTypeResolver) Dependencies(ctx context.Context, obj *model.DestinationType) ([]*model.ResourceReference, error) {
+	return r.dependencies(obj)
+}
+
 // Labels is the resolver for the labels field.
 func (r *metadataResolver) Labels(ctx context.Context, obj *model.Metadata) (map[string]interface{}, error) {
 	labels := map[string]interface{}{}
@@ -92,6 +168,35 @@ func (r *metadataResolver) Labels(ctx context.Context, obj *model.Metadata) (map
 	return labels, nil
 }
 
+// ExecuteAgentCommand is the resolver for the executeAgentCommand field.
+func (r *mutationResolver) ExecuteAgentCommand(ctx context.Context, typeArg string, ids []string, selector *string, group *string) ([]*model.AgentCommand, error) {
+	ctx, span := tracer.Start(ctx, "graphql/ExecuteAgentCommand")
+	defer span.End()
+
+	commandType, err := model.ParseAgentCommandType(typeArg)
+	if err != nil {
+		return nil, err
+	}
+
+	agentIDs, err := r.agentIDs(ctx, ids, selector, group)
+	if err != nil {
+		return nil, err
+	}
+
+	commands := []*model.AgentCommand{}
+	for _, id := range agentIDs {
+		command, err := r.bindplane.Manager().ExecuteAgentCommand(ctx, id, commandType)
+		if err != nil {
+			// report the error and continue with the remaining agents
+			r.bindplane.Logger().Error("error in graphql ExecuteAgentCommand", zap.String("agentID", id), zap.Error(err))
+			graphql.AddError(ctx, fmt.Errorf("failed to send %s to agent with id %s, %w", commandType, id, err))
+			continue
+		}
+		commands = append(commands, command)
+	}
+	return commands, nil
+}
+
 // Type is the resolver for the type field.
 func (r *parameterDefinitionResolver) Type(ctx context.Context, obj *model.ParameterDefinition) (model1.ParameterType, error) {
 	switch obj.Type {
@@ -118,6 +223,33 @@ func (r *parameterDefinitionResolver) Type(ctx context.Context, obj *model.Param
 	case "enums":
 		return model1.ParameterTypeEnums, nil
 
+	case "float":
+		return model1.ParameterTypeFloat, nil
+
+	case "duration":
+		return model1.ParameterTypeDuration, nil
+
+	case "byteSize":
+		return model1.ParameterTypeByteSize, nil
+
+	case "regex":
+		return model1.ParameterTypeRegex, nil
+
+	case "timezone":
+		return model1.ParameterTypeTimezone, nil
+
+	case "filePath":
+		return model1.ParameterTypeFilePath, nil
+
+	case "hostPort":
+		return model1.ParameterTypeHostPort, nil
+
+	case "object":
+		return model1.ParameterTypeObject, nil
+
+	case "objects":
+		return model1.ParameterTypeObjects, nil
+
 	default:
 		return "", errors.New("unknown parameter type")
 	}
@@ -128,11 +260,31 @@ func (r *processorResolver) Kind(ctx context.Context, obj *model.Processor) (str
 	return string(obj.GetKind()), nil
 }
 
+// Dependents is the resolver for the dependents field.
+func (r *processorResolver) Dependents(ctx context.Context, obj *model.Processor) (*model.ResourceDependents, error) {
+	return store.ResourceDependents(r.bindplane.Store(), obj)
+}
+
+// Dependencies is the resolver for the dependencies field.
+func (r *processorResolver) Dependencies(ctx context.Context, obj *model.Processor) ([]*model.ResourceReference, error) {
+	return r.dependencies(obj)
+}
+
 // Kind is the resolver for the kind field.
 func (r *processorTypeResolver) Kind(ctx context.Context, obj *model.ProcessorType) (string, error) {
 	return string(obj.GetKind()), nil
 }
 
+// Dependents is the resolver for the dependents field.
+func (r *processorTypeResolver) Dependents(ctx context.Context, obj *model.ProcessorType) (*model.ResourceDependents, error) {
+	return store.ResourceDependents(r.bindplane.Store(), obj)
+}
+
+// Dependencies is the resolver for the dependencies field.
+func (r *processorTypeResolver) Dependencies(ctx context.Context, obj *model.ProcessorType) ([]*model.ResourceReference, error) {
+	return r.dependencies(obj)
+}
+
 // Agents is the resolver for the agents field.
 func (r *queryResolver) Agents(ctx context.Context, selector *string, query *string) (*model1.Agents, error) {
 	ctx, span := tracer.Start(ctx, "graphql/Agents")
@@ -156,6 +308,16 @@ func (r *queryResolver) Agent(ctx context.Context, id string) (*model.Agent, err
 	return r.Resolver.bindplane.Store().Agent(id)
 }
 
+// AgentGroups is the resolver for the agentGroups field.
+func (r *queryResolver) AgentGroups(ctx context.Context) ([]*model.AgentGroup, error) {
+	return r.Resolver.bindplane.Store().AgentGroups()
+}
+
+// AgentGroup is the resolver for the agentGroup field.
+func (r *queryResolver) AgentGroup(ctx context.Context, name string) (*model.AgentGroup, error) {
+	return r.Resolver.bindplane.Store().AgentGroup(name)
+}
+
 // Configurations is the resolver for the configurations field.
 func (r *queryResolver) Configurations(ctx context.Context, selector *string, query *string) (*model1.Configurations, error) {
 	options, suggestions, err := r.queryOptionsAndSuggestions(selector, query, r.Resolver.bindplane.Store().ConfigurationIndex())
@@ -287,21 +449,174 @@ func (r *queryResolver) Components(ctx context.Context) (*model1.Components, err
 	}, nil
 }
 
+// AgentsConnection is the resolver for the agentsConnection field.
+func (r *queryResolver) AgentsConnection(ctx context.Context, first *int, after *string, sort *string, order *model1.SortOrder, selector *string, query *string) (*model1.AgentConnection, error) {
+	req, err := r.parsePageRequest(first, after, sort, order, selector, query, store.AgentSortFields)
+	if err != nil {
+		return nil, err
+	}
+	agents, err := r.Resolver.bindplane.Store().Agents(ctx, req.options...)
+	if err != nil {
+		return nil, err
+	}
+	edges, pageInfo := toEdges(agents, req, store.AgentCursor, func(cursor string, node *model.Agent) *model1.AgentEdge {
+		return &model1.AgentEdge{Cursor: cursor, Node: node}
+	})
+	return &model1.AgentConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+// ConfigurationsConnection is the resolver for the configurationsConnection field.
+func (r *queryResolver) ConfigurationsConnection(ctx context.Context, first *int, after *string, sort *string, order *model1.SortOrder, selector *string, query *string) (*model1.ConfigurationConnection, error) {
+	req, err := r.parsePageRequest(first, after, sort, order, selector, query, store.ResourceSortFields)
+	if err != nil {
+		return nil, err
+	}
+	configurations, err := r.Resolver.bindplane.Store().Configurations(req.options...)
+	if err != nil {
+		return nil, err
+	}
+	edges, pageInfo := resourcesToEdges(configurations, req, func(cursor string, node *model.Configuration) *model1.ConfigurationEdge {
+		return &model1.ConfigurationEdge{Cursor: cursor, Node: node}
+	})
+	return &model1.ConfigurationConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+// SourcesConnection is the resolver for the sourcesConnection field.
+func (r *queryResolver) SourcesConnection(ctx context.Context, first *int, after *string, sort *string, order *model1.SortOrder, selector *string) (*model1.SourceConnection, error) {
+	req, err := r.parsePageRequest(first, after, sort, order, selector, nil, store.ResourceSortFields)
+	if err != nil {
+		return nil, err
+	}
+	sources, err := r.Resolver.bindplane.Store().Sources(req.options...)
+	if err != nil {
+		return nil, err
+	}
+	edges, pageInfo := resourcesToEdges(sources, req, func(cursor string, node *model.Source) *model1.SourceEdge {
+		return &model1.SourceEdge{Cursor: cursor, Node: node}
+	})
+	return &model1.SourceConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+// SourceTypesConnection is the resolver for the sourceTypesConnection field.
+func (r *queryResolver) SourceTypesConnection(ctx context.Context, first *int, after *string, sort *string, order *model1.SortOrder, selector *string) (*model1.SourceTypeConnection, error) {
+	req, err := r.parsePageRequest(first, after, sort, order, selector, nil, store.ResourceSortFields)
+	if err != nil {
+		return nil, err
+	}
+	sourceTypes, err := r.Resolver.bindplane.Store().SourceTypes(req.options...)
+	if err != nil {
+		return nil, err
+	}
+	edges, pageInfo := resourcesToEdges(sourceTypes, req, func(cursor string, node *model.SourceType) *model1.SourceTypeEdge {
+		return &model1.SourceTypeEdge{Cursor: cursor, Node: node}
+	})
+	return &model1.SourceTypeConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+// ProcessorsConnection is the resolver for the processorsConnection field.
+func (r *queryResolver) ProcessorsConnection(ctx context.Context, first *int, after *string, sort *string, order *model1.SortOrder, selector *string) (*model1.ProcessorConnection, error) {
+	req, err := r.parsePageRequest(first, after, sort, order, selector, nil, store.ResourceSortFields)
+	if err != nil {
+		return nil, err
+	}
+	processors, err := r.Resolver.bindplane.Store().Processors(req.options...)
+	if err != nil {
+		return nil, err
+	}
+	edges, pageInfo := resourcesToEdges(processors, req, func(cursor string, node *model.Processor) *model1.ProcessorEdge {
+		return &model1.ProcessorEdge{Cursor: cursor, Node: node}
+	})
+	return &model1.ProcessorConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+// ProcessorTypesConnection is the resolver for the processorTypesConnection field.
+func (r *queryResolver) ProcessorTypesConnection(ctx context.Context, first *int, after *string, sort *string, order *model1.SortOrder, selector *string) (*model1.ProcessorTypeConnection, error) {
+	req, err := r.parsePageRequest(first, after, sort, order, selector, nil, store.ResourceSortFields)
+	if err != nil {
+		return nil, err
+	}
+	processorTypes, err := r.Resolver.bindplane.Store().ProcessorTypes(req.options...)
+	if err != nil {
+		return nil, err
+	}
+	edges, pageInfo := resourcesToEdges(processorTypes, req, func(cursor string, node *model.ProcessorType) *model1.ProcessorTypeEdge {
+		return &model1.ProcessorTypeEdge{Cursor: cursor, Node: node}
+	})
+	return &model1.ProcessorTypeConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+// DestinationsConnection is the resolver for the destinationsConnection field.
+func (r *queryResolver) DestinationsConnection(ctx context.Context, first *int, after *string, sort *string, order *model1.SortOrder, selector *string) (*model1.DestinationConnection, error) {
+	req, err := r.parsePageRequest(first, after, sort, order, selector, nil, store.ResourceSortFields)
+	if err != nil {
+		return nil, err
+	}
+	destinations, err := r.Resolver.bindplane.Store().Destinations(req.options...)
+	if err != nil {
+		return nil, err
+	}
+	edges, pageInfo := resourcesToEdges(destinations, req, func(cursor string, node *model.Destination) *model1.DestinationEdge {
+		return &model1.DestinationEdge{Cursor: cursor, Node: node}
+	})
+	return &model1.DestinationConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
+// DestinationTypesConnection is the resolver for the destinationTypesConnection field.
+func (r *queryResolver) DestinationTypesConnection(ctx context.Context, first *int, after *string, sort *string, order *model1.SortOrder, selector *string) (*model1.DestinationTypeConnection, error) {
+	req, err := r.parsePageRequest(first, after, sort, order, selector, nil, store.ResourceSortFields)
+	if err != nil {
+		return nil, err
+	}
+	destinationTypes, err := r.Resolver.bindplane.Store().DestinationTypes(req.options...)
+	if err != nil {
+		return nil, err
+	}
+	edges, pageInfo := resourcesToEdges(destinationTypes, req, func(cursor string, node *model.DestinationType) *model1.DestinationTypeEdge {
+		return &model1.DestinationTypeEdge{Cursor: cursor, Node: node}
+	})
+	return &model1.DestinationTypeConnection{Edges: edges, PageInfo: pageInfo}, nil
+}
+
 // Operator is the resolver for the operator field.
 func (r *relevantIfConditionResolver) Operator(ctx context.Context, obj *model.RelevantIfCondition) (model1.RelevantIfOperatorType, error) {
 	return model1.RelevantIfOperatorType(obj.Operator), nil
 }
 
+// Kind is the resolver for the kind field.
+func (r *resourceReferenceResolver) Kind(ctx context.Context, obj *model.ResourceReference) (string, error) {
+	return string(obj.Kind), nil
+}
+
 // Kind is the resolver for the kind field.
 func (r *sourceResolver) Kind(ctx context.Context, obj *model.Source) (string, error) {
 	return string(obj.GetKind()), nil
 }
 
+// Dependents is the resolver for the dependents field.
+func (r *sourceResolver) Dependents(ctx context.Context, obj *model.Source) (*model.ResourceDependents, error) {
+	return store.ResourceDependents(r.bindplane.Store(), obj)
+}
+
+// Dependencies is the resolver for the dependencies field.
+func (r *sourceResolver) Dependencies(ctx context.Context, obj *model.Source) ([]*model.ResourceReference, error) {
+	return r.dependencies(obj)
+}
+
 // Kind is the resolver for the kind field.
 func (r *sourceTypeResolver) Kind(ctx context.Context, obj *model.SourceType) (string, error) {
 	return string(obj.GetKind()), nil
 }
 
+// Dependents is the resolver for the dependents field.
+func (r *sourceTypeResolver) Dependents(ctx context.Context, obj *model.SourceType) (*model.ResourceDependents, error) {
+	return store.ResourceDependents(r.bindplane.Store(), obj)
+}
+
+// Dependencies is the resolver for the dependencies field.
+func (r *sourceTypeResolver) Dependencies(ctx context.Context, obj *model.SourceType) ([]*model.ResourceReference, error) {
+	return r.dependencies(obj)
+}
+
 // AgentChanges is the resolver for the agentChanges field.
 func (r *subscriptionResolver) AgentChanges(ctx context.Context, selector *string, query *string) (<-chan []*model1.AgentChange, error) {
 	parsedSelector, parsedQuery, err := r.parseSelectorAndQuery(selector, query)
@@ -319,7 +634,10 @@ func (r *subscriptionResolver) AgentChanges(ctx context.Context, selector *strin
 		events = applyQueryToChanges(parsedQuery, r.bindplane.Store().AgentIndex(), events)
 
 		return model1.ToAgentChangeArray(events), !events.Empty()
-	})
+	},
+		eventbus.WithBackpressure[[]*model1.AgentChange](eventbus.BackpressureDisconnect),
+		eventbus.WithBufferSize[[]*model1.AgentChange](subscriptionBufferSize),
+	)
 
 	return channel, nil
 }
@@ -339,7 +657,136 @@ func (r *subscriptionResolver) ConfigurationChanges(ctx context.Context, selecto
 		events = applyQueryToEvents(parsedQuery, r.bindplane.Store().ConfigurationIndex(), events)
 
 		return model1.ToConfigurationChanges(events), len(events) > 0
-	})
+	},
+		eventbus.WithBackpressure[[]*model1.ConfigurationChange](eventbus.BackpressureDisconnect),
+		eventbus.WithBufferSize[[]*model1.ConfigurationChange](subscriptionBufferSize),
+	)
+
+	return channel, nil
+}
+
+// SourceChanges is the resolver for the sourceChanges field.
+func (r *subscriptionResolver) SourceChanges(ctx context.Context, selector *string) (<-chan []*model1.SourceChange, error) {
+	parsedSelector, _, err := r.parseSelectorAndQuery(selector, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// we can ignore the unsubscribe function because this will automatically unsubscribe when the context is done.
+	channel, _ := eventbus.SubscribeWithFilterUntilDone(ctx, r.updates, func(updates *store.Updates) (result []*model1.SourceChange, accept bool) {
+		events := applySelectorToEvents(parsedSelector, updates.Sources)
+		return model1.ToSourceChanges(events), len(events) > 0
+	},
+		eventbus.WithBackpressure[[]*model1.SourceChange](eventbus.BackpressureDisconnect),
+		eventbus.WithBufferSize[[]*model1.SourceChange](subscriptionBufferSize),
+	)
+
+	return channel, nil
+}
+
+// ProcessorChanges is the resolver for the processorChanges field.
+func (r *subscriptionResolver) ProcessorChanges(ctx context.Context, selector *string) (<-chan []*model1.ProcessorChange, error) {
+	parsedSelector, _, err := r.parseSelectorAndQuery(selector, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// we can ignore the unsubscribe function because this will automatically unsubscribe when the context is done.
+	channel, _ := eventbus.SubscribeWithFilterUntilDone(ctx, r.updates, func(updates *store.Updates) (result []*model1.ProcessorChange, accept bool) {
+		events := applySelectorToEvents(parsedSelector, updates.Processors)
+		return model1.ToProcessorChanges(events), len(events) > 0
+	},
+		eventbus.WithBackpressure[[]*model1.ProcessorChange](eventbus.BackpressureDisconnect),
+		eventbus.WithBufferSize[[]*model1.ProcessorChange](subscriptionBufferSize),
+	)
+
+	return channel, nil
+}
+
+// DestinationChanges is the resolver for the destinationChanges field.
+func (r *subscriptionResolver) DestinationChanges(ctx context.Context, selector *string) (<-chan []*model1.DestinationChange, error) {
+	parsedSelector, _, err := r.parseSelectorAndQuery(selector, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// we can ignore the unsubscribe function because this will automatically unsubscribe when the context is done.
+	channel, _ := eventbus.SubscribeWithFilterUntilDone(ctx, r.updates, func(updates *store.Updates) (result []*model1.DestinationChange, accept bool) {
+		events := applySelectorToEvents(parsedSelector, updates.Destinations)
+		return model1.ToDestinationChanges(events), len(events) > 0
+	},
+		eventbus.WithBackpressure[[]*model1.DestinationChange](eventbus.BackpressureDisconnect),
+		eventbus.WithBufferSize[[]*model1.DestinationChange](subscriptionBufferSize),
+	)
+
+	return channel, nil
+}
+
+// ResourceTypeChanges is the resolver for the resourceTypeChanges field.
+func (r *subscriptionResolver) ResourceTypeChanges(ctx context.Context) (<-chan []*model1.ResourceChange, error) {
+	// we can ignore the unsubscribe function because this will automatically unsubscribe when the context is done.
+	channel, _ := eventbus.SubscribeWithFilterUntilDone(ctx, r.updates, func(updates *store.Updates) (result []*model1.ResourceChange, accept bool) {
+		result = model1.AppendResourceChanges(result, updates.SourceTypes)
+		result = model1.AppendResourceChanges(result, updates.ProcessorTypes)
+		result = model1.AppendResourceChanges(result, updates.DestinationTypes)
+		result = model1.AppendResourceChanges(result, updates.ConnectorTypes)
+		return result, len(result) > 0
+	},
+		eventbus.WithBackpressure[[]*model1.ResourceChange](eventbus.BackpressureDisconnect),
+		eventbus.WithBufferSize[[]*model1.ResourceChange](subscriptionBufferSize),
+	)
+
+	return channel, nil
+}
+
+// ResourceChanges is the resolver for the resourceChanges field.
+func (r *subscriptionResolver) ResourceChanges(ctx context.Context, kinds []string, selector *string) (<-chan []*model1.ResourceChange, error) {
+	parsedKinds, err := parseResourceKinds(kinds)
+	if err != nil {
+		return nil, err
+	}
+	parsedSelector, _, err := r.parseSelectorAndQuery(selector, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// we can ignore the unsubscribe function because this will automatically unsubscribe when the context is done.
+	channel, _ := eventbus.SubscribeWithFilterUntilDone(ctx, r.updates, func(updates *store.Updates) (result []*model1.ResourceChange, accept bool) {
+		if parsedKinds[model.KindConfiguration] {
+			result = model1.AppendResourceChanges(result, applySelectorToEvents(parsedSelector, updates.Configurations))
+		}
+		if parsedKinds[model.KindSource] {
+			result = model1.AppendResourceChanges(result, applySelectorToEvents(parsedSelector, updates.Sources))
+		}
+		if parsedKinds[model.KindProcessor] {
+			result = model1.AppendResourceChanges(result, applySelectorToEvents(parsedSelector, updates.Processors))
+		}
+		if parsedKinds[model.KindDestination] {
+			result = model1.AppendResourceChanges(result, applySelectorToEvents(parsedSelector, updates.Destinations))
+		}
+		if parsedKinds[model.KindSourceType] {
+			result = model1.AppendResourceChanges(result, applySelectorToEvents(parsedSelector, updates.SourceTypes))
+		}
+		if parsedKinds[model.KindProcessorType] {
+			result = model1.AppendResourceChanges(result, applySelectorToEvents(parsedSelector, updates.ProcessorTypes))
+		}
+		if parsedKinds[model.KindDestinationType] {
+			result = model1.AppendResourceChanges(result, applySelectorToEvents(parsedSelector, updates.DestinationTypes))
+		}
+		if parsedKinds[model.KindConnector] {
+			result = model1.AppendResourceChanges(result, applySelectorToEvents(parsedSelector, updates.Connectors))
+		}
+		if parsedKinds[model.KindConnectorType] {
+			result = model1.AppendResourceChanges(result, applySelectorToEvents(parsedSelector, updates.ConnectorTypes))
+		}
+		if parsedKinds[model.KindAgentGroup] {
+			result = model1.AppendResourceChanges(result, applySelectorToEvents(parsedSelector, updates.AgentGroups))
+		}
+		return result, len(result) > 0
+	},
+		eventbus.WithBackpressure[[]*model1.ResourceChange](eventbus.BackpressureDisconnect),
+		eventbus.WithBufferSize[[]*model1.ResourceChange](subscriptionBufferSize),
+	)
 
 	return channel, nil
 }
@@ -347,12 +794,24 @@ func (r *subscriptionResolver) ConfigurationChanges(ctx context.Context, selecto
 // Agent returns generated.AgentResolver implementation.
 func (r *Resolver) Agent() generated.AgentResolver { return &agentResolver{r} }
 
+// AgentCommand returns generated.AgentCommandResolver implementation.
+func (r *Resolver) AgentCommand() generated.AgentCommandResolver { return &agentCommandResolver{r} }
+
+// AgentGroup returns generated.AgentGroupResolver implementation.
+func (r *Resolver) AgentGroup() generated.AgentGroupResolver { return &agentGroupResolver{r} }
+
 // AgentSelector returns generated.AgentSelectorResolver implementation.
 func (r *Resolver) AgentSelector() generated.AgentSelectorResolver { return &agentSelectorResolver{r} }
 
 // Configuration returns generated.ConfigurationResolver implementation.
 func (r *Resolver) Configuration() generated.ConfigurationResolver { return &configurationResolver{r} }
 
+// Connector returns generated.ConnectorResolver implementation.
+func (r *Resolver) Connector() generated.ConnectorResolver { return &connectorResolver{r} }
+
+// ConnectorType returns generated.ConnectorTypeResolver implementation.
+func (r *Resolver) ConnectorType() generated.ConnectorTypeResolver { return &connectorTypeResolver{r} }
+
 // Destination returns generated.DestinationResolver implementation.
 func (r *Resolver) Destination() generated.DestinationResolver { return &destinationResolver{r} }
 
@@ -364,6 +823,9 @@ func (r *Resolver) DestinationType() generated.DestinationTypeResolver {
 // Metadata returns generated.MetadataResolver implementation.
 func (r *Resolver) Metadata() generated.MetadataResolver { return &metadataResolver{r} }
 
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
 // ParameterDefinition returns generated.ParameterDefinitionResolver implementation.
 func (r *Resolver) ParameterDefinition() generated.ParameterDefinitionResolver {
 	return &parameterDefinitionResolver{r}
@@ -383,6 +845,11 @@ func (r *Resolver) RelevantIfCondition() generated.RelevantIfConditionResolver {
 	return &relevantIfConditionResolver{r}
 }
 
+// ResourceReference returns generated.ResourceReferenceResolver implementation.
+func (r *Resolver) ResourceReference() generated.ResourceReferenceResolver {
+	return &resourceReferenceResolver{r}
+}
+
 // Source returns generated.SourceResolver implementation.
 func (r *Resolver) Source() generated.SourceResolver { return &sourceResolver{r} }
 
@@ -393,16 +860,22 @@ func (r *Resolver) SourceType() generated.SourceTypeResolver { return &sourceTyp
 func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
 type agentResolver struct{ *Resolver }
+type agentCommandResolver struct{ *Resolver }
+type agentGroupResolver struct{ *Resolver }
 type agentSelectorResolver struct{ *Resolver }
 type configurationResolver struct{ *Resolver }
+type connectorResolver struct{ *Resolver }
+type connectorTypeResolver struct{ *Resolver }
 type destinationResolver struct{ *Resolver }
 type destinationTypeResolver struct{ *Resolver }
 type metadataResolver struct{ *Resolver }
+type mutationResolver struct{ *Resolver }
 type parameterDefinitionResolver struct{ *Resolver }
 type processorResolver struct{ *Resolver }
 type processorTypeResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type relevantIfConditionResolver struct{ *Resolver }
+type resourceReferenceResolver struct{ *Resolver }
 type sourceResolver struct{ *Resolver }
 type sourceTypeResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
@@ -17,6 +17,7 @@ package graphql
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/99designs/gqlgen/client"
 	"github.com/stretchr/testify/require"
@@ -28,6 +29,7 @@ import (
 	"github.com/observiq/bindplane-op/internal/server"
 	"github.com/observiq/bindplane-op/internal/store"
 	"github.com/observiq/bindplane-op/model"
+	"github.com/observiq/bindplane-op/model/otel"
 )
 
 func addAgent(s store.Store, agent *model.Agent) (*model.Agent, error) {
@@ -95,6 +97,93 @@ func TestQueryResolvers(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, resp["agent"].ID, agent.ID)
 	})
+
+	t.Run("agentGroups returns the groups with a summary of their agents", func(t *testing.T) {
+		s.Clear()
+
+		xy, err := model.LabelsFromSelector("x=y")
+		require.NoError(t, err)
+
+		addAgent(s, &model.Agent{ID: "1", Name: "Fake Agent 1", Version: "1.0.0", Status: model.Connected, Labels: xy})
+		addAgent(s, &model.Agent{ID: "2", Name: "Fake Agent 2", Version: "1.1.0", Status: model.Disconnected, Labels: xy})
+		addAgent(s, &model.Agent{ID: "3", Name: "Fake Agent 3", Version: "1.0.0", Status: model.Connected})
+
+		_, err = s.ApplyResources([]model.Resource{model.NewAgentGroup("xy", map[string]string{"x": "y"}, "")})
+		require.NoError(t, err)
+
+		var resp struct {
+			AgentGroups []struct {
+				Kind     string
+				Metadata struct{ Name string }
+				Summary  struct {
+					Agents   int
+					Statuses []struct {
+						Value string
+						Count int
+					}
+					Versions []struct {
+						Value string
+						Count int
+					}
+				}
+			}
+		}
+		err = c.Post(`query TestQuery { agentGroups { kind metadata { name } summary { agents statuses { value count } versions { value count } } } }`, &resp)
+		require.NoError(t, err)
+		require.Len(t, resp.AgentGroups, 1)
+
+		group := resp.AgentGroups[0]
+		require.Equal(t, "AgentGroup", group.Kind)
+		require.Equal(t, "xy", group.Metadata.Name)
+		require.Equal(t, 2, group.Summary.Agents)
+		require.Len(t, group.Summary.Statuses, 2)
+		require.Len(t, group.Summary.Versions, 2)
+	})
+
+	t.Run("resources return their dependents and dependencies", func(t *testing.T) {
+		s.Clear()
+
+		_, err := s.ApplyResources([]model.Resource{
+			model.NewSourceType("macos", nil),
+			model.NewSource("macos-1", "macos", nil),
+			model.NewConfigurationWithSpec("configuration-1", model.ConfigurationSpec{
+				Sources:  []model.ResourceConfiguration{{Name: "macos-1"}},
+				Selector: model.AgentSelector{MatchLabels: model.MatchLabels{"configuration": "configuration-1"}},
+			}),
+		})
+		require.NoError(t, err)
+
+		labels, err := model.LabelsFromSelector("configuration=configuration-1")
+		require.NoError(t, err)
+		addAgent(s, &model.Agent{ID: "4", Name: "Fake Agent 4", Labels: labels})
+
+		type reference struct {
+			Kind string
+			Name string
+		}
+		var resp struct {
+			SourceType struct {
+				Dependents struct {
+					Resources []reference
+					AgentIds  []string
+				}
+			}
+			Source struct {
+				Dependencies []reference
+			}
+		}
+		err = c.Post(`query TestQuery {
+			sourceType(name: "macos") { dependents { resources { kind name } agentIds } }
+			source(name: "macos-1") { dependencies { kind name } }
+		}`, &resp)
+		require.NoError(t, err)
+		require.Equal(t, []reference{
+			{Kind: "Source", Name: "macos-1"},
+			{Kind: "Configuration", Name: "configuration-1"},
+		}, resp.SourceType.Dependents.Resources)
+		require.Equal(t, []string{"4"}, resp.SourceType.Dependents.AgentIds)
+		require.Equal(t, []reference{{Kind: "SourceType", Name: "macos"}}, resp.Source.Dependencies)
+	})
 }
 
 func TestConfigForAgent(t *testing.T) {
@@ -187,3 +276,369 @@ func TestConfigForAgent(t *testing.T) {
 		}
 	}
 }
+
+func TestExecuteAgentCommand(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mapstore := store.NewMapStore(ctx, store.Options{
+		SessionsSecret:   "super-secret-key",
+		MaxEventsToMerge: 1,
+	}, zap.NewNop())
+
+	bindplane, err := server.NewBindPlane(&common.Server{}, zaptest.NewLogger(t), mapstore, nil)
+	require.NoError(t, err)
+
+	srv := newHandler(bindplane)
+	c := client.New(srv)
+
+	xy, err := model.LabelsFromSelector("x=y")
+	require.NoError(t, err)
+	addAgent(mapstore, &model.Agent{ID: "1", Labels: xy})
+	addAgent(mapstore, &model.Agent{ID: "2", Labels: xy})
+	addAgent(mapstore, &model.Agent{ID: "3"})
+
+	resp := &struct {
+		ExecuteAgentCommand []struct {
+			AgentID string
+			Type    string
+			Status  string
+		}
+	}{}
+
+	t.Run("queues the command for agents matching ids and selector", func(t *testing.T) {
+		err := c.Post(`mutation { executeAgentCommand(type: "restart", ids: ["1", "3"], selector: "x=y") { agentId type status } }`, resp)
+		require.NoError(t, err)
+		require.Len(t, resp.ExecuteAgentCommand, 3)
+		for _, command := range resp.ExecuteAgentCommand {
+			require.Equal(t, "restart", command.Type)
+			require.Equal(t, "queued", command.Status)
+		}
+
+		agent, err := mapstore.Agent("2")
+		require.NoError(t, err)
+		require.Len(t, agent.Commands, 1)
+	})
+
+	t.Run("queues the command for agents in the group", func(t *testing.T) {
+		_, err := mapstore.ApplyResources([]model.Resource{model.NewAgentGroup("g1", map[string]string{"x": "y"}, "")})
+		require.NoError(t, err)
+
+		err = c.Post(`mutation { executeAgentCommand(type: "reload-config", group: "g1") { agentId type status } }`, resp)
+		require.NoError(t, err)
+		require.Len(t, resp.ExecuteAgentCommand, 2)
+		for _, command := range resp.ExecuteAgentCommand {
+			require.NotEqual(t, "3", command.AgentID)
+			require.Equal(t, "reload-config", command.Type)
+		}
+
+		err = c.Post(`mutation { executeAgentCommand(type: "restart", group: "missing") { id } }`, resp)
+		require.Error(t, err)
+	})
+
+	t.Run("reports errors for each agent and continues", func(t *testing.T) {
+		resp.ExecuteAgentCommand = nil
+		err := c.Post(`mutation { executeAgentCommand(type: "restart", ids: ["missing", "3"]) { agentId type status } }`, resp)
+		require.ErrorContains(t, err, "failed to send restart to agent with id missing")
+		require.Len(t, resp.ExecuteAgentCommand, 1)
+		require.Equal(t, "3", resp.ExecuteAgentCommand[0].AgentID)
+	})
+
+	t.Run("returns an error for unknown commands", func(t *testing.T) {
+		err := c.Post(`mutation { executeAgentCommand(type: "explode", ids: ["1"]) { id } }`, resp)
+		require.Error(t, err)
+
+		err = c.Post(`mutation { executeAgentCommand(type: "rotate-logs", ids: ["1"]) { id } }`, resp)
+		require.Error(t, err, "commands without an OpAMP equivalent are not available")
+	})
+
+	t.Run("returns an error without ids or selector", func(t *testing.T) {
+		err := c.Post(`mutation { executeAgentCommand(type: "restart") { id } }`, resp)
+		require.Error(t, err)
+	})
+}
+
+func nextChanges[T any](t *testing.T, channel <-chan []T) []T {
+	select {
+	case changes, ok := <-channel:
+		require.True(t, ok, "channel closed")
+		return changes
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for changes")
+	}
+	return nil
+}
+
+func TestResourceSubscriptions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mapstore := store.NewMapStore(ctx, store.Options{
+		SessionsSecret:   "super-secret-key",
+		MaxEventsToMerge: 1,
+	}, zap.NewNop())
+
+	bindplane, err := server.NewBindPlane(&common.Server{}, zaptest.NewLogger(t), mapstore, nil)
+	require.NoError(t, err)
+
+	subscription := NewResolver(bindplane).Subscription()
+	s := bindplane.Store()
+
+	apply := func(resources ...model.Resource) {
+		for _, resource := range resources {
+			_, err := s.ApplyResources([]model.Resource{resource})
+			require.NoError(t, err)
+		}
+	}
+
+	t.Run("resourceChanges includes the specified kinds", func(t *testing.T) {
+		subscriptionCtx, stop := context.WithCancel(ctx)
+		defer stop()
+		channel, err := subscription.ResourceChanges(subscriptionCtx, []string{"Source", "destinationtypes"}, nil)
+		require.NoError(t, err)
+
+		apply(
+			model.NewDestinationType("dt1", nil),
+			model.NewSourceType("st1", nil),
+			model.NewSource("s1", "st1", nil),
+		)
+
+		changes := nextChanges(t, channel)
+		require.Len(t, changes, 1)
+		require.Equal(t, model1.EventTypeInsert, changes[0].EventType)
+		require.Equal(t, model.KindDestinationType, changes[0].Resource.GetKind())
+		require.Equal(t, "dt1", changes[0].Resource.Name())
+
+		changes = nextChanges(t, channel)
+		require.Len(t, changes, 1)
+		require.Equal(t, model1.EventTypeInsert, changes[0].EventType)
+		require.Equal(t, model.KindSource, changes[0].Resource.GetKind())
+		require.Equal(t, "s1", changes[0].Resource.Name())
+	})
+
+	t.Run("resourceChanges requires resource kinds", func(t *testing.T) {
+		_, err := subscription.ResourceChanges(ctx, []string{"Agent"}, nil)
+		require.EqualError(t, err, "unknown resource kind: Agent")
+	})
+
+	t.Run("resourceTypeChanges includes resource types", func(t *testing.T) {
+		subscriptionCtx, stop := context.WithCancel(ctx)
+		defer stop()
+		channel, err := subscription.ResourceTypeChanges(subscriptionCtx)
+		require.NoError(t, err)
+
+		apply(model.NewProcessor("p1", "pt1", nil), model.NewProcessorType("pt1", nil))
+
+		changes := nextChanges(t, channel)
+		require.Len(t, changes, 1)
+		require.Equal(t, model.KindProcessorType, changes[0].Resource.GetKind())
+		require.Equal(t, "pt1", changes[0].Resource.Name())
+	})
+
+	t.Run("sourceChanges removes sources that no longer match the selector", func(t *testing.T) {
+		subscriptionCtx, stop := context.WithCancel(ctx)
+		defer stop()
+		selector := "env=test"
+		channel, err := subscription.SourceChanges(subscriptionCtx, &selector)
+		require.NoError(t, err)
+
+		source := model.NewSource("s2", "st1", nil)
+		source.Metadata.Labels, err = model.LabelsFromSelector("env=test")
+		require.NoError(t, err)
+		apply(model.NewSourceType("st1", nil), source)
+
+		changes := nextChanges(t, channel)
+		require.Len(t, changes, 1)
+		require.Equal(t, model1.EventTypeInsert, changes[0].EventType)
+		require.Equal(t, "s2", changes[0].Source.Name())
+
+		source = model.NewSource("s2", "st1", nil)
+		source.Metadata.Labels, err = model.LabelsFromSelector("env=prod")
+		require.NoError(t, err)
+		apply(source)
+
+		changes = nextChanges(t, channel)
+		require.Len(t, changes, 1)
+		require.Equal(t, model1.EventTypeRemove, changes[0].EventType)
+	})
+
+	t.Run("destinationChanges and processorChanges", func(t *testing.T) {
+		subscriptionCtx, stop := context.WithCancel(ctx)
+		defer stop()
+		destinations, err := subscription.DestinationChanges(subscriptionCtx, nil)
+		require.NoError(t, err)
+		processors, err := subscription.ProcessorChanges(subscriptionCtx, nil)
+		require.NoError(t, err)
+
+		apply(model.NewDestinationType("dt2", nil), model.NewDestination("d2", "dt2", nil), model.NewProcessor("p2", "pt1", nil))
+
+		destinationChanges := nextChanges(t, destinations)
+		require.Len(t, destinationChanges, 1)
+		require.Equal(t, "d2", destinationChanges[0].Destination.Name())
+
+		processorChanges := nextChanges(t, processors)
+		require.Len(t, processorChanges, 1)
+		require.Equal(t, "p2", processorChanges[0].Processor.Name())
+	})
+
+	connectorType := func(name string) *model.ConnectorType {
+		return model.NewConnectorTypeWithSpec(name, model.ResourceTypeSpec{
+			Traces:           model.ResourceTypeOutput{Connectors: "spanmetrics:"},
+			ConnectorOutputs: []otel.PipelineType{otel.Metrics},
+		})
+	}
+
+	t.Run("resourceTypeChanges includes connector types", func(t *testing.T) {
+		subscriptionCtx, stop := context.WithCancel(ctx)
+		defer stop()
+		channel, err := subscription.ResourceTypeChanges(subscriptionCtx)
+		require.NoError(t, err)
+
+		apply(connectorType("ct1"))
+
+		changes := nextChanges(t, channel)
+		require.Len(t, changes, 1)
+		require.Equal(t, model.KindConnectorType, changes[0].Resource.GetKind())
+		require.Equal(t, "ct1", changes[0].Resource.Name())
+	})
+
+	tests := []struct {
+		kind     model.Kind
+		resource model.Resource
+	}{
+		{
+			kind:     model.KindConnectorType,
+			resource: connectorType("ct2"),
+		},
+		{
+			kind:     model.KindConnector,
+			resource: model.NewConnector("c2", "ct2", nil),
+		},
+		{
+			kind:     model.KindAgentGroup,
+			resource: model.NewAgentGroup("g2", model.MatchLabels{"env": "prod"}, ""),
+		},
+	}
+	for _, test := range tests {
+		t.Run("resourceChanges includes "+string(test.kind), func(t *testing.T) {
+			subscriptionCtx, stop := context.WithCancel(ctx)
+			defer stop()
+			channel, err := subscription.ResourceChanges(subscriptionCtx, []string{string(test.kind)}, nil)
+			require.NoError(t, err)
+
+			apply(test.resource)
+
+			changes := nextChanges(t, channel)
+			require.Len(t, changes, 1)
+			require.Equal(t, model1.EventTypeInsert, changes[0].EventType)
+			require.Equal(t, test.kind, changes[0].Resource.GetKind())
+			require.Equal(t, test.resource.Name(), changes[0].Resource.Name())
+		})
+	}
+
+	t.Run("connectors, connector types, and agent groups are resources", func(t *testing.T) {
+		resp := struct {
+			Type struct {
+				PossibleTypes []struct{ Name string }
+			} `json:"__type"`
+		}{}
+		err := client.New(newHandler(bindplane)).Post(`{ __type(name: "Resource") { possibleTypes { name } } }`, &resp)
+		require.NoError(t, err)
+
+		names := []string{}
+		for _, possibleType := range resp.Type.PossibleTypes {
+			names = append(names, possibleType.Name)
+		}
+		require.Subset(t, names, []string{"Connector", "ConnectorType", "AgentGroup"})
+	})
+}
+
+func TestConnectionQueries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mapstore := store.NewMapStore(ctx, store.Options{
+		SessionsSecret:   "super-secret-key",
+		MaxEventsToMerge: 1,
+	}, zap.NewNop())
+
+	bindplane, err := server.NewBindPlane(&common.Server{}, zaptest.NewLogger(t), mapstore, nil)
+	require.NoError(t, err)
+
+	c := client.New(newHandler(bindplane))
+	s := bindplane.Store()
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		_, err := s.ApplyResources([]model.Resource{model.NewSourceType(name, nil)})
+		require.NoError(t, err)
+		_, err = addAgent(s, &model.Agent{ID: name, Name: "agent-" + name, Labels: model.LabelsFromValidatedMap(map[string]string{"x": "y"})})
+		require.NoError(t, err)
+	}
+
+	type connection struct {
+		Edges []struct {
+			Cursor string
+			Node   struct {
+				ID       string
+				Metadata struct{ Name string }
+			}
+		}
+		PageInfo struct {
+			HasNextPage bool
+			EndCursor   *string
+		}
+	}
+	names := func(conn connection) []string {
+		result := []string{}
+		for _, edge := range conn.Edges {
+			if edge.Node.ID != "" {
+				result = append(result, edge.Node.ID)
+			} else {
+				result = append(result, edge.Node.Metadata.Name)
+			}
+		}
+		return result
+	}
+
+	t.Run("sourceTypesConnection pages with after", func(t *testing.T) {
+		query := `query ($after: String) { sourceTypesConnection(first: 2, after: $after) { edges { cursor node { metadata { name } } } pageInfo { hasNextPage endCursor } } }`
+
+		var resp struct{ SourceTypesConnection connection }
+		require.NoError(t, c.Post(query, &resp))
+		require.Equal(t, []string{"a", "b"}, names(resp.SourceTypesConnection))
+		require.True(t, resp.SourceTypesConnection.PageInfo.HasNextPage)
+		require.Equal(t, resp.SourceTypesConnection.Edges[1].Cursor, *resp.SourceTypesConnection.PageInfo.EndCursor)
+
+		require.NoError(t, c.Post(query, &resp, client.Var("after", *resp.SourceTypesConnection.PageInfo.EndCursor)))
+		require.Equal(t, []string{"c", "d"}, names(resp.SourceTypesConnection))
+
+		require.NoError(t, c.Post(query, &resp, client.Var("after", *resp.SourceTypesConnection.PageInfo.EndCursor)))
+		require.Equal(t, []string{"e"}, names(resp.SourceTypesConnection))
+		require.False(t, resp.SourceTypesConnection.PageInfo.HasNextPage)
+	})
+
+	t.Run("agentsConnection sorts descending", func(t *testing.T) {
+		var resp struct{ AgentsConnection connection }
+		err := c.Post(`query { agentsConnection(first: 3, sort: "id", order: DESC, selector: "x=y") { edges { cursor node { id } } pageInfo { hasNextPage endCursor } } }`, &resp)
+		require.NoError(t, err)
+		require.Equal(t, []string{"e", "d", "c"}, names(resp.AgentsConnection))
+		require.True(t, resp.AgentsConnection.PageInfo.HasNextPage)
+	})
+
+	t.Run("without first returns everything", func(t *testing.T) {
+		var resp struct{ SourceTypesConnection connection }
+		err := c.Post(`query { sourceTypesConnection { edges { node { metadata { name } } } pageInfo { hasNextPage } } }`, &resp)
+		require.NoError(t, err)
+		require.Len(t, resp.SourceTypesConnection.Edges, 5)
+		require.False(t, resp.SourceTypesConnection.PageInfo.HasNextPage)
+	})
+
+	t.Run("unsupported sort", func(t *testing.T) {
+		var resp struct{ SourceTypesConnection connection }
+		err := c.Post(`query { sourceTypesConnection(sort: "spec") { edges { cursor } } }`, &resp)
+		require.ErrorContains(t, err, "unsupported sort: spec")
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		var resp struct{ SourceTypesConnection connection }
+		err := c.Post(`query { sourceTypesConnection(first: 1, after: "nope") { edges { cursor } } }`, &resp)
+		require.ErrorContains(t, err, "invalid cursor")
+	})
+}
@@ -54,6 +54,14 @@ func (s *opampServer) updateAgentState(ctx context.Context, agentID string, conn
 		// after sync, update sequence number
 		state.SequenceNum = msg.GetSequenceNum()
 
+		// keep the capabilities to determine which commands the agent supports
+		if msg.GetCapabilities() != 0 {
+			state.Status.Capabilities = msg.GetCapabilities()
+		}
+
+		// advance the status of any commands sent to the agent
+		updateAgentCommands(agent, msg)
+
 		// always update the agent status, regardless of RemoteConfigStatus message being present
 		updateAgentStatus(s.logger, agent, state.Status.GetRemoteConfigStatus())
 
@@ -57,6 +57,7 @@ func AddRoutes(router gin.IRouter, bindplane server.BindPlane) error {
 	server := opampSvr.New(bindplane.Logger().Sugar())
 
 	callbacks := newServer(bindplane.Manager(), bindplane.Logger())
+	callbacks.diagnosticsIncludeConfig = bindplane.Config().DiagnosticsIncludeConfig
 	settings := opampSvr.Settings{
 		Callbacks: callbacks,
 	}
@@ -82,6 +83,9 @@ type opampServer struct {
 	connections             *connections
 	compatibleOpAMPVersions []string
 	logger                  *zap.Logger
+
+	// diagnosticsIncludeConfig includes the effective configuration files in diagnostics bundles
+	diagnosticsIncludeConfig bool
 }
 
 var _ server.Protocol = (*opampServer)(nil)
@@ -214,6 +218,9 @@ func (s *opampServer) OnMessage(conn opamp.Connection, message *protobufs.AgentT
 			Type:         protobufs.ServerErrorResponse_Unknown,
 			ErrorMessage: err.Error(),
 		}
+	} else if response.RemoteConfig == nil {
+		// send any commands that were queued while the agent was disconnected
+		s.applyQueuedCommands(ctx, agentID, response)
 	}
 	s.logger.Info("sending response to the agent", zap.Any("agentID", agentID), zap.Any("response", response))
 
@@ -342,6 +349,9 @@ func (s *opampServer) verifyAgentConfig(ctx context.Context, conn opamp.Connecti
 		return fmt.Errorf("unable to update agent [%s]: %w", agentID, err)
 	}
 
+	// save any diagnostics bundles requested from the agent now that the state has been updated
+	s.collectDiagnostics(ctx, agent, state, message)
+
 	return s.updateAgentConfig(ctx, agent, state, response)
 }
 
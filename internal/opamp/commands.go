@@ -0,0 +1,197 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// SendCommand sends the command to the agent. Restart uses the OpAMP restart command, reload-config resends the full
+// configuration for the agent to apply, and collect-diagnostics asks the agent to report its full state which is saved
+// as a diagnostics bundle.
+func (s *opampServer) SendCommand(ctx context.Context, agent *model.Agent, command *model.AgentCommand) error {
+	conn := s.connections.connection(agent.ID)
+	if conn == nil {
+		return server.ErrAgentNotConnected
+	}
+	ctx, span := tracer.Start(ctx, "opamp/SendCommand", trace.WithAttributes(
+		attribute.String("bindplane.agent.id", agent.ID),
+		attribute.String("bindplane.agent.command", string(command.Type)),
+	))
+	defer span.End()
+
+	msg := &protobufs.ServerToAgent{
+		InstanceUid:  agent.ID,
+		Capabilities: capabilities,
+	}
+	if err := s.applyCommand(ctx, agent, command, msg); err != nil {
+		return err
+	}
+	return s.send(context.Background(), conn, msg)
+}
+
+// applyCommand modifies the response to include the command. The response is not modified if an error is returned.
+func (s *opampServer) applyCommand(ctx context.Context, agent *model.Agent, command *model.AgentCommand, response *protobufs.ServerToAgent) error {
+	state, err := decodeState(agent.State)
+	if err != nil {
+		s.logger.Error("error encountered while decoding agent state", zap.String("agentID", agent.ID), zap.Error(err))
+	}
+
+	switch command.Type {
+	case model.AgentCommandRestart:
+		if !hasCapability(&state.Status, protobufs.AgentCapabilities_AcceptsRestartCommand) {
+			return fmt.Errorf("%w: agent does not accept restart commands", server.ErrCommandNotSupported)
+		}
+		response.Command = &protobufs.ServerToAgentCommand{
+			Type: protobufs.ServerToAgentCommand_Restart,
+		}
+		return nil
+
+	case model.AgentCommandReloadConfig:
+		if !hasCapability(&state.Status, protobufs.AgentCapabilities_AcceptsRemoteConfig) {
+			return fmt.Errorf("%w: agent does not accept remote configuration", server.ErrCommandNotSupported)
+		}
+		return s.applyReloadConfig(ctx, agent, state, response)
+
+	case model.AgentCommandCollectDiagnostics:
+		if !hasCapability(&state.Status, protobufs.AgentCapabilities_ReportsEffectiveConfig) {
+			return fmt.Errorf("%w: agent does not report its effective configuration", server.ErrCommandNotSupported)
+		}
+		// the bundle is saved by collectDiagnostics when the agent reports its full state
+		response.Flags |= protobufs.ServerToAgent_ReportFullState
+		return nil
+
+	default:
+		return fmt.Errorf("%w: %s is not available in OpAMP %s", server.ErrCommandNotSupported, command.Type, s.compatibleOpAMPVersions[0])
+	}
+}
+
+// applyReloadConfig sets the RemoteConfig of the response to the full configuration of the agent. Unlike
+// updateAgentConfig, this includes all of the configuration files, even if they are unchanged.
+func (s *opampServer) applyReloadConfig(ctx context.Context, agent *model.Agent, state *agentState, response *protobufs.ServerToAgent) error {
+	agentRawConfiguration := state.Configuration()
+	if agentRawConfiguration == nil {
+		return fmt.Errorf("agent [%s] has not reported its configuration", agent.ID)
+	}
+
+	agentConfiguration, err := agentRawConfiguration.Parse()
+	if err != nil {
+		return fmt.Errorf("unable to parse the current agent configuration: %w", err)
+	}
+
+	updates, err := s.manager.AgentUpdates(ctx, agent)
+	if err != nil {
+		return fmt.Errorf("unable to get agent updates [%s]: %w", agent.ID, err)
+	}
+
+	serverConfiguration, err := s.updatedConfiguration(ctx, agentConfiguration, updates)
+	if err != nil {
+		return fmt.Errorf("unable to compute the updated agent configuration [%s]: %w", agent.ID, err)
+	}
+
+	serverRawConfiguration := serverConfiguration.Raw()
+	fullRawConfiguration := agentRawConfiguration.ApplyUpdates(&serverRawConfiguration)
+
+	response.RemoteConfig = agentRemoteConfig(&fullRawConfiguration, agentRawConfiguration)
+	response.Flags |= protobufs.ServerToAgent_ReportFullState
+	return nil
+}
+
+// applyQueuedCommands adds the oldest queued command to the response. Commands that are not supported are marked as
+// failed. Only one command is sent in each response because an OpAMP command replaces the other fields of the
+// response. The remaining commands will be sent with subsequent responses.
+func (s *opampServer) applyQueuedCommands(ctx context.Context, agentID string, response *protobufs.ServerToAgent) {
+	agent, err := s.manager.Agent(ctx, agentID)
+	if err != nil || agent == nil {
+		return
+	}
+	queued := agent.CommandsWithStatus(model.AgentCommandQueued)
+	if len(queued) == 0 {
+		return
+	}
+
+	statuses := map[string]model.AgentCommandStatus{}
+	messages := map[string]string{}
+	for _, command := range queued {
+		err := s.applyCommand(ctx, agent, command, response)
+		if errors.Is(err, server.ErrCommandNotSupported) {
+			statuses[command.ID] = model.AgentCommandFailed
+			messages[command.ID] = err.Error()
+			continue
+		}
+		if err != nil {
+			// leave the command queued and try again with the next message
+			s.logger.Error("unable to send queued command", zap.String("agentID", agentID), zap.String("command", string(command.Type)), zap.Error(err))
+			break
+		}
+		statuses[command.ID] = model.AgentCommandSent
+		break
+	}
+	if len(statuses) == 0 {
+		return
+	}
+
+	_, err = s.manager.UpsertAgent(ctx, agentID, func(current *model.Agent) {
+		for id, status := range statuses {
+			if c := current.Command(id); c != nil && c.Status == model.AgentCommandQueued {
+				c.SetStatus(status, messages[id])
+			}
+		}
+	})
+	if err != nil {
+		s.logger.Error("unable to update the status of queued commands", zap.String("agentID", agentID), zap.Error(err))
+	}
+}
+
+// updateAgentCommands advances the status of commands that have been sent to the agent based on the contents of the
+// message received from the agent
+func updateAgentCommands(agent *model.Agent, msg *protobufs.AgentToServer) {
+	for _, command := range agent.Commands {
+		if command.Status != model.AgentCommandSent && command.Status != model.AgentCommandAcknowledged {
+			continue
+		}
+		switch command.Type {
+		case model.AgentCommandRestart:
+			// agents report their description when they start
+			if msg.GetAgentDescription() != nil {
+				command.SetStatus(model.AgentCommandSucceeded, "")
+				continue
+			}
+
+		case model.AgentCommandReloadConfig:
+			switch remoteStatus := msg.GetRemoteConfigStatus(); remoteStatus.GetStatus() {
+			case protobufs.RemoteConfigStatus_APPLIED:
+				command.SetStatus(model.AgentCommandSucceeded, "")
+				continue
+			case protobufs.RemoteConfigStatus_FAILED:
+				command.SetStatus(model.AgentCommandFailed, remoteStatus.GetErrorMessage())
+				continue
+			}
+		}
+		if command.Status == model.AgentCommandSent {
+			command.SetStatus(model.AgentCommandAcknowledged, "")
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/internal/server/mocks"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestSendCommand(t *testing.T) {
+	restartCapable := &model.Agent{
+		ID: "restart",
+		State: encodeState(&agentState{
+			Status: protobufs.AgentToServer{Capabilities: protobufs.AgentCapabilities_AcceptsRestartCommand | protobufs.AgentCapabilities_ReportsEffectiveConfig},
+		}),
+	}
+	notCapable := &model.Agent{
+		ID:    "basic",
+		State: encodeState(&agentState{}),
+	}
+
+	tests := []struct {
+		name        string
+		agent       *model.Agent
+		commandType model.AgentCommandType
+		expectErr   error
+		expectSend  func(msg *protobufs.ServerToAgent) bool
+	}{
+		{
+			name:        "not connected",
+			agent:       &model.Agent{ID: "unknown"},
+			commandType: model.AgentCommandRestart,
+			expectErr:   server.ErrAgentNotConnected,
+		},
+		{
+			name:        "restart",
+			agent:       restartCapable,
+			commandType: model.AgentCommandRestart,
+			expectSend: func(msg *protobufs.ServerToAgent) bool {
+				return msg.GetCommand().GetType() == protobufs.ServerToAgentCommand_Restart
+			},
+		},
+		{
+			name:        "collect diagnostics",
+			agent:       restartCapable,
+			commandType: model.AgentCommandCollectDiagnostics,
+			expectSend: func(msg *protobufs.ServerToAgent) bool {
+				return msg.GetFlags()&protobufs.ServerToAgent_ReportFullState != 0 && msg.GetCommand() == nil
+			},
+		},
+		{
+			name:        "collect diagnostics without capability",
+			agent:       notCapable,
+			commandType: model.AgentCommandCollectDiagnostics,
+			expectErr:   server.ErrCommandNotSupported,
+		},
+		{
+			name:        "restart without capability",
+			agent:       notCapable,
+			commandType: model.AgentCommandRestart,
+			expectErr:   server.ErrCommandNotSupported,
+		},
+		{
+			name:        "reload config without capability",
+			agent:       notCapable,
+			commandType: model.AgentCommandReloadConfig,
+			expectErr:   server.ErrCommandNotSupported,
+		},
+		{
+			name:        "unknown command",
+			agent:       restartCapable,
+			commandType: model.AgentCommandType("rotate-logs"),
+			expectErr:   server.ErrCommandNotSupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conn := &mocks.Connection{}
+			server := testServer(&mocks.Manager{})
+			server.connections.connect(conn, restartCapable.ID)
+			server.connections.connect(conn, notCapable.ID)
+
+			if test.expectSend != nil {
+				conn.On("Send", mock.Anything, mock.MatchedBy(test.expectSend)).Return(nil)
+			}
+
+			err := server.SendCommand(context.TODO(), test.agent, model.NewAgentCommand(test.agent.ID, test.commandType))
+			if test.expectErr != nil {
+				require.ErrorIs(t, err, test.expectErr)
+			} else {
+				require.NoError(t, err)
+			}
+			conn.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateAgentCommands(t *testing.T) {
+	tests := []struct {
+		name         string
+		commandType  model.AgentCommandType
+		status       model.AgentCommandStatus
+		message      *protobufs.AgentToServer
+		expectStatus model.AgentCommandStatus
+	}{
+		{
+			name:         "queued is unchanged",
+			commandType:  model.AgentCommandRestart,
+			status:       model.AgentCommandQueued,
+			message:      &protobufs.AgentToServer{AgentDescription: &protobufs.AgentDescription{}},
+			expectStatus: model.AgentCommandQueued,
+		},
+		{
+			name:         "restart acknowledged",
+			commandType:  model.AgentCommandRestart,
+			status:       model.AgentCommandSent,
+			message:      &protobufs.AgentToServer{},
+			expectStatus: model.AgentCommandAcknowledged,
+		},
+		{
+			name:         "restart succeeded",
+			commandType:  model.AgentCommandRestart,
+			status:       model.AgentCommandAcknowledged,
+			message:      &protobufs.AgentToServer{AgentDescription: &protobufs.AgentDescription{}},
+			expectStatus: model.AgentCommandSucceeded,
+		},
+		{
+			name:        "reload config applying",
+			commandType: model.AgentCommandReloadConfig,
+			status:      model.AgentCommandSent,
+			message: &protobufs.AgentToServer{
+				RemoteConfigStatus: &protobufs.RemoteConfigStatus{Status: protobufs.RemoteConfigStatus_APPLYING},
+			},
+			expectStatus: model.AgentCommandAcknowledged,
+		},
+		{
+			name:        "reload config applied",
+			commandType: model.AgentCommandReloadConfig,
+			status:      model.AgentCommandAcknowledged,
+			message: &protobufs.AgentToServer{
+				RemoteConfigStatus: &protobufs.RemoteConfigStatus{Status: protobufs.RemoteConfigStatus_APPLIED},
+			},
+			expectStatus: model.AgentCommandSucceeded,
+		},
+		{
+			name:        "reload config failed",
+			commandType: model.AgentCommandReloadConfig,
+			status:      model.AgentCommandSent,
+			message: &protobufs.AgentToServer{
+				RemoteConfigStatus: &protobufs.RemoteConfigStatus{Status: protobufs.RemoteConfigStatus_FAILED, ErrorMessage: "bad config"},
+			},
+			expectStatus: model.AgentCommandFailed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			command := model.NewAgentCommand("1", test.commandType)
+			command.Status = test.status
+			agent := &model.Agent{ID: "1", Commands: []*model.AgentCommand{command}}
+
+			updateAgentCommands(agent, test.message)
+			require.Equal(t, test.expectStatus, command.Status)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/observiq/bindplane-op/internal/diagnostics"
+	"github.com/observiq/bindplane-op/model"
+	"github.com/observiq/bindplane-op/model/observiq"
+)
+
+const redacted = "(redacted)"
+
+// collectDiagnostics saves a diagnostics bundle for each collect-diagnostics command that the agent has acknowledged.
+// The collect-diagnostics command asks the agent to report its full state and the bundle is created from the state in
+// the next message that includes the AgentDescription. OpAMP v0.2.0 does not provide a way to request files or logs
+// from the agent, so the bundle only contains what the agent reports over OpAMP. Recent collector logs are not included
+// and logs.yaml records where the agent writes them instead. The effective configuration files are only included if the
+// server is configured with diagnosticsIncludeConfig.
+func (s *opampServer) collectDiagnostics(ctx context.Context, agent *model.Agent, state *agentState, msg *protobufs.AgentToServer) {
+	if msg.GetAgentDescription() == nil {
+		return
+	}
+	var pending []*model.AgentCommand
+	for _, command := range agent.CommandsWithStatus(model.AgentCommandAcknowledged) {
+		if command.Type == model.AgentCommandCollectDiagnostics {
+			pending = append(pending, command)
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+	ctx, span := tracer.Start(ctx, "opamp/collectDiagnostics")
+	defer span.End()
+
+	files := diagnosticsFiles(agent, state, s.diagnosticsIncludeConfig)
+
+	statuses := map[string]model.AgentCommandStatus{}
+	messages := map[string]string{}
+	for _, command := range pending {
+		bundle, contents, err := diagnostics.NewBundle(command.ID, agent.ID, files)
+		if err == nil {
+			err = s.manager.Diagnostics().Save(bundle, contents)
+		}
+		if err != nil {
+			s.logger.Error("unable to save diagnostics bundle", zap.String("agentID", agent.ID), zap.Error(err))
+			statuses[command.ID] = model.AgentCommandFailed
+			messages[command.ID] = fmt.Sprintf("unable to save diagnostics bundle: %s", err.Error())
+			continue
+		}
+		statuses[command.ID] = model.AgentCommandSucceeded
+	}
+
+	_, err := s.manager.UpsertAgent(ctx, agent.ID, func(current *model.Agent) {
+		for id, status := range statuses {
+			current.SetCommandStatus(id, status, messages[id])
+		}
+	})
+	if err != nil {
+		s.logger.Error("unable to update the status of collect-diagnostics commands", zap.String("agentID", agent.ID), zap.Error(err))
+	}
+}
+
+// diagnosticsFiles returns the contents of the diagnostics bundle for the agent. The effective configuration files are
+// only included if includeConfig is true because they may contain credentials.
+func diagnosticsFiles(agent *model.Agent, state *agentState, includeConfig bool) map[string][]byte {
+	files := map[string][]byte{}
+
+	// agent.yaml contains the agent as known by BindPlane without the protocol state and commands
+	summary := *agent
+	summary.State = nil
+	summary.Commands = nil
+	summary.Configuration = nil
+	files["agent.yaml"] = marshalDiagnostics(&summary)
+
+	// host.yaml contains the attributes reported in the AgentDescription
+	if description := state.Status.GetAgentDescription(); description != nil {
+		files["host.yaml"] = marshalDiagnostics(map[string]map[string]string{
+			"identifying":    attributeMap(description.GetIdentifyingAttributes()),
+			"nonIdentifying": attributeMap(description.GetNonIdentifyingAttributes()),
+		})
+	}
+
+	if remoteStatus := state.Status.GetRemoteConfigStatus(); remoteStatus != nil {
+		files["remote-config-status.yaml"] = marshalDiagnostics(map[string]string{
+			"status":               remoteStatus.GetStatus().String(),
+			"lastRemoteConfigHash": hex.EncodeToString(remoteStatus.GetLastRemoteConfigHash()),
+			"errorMessage":         remoteStatus.GetErrorMessage(),
+		})
+	}
+
+	// config/ contains the effective configuration files. Otherwise config.yaml lists them without their contents.
+	configFiles := state.Status.GetEffectiveConfig().GetConfigMap().GetConfigMap()
+	if includeConfig {
+		for name, file := range configFiles {
+			body := file.GetBody()
+			if name == observiq.ManagerFilename {
+				body = redactManagerConfig(body)
+			}
+			files[path.Join("config", path.Base(name))] = body
+		}
+	} else if len(configFiles) > 0 {
+		files["config.yaml"] = marshalDiagnostics(diagnosticsConfigSummary(configFiles))
+	}
+
+	files["logs.yaml"] = marshalDiagnostics(diagnosticsLogs(state))
+
+	return files
+}
+
+// diagnosticsConfigSummary describes the effective configuration files without their contents, which may contain
+// credentials. The sha256 of each file can be compared with a copy of the file.
+func diagnosticsConfigSummary(configFiles map[string]*protobufs.AgentConfigFile) map[string]any {
+	summary := map[string]map[string]any{}
+	for name, file := range configFiles {
+		sum := sha256.Sum256(file.GetBody())
+		summary[path.Base(name)] = map[string]any{
+			"size":   len(file.GetBody()),
+			"sha256": hex.EncodeToString(sum[:]),
+		}
+	}
+	return map[string]any{
+		"included": false,
+		"reason":   "configuration files may contain credentials and are only included if the server is started with diagnosticsIncludeConfig",
+		"files":    summary,
+	}
+}
+
+// diagnosticsLogs describes the location of the collector logs. The logs themselves cannot be requested over OpAMP.
+func diagnosticsLogs(state *agentState) map[string]any {
+	logs := map[string]any{
+		"included": false,
+		"reason":   "the agent does not provide its logs over OpAMP",
+	}
+	logging := state.Status.GetEffectiveConfig().GetConfigMap().GetConfigMap()[observiq.LoggingFilename]
+	if logging == nil {
+		return logs
+	}
+	var config struct {
+		Output string `yaml:"output"`
+		File   struct {
+			Filename string `yaml:"filename"`
+		} `yaml:"file"`
+	}
+	if err := yaml.Unmarshal(logging.GetBody(), &config); err != nil {
+		logs["error"] = fmt.Sprintf("unable to parse %s: %s", observiq.LoggingFilename, err.Error())
+		return logs
+	}
+	if config.Output != "" {
+		logs["output"] = config.Output
+	}
+	if config.File.Filename != "" {
+		logs["filename"] = config.File.Filename
+	}
+	return logs
+}
+
+// redactManagerConfig removes the secret key from manager.yaml. If it cannot be parsed, the contents are omitted.
+func redactManagerConfig(body []byte) []byte {
+	var config map[string]any
+	if err := yaml.Unmarshal(body, &config); err != nil {
+		return []byte(fmt.Sprintf("# unable to parse %s: %s\n", observiq.ManagerFilename, err.Error()))
+	}
+	if _, ok := config["secret_key"]; ok {
+		config["secret_key"] = redacted
+	}
+	return marshalDiagnostics(config)
+}
+
+func attributeMap(attributes []*protobufs.KeyValue) map[string]string {
+	result := map[string]string{}
+	for _, kv := range attributes {
+		result[kv.GetKey()] = anyValueString(kv.GetValue())
+	}
+	return result
+}
+
+func anyValueString(value *protobufs.AnyValue) string {
+	switch v := value.GetValue().(type) {
+	case *protobufs.AnyValue_StringValue:
+		return v.StringValue
+	case *protobufs.AnyValue_BoolValue:
+		return fmt.Sprintf("%t", v.BoolValue)
+	case *protobufs.AnyValue_IntValue:
+		return fmt.Sprintf("%d", v.IntValue)
+	case *protobufs.AnyValue_DoubleValue:
+		return fmt.Sprintf("%g", v.DoubleValue)
+	case *protobufs.AnyValue_ArrayValue:
+		values := make([]string, 0, len(v.ArrayValue.GetValues()))
+		for _, item := range v.ArrayValue.GetValues() {
+			values = append(values, anyValueString(item))
+		}
+		return fmt.Sprintf("%v", values)
+	default:
+		return value.String()
+	}
+}
+
+func marshalDiagnostics(value any) []byte {
+	bytes, err := yaml.Marshal(value)
+	if err != nil {
+		return []byte(fmt.Sprintf("# unable to marshal: %s\n", err.Error()))
+	}
+	return bytes
+}
@@ -0,0 +1,133 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/internal/diagnostics"
+	"github.com/observiq/bindplane-op/internal/server/mocks"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestCollectDiagnostics(t *testing.T) {
+	state := &agentState{
+		Status: protobufs.AgentToServer{
+			AgentDescription: &protobufs.AgentDescription{
+				IdentifyingAttributes: []*protobufs.KeyValue{
+					{Key: "service.version", Value: &protobufs.AnyValue{Value: &protobufs.AnyValue_StringValue{StringValue: "1.4.0"}}},
+				},
+			},
+			EffectiveConfig: &protobufs.EffectiveConfig{
+				ConfigMap: &protobufs.AgentConfigMap{
+					ConfigMap: map[string]*protobufs.AgentConfigFile{
+						"collector.yaml": {Body: []byte("receivers: {}\n")},
+						"manager.yaml":   {Body: []byte("endpoint: ws://localhost:3001/v1/opamp\nsecret_key: super-secret\n")},
+						"logging.yaml":   {Body: []byte("output: file\nfile:\n  filename: /opt/observiq-otel-collector/log/collector.log\n")},
+					},
+				},
+			},
+		},
+	}
+
+	command := model.NewAgentCommand("1", model.AgentCommandCollectDiagnostics)
+	command.Status = model.AgentCommandAcknowledged
+	agent := &model.Agent{ID: "1", Name: "agent-1", Commands: []*model.AgentCommand{command}}
+
+	bundles := diagnostics.NewStore(diagnostics.Settings{})
+	manager := &mocks.Manager{}
+	manager.On("Diagnostics").Return(bundles)
+	manager.On("UpsertAgent", mock.Anything, "1", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(2).(store.AgentUpdater)(agent)
+	}).Return(agent, nil)
+
+	s := testServer(manager)
+
+	t.Run("waits for the full state", func(t *testing.T) {
+		s.collectDiagnostics(context.TODO(), agent, state, &protobufs.AgentToServer{})
+		require.Equal(t, model.AgentCommandAcknowledged, command.Status)
+		manager.AssertNotCalled(t, "Diagnostics")
+	})
+
+	t.Run("saves the bundle", func(t *testing.T) {
+		s.collectDiagnostics(context.TODO(), agent, state, &state.Status)
+		require.Equal(t, model.AgentCommandSucceeded, command.Status)
+
+		bundle, contents, err := bundles.Bundle("1", command.ID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"agent.yaml", "config.yaml", "host.yaml", "logs.yaml"}, bundle.Files)
+
+		files := readArchive(t, contents)
+		require.Contains(t, files["config.yaml"], "collector.yaml:")
+		require.Contains(t, files["config.yaml"], "sha256: ")
+		require.Contains(t, files["config.yaml"], "included: false")
+		require.NotContains(t, files["config.yaml"], "receivers")
+		require.NotContains(t, files["config.yaml"], "super-secret")
+		require.Contains(t, files["host.yaml"], "service.version: 1.4.0")
+		require.Contains(t, files["agent.yaml"], "name: agent-1")
+		require.Contains(t, files["logs.yaml"], "filename: /opt/observiq-otel-collector/log/collector.log")
+		require.Contains(t, files["logs.yaml"], "included: false")
+	})
+
+	t.Run("includes the configuration files if enabled", func(t *testing.T) {
+		command = model.NewAgentCommand("1", model.AgentCommandCollectDiagnostics)
+		command.Status = model.AgentCommandAcknowledged
+		agent.Commands = append(agent.Commands, command)
+		s.diagnosticsIncludeConfig = true
+
+		s.collectDiagnostics(context.TODO(), agent, state, &state.Status)
+		require.Equal(t, model.AgentCommandSucceeded, command.Status)
+
+		bundle, contents, err := bundles.Bundle("1", command.ID)
+		require.NoError(t, err)
+		require.Equal(t, []string{"agent.yaml", "config/collector.yaml", "config/logging.yaml", "config/manager.yaml", "host.yaml", "logs.yaml"}, bundle.Files)
+
+		files := readArchive(t, contents)
+		require.Equal(t, "receivers: {}\n", files["config/collector.yaml"])
+		require.NotContains(t, files["config/manager.yaml"], "super-secret")
+		require.Contains(t, files["config/manager.yaml"], "endpoint: ws://localhost:3001/v1/opamp")
+		require.Contains(t, files["host.yaml"], "service.version: 1.4.0")
+		require.Contains(t, files["agent.yaml"], "name: agent-1")
+		require.Contains(t, files["logs.yaml"], "filename: /opt/observiq-otel-collector/log/collector.log")
+		require.Contains(t, files["logs.yaml"], "included: false")
+	})
+}
+
+func readArchive(t *testing.T, contents []byte) map[string]string {
+	gz, err := gzip.NewReader(bytes.NewReader(contents))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[header.Name] = string(data)
+	}
+}
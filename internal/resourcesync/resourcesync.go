@@ -0,0 +1,457 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package resourcesync syncs resources from a directory of resource yaml into the store. Resources created or updated
+// by sync are labeled as managed by sync so that they can be pruned when they are removed from the directory and so
+// that changes made outside of sync are reported as drift.
+package resourcesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// DefaultInterval is the default amount of time between syncs of the sync directory
+const DefaultInterval = time.Minute
+
+// ErrNotConfigured is returned when the sync directory is synced but no sync directory is configured
+var ErrNotConfigured = errors.New("no sync directory is configured")
+
+// ErrDuplicateResource is returned when the resources to sync include more than one resource with the same kind and
+// name
+var ErrDuplicateResource = errors.New("duplicate resource")
+
+// Options configures a single sync
+type Options struct {
+	// Prune deletes resources managed by sync that are not in the synced resources
+	Prune bool
+
+	// DryRun compares the resources with the store without changing the store
+	DryRun bool
+}
+
+// Syncer syncs resources into the store
+type Syncer interface {
+	// Sync compares the resources with the resources in the store, creates and updates resources that differ, and
+	// optionally prunes resources managed by sync that are not included. It returns the result for each resource.
+	Sync(ctx context.Context, resources []*model.AnyResource, options Options) ([]*model.SyncResource, error)
+
+	// SyncDirectory syncs the resources in the sync directory, returning ErrNotConfigured if there is no sync directory
+	SyncDirectory(ctx context.Context) ([]*model.SyncResource, error)
+
+	// Interval returns the amount of time between syncs of the sync directory or zero if it is not synced periodically
+	Interval() time.Duration
+}
+
+// Settings configures the Syncer
+type Settings struct {
+	// Directory is the path of a local directory of resource yaml synced periodically by the server. If empty, resources
+	// are only synced on request.
+	Directory string
+
+	// Interval is the amount of time between syncs of the Directory
+	Interval time.Duration
+
+	// Prune deletes resources managed by sync that are removed from the Directory
+	Prune bool
+
+	Store  store.Store
+	Logger *zap.Logger
+}
+
+type syncer struct {
+	directory string
+	interval  time.Duration
+	prune     bool
+	store     store.Store
+	logger    *zap.Logger
+
+	// mtx ensures that only one sync occurs at a time
+	mtx sync.Mutex
+}
+
+var _ Syncer = (*syncer)(nil)
+
+// NewSyncer returns a new Syncer using the specified settings
+func NewSyncer(settings Settings) Syncer {
+	interval := settings.Interval
+	if settings.Directory == "" {
+		interval = 0
+	}
+	return &syncer{
+		directory: settings.Directory,
+		interval:  interval,
+		prune:     settings.Prune,
+		store:     settings.Store,
+		logger:    settings.Logger,
+	}
+}
+
+// Sync compares the resources with the resources in the store, creates and updates resources that differ, and
+// optionally prunes resources managed by sync that are not included. It returns the result for each resource.
+func (s *syncer) Sync(_ context.Context, resources []*model.AnyResource, options Options) ([]*model.SyncResource, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	planned, err := s.plan(resources, options.Prune)
+	if err != nil {
+		return nil, err
+	}
+	if options.DryRun {
+		return statuses(planned), nil
+	}
+
+	if err := s.apply(planned); err != nil {
+		return nil, err
+	}
+	if err := s.delete(planned); err != nil {
+		return nil, err
+	}
+
+	result := statuses(planned)
+	s.logger.Info("Synced resources", zap.Any("resources", summary(result)))
+	return result, nil
+}
+
+// SyncDirectory syncs the resources in the sync directory, returning ErrNotConfigured if there is no sync directory
+func (s *syncer) SyncDirectory(ctx context.Context) ([]*model.SyncResource, error) {
+	if s.directory == "" {
+		return nil, ErrNotConfigured
+	}
+	resources, err := model.ResourcesFromDirectory(s.directory)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read sync directory: %w", err)
+	}
+	return s.Sync(ctx, resources, Options{Prune: s.prune})
+}
+
+// Interval returns the amount of time between syncs of the sync directory or zero if it is not synced periodically
+func (s *syncer) Interval() time.Duration {
+	return s.interval
+}
+
+// ----------------------------------------------------------------------
+
+// plannedResource is a resource to sync and the resource to apply to or delete from the store, if any
+type plannedResource struct {
+	status *model.SyncResource
+	apply  model.Resource
+	delete model.Resource
+}
+
+// applied updates the status of the resource with the result of applying it to the store
+func (p *plannedResource) applied(update model.ResourceStatus) {
+	switch update.Status {
+	case model.StatusCreated:
+		p.status.Status = model.SyncStatusCreated
+	case model.StatusConfigured:
+		if p.status.Status != model.SyncStatusUnchanged {
+			// unchanged resources are only configured to add the digest label
+			p.status.Status = model.SyncStatusUpdated
+		}
+	case model.StatusInvalid, model.StatusError, model.StatusConflict:
+		p.status.Status = model.SyncStatusInvalid
+		p.status.Reason = update.Reason
+	}
+}
+
+// syncKinds are the kinds of resources that can be synced in dependency order
+func syncKinds() []model.Kind {
+	kinds := []model.Kind{}
+	for _, kind := range model.BundleKinds {
+		if kind != model.KindAgent {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds
+}
+
+func isSyncKind(kind model.Kind) bool {
+	for _, k := range syncKinds() {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// plan compares the resources with the store. Resources that cannot be parsed are reported as invalid. Resources
+// managed by sync that are not included are deleted if prune is true.
+func (s *syncer) plan(resources []*model.AnyResource, prune bool) ([]*plannedResource, error) {
+	parsed := make([]model.Resource, 0, len(resources))
+	invalid := []*plannedResource{}
+	included := map[string]bool{}
+	digests := map[string]string{}
+	for _, resource := range resources {
+		key := resourceKey(resource.GetKind(), resource.Name())
+		if included[key] {
+			return nil, fmt.Errorf("%w: %s %s", ErrDuplicateResource, resource.GetKind(), resource.Name())
+		}
+		included[key] = true
+
+		r, digest, err := parseResource(resource)
+		if err != nil {
+			invalid = append(invalid, &plannedResource{status: &model.SyncResource{
+				Kind:   resource.GetKind(),
+				Name:   resource.Name(),
+				Status: model.SyncStatusInvalid,
+				Reason: err.Error(),
+			}})
+			continue
+		}
+		parsed = append(parsed, r)
+		digests[key] = digest
+	}
+	model.SortBundleResources(parsed)
+
+	planned := make([]*plannedResource, 0, len(resources))
+	for _, resource := range parsed {
+		p, err := s.planResource(resource, digests[resourceKey(resource.GetKind(), resource.Name())])
+		if err != nil {
+			return nil, err
+		}
+		planned = append(planned, p)
+	}
+	planned = append(planned, invalid...)
+
+	for _, kind := range syncKinds() {
+		existing, err := store.ResourcesOfKind(s.store, kind)
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range existing {
+			if included[resourceKey(kind, resource.Name())] || !model.IsManagedBySync(resource) {
+				continue
+			}
+			p, err := planOrphan(resource, prune)
+			if err != nil {
+				return nil, err
+			}
+			planned = append(planned, p)
+		}
+	}
+	return planned, nil
+}
+
+// planResource compares a single resource with the digest of its contents with the resource in the store
+func (s *syncer) planResource(resource model.Resource, digest string) (*plannedResource, error) {
+	status := &model.SyncResource{
+		Kind: resource.GetKind(),
+		Name: resource.Name(),
+	}
+	p := &plannedResource{status: status, apply: resource}
+
+	existing, err := store.CurrentResource(s.store, resource.GetKind(), resource.Name())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		status.Status = model.SyncStatusCreate
+		return p, nil
+	}
+
+	existingDigest, err := model.SyncDigest(existing)
+	if err != nil {
+		return nil, err
+	}
+	syncedDigest := existing.GetLabels().Get(model.LabelBindPlaneSyncDigest)
+	setDrift(status, syncedDigest, existingDigest)
+
+	switch {
+	case existingDigest != digest:
+		status.Status = model.SyncStatusUpdate
+	case syncedDigest != digest:
+		// identical resources that are not managed by sync are labeled so that they are managed by sync
+		status.Status = model.SyncStatusUnchanged
+	default:
+		status.Status = model.SyncStatusUnchanged
+		p.apply = nil
+	}
+	return p, nil
+}
+
+// planOrphan plans a resource managed by sync that is not included in the synced resources
+func planOrphan(resource model.Resource, prune bool) (*plannedResource, error) {
+	status := &model.SyncResource{
+		Kind:   resource.GetKind(),
+		Name:   resource.Name(),
+		Status: model.SyncStatusOrphaned,
+	}
+	p := &plannedResource{status: status}
+
+	existingDigest, err := model.SyncDigest(resource)
+	if err != nil {
+		return nil, err
+	}
+	setDrift(status, resource.GetLabels().Get(model.LabelBindPlaneSyncDigest), existingDigest)
+
+	if prune {
+		status.Status = model.SyncStatusDelete
+		p.delete = resource
+	}
+	return p, nil
+}
+
+// setDrift reports drift if the resource in the store was modified since it was synced and explains why resources that
+// are not managed by sync are updated
+func setDrift(status *model.SyncResource, syncedDigest, existingDigest string) {
+	switch {
+	case syncedDigest == "":
+		status.Reason = "not managed by sync"
+	case syncedDigest != existingDigest:
+		status.Drift = true
+		status.Reason = "modified since it was synced"
+	}
+}
+
+// apply applies the created and updated resources to the store in dependency order
+func (s *syncer) apply(planned []*plannedResource) error {
+	resources := []model.Resource{}
+	byKey := map[string]*plannedResource{}
+	for _, p := range planned {
+		if p.apply != nil {
+			resources = append(resources, p.apply)
+			byKey[resourceKey(p.apply.GetKind(), p.apply.Name())] = p
+		}
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+
+	updates, err := s.store.ApplyResources(resources)
+	if err != nil {
+		return err
+	}
+	for _, update := range updates {
+		if p, ok := byKey[resourceKey(update.Resource.GetKind(), update.Resource.Name())]; ok {
+			p.applied(update)
+		}
+	}
+	return nil
+}
+
+// delete prunes resources in reverse dependency order. Resources that are in use are retried as long as other
+// resources are deleted because they may only be in use by resources that are also being pruned.
+func (s *syncer) delete(planned []*plannedResource) error {
+	pending := []*plannedResource{}
+	for i := len(planned) - 1; i >= 0; i-- {
+		if planned[i].delete != nil {
+			pending = append(pending, planned[i])
+		}
+	}
+
+	for len(pending) > 0 {
+		resources := make([]model.Resource, len(pending))
+		byKey := map[string]*plannedResource{}
+		for i, p := range pending {
+			resources[i] = p.delete
+			byKey[resourceKey(p.delete.GetKind(), p.delete.Name())] = p
+			// resources that no longer exist are not included in the statuses
+			p.status.Status = model.SyncStatusDeleted
+		}
+
+		updates, err := s.store.DeleteResources(resources)
+		if err != nil {
+			return err
+		}
+
+		inUse := []*plannedResource{}
+		for _, update := range updates {
+			p, ok := byKey[resourceKey(update.Resource.GetKind(), update.Resource.Name())]
+			if !ok {
+				continue
+			}
+			switch update.Status {
+			case model.StatusDeleted:
+			case model.StatusInUse:
+				p.status.Status = model.SyncStatusInUse
+				p.status.Reason = update.Reason
+				inUse = append(inUse, p)
+			default:
+				p.status.Status = model.SyncStatusInvalid
+				p.status.Reason = update.Reason
+			}
+		}
+
+		if len(inUse) == len(pending) {
+			// no progress was made, the remaining resources are in use by resources that are not being pruned
+			break
+		}
+		pending = inUse
+	}
+	return nil
+}
+
+// parseResource parses a resource to sync, ensuring that it is a kind that can be synced. The parsed resource is
+// labeled with the digest of its contents and has no resourceVersion so that it replaces the resource in the store.
+func parseResource(resource *model.AnyResource) (model.Resource, string, error) {
+	if !isSyncKind(resource.GetKind()) {
+		return nil, "", fmt.Errorf("%s resources cannot be synced", resource.GetKind())
+	}
+	if resource.Name() == "" {
+		return nil, "", errors.New("missing metadata.name")
+	}
+
+	parsed, err := model.ParseResource(resource)
+	if err != nil {
+		return nil, "", err
+	}
+	digest, err := model.SyncDigest(parsed)
+	if err != nil {
+		return nil, "", err
+	}
+
+	labeled := *resource
+	labels := model.MakeLabels()
+	for name, value := range resource.Metadata.Labels.Set {
+		labels.Set[name] = value
+	}
+	labels.Set[model.LabelBindPlaneSyncDigest] = digest
+	labeled.Metadata.Labels = labels
+	labeled.Metadata.ResourceVersion = 0
+
+	parsed, err = model.ParseResource(&labeled)
+	if err != nil {
+		return nil, "", err
+	}
+	return parsed, digest, nil
+}
+
+func statuses(planned []*plannedResource) []*model.SyncResource {
+	result := make([]*model.SyncResource, len(planned))
+	for i, p := range planned {
+		result[i] = p.status
+	}
+	return result
+}
+
+func summary(resources []*model.SyncResource) map[model.SyncStatus]int {
+	result := map[model.SyncStatus]int{}
+	for _, r := range resources {
+		result[r.Status]++
+	}
+	return result
+}
+
+func resourceKey(kind model.Kind, name string) string {
+	return fmt.Sprintf("%s|%s", kind, name)
+}
@@ -0,0 +1,310 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcesync
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+const testSourceType = `apiVersion: bindplane.observiq.com/v1
+kind: SourceType
+metadata:
+  name: hostmetrics
+spec:
+  parameters:
+    - name: interval
+      type: int
+      default: 60
+  metrics:
+    receivers: |
+      - hostmetrics:
+          collection_interval: {{ .interval }}s
+`
+
+func testSource(name string, interval int) string {
+	return fmt.Sprintf(`apiVersion: bindplane.observiq.com/v1
+kind: Source
+metadata:
+  name: %s
+spec:
+  type: hostmetrics
+  parameters:
+    - name: interval
+      value: %d
+`, name, interval)
+}
+
+func testConfiguration(name string, source string) string {
+	return fmt.Sprintf(`apiVersion: bindplane.observiq.com/v1
+kind: Configuration
+metadata:
+  name: %s
+  labels:
+    platform: linux
+spec:
+  sources:
+    - name: %s
+`, name, source)
+}
+
+// writeResources replaces the contents of the directory with the specified files
+func writeResources(t *testing.T, dir string, files map[string]string) {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		require.NoError(t, os.RemoveAll(filepath.Join(dir, entry.Name())))
+	}
+	for name, contents := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0750))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	}
+}
+
+func newTestSyncer(t *testing.T, dir string, prune bool) (Syncer, store.Store) {
+	s := store.NewMapStore(context.Background(), store.Options{SessionsSecret: "super-secret-key", MaxEventsToMerge: 1}, zap.NewNop())
+	return NewSyncer(Settings{Directory: dir, Interval: DefaultInterval, Prune: prune, Store: s, Logger: zap.NewNop()}), s
+}
+
+// statusesByName returns the status of each resource as "status" or "status drift"
+func statusesByName(resources []*model.SyncResource) map[string]string {
+	result := map[string]string{}
+	for _, r := range resources {
+		status := string(r.Status)
+		if r.Drift {
+			status += " drift"
+		}
+		result[fmt.Sprintf("%s/%s", r.Kind, r.Name)] = status
+	}
+	return result
+}
+
+func TestSyncDirectory(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	syncer, s := newTestSyncer(t, dir, true)
+	require.Equal(t, DefaultInterval, syncer.Interval())
+
+	// files are read from subdirectories and configurations are applied after the sources they reference
+	writeResources(t, dir, map[string]string{
+		"a-configuration.yaml":   testConfiguration("linux", "cpu"),
+		"sources/cpu.yaml":       testSource("cpu", 30),
+		"sources/memory.yml":     testSource("memory", 60),
+		"types/hostmetrics.yaml": testSourceType,
+		"README.md":              "not a resource",
+		".git/ignored.yaml":      "not: a resource",
+	})
+	result, err := syncer.SyncDirectory(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"SourceType/hostmetrics": "created",
+		"Source/cpu":             "created",
+		"Source/memory":          "created",
+		"Configuration/linux":    "created",
+	}, statusesByName(result))
+
+	source, err := s.Source("cpu")
+	require.NoError(t, err)
+	require.True(t, model.IsManagedBySync(source))
+
+	// syncing again makes no changes
+	result, err = syncer.SyncDirectory(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"SourceType/hostmetrics": "unchanged",
+		"Source/cpu":             "unchanged",
+		"Source/memory":          "unchanged",
+		"Configuration/linux":    "unchanged",
+	}, statusesByName(result))
+
+	// modify memory outside of sync, change cpu in the directory, and remove the configuration and its source
+	memory, err := s.Source("memory")
+	require.NoError(t, err)
+	memory.Spec.Parameters[0].Value = 10
+	_, err = s.ApplyResources([]model.Resource{memory})
+	require.NoError(t, err)
+
+	writeResources(t, dir, map[string]string{
+		"sources/memory.yaml":    testSource("memory", 60),
+		"types/hostmetrics.yaml": testSourceType,
+	})
+	result, err = syncer.Sync(ctx, mustReadDirectory(t, dir), Options{DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"SourceType/hostmetrics": "unchanged",
+		"Source/cpu":             "orphaned",
+		"Source/memory":          "update drift",
+		"Configuration/linux":    "orphaned",
+	}, statusesByName(result))
+
+	result, err = syncer.SyncDirectory(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"SourceType/hostmetrics": "unchanged",
+		"Source/cpu":             "deleted",
+		"Source/memory":          "updated drift",
+		"Configuration/linux":    "deleted",
+	}, statusesByName(result))
+
+	memory, err = s.Source("memory")
+	require.NoError(t, err)
+	require.EqualValues(t, 60, memory.Spec.Parameters[0].Value)
+	source, err = s.Source("cpu")
+	require.NoError(t, err)
+	require.Nil(t, source)
+}
+
+func TestSyncProcessorReferences(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	syncer, s := newTestSyncer(t, dir, false)
+
+	// the processor must be applied before the source that references it by name on the first sync
+	writeResources(t, dir, map[string]string{
+		"source.yaml": `apiVersion: bindplane.observiq.com/v1
+kind: Source
+metadata:
+  name: cpu
+spec:
+  type: hostmetrics
+  processors:
+    - name: batch
+`,
+		"processor.yaml": `apiVersion: bindplane.observiq.com/v1
+kind: Processor
+metadata:
+  name: batch
+spec:
+  type: batch
+`,
+		"types/batch.yaml": `apiVersion: bindplane.observiq.com/v1
+kind: ProcessorType
+metadata:
+  name: batch
+spec:
+  parameters: []
+`,
+		"types/hostmetrics.yaml": testSourceType,
+	})
+	result, err := syncer.SyncDirectory(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"SourceType/hostmetrics": "created",
+		"ProcessorType/batch":    "created",
+		"Processor/batch":        "created",
+		"Source/cpu":             "created",
+	}, statusesByName(result))
+
+	source, err := s.Source("cpu")
+	require.NoError(t, err)
+	require.Equal(t, "batch", source.Spec.Processors[0].Name)
+}
+
+func TestSyncPruneInUse(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	syncer, s := newTestSyncer(t, dir, true)
+
+	writeResources(t, dir, map[string]string{
+		"resources.yaml": testSourceType + "---\n" + testSource("cpu", 30) + "---\n" + testConfiguration("linux", "cpu"),
+	})
+	_, err := syncer.SyncDirectory(ctx)
+	require.NoError(t, err)
+
+	// a configuration that is not managed by sync references the source
+	configuration, err := model.ParseResource(mustParse(t, testConfiguration("manual", "cpu")))
+	require.NoError(t, err)
+	_, err = s.ApplyResources([]model.Resource{configuration})
+	require.NoError(t, err)
+
+	// the managed configuration is deleted but the source remains in use by the manual configuration
+	writeResources(t, dir, map[string]string{
+		"types.yaml": testSourceType,
+	})
+	result, err := syncer.SyncDirectory(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"SourceType/hostmetrics": "unchanged",
+		"Source/cpu":             "in-use",
+		"Configuration/linux":    "deleted",
+	}, statusesByName(result))
+	require.Contains(t, result[1].Reason+result[2].Reason, "Configuration manual")
+
+	stored, err := s.Configuration("manual")
+	require.NoError(t, err)
+	require.False(t, model.IsManagedBySync(stored))
+}
+
+func TestSyncAdoptsAndRejects(t *testing.T) {
+	ctx := context.Background()
+	syncer, s := newTestSyncer(t, "", false)
+	require.Zero(t, syncer.Interval())
+
+	_, err := syncer.SyncDirectory(ctx)
+	require.ErrorIs(t, err, ErrNotConfigured)
+
+	sourceType, err := model.ParseResource(mustParse(t, testSourceType))
+	require.NoError(t, err)
+	_, err = s.ApplyResources([]model.Resource{sourceType})
+	require.NoError(t, err)
+
+	resources := []*model.AnyResource{
+		mustParse(t, testSourceType),
+		mustParse(t, testSource("cpu", 30)),
+		{ResourceMeta: model.ResourceMeta{Kind: model.KindAgent, Metadata: model.Metadata{Name: "agent"}}},
+	}
+	result, err := syncer.Sync(ctx, resources, Options{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"SourceType/hostmetrics": "unchanged",
+		"Source/cpu":             "created",
+		"Agent/agent":            "invalid",
+	}, statusesByName(result))
+	require.Equal(t, "not managed by sync", result[0].Reason)
+
+	stored, err := s.SourceType("hostmetrics")
+	require.NoError(t, err)
+	require.True(t, model.IsManagedBySync(stored))
+
+	_, err = syncer.Sync(ctx, append(resources, mustParse(t, testSource("cpu", 10))), Options{})
+	require.ErrorIs(t, err, ErrDuplicateResource)
+	require.EqualError(t, err, "duplicate resource: Source cpu")
+}
+
+func mustParse(t *testing.T, contents string) *model.AnyResource {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "resource.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	resources, err := model.ResourcesFromFile(path)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	return resources[0]
+}
+
+func mustReadDirectory(t *testing.T, dir string) []*model.AnyResource {
+	resources, err := model.ResourcesFromDirectory(dir)
+	require.NoError(t, err)
+	return resources
+}
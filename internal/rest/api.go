@@ -15,15 +15,21 @@
 package rest
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/backup"
+	"github.com/observiq/bindplane-op/internal/catalog"
+	"github.com/observiq/bindplane-op/internal/diagnostics"
+	"github.com/observiq/bindplane-op/internal/resourcesync"
 	"github.com/observiq/bindplane-op/internal/server"
 	"github.com/observiq/bindplane-op/internal/store"
 	"github.com/observiq/bindplane-op/internal/store/search"
@@ -33,15 +39,26 @@ import (
 
 var tracer = otel.Tracer("rest")
 
-// AddRestRoutes adds all API routes to the gin HTTP router
-func AddRestRoutes(router gin.IRouter, bindplane server.BindPlane) {
+// AddRestRoutes adds all API routes to the gin HTTP router. The history of events streamed by /events is kept until the
+// context is done.
+func AddRestRoutes(ctx context.Context, router gin.IRouter, bindplane server.BindPlane) {
+	events := newEventHistory(ctx, bindplane.Store().Updates(), eventHistorySize)
+
 	router.GET("/agents", func(c *gin.Context) { agents(c, bindplane) })
 	router.GET("/agents/:id", func(c *gin.Context) { getAgent(c, bindplane) })
+	router.GET("/agents/drift", func(c *gin.Context) { agentsDrift(c, bindplane) })
+	router.GET("/agents/pending", func(c *gin.Context) { agentPendingChanges(c, bindplane) })
+	router.POST("/agents/:id/pending/apply", func(c *gin.Context) { applyAgentPendingChange(c, bindplane) })
 	router.DELETE("/agents", func(c *gin.Context) { deleteAgents(c, bindplane) })
 	router.PATCH("/agents/labels", func(c *gin.Context) { labelAgents(c, bindplane) })
 	router.GET("/agents/:id/labels", func(c *gin.Context) { getAgentLabels(c, bindplane) })
 	router.PATCH("/agents/:id/labels", func(c *gin.Context) { patchAgentLabels(c, bindplane) })
 	router.PUT("/agents/:id/restart", func(c *gin.Context) { restartAgent(c, bindplane) })
+	router.POST("/agents/commands", func(c *gin.Context) { executeAgentCommand(c, bindplane) })
+	router.GET("/agents/:id/commands", func(c *gin.Context) { getAgentCommands(c, bindplane) })
+	router.POST("/agents/:id/diagnostics", func(c *gin.Context) { diagnoseAgent(c, bindplane) })
+	router.GET("/agents/:id/diagnostics", func(c *gin.Context) { getAgentDiagnostics(c, bindplane) })
+	router.GET("/agents/:id/diagnostics/:bundleId", func(c *gin.Context) { getAgentDiagnosticsBundle(c, bindplane) })
 	router.POST("/agents/:id/version", func(c *gin.Context) { updateAgent(c, bindplane) })
 	router.GET("/agents/:id/configuration", func(c *gin.Context) { getAgentConfiguration(c, bindplane) })
 
@@ -49,94 +66,145 @@ func AddRestRoutes(router gin.IRouter, bindplane server.BindPlane) {
 	router.GET("/configurations/:name", func(c *gin.Context) { configuration(c, bindplane) })
 	router.DELETE("/configurations/:name", func(c *gin.Context) { deleteConfiguration(c, bindplane) })
 	router.POST("/configurations/:name/copy", func(c *gin.Context) { copyConfig(c, bindplane) })
+	router.GET("/configurations/:name/dependents", func(c *gin.Context) { resourceDependents(c, bindplane, model.KindConfiguration) })
+	router.GET("/configurations/:name/dependencies", func(c *gin.Context) { resourceDependencies(c, bindplane, model.KindConfiguration) })
 
 	router.GET("/sources", func(c *gin.Context) { sources(c, bindplane) })
 	router.GET("/sources/:name", func(c *gin.Context) { source(c, bindplane) })
 	router.DELETE("/sources/:name", func(c *gin.Context) { deleteSource(c, bindplane) })
+	router.GET("/sources/:name/dependents", func(c *gin.Context) { resourceDependents(c, bindplane, model.KindSource) })
+	router.GET("/sources/:name/dependencies", func(c *gin.Context) { resourceDependencies(c, bindplane, model.KindSource) })
 
 	router.GET("/source-types", func(c *gin.Context) { sourceTypes(c, bindplane) })
 	router.GET("/source-types/:name", func(c *gin.Context) { sourceType(c, bindplane) })
 	router.DELETE("/source-types/:name", func(c *gin.Context) { deleteSourceType(c, bindplane) })
+	router.GET("/source-types/:name/versions", func(c *gin.Context) { resourceTypeVersions(c, bindplane, model.KindSourceType) })
+	router.GET("/source-types/:name/dependents", func(c *gin.Context) { resourceDependents(c, bindplane, model.KindSourceType) })
+	router.GET("/source-types/:name/dependencies", func(c *gin.Context) { resourceDependencies(c, bindplane, model.KindSourceType) })
 
 	router.GET("/processors", func(c *gin.Context) { processors(c, bindplane) })
 	router.GET("/processors/:name", func(c *gin.Context) { processor(c, bindplane) })
 	router.DELETE("/processors/:name", func(c *gin.Context) { deleteProcessor(c, bindplane) })
+	router.GET("/processors/:name/dependents", func(c *gin.Context) { resourceDependents(c, bindplane, model.KindProcessor) })
+	router.GET("/processors/:name/dependencies", func(c *gin.Context) { resourceDependencies(c, bindplane, model.KindProcessor) })
 
 	router.GET("/processor-types", func(c *gin.Context) { processorTypes(c, bindplane) })
 	router.GET("/processor-types/:name", func(c *gin.Context) { processorType(c, bindplane) })
 	router.DELETE("/processor-types/:name", func(c *gin.Context) { deleteProcessorType(c, bindplane) })
+	router.GET("/processor-types/:name/versions", func(c *gin.Context) { resourceTypeVersions(c, bindplane, model.KindProcessorType) })
+	router.GET("/processor-types/:name/dependents", func(c *gin.Context) { resourceDependents(c, bindplane, model.KindProcessorType) })
+	router.GET("/processor-types/:name/dependencies", func(c *gin.Context) { resourceDependencies(c, bindplane, model.KindProcessorType) })
 
 	router.GET("/destinations", func(c *gin.Context) { destinations(c, bindplane) })
 	router.GET("/destinations/:name", func(c *gin.Context) { destination(c, bindplane) })
 	router.DELETE("/destinations/:name", func(c *gin.Context) { deleteDestination(c, bindplane) })
+	router.GET("/destinations/:name/dependents", func(c *gin.Context) { resourceDependents(c, bindplane, model.KindDestination) })
+	router.GET("/destinations/:name/dependencies", func(c *gin.Context) { resourceDependencies(c, bindplane, model.KindDestination) })
 
 	router.GET("/destination-types", func(c *gin.Context) { destinationTypes(c, bindplane) })
 	router.GET("/destination-types/:name", func(c *gin.Context) { destinationType(c, bindplane) })
 	router.DELETE("/destination-types/:name", func(c *gin.Context) { deleteDestinationType(c, bindplane) })
+	router.GET("/destination-types/:name/versions", func(c *gin.Context) { resourceTypeVersions(c, bindplane, model.KindDestinationType) })
+	router.GET("/destination-types/:name/dependents", func(c *gin.Context) { resourceDependents(c, bindplane, model.KindDestinationType) })
+	router.GET("/destination-types/:name/dependencies", func(c *gin.Context) { resourceDependencies(c, bindplane, model.KindDestinationType) })
+
+	router.GET("/connectors", func(c *gin.Context) { connectors(c, bindplane) })
+	router.GET("/connectors/:name", func(c *gin.Context) { connector(c, bindplane) })
+	router.DELETE("/connectors/:name", func(c *gin.Context) { deleteConnector(c, bindplane) })
+	router.GET("/connectors/:name/dependents", func(c *gin.Context) { resourceDependents(c, bindplane, model.KindConnector) })
+	router.GET("/connectors/:name/dependencies", func(c *gin.Context) { resourceDependencies(c, bindplane, model.KindConnector) })
+
+	router.GET("/connector-types", func(c *gin.Context) { connectorTypes(c, bindplane) })
+	router.GET("/connector-types/:name", func(c *gin.Context) { connectorType(c, bindplane) })
+	router.DELETE("/connector-types/:name", func(c *gin.Context) { deleteConnectorType(c, bindplane) })
+	router.GET("/connector-types/:name/versions", func(c *gin.Context) { resourceTypeVersions(c, bindplane, model.KindConnectorType) })
+	router.GET("/connector-types/:name/dependents", func(c *gin.Context) { resourceDependents(c, bindplane, model.KindConnectorType) })
+	router.GET("/connector-types/:name/dependencies", func(c *gin.Context) { resourceDependencies(c, bindplane, model.KindConnectorType) })
+
+	router.GET("/resource-types/outdated", func(c *gin.Context) { outdatedResources(c, bindplane) })
+	router.POST("/resource-types/migrate", func(c *gin.Context) { migrateResources(c, bindplane) })
+
+	router.GET("/catalog", func(c *gin.Context) { catalogResourceTypes(c, bindplane) })
+	router.GET("/catalog/diff", func(c *gin.Context) { catalogDiff(c, bindplane) })
+	router.POST("/catalog/sync", func(c *gin.Context) { syncCatalog(c, bindplane) })
+
+	router.POST("/sync", func(c *gin.Context) { syncResources(c, bindplane) })
+
+	router.GET("/backups", func(c *gin.Context) { backups(c, bindplane) })
+	router.POST("/backups", func(c *gin.Context) { createBackup(c, bindplane) })
+	router.POST("/store/migration", func(c *gin.Context) { startStoreMigration(c, bindplane) })
+	router.DELETE("/store/migration", func(c *gin.Context) { stopStoreMigration(c, bindplane) })
+
+	router.GET("/agent-groups", func(c *gin.Context) { agentGroups(c, bindplane) })
+	router.GET("/agent-groups/:name", func(c *gin.Context) { agentGroup(c, bindplane) })
+	router.DELETE("/agent-groups/:name", func(c *gin.Context) { deleteAgentGroup(c, bindplane) })
+	router.GET("/agent-groups/:name/agents", func(c *gin.Context) { agentGroupAgents(c, bindplane) })
+	router.GET("/agent-groups/:name/summary", func(c *gin.Context) { agentGroupSummary(c, bindplane) })
+	router.GET("/agent-groups/:name/dependents", func(c *gin.Context) { resourceDependents(c, bindplane, model.KindAgentGroup) })
+	router.GET("/agent-groups/:name/dependencies", func(c *gin.Context) { resourceDependencies(c, bindplane, model.KindAgentGroup) })
 
 	router.POST("/apply", func(c *gin.Context) { applyResources(c, bindplane) })
 	router.POST("/delete", func(c *gin.Context) { deleteResources(c, bindplane) })
 
+	router.GET("/events", func(c *gin.Context) { streamEvents(c, bindplane, events) })
+
 	router.GET("/version", func(c *gin.Context) { bindplaneVersion(c) })
 	router.GET("/agent-versions/:version/install-command", func(c *gin.Context) { getInstallCommand(c, bindplane) })
 }
 
 // @Summary List agents
+// @Description Use watch=true to stream the agents followed by changes to the agents as server-sent events.
+// @Description Agents that stop matching the selector or query are sent as removed.
 // @Produce json
+// @Produce text/event-stream
 // @Router /agents [get]
+// @Param	selector	query	string	false	"label selector to filter agents"
+// @Param	query	query	string	false	"search query to filter agents"
+// @Param	watch	query	bool	false	"stream changes as server-sent events containing model.AgentWatchResponse"
+// @Param	sort	query	string	false	"field to sort by: name, id, or version"
+// @Param	order	query	string	false	"asc or desc"
+// @Param	offset	query	int	false	"number of agents to skip"
+// @Param	limit	query	int	false	"maximum number of agents to return"
+// @Param	cursor	query	string	false	"nextCursor of the previous page"
+// @Param	fields	query	string	false	"comma separated fields to include for each agent, e.g. id,name,status"
 // @Success 200 {object} model.AgentsResponse
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func agents(c *gin.Context, bindplane server.BindPlane) {
 	ctx, span := tracer.Start(c.Request.Context(), "rest/agents")
 	defer span.End()
 
-	options := []store.QueryOption{}
-
-	selectorString := c.DefaultQuery("selector", "")
-	selector, err := model.SelectorFromString(selectorString)
+	opts, err := parseListOptions(c, store.AgentSortFields)
 	if err != nil {
 		handleErrorResponse(c, http.StatusBadRequest, err)
 		return
 	}
-	options = append(options, store.WithSelector(selector))
 
+	var parsedQuery *search.Query
 	query := c.DefaultQuery("query", "")
 	if query != "" {
-		q := search.ParseQuery(query)
-		q.ReplaceVersionLatest(bindplane.Versions())
-		options = append(options, store.WithQuery(q))
-	}
-
-	offset := c.DefaultQuery("offset", "0")
-	offsetValue, err := strconv.Atoi(offset)
-	if err != nil {
-		handleErrorResponse(c, http.StatusBadRequest, fmt.Errorf("offset must be a number: %v", err))
-		return
+		parsedQuery = search.ParseQuery(query)
+		parsedQuery.ReplaceVersionLatest(bindplane.Versions())
+		opts.options = append(opts.options, store.WithQuery(parsedQuery))
 	}
-	options = append(options, store.WithOffset(offsetValue))
 
-	limit := c.DefaultQuery("limit", "0")
-	limitValue, err := strconv.Atoi(limit)
-	if err != nil {
-		handleErrorResponse(c, http.StatusBadRequest, fmt.Errorf("limit must be a number: %v", err))
+	if isWatch(c) {
+		watchAgents(c, bindplane, opts.selector, parsedQuery)
 		return
 	}
-	options = append(options, store.WithLimit(limitValue))
-
-	sort := c.DefaultQuery("sort", "")
-	if sort != "" {
-		options = append(options, store.WithSort(sort))
-	}
 
-	agents, err := bindplane.Store().Agents(ctx, options...)
-	if err != nil {
-		handleErrorResponse(c, http.StatusInternalServerError, err)
+	agents, err := bindplane.Store().Agents(ctx, opts.options...)
+	if !okList(c, err) {
 		return
 	}
 
-	c.JSON(http.StatusOK, model.AgentsResponse{
-		Agents: agents,
+	agents, next := store.Page(agents, opts.limit, func(agent *model.Agent) string {
+		return store.AgentCursor(opts.sort, agent)
 	})
+	writeList(c, model.AgentsResponse{
+		Agents:     agents,
+		NextCursor: next,
+	}, "agents", opts.fields)
 }
 
 // @Summary delete agents by ids
@@ -249,6 +317,7 @@ func getAgentConfiguration(c *gin.Context, bindplane server.BindPlane) {
 // @Produce json
 // @Router /agents/labels [patch]
 // @Param ids 	body	[]string	true "agent IDs"
+// @Param group 	body	string	false "name of an agent group whose members will also be labeled"
 // @Param labels 	body	map[string]string	true "labels to apply"
 // @Param labels body boolean false "overwrite labels"
 // @Success 200 {object} model.BulkAgentLabelsResponse
@@ -268,11 +337,19 @@ func labelAgents(c *gin.Context, bindplane server.BindPlane) {
 		return
 	}
 
-	if p.IDs == nil {
-		handleErrorResponse(c, http.StatusBadRequest, fmt.Errorf(("body is missing the required ids field")))
+	if p.IDs == nil && p.Group == "" {
+		handleErrorResponse(c, http.StatusBadRequest, fmt.Errorf(("body is missing the required ids or group field")))
 		return
 	}
 
+	if p.Group != "" {
+		ids, err := agentGroupIDs(bindplane, p.IDs, p.Group)
+		if !okResponse(c, err) {
+			return
+		}
+		p.IDs = ids
+	}
+
 	newLabels, err := model.LabelsFromMap(p.Labels)
 	if err != nil {
 		handleErrorResponse(c, http.StatusBadRequest, err)
@@ -308,7 +385,7 @@ func labelAgents(c *gin.Context, bindplane server.BindPlane) {
 
 	bindplane.Logger().Info("bulkApplyAgentLabels", zap.String("payloadLabels", newLabels.String()), zap.Any("ids", p.IDs), zap.Error(err))
 
-	_, err = bindplane.Store().UpsertAgents(ctx, p.IDs, updater)
+	_, err = bindplane.Store().UpsertAgents(ctx, upsertIDs, updater)
 
 	if err != nil {
 		handleErrorResponse(c, http.StatusInternalServerError, err)
@@ -384,17 +461,282 @@ func patchAgentLabels(c *gin.Context, bindplane server.BindPlane) {
 	})
 }
 
-// @Summary TODO restart agent
+// @Summary Restart agent
 // @Produce json
 // @Router /agents/{id}/restart [put]
 // @Param 	id	path	string	true "the id of the agent"
+// @Success 202 {object} model.AgentCommandResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
 func restartAgent(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/restartAgent")
+	defer span.End()
+
+	id := c.Param("id")
+
+	command, err := bindplane.Manager().ExecuteAgentCommand(ctx, id, model.AgentCommandRestart)
+	if !okResponse(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, model.AgentCommandResponse{
+		Command: command,
+	})
+}
+
+// @Summary Send a command to agents by id, selector, or agent group
+// @Produce json
+// @Router /agents/commands [post]
+// @Param 	command	body	model.AgentCommandPayload	true "the command and the agents to receive it"
+// @Success 202 {object} model.AgentCommandsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func executeAgentCommand(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/executeAgentCommand")
+	defer span.End()
+
+	p := &model.AgentCommandPayload{}
+	if err := c.BindJSON(p); err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	commandType, err := model.ParseAgentCommandType(p.Type)
+	if err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(p.IDs) == 0 && p.Selector == "" && p.Group == "" {
+		handleErrorResponse(c, http.StatusBadRequest, fmt.Errorf("body must include ids, a selector, or a group"))
+		return
+	}
+
+	ids := p.IDs
+	if p.Selector != "" {
+		selector, err := model.SelectorFromString(p.Selector)
+		if err != nil {
+			handleErrorResponse(c, http.StatusBadRequest, err)
+			return
+		}
+		ids, err = agentIDsMatching(ctx, bindplane, ids, selector)
+		if err != nil {
+			handleErrorResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+	if p.Group != "" {
+		ids, err = agentGroupIDs(bindplane, ids, p.Group)
+		if !okResponse(c, err) {
+			return
+		}
+	}
+
+	response := model.AgentCommandsResponse{
+		Commands: []*model.AgentCommand{},
+	}
+	for _, id := range ids {
+		command, err := bindplane.Manager().ExecuteAgentCommand(ctx, id, commandType)
+		if err != nil {
+			response.Errors = append(response.Errors, fmt.Sprintf("failed to send %s to agent with id %s, %s", commandType, id, err.Error()))
+			continue
+		}
+		response.Commands = append(response.Commands, command)
+	}
+
+	c.JSON(http.StatusAccepted, response)
+}
+
+// @Summary Get the commands sent to an agent
+// @Produce json
+// @Router /agents/{id}/commands [get]
+// @Param 	id	path	string	true "the id of the agent"
+// @Success 200 {object} model.AgentCommandsResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func getAgentCommands(c *gin.Context, bindplane server.BindPlane) {
+	id := c.Param("id")
+
+	agent, err := bindplane.Store().Agent(id)
+	if !okResource(c, agent == nil, err) {
+		return
+	}
+
+	commands := agent.Commands
+	if commands == nil {
+		commands = []*model.AgentCommand{}
+	}
+	c.JSON(http.StatusOK, model.AgentCommandsResponse{
+		Commands: commands,
+	})
+}
+
+// @Summary Get configuration drift of agents
+// @Description Compares the effective configuration reported by each agent with the configuration it should be running.
+// @Description Agents that are in sync are only included when all=true.
+// @Produce json
+// @Router /agents/drift [get]
+// @Param 	selector	query	string	false "label selector used to filter agents"
+// @Param 	query		query	string	false "search query used to filter agents"
+// @Param 	all			query	bool	false "include agents that are in sync"
+// @Success 200 {object} model.AgentDriftResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func agentsDrift(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/agentsDrift")
+	defer span.End()
+
+	options, err := agentQueryOptions(c, bindplane)
+	if err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	all := c.DefaultQuery("all", "false") == "true"
+
+	reports, err := bindplane.Manager().AgentsDrift(ctx, options...)
+	if err != nil {
+		handleErrorResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := model.AgentDriftResponse{
+		Agents: []*model.AgentDriftReport{},
+	}
+	for _, report := range reports {
+		if all || report.Status != model.AgentDriftInSync {
+			response.Agents = append(response.Agents, report)
+		}
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary Get configuration changes deferred by the schedule of the configuration
+// @Produce json
+// @Router /agents/pending [get]
+// @Param 	selector	query	string	false "label selector used to filter agents"
+// @Param 	query		query	string	false "search query used to filter agents"
+// @Success 200 {object} model.AgentPendingChangesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func agentPendingChanges(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/agentPendingChanges")
+	defer span.End()
+
+	options, err := agentQueryOptions(c, bindplane)
+	if err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	changes, err := bindplane.Manager().AgentPendingChanges(ctx, options...)
+	if err != nil {
+		handleErrorResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.AgentPendingChangesResponse{
+		Changes: changes,
+	})
+}
+
+// @Summary Apply the pending change of an agent without waiting for the schedule of the configuration
+// @Produce json
+// @Router /agents/{id}/pending/apply [post]
+// @Param 	id	path	string	true "the id of the agent"
+// @Success 200 {object} model.AgentPendingChangeResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func applyAgentPendingChange(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/applyAgentPendingChange")
+	defer span.End()
+
+	change, err := bindplane.Manager().ApplyPendingChange(ctx, c.Param("id"))
+	if !okResponse(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, model.AgentPendingChangeResponse{
+		Change: change,
+	})
+}
+
+// agentQueryOptions returns the store options for the selector and query parameters used to filter agents
+func agentQueryOptions(c *gin.Context, bindplane server.BindPlane) ([]store.QueryOption, error) {
+	options := []store.QueryOption{}
+
+	selector, err := model.SelectorFromString(c.DefaultQuery("selector", ""))
+	if err != nil {
+		return nil, err
+	}
+	options = append(options, store.WithSelector(selector))
+
+	if query := c.DefaultQuery("query", ""); query != "" {
+		q := search.ParseQuery(query)
+		q.ReplaceVersionLatest(bindplane.Versions())
+		options = append(options, store.WithQuery(q))
+	}
+	return options, nil
+}
+
+// @Summary Request a diagnostics bundle from an agent
+// @Description Sends a collect-diagnostics command to the agent. The ID of the command is the ID of the bundle that
+// @Description will be available when the command succeeds.
+// @Produce json
+// @Router /agents/{id}/diagnostics [post]
+// @Param 	id	path	string	true "the id of the agent"
+// @Success 202 {object} model.AgentCommandResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func diagnoseAgent(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/diagnoseAgent")
+	defer span.End()
+
 	id := c.Param("id")
 
-	// TODO(andy): Do a restart
-	bindplane.Logger().Info("TODO Restart agent", zap.String("id", id))
+	command, err := bindplane.Manager().ExecuteAgentCommand(ctx, id, model.AgentCommandCollectDiagnostics)
+	if !okResponse(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusAccepted, model.AgentCommandResponse{
+		Command: command,
+	})
+}
+
+// @Summary List the diagnostics bundles collected from an agent
+// @Produce json
+// @Router /agents/{id}/diagnostics [get]
+// @Param 	id	path	string	true "the id of the agent"
+// @Success 200 {object} model.DiagnosticsBundlesResponse
+// @Failure 500 {object} ErrorResponse
+func getAgentDiagnostics(c *gin.Context, bindplane server.BindPlane) {
+	bundles, err := bindplane.Manager().Diagnostics().Bundles(c.Param("id"))
+	if !okResponse(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, model.DiagnosticsBundlesResponse{
+		Bundles: bundles,
+	})
+}
+
+// @Summary Download a diagnostics bundle collected from an agent
+// @Produce application/gzip
+// @Router /agents/{id}/diagnostics/{bundleId} [get]
+// @Param 	id			path	string	true "the id of the agent"
+// @Param 	bundleId	path	string	true "the id of the diagnostics bundle"
+// @Success 200 {file} application/gzip
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func getAgentDiagnosticsBundle(c *gin.Context, bindplane server.BindPlane) {
+	bundle, contents, err := bindplane.Manager().Diagnostics().Bundle(c.Param("id"), c.Param("bundleId"))
+	if !okResponse(c, err) {
+		return
+	}
 
-	c.Status(http.StatusAccepted)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", bundle.FileName()))
+	c.Data(http.StatusOK, "application/gzip", contents)
 }
 
 // @Summary TODO update agent
@@ -418,20 +760,53 @@ func updateAgent(c *gin.Context, bindplane server.BindPlane) {
 }
 
 // @Summary List Configurations
+// @Description Use watch=true to stream the configurations followed by changes to the configurations as server-sent events.
+// @Description Configurations that stop matching the selector or query are sent as removed.
 // @Produce json
+// @Produce text/event-stream
 // @Router /configurations [get]
+// @Param	selector	query	string	false	"label selector to filter configurations"
+// @Param	query	query	string	false	"search query to filter configurations"
+// @Param	watch	query	bool	false	"stream changes as server-sent events containing model.ConfigurationWatchResponse"
+// @Param	sort	query	string	false	"field to sort by: name, id, or description"
+// @Param	order	query	string	false	"asc or desc"
+// @Param	limit	query	int	false	"maximum number of configurations to return"
+// @Param	cursor	query	string	false	"nextCursor of the previous page"
+// @Param	fields	query	string	false	"comma separated fields to include for each configuration, e.g. metadata.name"
 // @Success 200 {object} model.ConfigurationsResponse
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func configurations(c *gin.Context, bindplane server.BindPlane) {
-	configs, err := bindplane.Store().Configurations()
+	opts, err := parseListOptions(c, store.ResourceSortFields)
 	if err != nil {
-		handleErrorResponse(c, http.StatusInternalServerError, err)
+		handleErrorResponse(c, http.StatusBadRequest, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, model.ConfigurationsResponse{
-		Configurations: configs,
+	var parsedQuery *search.Query
+	if query := c.DefaultQuery("query", ""); query != "" {
+		parsedQuery = search.ParseQuery(query)
+		parsedQuery.ReplaceVersionLatest(bindplane.Versions())
+		opts.options = append(opts.options, store.WithQuery(parsedQuery))
+	}
+
+	if isWatch(c) {
+		watchConfigurations(c, bindplane, opts.selector, parsedQuery)
+		return
+	}
+
+	configs, err := bindplane.Store().Configurations(opts.options...)
+	if !okList(c, err) {
+		return
+	}
+
+	configs, next := store.Page(configs, opts.limit, func(config *model.Configuration) string {
+		return store.ResourceCursor(opts.sort, config)
 	})
+	writeList(c, model.ConfigurationsResponse{
+		Configurations: configs,
+		NextCursor:     next,
+	}, "configurations", opts.fields)
 }
 
 // @Summary Get configuration by name
@@ -439,6 +814,7 @@ func configurations(c *gin.Context, bindplane server.BindPlane) {
 // @Router /configurations/{name} [get]
 // @Param 	name	path	string	true "the name of the configuration"
 // @Success 200 {object} model.ConfigurationResponse
+// @Header 200 {string} ETag "the resourceVersion of the resource"
 // @Failure 500 {object} ErrorResponse
 func configuration(c *gin.Context, bindplane server.BindPlane) {
 	ctx, span := tracer.Start(c.Request.Context(), "rest/configuration")
@@ -456,6 +832,7 @@ func configuration(c *gin.Context, bindplane server.BindPlane) {
 		return
 	}
 
+	setETag(c, config)
 	c.JSON(http.StatusOK, model.ConfigurationResponse{
 		Configuration: config,
 		Raw:           raw,
@@ -466,10 +843,16 @@ func configuration(c *gin.Context, bindplane server.BindPlane) {
 // @Produce json
 // @Router /configurations/{name} [delete]
 // @Param 	name	path	string	true "the name of the configuration to delete"
+// @Param	If-Match	header	string	false	"ETag of the resource, which must match to delete it"
 // @Success 204	"Successful Delete, no content"
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func deleteConfiguration(c *gin.Context, bindplane server.BindPlane) {
+	if deleteIfMatch(c, bindplane, model.KindConfiguration) {
+		return
+	}
+
 	name := c.Param("name")
 	configuration, err := bindplane.Store().DeleteConfiguration(name)
 	if okResource(c, configuration == nil, err) {
@@ -538,15 +921,22 @@ func copyConfig(c *gin.Context, bindplane server.BindPlane) {
 // @Summary List sources
 // @Produce json
 // @Router /sources [get]
+// @Param	selector	query	string	false	"label selector to filter sources"
+// @Param	sort	query	string	false	"field to sort by: name, id, or description"
+// @Param	order	query	string	false	"asc or desc"
+// @Param	limit	query	int	false	"maximum number of sources to return"
+// @Param	cursor	query	string	false	"nextCursor of the previous page"
+// @Param	fields	query	string	false	"comma separated fields to include for each source, e.g. metadata.name"
 // @Success 200 {object} model.SourcesResponse
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func sources(c *gin.Context, bindplane server.BindPlane) {
-	sources, err := bindplane.Store().Sources()
-	if okResponse(c, err) {
-		c.JSON(http.StatusOK, model.SourcesResponse{
-			Sources: sources,
-		})
-	}
+	listResources(c, bindplane.Store().Sources, "sources", func(sources []*model.Source, nextCursor string) any {
+		return model.SourcesResponse{
+			Sources:    sources,
+			NextCursor: nextCursor,
+		}
+	})
 }
 
 // @Summary Get source by name
@@ -554,12 +944,14 @@ func sources(c *gin.Context, bindplane server.BindPlane) {
 // @Router /sources/{name} [get]
 // @Param 	name	path	string	true "the name of the source"
 // @Success 200 {object} model.SourceResponse
+// @Header 200 {string} ETag "the resourceVersion of the resource"
 // @Failure 401 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func source(c *gin.Context, bindplane server.BindPlane) {
 	name := c.Param("name")
 	source, err := bindplane.Store().Source(name)
 	if okResource(c, source == nil, err) {
+		setETag(c, source)
 		c.JSON(http.StatusOK, model.SourceResponse{
 			Source: source,
 		})
@@ -570,10 +962,16 @@ func source(c *gin.Context, bindplane server.BindPlane) {
 // @Produce json
 // @Router /sources/{name} [delete]
 // @Param 	name	path	string	true "the name of the source to delete"
+// @Param	If-Match	header	string	false	"ETag of the resource, which must match to delete it"
 // @Success 204	"Successful Delete, no content"
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func deleteSource(c *gin.Context, bindplane server.BindPlane) {
+	if deleteIfMatch(c, bindplane, model.KindSource) {
+		return
+	}
+
 	name := c.Param("name")
 	source, err := bindplane.Store().DeleteSource(name)
 
@@ -587,15 +985,22 @@ func deleteSource(c *gin.Context, bindplane server.BindPlane) {
 // @Summary List source types
 // @Produce json
 // @Router /source-types [get]
+// @Param	selector	query	string	false	"label selector to filter source types"
+// @Param	sort	query	string	false	"field to sort by: name, id, or description"
+// @Param	order	query	string	false	"asc or desc"
+// @Param	limit	query	int	false	"maximum number of source types to return"
+// @Param	cursor	query	string	false	"nextCursor of the previous page"
+// @Param	fields	query	string	false	"comma separated fields to include for each source type, e.g. metadata.name"
 // @Success 200 {object} model.SourceTypesResponse
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func sourceTypes(c *gin.Context, bindplane server.BindPlane) {
-	sourceTypes, err := bindplane.Store().SourceTypes()
-	if okResponse(c, err) {
-		c.JSON(http.StatusOK, model.SourceTypesResponse{
+	listResources(c, bindplane.Store().SourceTypes, "sourceTypes", func(sourceTypes []*model.SourceType, nextCursor string) any {
+		return model.SourceTypesResponse{
 			SourceTypes: sourceTypes,
-		})
-	}
+			NextCursor:  nextCursor,
+		}
+	})
 }
 
 // @Summary Get source type by name
@@ -603,12 +1008,14 @@ func sourceTypes(c *gin.Context, bindplane server.BindPlane) {
 // @Router /source-types/{name} [get]
 // @Param 	name	path	string	true "the name of the source type"
 // @Success 200 {object} model.SourceTypeResponse
+// @Header 200 {string} ETag "the resourceVersion of the resource"
 // @Failure 401 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func sourceType(c *gin.Context, bindplane server.BindPlane) {
 	name := c.Param("name")
 	sourceType, err := bindplane.Store().SourceType(name)
 	if okResource(c, sourceType == nil, err) {
+		setETag(c, sourceType)
 		c.JSON(http.StatusOK, model.SourceTypeResponse{
 			SourceType: sourceType,
 		})
@@ -619,10 +1026,16 @@ func sourceType(c *gin.Context, bindplane server.BindPlane) {
 // @Produce json
 // @Router /source-types/{name} [delete]
 // @Param 	name	path	string	true "the name of the source type to delete"
+// @Param	If-Match	header	string	false	"ETag of the resource, which must match to delete it"
 // @Success 204	"Successful Delete, no content"
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func deleteSourceType(c *gin.Context, bindplane server.BindPlane) {
+	if deleteIfMatch(c, bindplane, model.KindSourceType) {
+		return
+	}
+
 	name := c.Param("name")
 	sourceType, err := bindplane.Store().DeleteSourceType(name)
 	if okResource(c, sourceType == nil, err) {
@@ -635,15 +1048,22 @@ func deleteSourceType(c *gin.Context, bindplane server.BindPlane) {
 // @Summary List processors
 // @Produce json
 // @Router /processors [get]
+// @Param	selector	query	string	false	"label selector to filter processors"
+// @Param	sort	query	string	false	"field to sort by: name, id, or description"
+// @Param	order	query	string	false	"asc or desc"
+// @Param	limit	query	int	false	"maximum number of processors to return"
+// @Param	cursor	query	string	false	"nextCursor of the previous page"
+// @Param	fields	query	string	false	"comma separated fields to include for each processor, e.g. metadata.name"
 // @Success 200 {object} model.ProcessorsResponse
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func processors(c *gin.Context, bindplane server.BindPlane) {
-	processors, err := bindplane.Store().Processors()
-	if okResponse(c, err) {
-		c.JSON(http.StatusOK, model.ProcessorsResponse{
+	listResources(c, bindplane.Store().Processors, "processors", func(processors []*model.Processor, nextCursor string) any {
+		return model.ProcessorsResponse{
 			Processors: processors,
-		})
-	}
+			NextCursor: nextCursor,
+		}
+	})
 }
 
 // @Summary Get processor by name
@@ -651,12 +1071,14 @@ func processors(c *gin.Context, bindplane server.BindPlane) {
 // @Router /processors/{name} [get]
 // @Param 	name	path	string	true "the name of the processor"
 // @Success 200 {object} model.ProcessorResponse
+// @Header 200 {string} ETag "the resourceVersion of the resource"
 // @Failure 401 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func processor(c *gin.Context, bindplane server.BindPlane) {
 	name := c.Param("name")
 	processor, err := bindplane.Store().Processor(name)
 	if okResource(c, processor == nil, err) {
+		setETag(c, processor)
 		c.JSON(http.StatusOK, model.ProcessorResponse{
 			Processor: processor,
 		})
@@ -667,10 +1089,16 @@ func processor(c *gin.Context, bindplane server.BindPlane) {
 // @Produce json
 // @Router /processors/{name} [delete]
 // @Param 	name	path	string	true "the name of the processor to delete"
+// @Param	If-Match	header	string	false	"ETag of the resource, which must match to delete it"
 // @Success 204	"Successful Delete, no content"
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func deleteProcessor(c *gin.Context, bindplane server.BindPlane) {
+	if deleteIfMatch(c, bindplane, model.KindProcessor) {
+		return
+	}
+
 	name := c.Param("name")
 	processor, err := bindplane.Store().DeleteProcessor(name)
 	if okResource(c, processor == nil, err) {
@@ -683,15 +1111,22 @@ func deleteProcessor(c *gin.Context, bindplane server.BindPlane) {
 // @Summary List processor types
 // @Produce json
 // @Router /processor-types [get]
+// @Param	selector	query	string	false	"label selector to filter processor types"
+// @Param	sort	query	string	false	"field to sort by: name, id, or description"
+// @Param	order	query	string	false	"asc or desc"
+// @Param	limit	query	int	false	"maximum number of processor types to return"
+// @Param	cursor	query	string	false	"nextCursor of the previous page"
+// @Param	fields	query	string	false	"comma separated fields to include for each processor type, e.g. metadata.name"
 // @Success 200 {object} model.ProcessorTypesResponse
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func processorTypes(c *gin.Context, bindplane server.BindPlane) {
-	processorTypes, err := bindplane.Store().ProcessorTypes()
-	if okResponse(c, err) {
-		c.JSON(http.StatusOK, model.ProcessorTypesResponse{
+	listResources(c, bindplane.Store().ProcessorTypes, "processorTypes", func(processorTypes []*model.ProcessorType, nextCursor string) any {
+		return model.ProcessorTypesResponse{
 			ProcessorTypes: processorTypes,
-		})
-	}
+			NextCursor:     nextCursor,
+		}
+	})
 }
 
 // @Summary Get processor type by name
@@ -699,12 +1134,14 @@ func processorTypes(c *gin.Context, bindplane server.BindPlane) {
 // @Router /processor-types/{name} [get]
 // @Param 	name	path	string	true "the name of the processor type"
 // @Success 200 {object} model.ProcessorTypeResponse
+// @Header 200 {string} ETag "the resourceVersion of the resource"
 // @Failure 401 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func processorType(c *gin.Context, bindplane server.BindPlane) {
 	name := c.Param("name")
 	processorType, err := bindplane.Store().ProcessorType(name)
 	if okResource(c, processorType == nil, err) {
+		setETag(c, processorType)
 		c.JSON(http.StatusOK, model.ProcessorTypeResponse{
 			ProcessorType: processorType,
 		})
@@ -715,10 +1152,16 @@ func processorType(c *gin.Context, bindplane server.BindPlane) {
 // @Produce json
 // @Router /processor-types/{name} [delete]
 // @Param 	name	path	string	true "the name of the processor type to delete"
+// @Param	If-Match	header	string	false	"ETag of the resource, which must match to delete it"
 // @Success 204	"Successful Delete, no content"
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func deleteProcessorType(c *gin.Context, bindplane server.BindPlane) {
+	if deleteIfMatch(c, bindplane, model.KindProcessorType) {
+		return
+	}
+
 	name := c.Param("name")
 	processorType, err := bindplane.Store().DeleteProcessorType(name)
 	if okResource(c, processorType == nil, err) {
@@ -731,15 +1174,22 @@ func deleteProcessorType(c *gin.Context, bindplane server.BindPlane) {
 // @Summary List destinations
 // @Produce json
 // @Router /destinations [get]
+// @Param	selector	query	string	false	"label selector to filter destinations"
+// @Param	sort	query	string	false	"field to sort by: name, id, or description"
+// @Param	order	query	string	false	"asc or desc"
+// @Param	limit	query	int	false	"maximum number of destinations to return"
+// @Param	cursor	query	string	false	"nextCursor of the previous page"
+// @Param	fields	query	string	false	"comma separated fields to include for each destination, e.g. metadata.name"
 // @Success 200 {object} model.DestinationsResponse
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func destinations(c *gin.Context, bindplane server.BindPlane) {
-	destinations, err := bindplane.Store().Destinations()
-	if okResponse(c, err) {
-		c.JSON(http.StatusOK, model.DestinationsResponse{
+	listResources(c, bindplane.Store().Destinations, "destinations", func(destinations []*model.Destination, nextCursor string) any {
+		return model.DestinationsResponse{
 			Destinations: destinations,
-		})
-	}
+			NextCursor:   nextCursor,
+		}
+	})
 }
 
 // @Summary Get destination by name
@@ -747,12 +1197,14 @@ func destinations(c *gin.Context, bindplane server.BindPlane) {
 // @Router /destinations/{name} [get]
 // @Param 	name	path	string	true "the name of the destination"
 // @Success 200 {object} model.DestinationResponse
+// @Header 200 {string} ETag "the resourceVersion of the resource"
 // @Failure 401 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func destination(c *gin.Context, bindplane server.BindPlane) {
 	name := c.Param("name")
 	destination, err := bindplane.Store().Destination(name)
 	if okResource(c, destination == nil, err) {
+		setETag(c, destination)
 		c.JSON(http.StatusOK, model.DestinationResponse{
 			Destination: destination,
 		})
@@ -763,10 +1215,16 @@ func destination(c *gin.Context, bindplane server.BindPlane) {
 // @Produce json
 // @Router /destinations/{name} [delete]
 // @Param 	name	path	string	true "the name of the destination to delete"
+// @Param	If-Match	header	string	false	"ETag of the resource, which must match to delete it"
 // @Success 204	"Successful Delete, no content"
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func deleteDestination(c *gin.Context, bindplane server.BindPlane) {
+	if deleteIfMatch(c, bindplane, model.KindDestination) {
+		return
+	}
+
 	name := c.Param("name")
 	destination, err := bindplane.Store().DeleteDestination(name)
 	if okResource(c, destination == nil, err) {
@@ -779,15 +1237,22 @@ func deleteDestination(c *gin.Context, bindplane server.BindPlane) {
 // @Summary List destination types
 // @Produce json
 // @Router /destination-types [get]
+// @Param	selector	query	string	false	"label selector to filter destination types"
+// @Param	sort	query	string	false	"field to sort by: name, id, or description"
+// @Param	order	query	string	false	"asc or desc"
+// @Param	limit	query	int	false	"maximum number of destination types to return"
+// @Param	cursor	query	string	false	"nextCursor of the previous page"
+// @Param	fields	query	string	false	"comma separated fields to include for each destination type, e.g. metadata.name"
 // @Success 200 {object} model.DestinationTypesResponse
+// @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func destinationTypes(c *gin.Context, bindplane server.BindPlane) {
-	destinationTypes, err := bindplane.Store().DestinationTypes()
-	if okResponse(c, err) {
-		c.JSON(http.StatusOK, model.DestinationTypesResponse{
+	listResources(c, bindplane.Store().DestinationTypes, "destinationTypes", func(destinationTypes []*model.DestinationType, nextCursor string) any {
+		return model.DestinationTypesResponse{
 			DestinationTypes: destinationTypes,
-		})
-	}
+			NextCursor:       nextCursor,
+		}
+	})
 }
 
 // @Summary Get destination type by name
@@ -795,12 +1260,14 @@ func destinationTypes(c *gin.Context, bindplane server.BindPlane) {
 // @Router /destination-types/{name} [get]
 // @Param 	name	path	string	true "the name of the destination type"
 // @Success 200 {object} model.DestinationTypeResponse
+// @Header 200 {string} ETag "the resourceVersion of the resource"
 // @Failure 401 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func destinationType(c *gin.Context, bindplane server.BindPlane) {
 	name := c.Param("name")
 	destinationType, err := bindplane.Store().DestinationType(name)
 	if okResource(c, destinationType == nil, err) {
+		setETag(c, destinationType)
 		c.JSON(http.StatusOK, model.DestinationTypeResponse{
 			DestinationType: destinationType,
 		})
@@ -811,10 +1278,16 @@ func destinationType(c *gin.Context, bindplane server.BindPlane) {
 // @Produce json
 // @Router /destination-types/{name} [delete]
 // @Param 	name	path	string	true "the name of the destination type to delete"
+// @Param	If-Match	header	string	false	"ETag of the resource, which must match to delete it"
 // @Success 204	"Successful Delete, no content"
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 func deleteDestinationType(c *gin.Context, bindplane server.BindPlane) {
+	if deleteIfMatch(c, bindplane, model.KindDestinationType) {
+		return
+	}
+
 	name := c.Param("name")
 	destinationType, err := bindplane.Store().DeleteDestinationType(name)
 	if okResource(c, destinationType == nil, err) {
@@ -824,15 +1297,610 @@ func deleteDestinationType(c *gin.Context, bindplane server.BindPlane) {
 
 // ----------------------------------------------------------------------
 
-// @Summary Create, edit, and configure multiple resources.
-// @Description The /apply route will try to parse resources
-// @Description and upsert them into the store.  Additionally
-// @Description it will send reconfigure tasks to affected agents.
+// @Summary List connectors
 // @Produce json
-// @Router /apply [post]
+// @Router /connectors [get]
+// @Param	selector	query	string	false	"label selector to filter connectors"
+// @Param	sort	query	string	false	"field to sort by: name, id, or description"
+// @Param	order	query	string	false	"asc or desc"
+// @Param	limit	query	int	false	"maximum number of connectors to return"
+// @Param	cursor	query	string	false	"nextCursor of the previous page"
+// @Param	fields	query	string	false	"comma separated fields to include for each connector, e.g. metadata.name"
+// @Success 200 {object} model.ConnectorsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func connectors(c *gin.Context, bindplane server.BindPlane) {
+	listResources(c, bindplane.Store().Connectors, "connectors", func(connectors []*model.Connector, nextCursor string) any {
+		return model.ConnectorsResponse{
+			Connectors: connectors,
+			NextCursor: nextCursor,
+		}
+	})
+}
+
+// @Summary Get connector by name
+// @Produce json
+// @Router /connectors/{name} [get]
+// @Param 	name	path	string	true "the name of the connector"
+// @Success 200 {object} model.ConnectorResponse
+// @Header 200 {string} ETag "the resourceVersion of the resource"
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func connector(c *gin.Context, bindplane server.BindPlane) {
+	name := c.Param("name")
+	connector, err := bindplane.Store().Connector(name)
+	if okResource(c, connector == nil, err) {
+		setETag(c, connector)
+		c.JSON(http.StatusOK, model.ConnectorResponse{
+			Connector: connector,
+		})
+	}
+}
+
+// @Summary Delete connector by name
+// @Produce json
+// @Router /connectors/{name} [delete]
+// @Param 	name	path	string	true "the name of the connector to delete"
+// @Param	If-Match	header	string	false	"ETag of the resource, which must match to delete it"
+// @Success 204	"Successful Delete, no content"
+// @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func deleteConnector(c *gin.Context, bindplane server.BindPlane) {
+	if deleteIfMatch(c, bindplane, model.KindConnector) {
+		return
+	}
+
+	name := c.Param("name")
+	connector, err := bindplane.Store().DeleteConnector(name)
+	if okResource(c, connector == nil, err) {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// ----------------------------------------------------------------------
+
+// @Summary List connector types
+// @Produce json
+// @Router /connector-types [get]
+// @Param	selector	query	string	false	"label selector to filter connector types"
+// @Param	sort	query	string	false	"field to sort by: name, id, or description"
+// @Param	order	query	string	false	"asc or desc"
+// @Param	limit	query	int	false	"maximum number of connector types to return"
+// @Param	cursor	query	string	false	"nextCursor of the previous page"
+// @Param	fields	query	string	false	"comma separated fields to include for each connector type, e.g. metadata.name"
+// @Success 200 {object} model.ConnectorTypesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func connectorTypes(c *gin.Context, bindplane server.BindPlane) {
+	listResources(c, bindplane.Store().ConnectorTypes, "connectorTypes", func(connectorTypes []*model.ConnectorType, nextCursor string) any {
+		return model.ConnectorTypesResponse{
+			ConnectorTypes: connectorTypes,
+			NextCursor:     nextCursor,
+		}
+	})
+}
+
+// @Summary Get connector type by name
+// @Produce json
+// @Router /connector-types/{name} [get]
+// @Param 	name	path	string	true "the name of the connector type"
+// @Success 200 {object} model.ConnectorTypeResponse
+// @Header 200 {string} ETag "the resourceVersion of the resource"
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func connectorType(c *gin.Context, bindplane server.BindPlane) {
+	name := c.Param("name")
+	connectorType, err := bindplane.Store().ConnectorType(name)
+	if okResource(c, connectorType == nil, err) {
+		setETag(c, connectorType)
+		c.JSON(http.StatusOK, model.ConnectorTypeResponse{
+			ConnectorType: connectorType,
+		})
+	}
+}
+
+// @Summary Delete connector type by name
+// @Produce json
+// @Router /connector-types/{name} [delete]
+// @Param 	name	path	string	true "the name of the connector type to delete"
+// @Param	If-Match	header	string	false	"ETag of the resource, which must match to delete it"
+// @Success 204	"Successful Delete, no content"
+// @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func deleteConnectorType(c *gin.Context, bindplane server.BindPlane) {
+	if deleteIfMatch(c, bindplane, model.KindConnectorType) {
+		return
+	}
+
+	name := c.Param("name")
+	connectorType, err := bindplane.Store().DeleteConnectorType(name)
+	if okResource(c, connectorType == nil, err) {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// @Summary List the versions of a resource type
+// @Description Previous versions of a resource type are kept when it is replaced by a different version so that
+// @Description resources pinned to a previous version continue to use it. Versions are sorted from earliest to latest.
+// @Produce json
+// @Router /source-types/{name}/versions [get]
+// @Router /processor-types/{name}/versions [get]
+// @Router /destination-types/{name}/versions [get]
+// @Router /connector-types/{name}/versions [get]
+// @Param 	name	path	string	true "the name of the resource type"
+// @Success 200 {object} model.ResourceTypeVersionsResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func resourceTypeVersions(c *gin.Context, bindplane server.BindPlane, kind model.Kind) {
+	versions, err := bindplane.Store().ResourceTypeVersions(kind, c.Param("name"))
+	if okResource(c, len(versions) == 0, err) {
+		c.JSON(http.StatusOK, model.ResourceTypeVersionsResponse{
+			ResourceTypes: versions,
+		})
+	}
+}
+
+// @Summary List the resources and agents affected by a change to a resource
+// @Description Dependents are the resources that use the resource directly or indirectly, e.g. the sources that use a
+// @Description source type and the configurations that use those sources. Agent IDs are the agents using the resource
+// @Description if it is a configuration or any of the dependent configurations.
+// @Produce json
+// @Router /configurations/{name}/dependents [get]
+// @Router /sources/{name}/dependents [get]
+// @Router /source-types/{name}/dependents [get]
+// @Router /processors/{name}/dependents [get]
+// @Router /processor-types/{name}/dependents [get]
+// @Router /destinations/{name}/dependents [get]
+// @Router /destination-types/{name}/dependents [get]
+// @Router /connectors/{name}/dependents [get]
+// @Router /connector-types/{name}/dependents [get]
+// @Router /agent-groups/{name}/dependents [get]
+// @Param 	name	path	string	true "the name of the resource"
+// @Success 200 {object} model.ResourceDependentsResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func resourceDependents(c *gin.Context, bindplane server.BindPlane, kind model.Kind) {
+	resource, err := store.CurrentResource(bindplane.Store(), kind, c.Param("name"))
+	if !okResource(c, resource == nil, err) {
+		return
+	}
+
+	dependents, err := store.ResourceDependents(bindplane.Store(), resource)
+	if okResponse(c, err) {
+		c.JSON(http.StatusOK, model.ResourceDependentsResponse{
+			Resources: dependents.Resources,
+			AgentIDs:  dependents.AgentIDs,
+		})
+	}
+}
+
+// @Summary List the resources used by a resource
+// @Description Dependencies are the resources that the resource uses directly or indirectly, e.g. the sources of a
+// @Description configuration and the source types of those sources.
+// @Produce json
+// @Router /configurations/{name}/dependencies [get]
+// @Router /sources/{name}/dependencies [get]
+// @Router /source-types/{name}/dependencies [get]
+// @Router /processors/{name}/dependencies [get]
+// @Router /processor-types/{name}/dependencies [get]
+// @Router /destinations/{name}/dependencies [get]
+// @Router /destination-types/{name}/dependencies [get]
+// @Router /connectors/{name}/dependencies [get]
+// @Router /connector-types/{name}/dependencies [get]
+// @Router /agent-groups/{name}/dependencies [get]
+// @Param 	name	path	string	true "the name of the resource"
+// @Success 200 {object} model.ResourceDependenciesResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func resourceDependencies(c *gin.Context, bindplane server.BindPlane, kind model.Kind) {
+	resource, err := store.CurrentResource(bindplane.Store(), kind, c.Param("name"))
+	if !okResource(c, resource == nil, err) {
+		return
+	}
+
+	dependencies, err := store.ResourceDependencies(bindplane.Store(), resource)
+	if okResponse(c, err) {
+		c.JSON(http.StatusOK, model.ResourceDependenciesResponse{
+			Resources: model.NewResourceReferences(dependencies),
+		})
+	}
+}
+
+// @Summary List resources pinned to outdated resource type versions
+// @Description Lists the sources, processors, destinations, connectors, and configurations that are pinned to a version
+// @Description of their resource type that is earlier than the current version.
+// @Produce json
+// @Router /resource-types/outdated [get]
+// @Success 200 {object} model.OutdatedResourcesResponse
+// @Failure 500 {object} ErrorResponse
+func outdatedResources(c *gin.Context, bindplane server.BindPlane) {
+	outdated, err := store.OutdatedResources(bindplane.Store())
+	if okResponse(c, err) {
+		c.JSON(http.StatusOK, model.OutdatedResourcesResponse{
+			Resources: outdated,
+		})
+	}
+}
+
+// @Summary Migrate resources to the current resource type versions
+// @Description Applies the migrations of resource types to the parameters of outdated resources and pins them to the
+// @Description current version. Resources that cannot be migrated are returned with the invalid status.
+// @Produce json
+// @Router /resource-types/migrate [post]
+// @Param 	payload	body	model.MigrateResourcesPayload	true "the kind and name of the resources to migrate"
+// @Success 202 {object} model.ApplyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func migrateResources(c *gin.Context, bindplane server.BindPlane) {
+	p := &model.MigrateResourcesPayload{}
+	if err := c.BindJSON(p); err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	kind := p.Kind
+	if kind != "" {
+		kind = model.ParseKind(string(kind))
+		switch kind {
+		case model.KindSource, model.KindProcessor, model.KindDestination, model.KindConnector, model.KindConfiguration:
+		default:
+			handleErrorResponse(c, http.StatusBadRequest, fmt.Errorf("%s resources cannot be migrated", p.Kind))
+			return
+		}
+	}
+
+	resourceStatuses, err := store.MigrateResources(bindplane.Store(), kind, p.Name)
+	if err != nil {
+		handleErrorResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, &model.ApplyResponse{
+		Updates: resourceStatuses,
+	})
+}
+
+// ----------------------------------------------------------------------
+
+// @Summary List the resource types in the resource type catalog
+// @Description Compares each resource type in the catalog with the resource type in the store.
+// @Produce json
+// @Router /catalog [get]
+// @Success 200 {object} model.CatalogResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func catalogResourceTypes(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/catalogResourceTypes")
+	defer span.End()
+
+	handleCatalog(c, bindplane, func(resourceTypeCatalog catalog.Catalog) ([]*model.CatalogResourceType, error) {
+		return resourceTypeCatalog.List(ctx)
+	})
+}
+
+// @Summary Compare the resource types in the resource type catalog with the store
+// @Description Compares each resource type in the catalog with the resource type in the store, including a diff for
+// @Description each resource type that differs from the store.
+// @Produce json
+// @Router /catalog/diff [get]
+// @Success 200 {object} model.CatalogResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func catalogDiff(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/catalogDiff")
+	defer span.End()
+
+	handleCatalog(c, bindplane, func(resourceTypeCatalog catalog.Catalog) ([]*model.CatalogResourceType, error) {
+		return resourceTypeCatalog.Diff(ctx)
+	})
+}
+
+// @Summary Sync the resource types in the resource type catalog
+// @Description Applies the new and updated resource types in the catalog to the store and returns the result for each
+// @Description resource type in the catalog.
+// @Produce json
+// @Router /catalog/sync [post]
+// @Success 200 {object} model.CatalogResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func syncCatalog(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/syncCatalog")
+	defer span.End()
+
+	handleCatalog(c, bindplane, func(resourceTypeCatalog catalog.Catalog) ([]*model.CatalogResourceType, error) {
+		return resourceTypeCatalog.Sync(ctx)
+	})
+}
+
+// handleCatalog responds with the resource types returned by the catalog operation or 404 if no catalog is configured
+func handleCatalog(c *gin.Context, bindplane server.BindPlane, operation func(catalog.Catalog) ([]*model.CatalogResourceType, error)) {
+	resourceTypeCatalog := bindplane.Manager().Catalog()
+	if resourceTypeCatalog == nil {
+		handleErrorResponse(c, http.StatusNotFound, catalog.ErrNotConfigured)
+		return
+	}
+
+	resourceTypes, err := operation(resourceTypeCatalog)
+	if okResponse(c, err) {
+		c.JSON(http.StatusOK, model.CatalogResponse{
+			ResourceTypes: resourceTypes,
+		})
+	}
+}
+
+// ----------------------------------------------------------------------
+
+// @Summary Sync resources
+// @Description Compares the resources with the store, creates and updates resources that differ, and labels them as
+// @Description managed by sync. With prune, resources managed by sync that are not included are deleted in reverse
+// @Description dependency order. Resources in the store modified since they were synced are reported as drift.
+// @Produce json
+// @Router /sync [post]
+// @Param payload	body	model.SyncPayload	true	"Resources to sync"
+// @Success 200 {object} model.SyncResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func syncResources(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/syncResources")
+	defer span.End()
+
+	p := &model.SyncPayload{}
+	if err := c.BindJSON(p); err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	options := resourcesync.Options{Prune: p.Prune, DryRun: p.DryRun}
+	resources, err := bindplane.Manager().Syncer().Sync(ctx, p.Resources, options)
+	if errors.Is(err, resourcesync.ErrDuplicateResource) {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+	if okResponse(c, err) {
+		c.JSON(http.StatusOK, model.SyncResponse{
+			Resources: resources,
+		})
+	}
+}
+
+// ----------------------------------------------------------------------
+
+// @Summary List backups of the store
+// @Description Lists the backups saved by the server, most recent first.
+// @Produce json
+// @Router /backups [get]
+// @Success 200 {object} model.BackupsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func backups(c *gin.Context, bindplane server.BindPlane) {
+	_, span := tracer.Start(c.Request.Context(), "rest/backups")
+	defer span.End()
+
+	storeBackups := bindplane.Manager().Backups()
+	if storeBackups == nil {
+		handleErrorResponse(c, http.StatusBadRequest, backup.ErrNotSupported)
+		return
+	}
+
+	list, err := storeBackups.List()
+	if okResponse(c, err) {
+		c.JSON(http.StatusOK, model.BackupsResponse{
+			Backups: list,
+		})
+	}
+}
+
+// @Summary Create a backup of the store
+// @Description Saves a consistent snapshot of the store while the server is running and removes the oldest backups
+// @Description beyond the retention.
+// @Produce json
+// @Router /backups [post]
+// @Success 201 {object} model.BackupResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func createBackup(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/createBackup")
+	defer span.End()
+
+	storeBackups := bindplane.Manager().Backups()
+	if storeBackups == nil {
+		handleErrorResponse(c, http.StatusBadRequest, backup.ErrNotSupported)
+		return
+	}
+
+	created, err := storeBackups.Create(ctx)
+	if okResponse(c, err) {
+		c.JSON(http.StatusCreated, model.BackupResponse{
+			Backup: created,
+		})
+	}
+}
+
+// @Summary Migrate the store to another store
+// @Description Copies all resources and agents from the store of the server to the target store while the server is
+// @Description running. The target uses the sessions secret of the server so that user sessions remain valid. With
+// @Description follow=true, changes are copied until the migration is stopped. Otherwise the stores are verified and
+// @Description the checks are included in the response.
+// @Produce json
+// @Router /store/migration [post]
+// @Param	migration	body	model.StoreMigrationRequest	true	"target store"
+// @Success 200 {object} model.StoreMigrationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func startStoreMigration(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/startStoreMigration")
+	defer span.End()
+
+	request := &model.StoreMigrationRequest{}
+	if err := c.BindJSON(request); err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	migration, err := bindplane.Manager().Migrations().Start(ctx, &common.Server{
+		StoreType:            request.StoreType,
+		StorageFilePath:      request.StorageFilePath,
+		GoogleCloudDatastore: request.GoogleCloudDatastore,
+		GoogleCloudPubSub:    request.GoogleCloudPubSub,
+	}, request.Follow)
+	switch {
+	case errors.Is(err, store.ErrMigrationRunning):
+		handleErrorResponse(c, http.StatusConflict, err)
+	case errors.Is(err, store.ErrInvalidMigrationTarget):
+		handleErrorResponse(c, http.StatusBadRequest, err)
+	case okResponse(c, err):
+		c.JSON(http.StatusOK, model.StoreMigrationResponse{
+			Migration: migration,
+		})
+	}
+}
+
+// @Summary Stop migrating the store
+// @Description Stops following changes, copies and verifies the stores one last time, and closes the target store so
+// @Description that a server can be started with it.
+// @Produce json
+// @Router /store/migration [delete]
+// @Success 200 {object} model.StoreMigrationResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func stopStoreMigration(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/stopStoreMigration")
+	defer span.End()
+
+	migration, err := bindplane.Manager().Migrations().Stop(ctx)
+	switch {
+	case errors.Is(err, store.ErrNoMigration):
+		handleErrorResponse(c, http.StatusNotFound, err)
+	case okResponse(c, err):
+		c.JSON(http.StatusOK, model.StoreMigrationResponse{
+			Migration: migration,
+		})
+	}
+}
+
+// ----------------------------------------------------------------------
+
+// @Summary List agent groups
+// @Produce json
+// @Router /agent-groups [get]
+// @Param	selector	query	string	false	"label selector to filter agent groups"
+// @Param	sort	query	string	false	"field to sort by: name, id, or description"
+// @Param	order	query	string	false	"asc or desc"
+// @Param	limit	query	int	false	"maximum number of agent groups to return"
+// @Param	cursor	query	string	false	"nextCursor of the previous page"
+// @Param	fields	query	string	false	"comma separated fields to include for each agent group, e.g. metadata.name"
+// @Success 200 {object} model.AgentGroupsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func agentGroups(c *gin.Context, bindplane server.BindPlane) {
+	listResources(c, bindplane.Store().AgentGroups, "agentGroups", func(agentGroups []*model.AgentGroup, nextCursor string) any {
+		return model.AgentGroupsResponse{
+			AgentGroups: agentGroups,
+			NextCursor:  nextCursor,
+		}
+	})
+}
+
+// @Summary Get agent group by name
+// @Produce json
+// @Router /agent-groups/{name} [get]
+// @Param 	name	path	string	true "the name of the agent group"
+// @Success 200 {object} model.AgentGroupResponse
+// @Header 200 {string} ETag "the resourceVersion of the resource"
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func agentGroup(c *gin.Context, bindplane server.BindPlane) {
+	name := c.Param("name")
+	agentGroup, err := bindplane.Store().AgentGroup(name)
+	if okResource(c, agentGroup == nil, err) {
+		setETag(c, agentGroup)
+		c.JSON(http.StatusOK, model.AgentGroupResponse{
+			AgentGroup: agentGroup,
+		})
+	}
+}
+
+// @Summary Delete agent group by name
+// @Produce json
+// @Router /agent-groups/{name} [delete]
+// @Param 	name	path	string	true "the name of the agent group to delete"
+// @Param	If-Match	header	string	false	"ETag of the resource, which must match to delete it"
+// @Success 204	"Successful Delete, no content"
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func deleteAgentGroup(c *gin.Context, bindplane server.BindPlane) {
+	if deleteIfMatch(c, bindplane, model.KindAgentGroup) {
+		return
+	}
+
+	name := c.Param("name")
+	agentGroup, err := bindplane.Store().DeleteAgentGroup(name)
+	if okResource(c, agentGroup == nil, err) {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// @Summary List the agents that are members of an agent group
+// @Produce json
+// @Router /agent-groups/{name}/agents [get]
+// @Param 	name	path	string	true "the name of the agent group"
+// @Success 200 {object} model.AgentsResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func agentGroupAgents(c *gin.Context, bindplane server.BindPlane) {
+	agentGroup, err := bindplane.Store().AgentGroup(c.Param("name"))
+	if !okResource(c, agentGroup == nil, err) {
+		return
+	}
+	agents, err := store.AgentGroupMembers(bindplane.Store(), agentGroup)
+	if okResponse(c, err) {
+		c.JSON(http.StatusOK, model.AgentsResponse{
+			Agents: agents,
+		})
+	}
+}
+
+// @Summary Get the number of agents in an agent group by status and version
+// @Produce json
+// @Router /agent-groups/{name}/summary [get]
+// @Param 	name	path	string	true "the name of the agent group"
+// @Success 200 {object} model.AgentGroupSummaryResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func agentGroupSummary(c *gin.Context, bindplane server.BindPlane) {
+	agentGroup, err := bindplane.Store().AgentGroup(c.Param("name"))
+	if !okResource(c, agentGroup == nil, err) {
+		return
+	}
+	agents, err := store.AgentGroupMembers(bindplane.Store(), agentGroup)
+	if okResponse(c, err) {
+		c.JSON(http.StatusOK, model.AgentGroupSummaryResponse{
+			Summary: model.NewAgentGroupSummary(agentGroup, agents),
+		})
+	}
+}
+
+// ----------------------------------------------------------------------
+
+// @Summary Create, edit, and configure multiple resources.
+// @Description The /apply route will try to parse resources
+// @Description and upsert them into the store.  Additionally
+// @Description it will send reconfigure tasks to affected agents.
+// @Description A resource with a resourceVersion is only applied if it matches the current
+// @Description resourceVersion, otherwise its status is conflict.
+// @Produce json
+// @Router /apply [post]
 // @Param resources 	body	[]model.AnyResource	true "Resources"
+// @Param	If-Match	header	string	false	"ETag of the resource when applying a single resource"
 // @Success 200 {object} model.ApplyResponse
+// @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} model.ApplyResponse
 // @Failure 500 {object} ErrorResponse
 func applyResources(c *gin.Context, bindplane server.BindPlane) {
 	p := &model.ApplyPayload{}
@@ -841,6 +1909,16 @@ func applyResources(c *gin.Context, bindplane server.BindPlane) {
 		return
 	}
 
+	resourceVersion, precondition, err := ifMatch(c)
+	if err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+	if precondition && len(p.Resources) != 1 {
+		handleErrorResponse(c, http.StatusBadRequest, errors.New("If-Match can only be used to apply a single resource"))
+		return
+	}
+
 	// parse the resources
 	resources := []model.Resource{}
 	for _, res := range p.Resources {
@@ -851,6 +1929,19 @@ func applyResources(c *gin.Context, bindplane server.BindPlane) {
 			return
 		}
 
+		if resourceVersion != 0 {
+			// unlike a resourceVersion in the resource, If-Match requires the resource to exist
+			current, err := store.CurrentResource(bindplane.Store(), parsed.GetKind(), parsed.Name())
+			if !okResponse(c, err) {
+				return
+			}
+			if current == nil {
+				handleErrorResponse(c, http.StatusPreconditionFailed, fmt.Errorf("%w: %s %s does not exist", errPreconditionFailed, parsed.GetKind(), parsed.Name()))
+				return
+			}
+			parsed.SetResourceVersion(resourceVersion)
+		}
+
 		resources = append(resources, parsed)
 	}
 
@@ -862,7 +1953,17 @@ func applyResources(c *gin.Context, bindplane server.BindPlane) {
 		return
 	}
 
-	c.JSON(http.StatusAccepted, &model.ApplyResponse{
+	status := http.StatusAccepted
+	if precondition && len(resourceStatuses) == 1 {
+		switch resourceStatuses[0].Status {
+		case model.StatusConflict:
+			status = http.StatusPreconditionFailed
+		case model.StatusCreated, model.StatusConfigured, model.StatusUnchanged:
+			setETag(c, resourceStatuses[0].Resource)
+		}
+	}
+
+	c.JSON(status, &model.ApplyResponse{
 		Updates: resourceStatuses,
 	})
 }
@@ -987,7 +2088,7 @@ func okResponse(c *gin.Context, err error) bool {
 	switch {
 	case err == nil:
 		return true
-	case errors.Is(err, store.ErrResourceMissing):
+	case errors.Is(err, store.ErrResourceMissing), errors.Is(err, diagnostics.ErrBundleNotFound), errors.Is(err, server.ErrNoPendingChange):
 		handleErrorResponse(c, http.StatusNotFound, err)
 	case isDependencyError(err):
 		handleErrorResponse(c, http.StatusConflict, err)
@@ -1010,6 +2111,40 @@ func okResource(c *gin.Context, resourceIsNil bool, err error) bool {
 	return true
 }
 
+// agentIDsMatching returns the specified ids along with the ids of any agents matching the selector, without
+// duplicates
+func agentIDsMatching(ctx context.Context, bindplane server.BindPlane, ids []string, selector model.Selector) ([]string, error) {
+	agents, err := bindplane.Store().Agents(ctx, store.WithSelector(selector))
+	if err != nil {
+		return nil, err
+	}
+	result := append([]string{}, ids...)
+	for _, agent := range agents {
+		if !slices.Contains(result, agent.ID) {
+			result = append(result, agent.ID)
+		}
+	}
+	return result, nil
+}
+
+// agentGroupIDs returns the specified ids along with the ids of the members of the agent group, without duplicates
+func agentGroupIDs(bindplane server.BindPlane, ids []string, name string) ([]string, error) {
+	group, err := bindplane.Store().AgentGroup(name)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, fmt.Errorf("%w: agent group %s", store.ErrResourceMissing, name)
+	}
+	result := append([]string{}, ids...)
+	for _, id := range group.AgentIDs(bindplane.Store().AgentIndex()) {
+		if !slices.Contains(result, id) {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
 func isDependencyError(err error) bool {
 	_, ok := err.(*store.DependencyError)
 	return ok
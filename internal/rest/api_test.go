@@ -16,12 +16,16 @@ package rest
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -36,6 +40,8 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/diagnostics"
+	"github.com/observiq/bindplane-op/internal/eventbus"
 	"github.com/observiq/bindplane-op/internal/server"
 	"github.com/observiq/bindplane-op/internal/store"
 	"github.com/observiq/bindplane-op/model"
@@ -189,7 +195,7 @@ func TestREST(t *testing.T) {
 
 	bindplane, err := server.NewBindPlane(&common.Server{}, zaptest.NewLogger(t), store, nil)
 	require.NoError(t, err)
-	AddRestRoutes(router, bindplane)
+	AddRestRoutes(ctx, router, bindplane)
 
 	client := resty.New()
 	client.SetBaseURL(svr.URL)
@@ -250,6 +256,10 @@ func TestREST(t *testing.T) {
 		_, err := s.ApplyResources([]model.Resource{destination1, destination2})
 		require.NoError(t, err)
 
+		// the store assigns resourceVersion 1 to created resources
+		destination1.SetResourceVersion(1)
+		destination2.SetResourceVersion(1)
+
 		getRequest(t, client, endpoint, rr)
 
 		require.Len(t, rr.Destinations, 2)
@@ -267,6 +277,10 @@ func TestREST(t *testing.T) {
 		_, err := s.ApplyResources([]model.Resource{destination1, destination2})
 		require.NoError(t, err)
 
+		// the store assigns resourceVersion 1 to created resources
+		destination1.SetResourceVersion(1)
+		destination2.SetResourceVersion(1)
+
 		rr := &model.DestinationResponse{}
 
 		getRequest(t, client, "/destinations/destination-2", rr)
@@ -302,6 +316,225 @@ func TestREST(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, resp.StatusCode())
 	})
 
+	t.Run("POST /agents/commands queues commands for agents by id and selector", func(t *testing.T) {
+		resetStore(t, s)
+		addAgent(s, &model.Agent{ID: "1", Labels: model.MakeLabels()})
+		addAgent(s, &model.Agent{ID: "2", Labels: model.LabelsFromValidatedMap(map[string]string{"env": "test"})})
+		addAgent(s, &model.Agent{ID: "3", Labels: model.MakeLabels()})
+
+		result := &model.AgentCommandsResponse{}
+		resp, err := client.R().SetBody(model.AgentCommandPayload{
+			Type:     string(model.AgentCommandRestart),
+			IDs:      []string{"1", "missing"},
+			Selector: "env=test",
+		}).SetResult(result).Post("/agents/commands")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, resp.StatusCode())
+
+		require.Len(t, result.Commands, 2)
+		require.Len(t, result.Errors, 1)
+		agentIDs := []string{}
+		for _, command := range result.Commands {
+			require.Equal(t, model.AgentCommandRestart, command.Type)
+			require.Equal(t, model.AgentCommandQueued, command.Status)
+			agentIDs = append(agentIDs, command.AgentID)
+		}
+		require.ElementsMatch(t, []string{"1", "2"}, agentIDs)
+
+		commands := &model.AgentCommandsResponse{}
+		getRequest(t, client, "/agents/2/commands", commands)
+		require.Len(t, commands.Commands, 1)
+		require.Equal(t, model.AgentCommandRestart, commands.Commands[0].Type)
+
+		commands = &model.AgentCommandsResponse{}
+		getRequest(t, client, "/agents/3/commands", commands)
+		require.Len(t, commands.Commands, 0)
+	})
+
+	t.Run("POST /agents/commands 400", func(t *testing.T) {
+		resetStore(t, s)
+
+		resp, err := client.R().SetBody(model.AgentCommandPayload{Type: "explode", IDs: []string{"1"}}).Post("/agents/commands")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+
+		resp, err = client.R().SetBody(model.AgentCommandPayload{Type: string(model.AgentCommandRestart)}).Post("/agents/commands")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	t.Run("PUT /agents/:id/restart", func(t *testing.T) {
+		resetStore(t, s)
+		addAgent(s, &model.Agent{ID: "1", Labels: model.MakeLabels()})
+
+		result := &model.AgentCommandResponse{}
+		resp, err := client.R().SetResult(result).Put("/agents/1/restart")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, resp.StatusCode())
+		require.Equal(t, model.AgentCommandRestart, result.Command.Type)
+
+		resp, err = client.R().Put("/agents/missing/restart")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+
+		resp, err = client.R().Get("/agents/missing/commands")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+
+	t.Run("/agents/:id/diagnostics", func(t *testing.T) {
+		resetStore(t, s)
+		addAgent(s, &model.Agent{ID: "1", Labels: model.MakeLabels()})
+
+		result := &model.AgentCommandResponse{}
+		resp, err := client.R().SetResult(result).Post("/agents/1/diagnostics")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, resp.StatusCode())
+		require.Equal(t, model.AgentCommandCollectDiagnostics, result.Command.Type)
+
+		resp, err = client.R().Post("/agents/missing/diagnostics")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+
+		bundle, contents, err := diagnostics.NewBundle(result.Command.ID, "1", map[string][]byte{"agent.yaml": []byte("id: 1")})
+		require.NoError(t, err)
+		require.NoError(t, bindplane.Manager().Diagnostics().Save(bundle, contents))
+
+		list := &model.DiagnosticsBundlesResponse{}
+		resp, err = client.R().SetResult(list).Get("/agents/1/diagnostics")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, list.Bundles, 1)
+		require.Equal(t, bundle.ID, list.Bundles[0].ID)
+
+		resp, err = client.R().Get("/agents/1/diagnostics/" + bundle.ID)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Equal(t, "application/gzip", resp.Header().Get("Content-Type"))
+		require.Equal(t, contents, resp.Body())
+
+		resp, err = client.R().Get("/agents/1/diagnostics/missing")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+
+	t.Run("GET /agents/drift", func(t *testing.T) {
+		resetStore(t, s)
+		addAgent(s, &model.Agent{ID: "1", Labels: model.MakeLabels()})
+
+		result := &model.AgentDriftResponse{}
+		resp, err := client.R().SetResult(result).Get("/agents/drift")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, result.Agents, 1)
+		require.Equal(t, "1", result.Agents[0].AgentID)
+		require.Equal(t, model.AgentDriftUnmanaged, result.Agents[0].Status)
+
+		resp, err = client.R().SetResult(result).Get("/agents/drift?selector=app=missing")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, result.Agents, 0)
+
+		resp, err = client.R().Get("/agents/drift?selector=bad=sel=ector")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	t.Run("/agents/pending", func(t *testing.T) {
+		resetStore(t, s)
+		addAgent(s, &model.Agent{ID: "1", Labels: model.MakeLabels()})
+		addAgent(s, &model.Agent{ID: "2", Labels: model.MakeLabels(), PendingChange: &model.AgentPendingChange{AgentID: "2", Configuration: "nightly"}})
+
+		result := &model.AgentPendingChangesResponse{}
+		resp, err := client.R().SetResult(result).Get("/agents/pending")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, result.Changes, 1)
+		require.Equal(t, "2", result.Changes[0].AgentID)
+
+		applied := &model.AgentPendingChangeResponse{}
+		resp, err = client.R().SetResult(applied).Post("/agents/2/pending/apply")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.True(t, applied.Change.Forced)
+
+		resp, err = client.R().Post("/agents/1/pending/apply")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+
+	t.Run("/agent-groups", func(t *testing.T) {
+		resetStore(t, s)
+		addAgent(s, &model.Agent{ID: "g1", Version: "1.0.0", Status: model.Connected, Labels: model.LabelsFromValidatedMap(map[string]string{"fleet": "edge"})})
+		addAgent(s, &model.Agent{ID: "g2", Version: "1.1.0", Status: model.Disconnected, Labels: model.LabelsFromValidatedMap(map[string]string{"fleet": "edge"})})
+		addAgent(s, &model.Agent{ID: "g3", Version: "1.1.0", Labels: model.LabelsFromValidatedMap(map[string]string{"fleet": "core"})})
+
+		group := model.NewAgentGroup("edge", model.MatchLabels{"fleet": "edge"}, "")
+		configuration := testRawConfiguration("", "edge-config")
+		configuration.Spec.AgentGroup = group.Name()
+		_, err := s.ApplyResources([]model.Resource{group, configuration})
+		require.NoError(t, err)
+
+		groups := &model.AgentGroupsResponse{}
+		getRequest(t, client, "/agent-groups", groups)
+		require.Len(t, groups.AgentGroups, 1)
+
+		result := &model.AgentGroupResponse{}
+		getRequest(t, client, "/agent-groups/edge", result)
+		require.Equal(t, group.Spec, result.AgentGroup.Spec)
+
+		agents := &model.AgentsResponse{}
+		getRequest(t, client, "/agent-groups/edge/agents", agents)
+		require.Len(t, agents.Agents, 2)
+
+		summary := &model.AgentGroupSummaryResponse{}
+		getRequest(t, client, "/agent-groups/edge/summary", summary)
+		require.Equal(t, 2, summary.Summary.Agents)
+		require.Equal(t, 1, summary.Summary.Count("Connected"))
+		require.Equal(t, 1, summary.Summary.Count("Disconnected"))
+		require.Equal(t, []*model.AgentGroupCount{{Value: "1.0.0", Count: 1}, {Value: "1.1.0", Count: 1}}, summary.Summary.Versions)
+
+		commands := &model.AgentCommandsResponse{}
+		resp, err := client.R().SetBody(model.AgentCommandPayload{
+			Type:  string(model.AgentCommandRestart),
+			Group: group.Name(),
+		}).SetResult(commands).Post("/agents/commands")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, resp.StatusCode())
+		require.Len(t, commands.Commands, 2)
+
+		resp, err = client.R().SetBody(model.BulkAgentLabelsPayload{
+			Group:  group.Name(),
+			Labels: map[string]string{"tier": "1"},
+		}).Patch("/agents/labels")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		labeled, err := s.Agent("g2")
+		require.NoError(t, err)
+		require.Equal(t, "1", labeled.Labels.Set["tier"])
+
+		resp, err = client.R().SetBody(model.AgentCommandPayload{
+			Type:  string(model.AgentCommandRestart),
+			Group: "missing",
+		}).Post("/agents/commands")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+
+		resp, err = client.R().Get("/agent-groups/missing/summary")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+
+		resp, err = client.R().Delete("/agent-groups/edge")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusConflict, resp.StatusCode())
+
+		_, err = s.DeleteConfiguration(configuration.Name())
+		require.NoError(t, err)
+		resp, err = client.R().Delete("/agent-groups/edge")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode())
+	})
+
 	t.Run("DELETE /destinations/:name 404 Not Found", func(t *testing.T) {
 		resetStore(t, s)
 
@@ -385,6 +618,10 @@ func TestREST(t *testing.T) {
 		_, err := s.ApplyResources([]model.Resource{source1, source2})
 		require.NoError(t, err)
 
+		// the store assigns resourceVersion 1 to created resources
+		source1.SetResourceVersion(1)
+		source2.SetResourceVersion(1)
+
 		getRequest(t, client, endpoint, rr)
 
 		require.Len(t, rr.Sources, 2)
@@ -408,6 +645,10 @@ func TestREST(t *testing.T) {
 			source2,
 		})
 		require.NoError(t, err)
+
+		// the store assigns resourceVersion 1 to created resources
+		source1.SetResourceVersion(1)
+		source2.SetResourceVersion(1)
 		rr := &model.SourceResponse{}
 
 		getRequest(t, client, "/sources/source-2", rr)
@@ -583,6 +824,10 @@ func TestREST(t *testing.T) {
 		})
 		require.NoError(t, err)
 
+		// the store assigns resourceVersion 1 to created resources
+		testConfiguration1.SetResourceVersion(1)
+		testConfiguration2.SetResourceVersion(1)
+
 		getRequest(t, client, endpoint, rr)
 
 		require.Len(t, rr.Configurations, 2)
@@ -600,6 +845,10 @@ func TestREST(t *testing.T) {
 			testConfiguration2,
 		})
 		require.NoError(t, err)
+
+		// the store assigns resourceVersion 1 to created resources
+		testConfiguration1.SetResourceVersion(1)
+		testConfiguration2.SetResourceVersion(1)
 		pr := &model.ConfigurationResponse{}
 
 		getRequest(t, client, "/configurations/test-configuration-2", pr)
@@ -1622,6 +1871,9 @@ func TestRESTMock(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(strings.Join([]string{test.method, test.endpoint, fmt.Sprint(test.expectStatus)}, " "), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
 			router := gin.Default()
 			svr := httptest.NewServer(router)
 			defer svr.Close()
@@ -1629,7 +1881,7 @@ func TestRESTMock(t *testing.T) {
 			store := &mockStore{}
 			bindplane, err := server.NewBindPlane(&common.Server{}, zaptest.NewLogger(t), store, nil)
 			require.NoError(t, err)
-			AddRestRoutes(router, bindplane)
+			AddRestRoutes(ctx, router, bindplane)
 
 			client := resty.New()
 			client.SetBaseURL(svr.URL)
@@ -1670,6 +1922,10 @@ type mockStore struct {
 	mock.Mock
 }
 
+func (m *mockStore) Updates() eventbus.Source[*store.Updates] {
+	return eventbus.NewSource[*store.Updates]()
+}
+
 func (m *mockStore) ApplyResources(resources []model.Resource) ([]model.ResourceStatus, error) {
 	args := m.Called(resources)
 	return args.Get(0).([]model.ResourceStatus), args.Error(1)
@@ -1680,7 +1936,7 @@ func (m *mockStore) DeleteResources(resources []model.Resource) ([]model.Resourc
 	return args.Get(0).([]model.ResourceStatus), args.Error(1)
 }
 
-func (m *mockStore) Sources() ([]*model.Source, error) {
+func (m *mockStore) Sources(options ...store.QueryOption) ([]*model.Source, error) {
 	args := m.Called()
 	return args.Get(0).([]*model.Source), args.Error(1)
 }
@@ -1720,7 +1976,7 @@ func (m *mockStore) Agent(id string) (*model.Agent, error) {
 	}
 }
 
-func (m *mockStore) Destinations() ([]*model.Destination, error) {
+func (m *mockStore) Destinations(options ...store.QueryOption) ([]*model.Destination, error) {
 	args := m.Called()
 	return args.Get(0).([]*model.Destination), args.Error(1)
 }
@@ -1769,3 +2025,254 @@ func (m *mockStore) DeleteConfiguration(name string) (*model.Configuration, erro
 		return args.Get(0).(*model.Configuration), args.Error(1)
 	}
 }
+
+func TestRESTCatalog(t *testing.T) {
+	catalogDir := t.TempDir()
+	sourceType := []byte("apiVersion: bindplane.observiq.com/v1\nkind: SourceType\nmetadata:\n  name: catalog-source\nspec:\n  version: 1.0.0\n")
+	sum := sha256.Sum256(sourceType)
+	index := fmt.Sprintf("resourceTypes:\n  - kind: SourceType\n    name: catalog-source\n    path: catalog-source.yaml\n    sha256: %s\n", hex.EncodeToString(sum[:]))
+	require.NoError(t, os.WriteFile(filepath.Join(catalogDir, "catalog-source.yaml"), sourceType, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(catalogDir, "index.yaml"), []byte(index), 0600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tests := []struct {
+		name    string
+		config  *common.Server
+		request func(*resty.Request, string) (*resty.Response, error)
+		path    string
+		status  int
+		expect  model.CatalogStatus
+	}{
+		{
+			name:   "GET /catalog without catalog",
+			config: &common.Server{},
+			path:   "/catalog",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "GET /catalog",
+			config: &common.Server{ResourceTypeCatalog: catalogDir},
+			path:   "/catalog",
+			status: http.StatusOK,
+			expect: model.CatalogStatusNew,
+		},
+		{
+			name:   "GET /catalog/diff",
+			config: &common.Server{ResourceTypeCatalog: catalogDir},
+			path:   "/catalog/diff",
+			status: http.StatusOK,
+			expect: model.CatalogStatusNew,
+		},
+		{
+			name:    "POST /catalog/sync",
+			config:  &common.Server{ResourceTypeCatalog: catalogDir},
+			request: (*resty.Request).Post,
+			path:    "/catalog/sync",
+			status:  http.StatusOK,
+			expect:  model.CatalogStatusCreated,
+		},
+		{
+			name:    "POST /catalog/sync with missing catalog",
+			config:  &common.Server{ResourceTypeCatalog: filepath.Join(catalogDir, "missing")},
+			request: (*resty.Request).Post,
+			path:    "/catalog/sync",
+			status:  http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			router := gin.Default()
+			svr := httptest.NewServer(router)
+			defer svr.Close()
+
+			s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key", MaxEventsToMerge: 1}, zap.NewNop())
+			bindplane, err := server.NewBindPlane(test.config, zaptest.NewLogger(t), s, nil)
+			require.NoError(t, err)
+			AddRestRoutes(ctx, router, bindplane)
+
+			request := test.request
+			if request == nil {
+				request = (*resty.Request).Get
+			}
+			result := &model.CatalogResponse{}
+			resp, err := request(resty.New().SetBaseURL(svr.URL).R().SetResult(result), test.path)
+			require.NoError(t, err)
+			require.Equal(t, test.status, resp.StatusCode())
+			if test.expect != "" {
+				require.Len(t, result.ResourceTypes, 1)
+				require.Equal(t, test.expect, result.ResourceTypes[0].Status)
+			}
+		})
+	}
+}
+
+func TestRESTBackups(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	options := store.Options{SessionsSecret: "super-secret-key", MaxEventsToMerge: 1}
+
+	t.Run("mapstore does not support backups", func(t *testing.T) {
+		router := gin.Default()
+		svr := httptest.NewServer(router)
+		defer svr.Close()
+
+		s := store.NewMapStore(ctx, options, zap.NewNop())
+		bindplane, err := server.NewBindPlane(&common.Server{}, zaptest.NewLogger(t), s, nil)
+		require.NoError(t, err)
+		AddRestRoutes(ctx, router, bindplane)
+
+		resp, err := resty.New().SetBaseURL(svr.URL).R().Post("/backups")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+	})
+
+	t.Run("create and list backups", func(t *testing.T) {
+		router := gin.Default()
+		svr := httptest.NewServer(router)
+		defer svr.Close()
+
+		dir := t.TempDir()
+		db, err := store.InitDB(filepath.Join(dir, "storage"))
+		require.NoError(t, err)
+		defer db.Close()
+
+		s := store.NewBoltStore(ctx, db, options, zap.NewNop())
+		config := &common.Server{BackupsFolderPath: filepath.Join(dir, "backups")}
+		bindplane, err := server.NewBindPlane(config, zaptest.NewLogger(t), s, nil)
+		require.NoError(t, err)
+		AddRestRoutes(ctx, router, bindplane)
+
+		created := &model.BackupResponse{}
+		resp, err := resty.New().SetBaseURL(svr.URL).R().SetResult(created).Post("/backups")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, resp.StatusCode())
+		require.NotNil(t, created.Backup)
+		require.FileExists(t, filepath.Join(dir, "backups", created.Backup.Name+".db"))
+
+		list := &model.BackupsResponse{}
+		resp, err = resty.New().SetBaseURL(svr.URL).R().SetResult(list).Get("/backups")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Len(t, list.Backups, 1)
+		require.Equal(t, created.Backup.Name, list.Backups[0].Name)
+	})
+}
+
+func TestRESTSync(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	router := gin.Default()
+	svr := httptest.NewServer(router)
+	defer svr.Close()
+
+	s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key", MaxEventsToMerge: 1}, zap.NewNop())
+	bindplane, err := server.NewBindPlane(&common.Server{}, zaptest.NewLogger(t), s, nil)
+	require.NoError(t, err)
+	AddRestRoutes(ctx, router, bindplane)
+
+	sourceType := &model.AnyResource{
+		ResourceMeta: model.ResourceMeta{APIVersion: model.V1Alpha, Kind: model.KindSourceType, Metadata: model.Metadata{Name: "hostmetrics"}},
+		Spec:         map[string]any{},
+	}
+
+	tests := []struct {
+		name         string
+		payload      model.SyncPayload
+		status       int
+		expectStatus model.SyncStatus
+	}{
+		{
+			name:         "dry run",
+			payload:      model.SyncPayload{Resources: []*model.AnyResource{sourceType}, DryRun: true},
+			status:       http.StatusOK,
+			expectStatus: model.SyncStatusCreate,
+		},
+		{
+			name:         "sync",
+			payload:      model.SyncPayload{Resources: []*model.AnyResource{sourceType}, Prune: true},
+			status:       http.StatusOK,
+			expectStatus: model.SyncStatusCreated,
+		},
+		{
+			name:    "duplicate resources",
+			payload: model.SyncPayload{Resources: []*model.AnyResource{sourceType, sourceType}},
+			status:  http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := &model.SyncResponse{}
+			resp, err := resty.New().SetBaseURL(svr.URL).R().SetBody(test.payload).SetResult(result).Post("/sync")
+			require.NoError(t, err)
+			require.Equal(t, test.status, resp.StatusCode())
+			if test.expectStatus != "" {
+				require.Len(t, result.Resources, 1)
+				require.Equal(t, test.expectStatus, result.Resources[0].Status)
+			}
+		})
+	}
+
+	stored, err := s.SourceType("hostmetrics")
+	require.NoError(t, err)
+	require.True(t, model.IsManagedBySync(stored))
+}
+
+func TestRESTDependents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	router := gin.Default()
+	svr := httptest.NewServer(router)
+	defer svr.Close()
+
+	s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key", MaxEventsToMerge: 1}, zap.NewNop())
+	bindplane, err := server.NewBindPlane(&common.Server{}, zaptest.NewLogger(t), s, nil)
+	require.NoError(t, err)
+	AddRestRoutes(ctx, router, bindplane)
+
+	_, err = s.ApplyResources([]model.Resource{
+		model.NewSourceType("macos", nil),
+		model.NewSource("macos-1", "macos", nil),
+		model.NewConfigurationWithSpec("configuration-1", model.ConfigurationSpec{
+			Sources: []model.ResourceConfiguration{{Name: "macos-1"}},
+		}),
+	})
+	require.NoError(t, err)
+	_, err = s.UpsertAgent(ctx, "agent-1", func(agent *model.Agent) {})
+	require.NoError(t, err)
+
+	t.Run("dependents", func(t *testing.T) {
+		result := &model.ResourceDependentsResponse{}
+		resp, err := resty.New().SetBaseURL(svr.URL).R().SetResult(result).Get("/source-types/macos/dependents")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Equal(t, []*model.ResourceReference{
+			{Kind: model.KindSource, Name: "macos-1"},
+			{Kind: model.KindConfiguration, Name: "configuration-1"},
+		}, result.Resources)
+		require.Equal(t, []string{"agent-1"}, result.AgentIDs)
+	})
+
+	t.Run("dependencies", func(t *testing.T) {
+		result := &model.ResourceDependenciesResponse{}
+		resp, err := resty.New().SetBaseURL(svr.URL).R().SetResult(result).Get("/configurations/configuration-1/dependencies")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+		require.Equal(t, []*model.ResourceReference{
+			{Kind: model.KindSourceType, Name: "macos"},
+			{Kind: model.KindSource, Name: "macos-1"},
+		}, result.Resources)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		resp, err := resty.New().SetBaseURL(svr.URL).R().Get("/sources/missing/dependents")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode())
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// errPreconditionFailed is returned when the resourceVersion in the If-Match header does not match the resource
+var errPreconditionFailed = errors.New("precondition failed")
+
+// setETag sets the ETag header to the quoted resourceVersion of the resource
+func setETag(c *gin.Context, resource model.Resource) {
+	c.Header("ETag", strconv.Quote(strconv.FormatInt(resource.ResourceVersion(), 10)))
+}
+
+// ifMatch returns the resourceVersion in the If-Match header and true if the header is specified. An If-Match of * is
+// specified but returns a resourceVersion of 0 which matches any version of the resource.
+func ifMatch(c *gin.Context) (int64, bool, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	switch header {
+	case "":
+		return 0, false, nil
+	case "*":
+		return 0, true, nil
+	}
+	unquoted, err := strconv.Unquote(header)
+	if err != nil {
+		return 0, true, fmt.Errorf("If-Match must be a single quoted ETag, got %s", header)
+	}
+	resourceVersion, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || resourceVersion <= 0 {
+		return 0, true, fmt.Errorf("If-Match must be the ETag of a resource, got %s", header)
+	}
+	return resourceVersion, true, nil
+}
+
+// deleteIfMatch deletes the resource with the specified kind and the name in the path if the resourceVersion in the
+// If-Match header matches the resource. It returns false without writing a response if there is no If-Match header.
+func deleteIfMatch(c *gin.Context, bindplane server.BindPlane, kind model.Kind) bool {
+	resourceVersion, ok, err := ifMatch(c)
+	if !ok {
+		return false
+	}
+	if err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return true
+	}
+
+	resource, err := model.ParseResource(&model.AnyResource{
+		ResourceMeta: model.ResourceMeta{
+			Kind: kind,
+			Metadata: model.Metadata{
+				Name:            c.Param("name"),
+				ResourceVersion: resourceVersion,
+			},
+		},
+	})
+	if err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return true
+	}
+
+	statuses, err := bindplane.Store().DeleteResources([]model.Resource{resource})
+	if !okResponse(c, err) {
+		return true
+	}
+	if len(statuses) == 0 {
+		handleErrorResponse(c, http.StatusNotFound, store.ErrResourceMissing)
+		return true
+	}
+
+	switch status := statuses[0]; status.Status {
+	case model.StatusDeleted:
+		c.Status(http.StatusNoContent)
+	case model.StatusConflict:
+		handleErrorResponse(c, http.StatusPreconditionFailed, fmt.Errorf("%w: %s", errPreconditionFailed, status.Reason))
+	case model.StatusInUse:
+		handleErrorResponse(c, http.StatusConflict, errors.New(status.Reason))
+	default:
+		handleErrorResponse(c, http.StatusInternalServerError, errors.New(status.Reason))
+	}
+	return true
+}
@@ -0,0 +1,146 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestETags(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := store.NewMapStore(ctx, store.Options{
+		SessionsSecret:   "super-secret-key",
+		MaxEventsToMerge: 1,
+	}, zap.NewNop())
+	bindplane, err := server.NewBindPlane(&common.Server{}, zap.NewNop(), s, nil)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	AddRestRoutes(ctx, router, bindplane)
+	svr := httptest.NewServer(router)
+	defer svr.Close()
+
+	_, err = s.ApplyResources([]model.Resource{testRawConfiguration("", "config")})
+	require.NoError(t, err)
+
+	do := func(t *testing.T, method, path, ifMatch string, body any) *http.Response {
+		var reader *bytes.Reader
+		if body != nil {
+			data, err := json.Marshal(body)
+			require.NoError(t, err)
+			reader = bytes.NewReader(data)
+		} else {
+			reader = bytes.NewReader(nil)
+		}
+		req, err := http.NewRequest(method, svr.URL+path, reader)
+		require.NoError(t, err)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+	apply := func(t *testing.T, ifMatch string, raw string) (*http.Response, *model.ApplyResponseClientSide) {
+		configuration := testRawConfiguration("", "config")
+		configuration.Spec.Raw = raw
+		var resource model.AnyResource
+		data, err := json.Marshal(configuration)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &resource))
+
+		resp := do(t, http.MethodPost, "/apply", ifMatch, model.ApplyPayload{Resources: []*model.AnyResource{&resource}})
+		var response model.ApplyResponseClientSide
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+		return resp, &response
+	}
+
+	t.Run("get returns the resourceVersion as the ETag", func(t *testing.T) {
+		resp := do(t, http.MethodGet, "/configurations/config", "", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, `"1"`, resp.Header.Get("ETag"))
+	})
+
+	t.Run("apply with a matching If-Match", func(t *testing.T) {
+		resp, response := apply(t, `"1"`, "raw: 2")
+		require.Equal(t, http.StatusAccepted, resp.StatusCode)
+		require.Equal(t, `"2"`, resp.Header.Get("ETag"))
+		require.Equal(t, model.StatusConfigured, response.Updates[0].Status)
+	})
+
+	t.Run("apply with a stale If-Match", func(t *testing.T) {
+		resp, response := apply(t, `"1"`, "raw: 3")
+		require.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+		require.Equal(t, model.StatusConflict, response.Updates[0].Status)
+		require.Equal(t, "resourceVersion 1 does not match the current resourceVersion 2", response.Updates[0].Reason)
+
+		configuration, err := s.Configuration("config")
+		require.NoError(t, err)
+		require.Equal(t, "raw: 2", configuration.Spec.Raw)
+	})
+
+	t.Run("apply with an invalid If-Match", func(t *testing.T) {
+		resp := do(t, http.MethodPost, "/apply", "W/1", model.ApplyPayload{})
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("apply of a missing resource with If-Match", func(t *testing.T) {
+		resp := do(t, http.MethodPost, "/apply", `"1"`, model.ApplyPayload{Resources: []*model.AnyResource{
+			{ResourceMeta: model.ResourceMeta{Kind: model.KindConfiguration, Metadata: model.Metadata{Name: "missing"}}},
+		}})
+		require.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	t.Run("delete with a stale If-Match", func(t *testing.T) {
+		resp := do(t, http.MethodDelete, "/configurations/config", `"1"`, nil)
+		require.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+
+		configuration, err := s.Configuration("config")
+		require.NoError(t, err)
+		require.NotNil(t, configuration)
+	})
+
+	t.Run("delete with a matching If-Match", func(t *testing.T) {
+		resp := do(t, http.MethodDelete, "/configurations/config", `"2"`, nil)
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		configuration, err := s.Configuration("config")
+		require.NoError(t, err)
+		require.Nil(t, configuration)
+	})
+
+	t.Run("delete of a missing resource with If-Match", func(t *testing.T) {
+		resp := do(t, http.MethodDelete, "/configurations/config", `"2"`, nil)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
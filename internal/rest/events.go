@@ -0,0 +1,364 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/observiq/bindplane-op/internal/eventbus"
+	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// eventHistorySize is the number of events kept so that a stream of events can resume after reconnecting
+const eventHistorySize = 1000
+
+// errEventsUnavailable is returned when a stream can't resume because the events have been discarded or the ID is from
+// before the server restarted
+var errEventsUnavailable = errors.New("events since the requested id are no longer available")
+
+// errInvalidEventID is returned when the ID to resume after is not an event ID
+var errInvalidEventID = errors.New("since must be an event id")
+
+// eventKinds are the kinds that can be streamed by GET /v1/events
+var eventKinds = []model.Kind{
+	model.KindAgent,
+	model.KindAgentGroup,
+	model.KindConfiguration,
+	model.KindConnector,
+	model.KindConnectorType,
+	model.KindDestination,
+	model.KindDestinationType,
+	model.KindProcessor,
+	model.KindProcessorType,
+	model.KindSource,
+	model.KindSourceType,
+}
+
+// eventHistory assigns IDs to the changes from the store and keeps the most recent events so that a stream of events
+// can resume after the last event it received. IDs are of the form <epoch>-<sequence> where the epoch identifies this
+// history so that IDs from before the server restarted are detected.
+type eventHistory struct {
+	size  int
+	epoch string
+
+	mtx    sync.Mutex
+	events []*model.Event
+	lastID uint64
+
+	source eventbus.Source[*model.Event]
+}
+
+func newEventHistory(ctx context.Context, updates eventbus.Source[*store.Updates], size int) *eventHistory {
+	h := &eventHistory{
+		size:   size,
+		epoch:  strconv.FormatInt(time.Now().UnixNano(), 36),
+		source: eventbus.NewSource[*model.Event](),
+	}
+	channel, unsubscribe := eventbus.Subscribe(updates)
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case updates, ok := <-channel:
+				if !ok {
+					return
+				}
+				h.add(eventsFromUpdates(updates))
+			}
+		}
+	}()
+	return h
+}
+
+// add assigns IDs to the events, keeps them in the history, and sends them to subscribers
+func (h *eventHistory) add(events []*model.Event) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	for _, event := range events {
+		h.lastID++
+		event.ID = h.eventID(h.lastID)
+		h.events = append(h.events, event)
+		h.source.Send(event)
+	}
+	if len(h.events) > h.size {
+		h.events = append([]*model.Event(nil), h.events[len(h.events)-h.size:]...)
+	}
+}
+
+// eventID returns the ID of the event with the sequence number
+func (h *eventHistory) eventID(sequence uint64) string {
+	return fmt.Sprintf("%s-%d", h.epoch, sequence)
+}
+
+// sequence returns the sequence number of the event ID. errEventsUnavailable is returned if the ID is from a different
+// epoch, i.e. from before the server restarted.
+func (h *eventHistory) sequence(id string) (uint64, error) {
+	epoch, value, ok := strings.Cut(id, "-")
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", errInvalidEventID, id)
+	}
+	sequence, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errInvalidEventID, id)
+	}
+	if epoch != h.epoch {
+		return 0, errEventsUnavailable
+	}
+	return sequence, nil
+}
+
+// subscribe returns the events after the since ID that are accepted by the filter and a channel of new events accepted
+// by the filter. The filter is called with each event in order and may replace the event that is sent. If since is
+// empty, no events are replayed. The channel is closed when the context is done or the subscriber falls too far behind.
+func (h *eventHistory) subscribe(ctx context.Context, since string, filter eventbus.SubscriptionFilter[*model.Event, *model.Event]) ([]*model.Event, <-chan *model.Event, error) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	var replay []*model.Event
+	if since != "" {
+		sequence, err := h.sequence(since)
+		if err != nil {
+			return nil, nil, err
+		}
+		// events are kept in order without gaps, so the first event has the sequence after the discarded events
+		first := h.lastID - uint64(len(h.events)) + 1
+		if sequence > h.lastID || sequence < first-1 {
+			return nil, nil, errEventsUnavailable
+		}
+		for i, event := range h.events {
+			if first+uint64(i) <= sequence {
+				continue
+			}
+			if event, accept := filter(event); accept {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	channel, _ := eventbus.SubscribeWithFilterUntilDone(ctx, h.source, filter,
+		eventbus.WithBackpressure[*model.Event](eventbus.BackpressureDisconnect),
+		eventbus.WithBufferSize[*model.Event](watchBufferSize),
+	)
+	return replay, channel, nil
+}
+
+// eventsFromUpdates returns an event for each change in the updates, ordered by kind and name
+func eventsFromUpdates(updates *store.Updates) []*model.Event {
+	var events []*model.Event
+	events = appendEvents(events, model.KindAgent, updates.Agents)
+	events = appendEvents(events, model.KindAgentGroup, updates.AgentGroups)
+	events = appendEvents(events, model.KindConfiguration, updates.Configurations)
+	events = appendEvents(events, model.KindConnector, updates.Connectors)
+	events = appendEvents(events, model.KindConnectorType, updates.ConnectorTypes)
+	events = appendEvents(events, model.KindDestination, updates.Destinations)
+	events = appendEvents(events, model.KindDestinationType, updates.DestinationTypes)
+	events = appendEvents(events, model.KindProcessor, updates.Processors)
+	events = appendEvents(events, model.KindProcessorType, updates.ProcessorTypes)
+	events = appendEvents(events, model.KindSource, updates.Sources)
+	events = appendEvents(events, model.KindSourceType, updates.SourceTypes)
+	return events
+}
+
+func appendEvents[T model.HasUniqueKey](events []*model.Event, kind model.Kind, changes store.Events[T]) []*model.Event {
+	for _, key := range sortedKeys(changes) {
+		change := changes[key]
+		events = append(events, &model.Event{
+			Type:     watchEventType(change.Type),
+			Kind:     kind,
+			Name:     key,
+			Resource: change.Item,
+		})
+	}
+	return events
+}
+
+// eventKey returns the key of the agent or resource of the event
+func eventKey(kind model.Kind, name string) string {
+	return fmt.Sprintf("%s|%s", kind, name)
+}
+
+// matchingEventKeys returns the keys of the agents and resources of the kinds that match the selector so that a
+// stream of events can send a remove event when one of them stops matching
+func matchingEventKeys(ctx context.Context, s store.Store, kinds map[model.Kind]bool, selector model.Selector) (*matchedKeys, error) {
+	matched := newMatchedKeys()
+	for kind := range kinds {
+		if kind == model.KindAgent {
+			agents, err := s.Agents(ctx, store.WithSelector(selector))
+			if err != nil {
+				return nil, err
+			}
+			for _, agent := range agents {
+				matched.add(eventKey(kind, agent.UniqueKey()))
+			}
+			continue
+		}
+		resources, err := store.ResourcesOfKind(s, kind)
+		if err != nil {
+			return nil, err
+		}
+		for _, resource := range resources {
+			if selector.Matches(resource.GetLabels()) {
+				matched.add(eventKey(kind, resource.UniqueKey()))
+			}
+		}
+	}
+	return matched, nil
+}
+
+// parseEventKinds parses a comma-separated list of kinds. All kinds are streamed if the list is empty.
+func parseEventKinds(kinds string) (map[model.Kind]bool, error) {
+	result := map[model.Kind]bool{}
+	if kinds == "" {
+		for _, kind := range eventKinds {
+			result[kind] = true
+		}
+		return result, nil
+	}
+	for _, name := range strings.Split(kinds, ",") {
+		kind := model.ParseKind(strings.TrimSpace(name))
+		if !eventKind(kind) {
+			return nil, fmt.Errorf("unable to stream events for kind: %s", name)
+		}
+		result[kind] = true
+	}
+	return result, nil
+}
+
+func eventKind(kind model.Kind) bool {
+	for _, k := range eventKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// @Summary Stream changes to agents and resources
+// @Description Streams events as newline-delimited JSON or as server-sent events if format=sse or the Accept header is
+// @Description text/event-stream. To resume the stream after reconnecting, specify the ID of the last event received
+// @Description using since or the Last-Event-ID header. 410 Gone is returned if those events are no longer available,
+// @Description including when the ID is from before the server restarted. An idle stream receives an empty line or a
+// @Description comment as a heartbeat every 30 seconds. With a selector, an agent or resource that stops matching the
+// @Description selector is sent as a remove event.
+// @Produce json
+// @Produce text/event-stream
+// @Router /events [get]
+// @Param	kinds	query	string	false	"comma-separated kinds of events to stream, e.g. Agent,Configuration. All kinds are streamed by default."
+// @Param	selector	query	string	false	"label selector to filter events"
+// @Param	since	query	string	false	"resume after the event with this ID"
+// @Param	format	query	string	false	"ndjson or sse"
+// @Param	Last-Event-ID	header	string	false	"resume after the event with this ID"
+// @Success 200 {object} model.Event
+// @Failure 400 {object} ErrorResponse
+// @Failure 410 {object} ErrorResponse
+func streamEvents(c *gin.Context, bindplane server.BindPlane, history *eventHistory) {
+	ctx := c.Request.Context()
+
+	kinds, err := parseEventKinds(c.DefaultQuery("kinds", ""))
+	if err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	selector, err := model.SelectorFromString(c.DefaultQuery("selector", ""))
+	if err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	since := c.DefaultQuery("since", c.GetHeader("Last-Event-ID"))
+
+	var sse bool
+	switch format := c.DefaultQuery("format", ""); format {
+	case "":
+		sse = strings.Contains(c.GetHeader("Accept"), "text/event-stream")
+	case "sse":
+		sse = true
+	case "ndjson":
+	default:
+		handleErrorResponse(c, http.StatusBadRequest, fmt.Errorf("format must be ndjson or sse, not %s", format))
+		return
+	}
+
+	// with a selector, track the agents and resources that match so that a remove event is sent when one stops matching
+	var matched *matchedKeys
+	if !selector.Empty() {
+		matched, err = matchingEventKeys(ctx, bindplane.Store(), kinds, selector)
+		if err != nil {
+			handleErrorResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	replay, channel, err := history.subscribe(ctx, since, func(event *model.Event) (*model.Event, bool) {
+		if !kinds[event.Kind] {
+			return event, false
+		}
+		labeled, ok := event.Resource.(model.Labeled)
+		if !ok || matched == nil {
+			return event, true
+		}
+		eventType, send := matched.filter(eventKey(event.Kind, event.Name), event.Type, selector.Matches(labeled.GetLabels()))
+		if !send || eventType == event.Type {
+			return event, send
+		}
+		// events are shared by all of the streams, so the remove event is a copy
+		removed := *event
+		removed.Type = eventType
+		return &removed, true
+	})
+	switch {
+	case errors.Is(err, errInvalidEventID):
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	case err != nil:
+		handleErrorResponse(c, http.StatusGone, err)
+		return
+	}
+
+	write := writeNDJSONEvent
+	if sse {
+		write = writeSSEEvent
+	}
+	writeStream(c, sse, replay, channel, write)
+}
+
+func writeNDJSONEvent(w io.Writer, event *model.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+func writeSSEEvent(w io.Writer, event *model.Event) error {
+	return writeSSE(w, "", event.ID, event)
+}
@@ -0,0 +1,258 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/eventbus"
+	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestEventHistory(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h := &eventHistory{size: 3, epoch: "boot", source: eventbus.NewSource[*model.Event]()}
+	acceptAll := func(e *model.Event) (*model.Event, bool) { return e, true }
+
+	event := func(name string) *model.Event {
+		return &model.Event{Type: model.WatchEventInsert, Kind: model.KindSource, Name: name}
+	}
+	names := func(events []*model.Event) []string {
+		var result []string
+		for _, event := range events {
+			result = append(result, event.Name)
+		}
+		return result
+	}
+
+	// nothing has been sent yet
+	_, _, err := h.subscribe(ctx, "boot-1", acceptAll)
+	require.ErrorIs(t, err, errEventsUnavailable)
+
+	h.add([]*model.Event{event("a"), event("b")})
+	h.add([]*model.Event{event("c"), event("d"), event("e")})
+	require.Equal(t, uint64(5), h.lastID)
+	require.Equal(t, []string{"c", "d", "e"}, names(h.events))
+
+	require.Equal(t, "boot-5", h.events[2].ID)
+
+	tests := []struct {
+		name   string
+		since  string
+		expect []string
+		err    error
+	}{
+		{name: "no replay", since: ""},
+		{name: "resume after oldest missing event", since: "boot-2", expect: []string{"c", "d", "e"}},
+		{name: "resume", since: "boot-3", expect: []string{"d", "e"}},
+		{name: "up to date", since: "boot-5"},
+		{name: "discarded", since: "boot-1", err: errEventsUnavailable},
+		{name: "future", since: "boot-6", err: errEventsUnavailable},
+		{name: "before restart", since: "previous-3", err: errEventsUnavailable},
+		{name: "invalid", since: "3", err: errInvalidEventID},
+		{name: "invalid sequence", since: "boot-x", err: errInvalidEventID},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			replay, channel, err := h.subscribe(ctx, test.since, acceptAll)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, channel)
+			require.Equal(t, test.expect, names(replay))
+		})
+	}
+
+	t.Run("filters replayed and new events", func(t *testing.T) {
+		subscribeCtx, stop := context.WithCancel(ctx)
+		defer stop()
+		replay, channel, err := h.subscribe(subscribeCtx, "boot-2", func(e *model.Event) (*model.Event, bool) { return e, e.Name != "d" && e.Name != "f" })
+		require.NoError(t, err)
+		require.Equal(t, []string{"c", "e"}, names(replay))
+
+		h.add([]*model.Event{event("f"), event("g")})
+		select {
+		case e := <-channel:
+			require.Equal(t, "g", e.Name)
+			require.Equal(t, "boot-7", e.ID)
+		case <-time.After(time.Second):
+			require.Fail(t, "timed out waiting for event")
+		}
+	})
+}
+
+func TestStreamEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := store.NewMapStore(ctx, store.Options{
+		SessionsSecret:   "super-secret-key",
+		MaxEventsToMerge: 1,
+	}, zap.NewNop())
+	bindplane, err := server.NewBindPlane(&common.Server{}, zap.NewNop(), s, nil)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	AddRestRoutes(ctx, router, bindplane)
+	svr := httptest.NewServer(router)
+	defer svr.Close()
+
+	stream := func(t *testing.T, ctx context.Context, query string, header http.Header) (*http.Response, *bufio.Reader) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, svr.URL+"/events?"+query, nil)
+		require.NoError(t, err)
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp, bufio.NewReader(resp.Body)
+	}
+	readLine := func(t *testing.T, reader *bufio.Reader) string {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		return strings.TrimSuffix(line, "\n")
+	}
+	configuration := func(name, labels string) *model.Configuration {
+		configuration := testRawConfiguration("", name)
+		configuration.Metadata.Labels, err = model.LabelsFromSelector(labels)
+		require.NoError(t, err)
+		return configuration
+	}
+
+	streamCtx, stopStream := context.WithCancel(ctx)
+	defer stopStream()
+	resp, reader := stream(t, streamCtx, "kinds=Configuration&selector=env%3Dtest", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+
+	_, err = s.ApplyResources([]model.Resource{configuration("c1", "env=test")})
+	require.NoError(t, err)
+	_, err = addAgent(s, &model.Agent{ID: "1", Labels: model.MakeLabels()})
+	require.NoError(t, err)
+	_, err = s.ApplyResources([]model.Resource{configuration("c2", "env=prod")})
+	require.NoError(t, err)
+	_, err = s.ApplyResources([]model.Resource{configuration("c3", "env=test")})
+	require.NoError(t, err)
+
+	// the agent and configuration c2 are filtered out
+	first := &model.Event{}
+	require.NoError(t, json.Unmarshal([]byte(readLine(t, reader)), first))
+	require.Equal(t, model.WatchEventInsert, first.Type)
+	require.Equal(t, model.KindConfiguration, first.Kind)
+	require.Equal(t, "c1", first.Name)
+
+	second := &model.Event{}
+	require.NoError(t, json.Unmarshal([]byte(readLine(t, reader)), second))
+	require.Equal(t, "c3", second.Name)
+	epoch, _, _ := strings.Cut(first.ID, "-")
+	require.Equal(t, epoch+"-4", second.ID)
+	stopStream()
+	resp.Body.Close()
+
+	t.Run("resumes with Last-Event-ID as server-sent events", func(t *testing.T) {
+		streamCtx, stopStream := context.WithCancel(ctx)
+		defer stopStream()
+		resp, reader := stream(t, streamCtx, "kinds=Configuration", http.Header{
+			"Accept":        []string{"text/event-stream"},
+			"Last-Event-Id": []string{first.ID},
+		})
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		for _, name := range []string{"c2", "c3"} {
+			require.True(t, strings.HasPrefix(readLine(t, reader), "id: "))
+			data := readLine(t, reader)
+			require.True(t, strings.HasPrefix(data, "data: "))
+			event := &model.Event{}
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(data, "data: ")), event))
+			require.Equal(t, name, event.Name)
+			require.Equal(t, "", readLine(t, reader))
+		}
+	})
+
+	t.Run("sends remove events for resources that stop matching the selector", func(t *testing.T) {
+		streamCtx, stopStream := context.WithCancel(ctx)
+		defer stopStream()
+		resp, reader := stream(t, streamCtx, "kinds=Configuration&selector=env%3Dtest", nil)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// c2 never matched so it is not sent
+		c2 := configuration("c2", "env=prod")
+		c2.Spec.Raw = "raw: changed"
+		_, err = s.ApplyResources([]model.Resource{c2})
+		require.NoError(t, err)
+		_, err = s.ApplyResources([]model.Resource{configuration("c3", "env=prod")})
+		require.NoError(t, err)
+		c1 := configuration("c1", "env=test")
+		c1.Spec.Raw = "raw: changed"
+		_, err = s.ApplyResources([]model.Resource{c1})
+		require.NoError(t, err)
+
+		for _, expected := range []model.Event{
+			{Type: model.WatchEventRemove, Name: "c3"},
+			{Type: model.WatchEventUpdate, Name: "c1"},
+		} {
+			event := &model.Event{}
+			require.NoError(t, json.Unmarshal([]byte(readLine(t, reader)), event))
+			require.Equal(t, expected.Type, event.Type)
+			require.Equal(t, expected.Name, event.Name)
+		}
+	})
+
+	t.Run("returns 410 when events are not available", func(t *testing.T) {
+		resp, _ := stream(t, ctx, "since="+epoch+"-1000000", nil)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusGone, resp.StatusCode)
+	})
+
+	t.Run("returns 410 for ids from before the server restarted", func(t *testing.T) {
+		resp, _ := stream(t, ctx, "since=previous-1", nil)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusGone, resp.StatusCode)
+	})
+
+	t.Run("returns 400 for invalid ids", func(t *testing.T) {
+		resp, _ := stream(t, ctx, "since=1", nil)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("returns 400 for kinds that can't be streamed", func(t *testing.T) {
+		resp, _ := stream(t, ctx, "kinds=Profile", nil)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
@@ -0,0 +1,216 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/exp/slices"
+
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// listOptions are the options parsed from the query parameters of a request to a list route
+type listOptions struct {
+	// options are passed to the store to select the page of results. The limit is one more than the requested limit
+	// so that we know if there is another page.
+	options  []store.QueryOption
+	selector model.Selector
+	sort     string
+	limit    int
+	fields   []string
+}
+
+// parseListOptions parses the selector, sort, order, cursor, offset, limit, and fields query parameters used by all
+// list routes. The sort must be one of the specified sortFields.
+func parseListOptions(c *gin.Context, sortFields []string) (*listOptions, error) {
+	opts := &listOptions{}
+
+	selector, err := model.SelectorFromString(c.DefaultQuery("selector", ""))
+	if err != nil {
+		return nil, err
+	}
+	opts.selector = selector
+	opts.options = append(opts.options, store.WithSelector(selector))
+
+	if sort := c.DefaultQuery("sort", ""); sort != "" {
+		descending := strings.HasPrefix(sort, "-")
+		field := strings.TrimPrefix(sort, "-")
+		if !slices.Contains(sortFields, field) {
+			return nil, fmt.Errorf("sort must be one of %s", strings.Join(sortFields, ", "))
+		}
+		switch order := c.DefaultQuery("order", "asc"); order {
+		case "asc":
+		case "desc":
+			descending = !descending
+		default:
+			return nil, fmt.Errorf("order must be asc or desc, got %s", order)
+		}
+		if descending {
+			field = "-" + field
+		}
+		opts.sort = field
+		opts.options = append(opts.options, store.WithSort(field))
+	}
+
+	if cursor := c.DefaultQuery("cursor", ""); cursor != "" {
+		opts.options = append(opts.options, store.WithCursor(cursor))
+	}
+
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil {
+		return nil, fmt.Errorf("offset must be a number: %v", err)
+	}
+	opts.options = append(opts.options, store.WithOffset(offset))
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil {
+		return nil, fmt.Errorf("limit must be a number: %v", err)
+	}
+	if limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative")
+	}
+	if limit > 0 {
+		opts.limit = limit
+		opts.options = append(opts.options, store.WithLimit(limit+1))
+	}
+
+	if fields := c.DefaultQuery("fields", ""); fields != "" {
+		opts.fields = strings.Split(fields, ",")
+	}
+
+	return opts, nil
+}
+
+// listResources handles requests to list routes for resources. The response is created with the page of resources and
+// the cursor for the next page and the items in the list are projected to the requested fields.
+func listResources[R model.Resource](c *gin.Context, list func(...store.QueryOption) ([]R, error), listKey string, response func(items []R, nextCursor string) any) {
+	opts, err := parseListOptions(c, store.ResourceSortFields)
+	if err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	items, err := list(opts.options...)
+	if !okList(c, err) {
+		return
+	}
+
+	items, next := store.Page(items, opts.limit, func(item R) string {
+		return store.ResourceCursor(opts.sort, item)
+	})
+	writeList(c, response(items, next), listKey, opts.fields)
+}
+
+// okList writes an error response if the list failed and returns false. Invalid cursors are a bad request.
+func okList(c *gin.Context, err error) bool {
+	switch {
+	case err == nil:
+		return true
+	case errors.Is(err, store.ErrInvalidCursor):
+		handleErrorResponse(c, http.StatusBadRequest, err)
+	default:
+		handleErrorResponse(c, http.StatusInternalServerError, err)
+	}
+	return false
+}
+
+// writeList writes the response of a list route. If fields are specified, each item in the list found at listKey is
+// projected to only include those fields.
+func writeList(c *gin.Context, response any, listKey string, fields []string) {
+	if len(fields) == 0 {
+		c.JSON(http.StatusOK, response)
+		return
+	}
+	projected, err := projectList(response, listKey, fields)
+	if err != nil {
+		handleErrorResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusOK, projected)
+}
+
+// projectList converts the response to a map and projects each item in the list found at listKey to the specified
+// fields. Fields are paths separated by dots, e.g. metadata.name.
+func projectList(response any, listKey string, fields []string) (map[string]any, error) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+	// use numbers to avoid converting integers to floats
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var result map[string]any
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+
+	items, _ := result[listKey].([]any)
+	for i, item := range items {
+		items[i] = projectFields(item, fields)
+	}
+	return result, nil
+}
+
+// projectFields returns a copy of the item that only includes the specified fields. Fields that don't exist are
+// omitted.
+func projectFields(item any, fields []string) any {
+	source, ok := item.(map[string]any)
+	if !ok {
+		return item
+	}
+	result := map[string]any{}
+	for _, field := range fields {
+		path := strings.Split(strings.TrimSpace(field), ".")
+		if value, ok := lookupField(source, path); ok {
+			setField(result, path, value)
+		}
+	}
+	return result
+}
+
+func lookupField(source map[string]any, path []string) (any, bool) {
+	value, ok := source[path[0]]
+	if !ok || len(path) == 1 {
+		return value, ok
+	}
+	next, ok := value.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return lookupField(next, path[1:])
+}
+
+func setField(result map[string]any, path []string, value any) {
+	if len(path) == 1 {
+		result[path[0]] = value
+		return
+	}
+	next, ok := result[path[0]].(map[string]any)
+	if !ok {
+		next = map[string]any{}
+		result[path[0]] = next
+	}
+	setField(next, path[1:], value)
+}
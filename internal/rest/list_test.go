@@ -0,0 +1,197 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestListPagination(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := store.NewMapStore(ctx, store.Options{
+		SessionsSecret:   "super-secret-key",
+		MaxEventsToMerge: 1,
+	}, zap.NewNop())
+	bindplane, err := server.NewBindPlane(&common.Server{}, zap.NewNop(), s, nil)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	AddRestRoutes(ctx, router, bindplane)
+	svr := httptest.NewServer(router)
+	defer svr.Close()
+
+	resources := []model.Resource{}
+	for i := 0; i < 7; i++ {
+		configuration := testRawConfiguration("", fmt.Sprintf("config-%d", i))
+		configuration.Metadata.Labels = model.LabelsFromValidatedMap(map[string]string{"env": "prod"})
+		if i%2 == 1 {
+			configuration.Metadata.Labels = model.LabelsFromValidatedMap(map[string]string{"env": "dev"})
+		}
+		resources = append(resources, configuration)
+	}
+	_, err = s.ApplyResources(resources)
+	require.NoError(t, err)
+
+	get := func(t *testing.T, query string) (int, []byte) {
+		resp, err := http.Get(svr.URL + "/configurations?" + query)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var body json.RawMessage
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		return resp.StatusCode, body
+	}
+	names := func(t *testing.T, body []byte) ([]string, string) {
+		var response model.ConfigurationsResponse
+		require.NoError(t, json.Unmarshal(body, &response))
+		names := []string{}
+		for _, configuration := range response.Configurations {
+			names = append(names, configuration.Name())
+		}
+		return names, response.NextCursor
+	}
+
+	t.Run("without limit returns everything", func(t *testing.T) {
+		status, body := get(t, "")
+		require.Equal(t, http.StatusOK, status)
+		list, next := names(t, body)
+		require.Len(t, list, 7)
+		require.Empty(t, next)
+	})
+
+	t.Run("pages with cursor", func(t *testing.T) {
+		all := []string{}
+		query := "limit=3"
+		for pages := 0; pages < 5; pages++ {
+			status, body := get(t, query)
+			require.Equal(t, http.StatusOK, status)
+			list, next := names(t, body)
+			all = append(all, list...)
+			if next == "" {
+				break
+			}
+			query = "limit=3&cursor=" + next
+		}
+		require.Equal(t, []string{"config-0", "config-1", "config-2", "config-3", "config-4", "config-5", "config-6"}, all)
+	})
+
+	t.Run("sort and order", func(t *testing.T) {
+		status, body := get(t, "sort=name&order=desc&limit=2")
+		require.Equal(t, http.StatusOK, status)
+		list, next := names(t, body)
+		require.Equal(t, []string{"config-6", "config-5"}, list)
+
+		status, body = get(t, "sort=name&order=desc&limit=2&cursor="+next)
+		require.Equal(t, http.StatusOK, status)
+		list, _ = names(t, body)
+		require.Equal(t, []string{"config-4", "config-3"}, list)
+	})
+
+	t.Run("selector", func(t *testing.T) {
+		status, body := get(t, "selector=env%3Ddev")
+		require.Equal(t, http.StatusOK, status)
+		list, _ := names(t, body)
+		require.Equal(t, []string{"config-1", "config-3", "config-5"}, list)
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		status, body := get(t, "fields=metadata.name,kind&limit=1")
+		require.Equal(t, http.StatusOK, status)
+		var response map[string]any
+		require.NoError(t, json.Unmarshal(body, &response))
+		require.Equal(t, []any{
+			map[string]any{
+				"kind":     "Configuration",
+				"metadata": map[string]any{"name": "config-0"},
+			},
+		}, response["configurations"])
+		require.NotEmpty(t, response["nextCursor"])
+	})
+
+	t.Run("unsupported sort", func(t *testing.T) {
+		status, _ := get(t, "sort=spec.raw")
+		require.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("invalid order", func(t *testing.T) {
+		status, _ := get(t, "sort=name&order=sideways")
+		require.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		status, _ := get(t, "limit=2&cursor=nope")
+		require.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("cursor with a different sort", func(t *testing.T) {
+		_, body := get(t, "limit=2")
+		_, next := names(t, body)
+		status, _ := get(t, "limit=2&order=desc&sort=name&cursor="+next)
+		require.Equal(t, http.StatusBadRequest, status)
+	})
+}
+
+func TestProjectFields(t *testing.T) {
+	item := map[string]any{
+		"id": "1",
+		"metadata": map[string]any{
+			"name":   "a",
+			"labels": map[string]any{"env": "prod"},
+		},
+		"spec": "raw",
+	}
+	tests := []struct {
+		name   string
+		fields []string
+		expect any
+	}{
+		{
+			name:   "top level",
+			fields: []string{"id"},
+			expect: map[string]any{"id": "1"},
+		},
+		{
+			name:   "nested",
+			fields: []string{"metadata.name", "metadata.labels.env"},
+			expect: map[string]any{"metadata": map[string]any{"name": "a", "labels": map[string]any{"env": "prod"}}},
+		},
+		{
+			name:   "missing fields are omitted",
+			fields: []string{"status", "spec.type", " id "},
+			expect: map[string]any{"id": "1"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expect, projectFields(item, test.fields))
+		})
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/observiq/bindplane-op/internal/eventbus"
+	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/internal/store/search"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// watchBufferSize is the number of server-sent events that can be waiting to be written to a watch. A watch that falls
+// further behind is disconnected so that it can't stall updates sent to other subscribers.
+const watchBufferSize = 100
+
+// streamHeartbeatInterval is the interval between the heartbeats written to a stream so that clients and proxies do not
+// treat an idle stream as a broken connection. Streams of server-sent events receive a comment and newline-delimited
+// JSON streams receive an empty line.
+var streamHeartbeatInterval = 30 * time.Second
+
+// errStreamBehind is sent in an error event before closing a stream of server-sent events that is disconnected because
+// it fell too far behind
+var errStreamBehind = errors.New("the server closed the stream because the client fell too far behind")
+
+// writeStream writes the initial items followed by each item received from the channel until the request is done or
+// the channel is closed. Streams receive a heartbeat every streamHeartbeatInterval and streams of server-sent events
+// receive an error event if the channel is closed while the request is still active. It is used by GET /v1/events and by requests
+// with watch=true.
+func writeStream[T any](c *gin.Context, sse bool, initial []T, channel <-chan T, write func(w io.Writer, item T) error) {
+	ctx := c.Request.Context()
+
+	c.Header("Content-Type", "application/x-ndjson")
+	if sse {
+		c.Header("Content-Type", "text/event-stream")
+	}
+	c.Header("Cache-Control", "no-cache")
+	c.Status(http.StatusOK)
+	c.Writer.WriteHeaderNow()
+
+	for _, item := range initial {
+		if err := write(c.Writer, item); err != nil {
+			return
+		}
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-heartbeat.C:
+			ping := "\n"
+			if sse {
+				ping = ": ping\n\n"
+			}
+			_, err := io.WriteString(w, ping)
+			return err == nil
+		case item, ok := <-channel:
+			if !ok {
+				if sse && ctx.Err() == nil {
+					_ = writeSSE(w, "error", "", NewErrorResponse(errStreamBehind))
+				}
+				return false
+			}
+			return write(w, item) == nil
+		}
+	})
+}
+
+// writeSSE writes a server-sent event with the value encoded as JSON in the data field. The event name and id are
+// omitted if empty.
+func writeSSE(w io.Writer, event string, id string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if event != "" {
+		if _, err := fmt.Fprintf(w, "event: %s\n", event); err != nil {
+			return err
+		}
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "data: %s\n\n", data)
+	return err
+}
+
+// watchUpdates streams a server-sent event with the initial response followed by a server-sent event for each set of
+// updates accepted by the filter. It subscribes to updates before getting the initial response so that no updates are
+// missed and returns when the request is done.
+func watchUpdates[R any](c *gin.Context, bindplane server.BindPlane, event string, initial func() (R, error), filter eventbus.SubscriptionFilter[*store.Updates, R]) {
+	ctx := c.Request.Context()
+
+	channel, unsubscribe := eventbus.SubscribeWithFilterUntilDone(ctx, bindplane.Store().Updates(), filter,
+		eventbus.WithBackpressure[R](eventbus.BackpressureDisconnect),
+		eventbus.WithBufferSize[R](watchBufferSize),
+	)
+	defer unsubscribe()
+
+	response, err := initial()
+	if err != nil {
+		handleErrorResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeStream(c, true, []R{response}, channel, func(w io.Writer, response R) error {
+		return writeSSE(w, event, "", response)
+	})
+}
+
+// watchEventType converts the type of a store event to the type of a watch event
+func watchEventType(eventType store.EventType) model.WatchEventType {
+	switch eventType {
+	case store.EventTypeRemove:
+		return model.WatchEventRemove
+	case store.EventTypeInsert:
+		return model.WatchEventInsert
+	default:
+		return model.WatchEventUpdate
+	}
+}
+
+// matchedKeys tracks the keys of the items sent to a client that filters by selector or query so that an item that
+// stops matching is sent as a remove and changes to items that never matched are not sent
+type matchedKeys struct {
+	mtx  sync.Mutex
+	keys map[string]bool
+}
+
+func newMatchedKeys() *matchedKeys {
+	return &matchedKeys{keys: map[string]bool{}}
+}
+
+// add records that the item with the key was sent to the client
+func (m *matchedKeys) add(key string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.keys[key] = true
+}
+
+// filter returns the type of event to send for a change to the item with the key and false if no event is sent. An
+// item that no longer matches is sent as a remove.
+func (m *matchedKeys) filter(key string, eventType model.WatchEventType, matches bool) (model.WatchEventType, bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	matched := m.keys[key]
+	switch {
+	case eventType == model.WatchEventRemove:
+		delete(m.keys, key)
+		return eventType, matched || matches
+	case matches:
+		m.keys[key] = true
+		return eventType, true
+	case matched:
+		delete(m.keys, key)
+		return model.WatchEventRemove, true
+	}
+	return eventType, false
+}
+
+// sortedKeys returns the keys of the events sorted so that changes are streamed in a consistent order
+func sortedKeys[T model.HasUniqueKey](events store.Events[T]) []string {
+	keys := events.Keys()
+	sort.Strings(keys)
+	return keys
+}
+
+func watchAgents(c *gin.Context, bindplane server.BindPlane, selector model.Selector, query *search.Query) {
+	ctx := c.Request.Context()
+
+	matches := func(agent *model.Agent) bool {
+		if !selector.Matches(agent.Labels) {
+			return false
+		}
+		return query == nil || bindplane.Store().AgentIndex().Matches(query, agent.ID)
+	}
+
+	matched := newMatchedKeys()
+
+	initial := func() (*model.AgentWatchResponse, error) {
+		options := []store.QueryOption{store.WithSelector(selector)}
+		if query != nil {
+			options = append(options, store.WithQuery(query))
+		}
+		agents, err := bindplane.Store().Agents(ctx, options...)
+		if err != nil {
+			return nil, err
+		}
+		response := &model.AgentWatchResponse{Events: make([]*model.AgentWatchEvent, 0, len(agents))}
+		for _, agent := range agents {
+			matched.add(agent.UniqueKey())
+			response.Events = append(response.Events, &model.AgentWatchEvent{Type: model.WatchEventInsert, Agent: agent})
+		}
+		return response, nil
+	}
+
+	watchUpdates(c, bindplane, "agents", initial, func(updates *store.Updates) (*model.AgentWatchResponse, bool) {
+		response := &model.AgentWatchResponse{}
+		for _, key := range sortedKeys(updates.Agents) {
+			event := updates.Agents[key]
+			eventType, send := matched.filter(key, watchEventType(event.Type), matches(event.Item))
+			if send {
+				response.Events = append(response.Events, &model.AgentWatchEvent{Type: eventType, Agent: event.Item})
+			}
+		}
+		return response, len(response.Events) > 0
+	})
+}
+
+func watchConfigurations(c *gin.Context, bindplane server.BindPlane, selector model.Selector, query *search.Query) {
+	matches := func(configuration *model.Configuration) bool {
+		if !selector.Matches(configuration.GetLabels()) {
+			return false
+		}
+		return query == nil || bindplane.Store().ConfigurationIndex().Matches(query, configuration.Name())
+	}
+
+	matched := newMatchedKeys()
+
+	initial := func() (*model.ConfigurationWatchResponse, error) {
+		options := []store.QueryOption{store.WithSelector(selector)}
+		if query != nil {
+			options = append(options, store.WithQuery(query))
+		}
+		configurations, err := bindplane.Store().Configurations(options...)
+		if err != nil {
+			return nil, err
+		}
+		response := &model.ConfigurationWatchResponse{Events: make([]*model.ConfigurationWatchEvent, 0, len(configurations))}
+		for _, configuration := range configurations {
+			matched.add(configuration.UniqueKey())
+			response.Events = append(response.Events, &model.ConfigurationWatchEvent{Type: model.WatchEventInsert, Configuration: configuration})
+		}
+		return response, nil
+	}
+
+	watchUpdates(c, bindplane, "configurations", initial, func(updates *store.Updates) (*model.ConfigurationWatchResponse, bool) {
+		response := &model.ConfigurationWatchResponse{}
+		for _, key := range sortedKeys(updates.Configurations) {
+			event := updates.Configurations[key]
+			eventType, send := matched.filter(key, watchEventType(event.Type), matches(event.Item))
+			if send {
+				response.Events = append(response.Events, &model.ConfigurationWatchEvent{Type: eventType, Configuration: event.Item})
+			}
+		}
+		return response, len(response.Events) > 0
+	})
+}
+
+// isWatch returns true if the request has watch=true
+func isWatch(c *gin.Context) bool {
+	watch, _ := strconv.ParseBool(c.DefaultQuery("watch", "false"))
+	return watch
+}
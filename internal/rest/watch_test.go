@@ -0,0 +1,186 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/eventbus"
+	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestWriteStream(t *testing.T) {
+	interval := streamHeartbeatInterval
+	streamHeartbeatInterval = 10 * time.Millisecond
+	defer func() { streamHeartbeatInterval = interval }()
+
+	channel := make(chan string)
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/stream", func(c *gin.Context) {
+		writeStream(c, true, []string{"first"}, channel, func(w io.Writer, item string) error {
+			return writeSSE(w, "item", "", item)
+		})
+	})
+	svr := httptest.NewServer(router)
+	defer svr.Close()
+
+	resp, err := http.Get(svr.URL + "/stream")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	readLine := func() string {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		return line
+	}
+
+	require.Equal(t, "event: item\n", readLine())
+	require.Equal(t, "data: \"first\"\n", readLine())
+	require.Equal(t, "\n", readLine())
+
+	// idle streams receive a comment
+	require.Equal(t, ": ping\n", readLine())
+	require.Equal(t, "\n", readLine())
+
+	// the channel is closed when the client falls too far behind
+	close(channel)
+	body, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "event: error\ndata: {\"errors\":[\"the server closed the stream because the client fell too far behind\"]}\n\n")
+}
+
+func TestWriteStreamNDJSON(t *testing.T) {
+	interval := streamHeartbeatInterval
+	streamHeartbeatInterval = 10 * time.Millisecond
+	defer func() { streamHeartbeatInterval = interval }()
+
+	channel := make(chan string)
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/stream", func(c *gin.Context) {
+		writeStream(c, false, []string{"first"}, channel, func(w io.Writer, item string) error {
+			return json.NewEncoder(w).Encode(item)
+		})
+	})
+	svr := httptest.NewServer(router)
+	defer svr.Close()
+
+	resp, err := http.Get(svr.URL + "/stream")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "\"first\"\n", line)
+
+	// idle streams receive an empty line
+	line, err = reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "\n", line)
+	close(channel)
+}
+
+func TestWatchConfigurations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := store.NewMapStore(ctx, store.Options{
+		SessionsSecret:   "super-secret-key",
+		MaxEventsToMerge: 1,
+	}, zap.NewNop())
+	bindplane, err := server.NewBindPlane(&common.Server{}, zap.NewNop(), s, nil)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	AddRestRoutes(ctx, router, bindplane)
+	svr := httptest.NewServer(router)
+	defer svr.Close()
+
+	apply := func(name, labels, raw string) {
+		configuration := testRawConfiguration("", name)
+		configuration.Metadata.Labels, err = model.LabelsFromSelector(labels)
+		require.NoError(t, err)
+		configuration.Spec.Raw = raw
+		_, err = s.ApplyResources([]model.Resource{configuration})
+		require.NoError(t, err)
+	}
+	// wait for the updates to be sent so that they are not received by the watch
+	updates, unsubscribe := eventbus.Subscribe(s.Updates())
+	apply("c1", "env=test", "raw:")
+	apply("c2", "env=prod", "raw:")
+	<-updates
+	<-updates
+	unsubscribe()
+
+	streamCtx, stopStream := context.WithCancel(ctx)
+	defer stopStream()
+	req, err := http.NewRequestWithContext(streamCtx, http.MethodGet, svr.URL+"/configurations?watch=true&selector=env%3Dtest", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	reader := bufio.NewReader(resp.Body)
+	next := func() []string {
+		for {
+			line, err := reader.ReadString('\n')
+			require.NoError(t, err)
+			if !strings.HasPrefix(line, "data: ") {
+				continue
+			}
+			response := &model.ConfigurationWatchResponse{}
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), response))
+			events := []string{}
+			for _, event := range response.Events {
+				events = append(events, string(event.Type)+" "+event.Configuration.Name())
+			}
+			return events
+		}
+	}
+
+	require.Equal(t, []string{"insert c1"}, next())
+
+	// c2 never matched so changes to it are not sent
+	apply("c2", "env=prod", "raw: changed")
+	// c1 stopped matching so it is removed
+	apply("c1", "env=prod", "raw:")
+	require.Equal(t, []string{"remove c1"}, next())
+	// c2 matches for the first time
+	apply("c2", "env=test", "raw: changed")
+	require.Equal(t, []string{"update c2"}, next())
+	apply("c2", "env=test", "raw: changed again")
+	require.Equal(t, []string{"update c2"}, next())
+}
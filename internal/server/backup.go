@@ -0,0 +1,31 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// handleScheduledBackup creates a backup of the store, logging any errors
+func (m *manager) handleScheduledBackup(ctx context.Context) {
+	ctx, span := tracer.Start(ctx, "manager/handleScheduledBackup")
+	defer span.End()
+
+	if _, err := m.backups.Create(ctx); err != nil {
+		m.logger.Error("unable to create scheduled backup", zap.Error(err))
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+	"github.com/observiq/bindplane-op/model/observiq"
+)
+
+// agentDrift is the drift of an agent along with the collector configurations used to determine it
+type agentDrift struct {
+	agent     *model.Agent
+	drift     *model.AgentDrift
+	desired   string
+	effective string
+}
+
+// report returns the AgentDriftReport for the drift, including a diff of the configurations
+func (d *agentDrift) report() *model.AgentDriftReport {
+	report := &model.AgentDriftReport{
+		AgentID:       d.agent.ID,
+		AgentName:     d.agent.Name,
+		Status:        d.drift.Status,
+		Configuration: d.drift.Configuration,
+	}
+	if d.drift.Status != model.AgentDriftInSync && d.drift.Status != model.AgentDriftUnmanaged {
+		report.Diff = configurationDiff(d.desired, d.effective)
+	}
+	return report
+}
+
+// AgentsDrift returns drift reports for the agents matching the options
+func (m *manager) AgentsDrift(ctx context.Context, options ...store.QueryOption) ([]*model.AgentDriftReport, error) {
+	ctx, span := tracer.Start(ctx, "manager/AgentsDrift")
+	defer span.End()
+
+	drifts, err := m.agentsDrift(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*model.AgentDriftReport, 0, len(drifts))
+	for _, d := range drifts {
+		reports = append(reports, d.report())
+	}
+	return reports, nil
+}
+
+// agentsDrift computes the drift of the agents matching the options. Each configuration is rendered once.
+func (m *manager) agentsDrift(ctx context.Context, options ...store.QueryOption) ([]*agentDrift, error) {
+	agents, err := m.store.Agents(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := map[string]string{}
+	drifts := make([]*agentDrift, 0, len(agents))
+	for _, agent := range agents {
+		configuration, err := m.store.AgentConfiguration(agent.ID)
+		if err != nil {
+			m.logger.Error("unable to find agent configuration to detect drift", zap.String("agentID", agent.ID), zap.Error(err))
+			continue
+		}
+
+		var configurationName, desired string
+		if configuration != nil {
+			configurationName = configuration.Name()
+			var ok bool
+			if desired, ok = rendered[configurationName]; !ok {
+				desired, err = configuration.Render(ctx, m.store)
+				if err != nil {
+					m.logger.Error("unable to render configuration to detect drift", zap.String("configuration.name", configurationName), zap.Error(err))
+					continue
+				}
+				rendered[configurationName] = desired
+			}
+		}
+
+		var effective string
+		if agent.Configuration != nil {
+			agentConfiguration, err := observiq.DecodeAgentConfiguration(agent.Configuration)
+			if err != nil {
+				m.logger.Error("unable to decode agent configuration to detect drift", zap.String("agentID", agent.ID), zap.Error(err))
+				continue
+			}
+			effective = agentConfiguration.Collector
+		}
+
+		drifts = append(drifts, &agentDrift{
+			agent:     agent,
+			drift:     classifyDrift(agent, configurationName, desired, effective),
+			desired:   desired,
+			effective: effective,
+		})
+	}
+	return drifts, nil
+}
+
+// handleDriftDetection updates the drift status of all agents and resends the configuration to agents that should be
+// remediated
+func (m *manager) handleDriftDetection() {
+	ctx, span := tracer.Start(context.TODO(), "manager/handleDriftDetection")
+	defer span.End()
+
+	drifts, err := m.agentsDrift(ctx)
+	if err != nil {
+		m.logger.Error("unable to detect agent configuration drift", zap.Error(err))
+		return
+	}
+
+	for _, d := range drifts {
+		current := d.agent.Drift
+		if current == nil || current.Status != d.drift.Status || current.Configuration != d.drift.Configuration || current.SyncedHash != d.drift.SyncedHash {
+			drift := d.drift
+			_, err := m.store.UpsertAgent(ctx, d.agent.ID, func(current *model.Agent) {
+				current.Drift = drift
+			})
+			if err != nil {
+				m.logger.Error("unable to update agent drift", zap.String("agentID", d.agent.ID), zap.Error(err))
+				continue
+			}
+		}
+
+		if !shouldRemediate(m.driftRemediation, d.agent, d.drift.Status) || !m.connected(d.agent.ID) {
+			continue
+		}
+		m.logger.Info("remediating agent configuration drift", zap.String("agentID", d.agent.ID), zap.String("drift", string(d.drift.Status)))
+		if _, err := m.ExecuteAgentCommand(ctx, d.agent.ID, model.AgentCommandReloadConfig); err != nil {
+			m.logger.Error("unable to remediate agent configuration drift", zap.String("agentID", d.agent.ID), zap.Error(err))
+		}
+	}
+}
+
+// classifyDrift determines the drift status of an agent by comparing the collector configuration it should be running
+// with the effective collector configuration it reported
+func classifyDrift(agent *model.Agent, configuration, desired, effective string) *model.AgentDrift {
+	drift := &model.AgentDrift{
+		Configuration: configuration,
+		UpdatedAt:     time.Now(),
+	}
+	if agent.Drift != nil {
+		drift.SyncedHash = agent.Drift.SyncedHash
+	}
+
+	desiredHash := configurationHash(desired)
+	switch {
+	case configuration == "":
+		drift.Status = model.AgentDriftUnmanaged
+		drift.SyncedHash = ""
+	case desired == effective:
+		drift.Status = model.AgentDriftInSync
+		drift.SyncedHash = desiredHash
+	case agent.Status == model.Configuring || agent.Status == model.Disconnected || agent.PendingChange != nil:
+		drift.Status = model.AgentDriftPending
+	case drift.SyncedHash == desiredHash:
+		// the agent was running this configuration and the configuration has not changed on the server
+		drift.Status = model.AgentDriftLocallyModified
+	default:
+		drift.Status = model.AgentDriftDrifted
+	}
+
+	// keep the time of the last change if the status is unchanged
+	if agent.Drift != nil && agent.Drift.Status == drift.Status {
+		drift.UpdatedAt = agent.Drift.UpdatedAt
+	}
+	return drift
+}
+
+// shouldRemediate returns true if the configuration should be resent to the agent based on the remediation policy.
+// Agents that report an error or already have a reload in progress are skipped to avoid repeatedly sending a
+// configuration that cannot be applied.
+func shouldRemediate(policy common.DriftRemediation, agent *model.Agent, status model.AgentDriftStatus) bool {
+	switch {
+	case status == model.AgentDriftLocallyModified:
+		if policy != common.DriftRemediationLocallyModified && policy != common.DriftRemediationAll {
+			return false
+		}
+	case status == model.AgentDriftDrifted:
+		if policy != common.DriftRemediationAll {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if agent.Status == model.Error {
+		return false
+	}
+	for _, c := range agent.Commands {
+		if c.Type == model.AgentCommandReloadConfig && !c.Complete() {
+			return false
+		}
+	}
+	return true
+}
+
+func configurationHash(configuration string) string {
+	if configuration == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(configuration))
+	return hex.EncodeToString(sum[:])
+}
+
+// configurationDiff returns a unified diff from the desired configuration to the effective configuration
+func configurationDiff(desired, effective string) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(desired),
+		B:        difflib.SplitLines(effective),
+		FromFile: "desired",
+		ToFile:   "effective",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Sprintf("unable to compute diff: %v", err)
+	}
+	return diff
+}
@@ -0,0 +1,210 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/store/search"
+	"github.com/observiq/bindplane-op/model"
+	"github.com/observiq/bindplane-op/model/observiq"
+)
+
+func TestClassifyDrift(t *testing.T) {
+	desired := "receivers:\n  hostmetrics:\n"
+	modified := "receivers:\n  filelog:\n"
+
+	tests := []struct {
+		name          string
+		agent         *model.Agent
+		configuration string
+		desired       string
+		effective     string
+		expect        model.AgentDriftStatus
+	}{
+		{
+			name:      "no configuration",
+			agent:     &model.Agent{Status: model.Connected},
+			effective: modified,
+			expect:    model.AgentDriftUnmanaged,
+		},
+		{
+			name:          "in sync",
+			agent:         &model.Agent{Status: model.Connected},
+			configuration: "test",
+			desired:       desired,
+			effective:     desired,
+			expect:        model.AgentDriftInSync,
+		},
+		{
+			name:          "configuring",
+			agent:         &model.Agent{Status: model.Configuring},
+			configuration: "test",
+			desired:       desired,
+			effective:     modified,
+			expect:        model.AgentDriftPending,
+		},
+		{
+			name:          "disconnected",
+			agent:         &model.Agent{Status: model.Disconnected},
+			configuration: "test",
+			desired:       desired,
+			effective:     modified,
+			expect:        model.AgentDriftPending,
+		},
+		{
+			name:          "failed to apply",
+			agent:         &model.Agent{Status: model.Error},
+			configuration: "test",
+			desired:       desired,
+			effective:     modified,
+			expect:        model.AgentDriftDrifted,
+		},
+		{
+			name: "modified after sync",
+			agent: &model.Agent{
+				Status: model.Connected,
+				Drift:  &model.AgentDrift{Status: model.AgentDriftInSync, SyncedHash: configurationHash(desired)},
+			},
+			configuration: "test",
+			desired:       desired,
+			effective:     modified,
+			expect:        model.AgentDriftLocallyModified,
+		},
+		{
+			name: "changed on server after sync",
+			agent: &model.Agent{
+				Status: model.Connected,
+				Drift:  &model.AgentDrift{Status: model.AgentDriftInSync, SyncedHash: configurationHash(modified)},
+			},
+			configuration: "test",
+			desired:       desired,
+			effective:     modified,
+			expect:        model.AgentDriftDrifted,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			drift := classifyDrift(test.agent, test.configuration, test.desired, test.effective)
+			require.Equal(t, test.expect, drift.Status)
+			require.Equal(t, test.configuration, drift.Configuration)
+		})
+	}
+
+	t.Run("keeps time of last change", func(t *testing.T) {
+		updatedAt := time.Now().Add(-time.Hour)
+		agent := &model.Agent{Drift: &model.AgentDrift{Status: model.AgentDriftInSync, UpdatedAt: updatedAt}}
+		drift := classifyDrift(agent, "test", desired, desired)
+		require.Equal(t, updatedAt, drift.UpdatedAt)
+		require.Equal(t, configurationHash(desired), drift.SyncedHash)
+	})
+}
+
+func TestShouldRemediate(t *testing.T) {
+	connected := &model.Agent{Status: model.Connected}
+	failed := &model.Agent{Status: model.Error}
+	reloading := &model.Agent{Status: model.Connected, Commands: []*model.AgentCommand{model.NewAgentCommand("1", model.AgentCommandReloadConfig)}}
+
+	tests := []struct {
+		name   string
+		policy common.DriftRemediation
+		agent  *model.Agent
+		status model.AgentDriftStatus
+		expect bool
+	}{
+		{"default policy", "", connected, model.AgentDriftLocallyModified, false},
+		{"none", common.DriftRemediationNone, connected, model.AgentDriftLocallyModified, false},
+		{"locally modified", common.DriftRemediationLocallyModified, connected, model.AgentDriftLocallyModified, true},
+		{"locally modified ignores drifted", common.DriftRemediationLocallyModified, connected, model.AgentDriftDrifted, false},
+		{"all drifted", common.DriftRemediationAll, connected, model.AgentDriftDrifted, true},
+		{"all ignores pending", common.DriftRemediationAll, connected, model.AgentDriftPending, false},
+		{"agent with error", common.DriftRemediationAll, failed, model.AgentDriftDrifted, false},
+		{"reload in progress", common.DriftRemediationAll, reloading, model.AgentDriftLocallyModified, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expect, shouldRemediate(test.policy, test.agent, test.status))
+		})
+	}
+}
+
+func TestHandleDriftDetection(t *testing.T) {
+	managerTestReset()
+	defer func() { testManager.driftRemediation = "" }()
+	testManager.driftRemediation = common.DriftRemediationLocallyModified
+
+	configuration := makeTestConfiguration(t, "test", "configuration=test", "receivers:\n  hostmetrics:\n")
+	_, err := testMapstore.ApplyResources([]model.Resource{configuration})
+	require.NoError(t, err)
+
+	makeTestAgentWithLabels("A", "configuration=test")
+	_, err = testMapstore.UpsertAgent(context.TODO(), "A", func(agent *model.Agent) {
+		agent.Status = model.Connected
+		agent.Configuration = observiq.AgentConfiguration{Collector: "receivers:\n  hostmetrics:\n"}
+	})
+	require.NoError(t, err)
+	makeTestAgent("B")
+
+	testManager.handleDriftDetection()
+
+	agent, err := testMapstore.Agent("A")
+	require.NoError(t, err)
+	require.Equal(t, model.AgentDriftInSync, agent.DriftStatus())
+	agent, err = testMapstore.Agent("B")
+	require.NoError(t, err)
+	require.Equal(t, model.AgentDriftUnmanaged, agent.DriftStatus())
+
+	// modify the configuration on the agent
+	_, err = testMapstore.UpsertAgent(context.TODO(), "A", func(agent *model.Agent) {
+		agent.Configuration = observiq.AgentConfiguration{Collector: "receivers:\n  filelog:\n"}
+	})
+	require.NoError(t, err)
+
+	testProtocol.
+		On("Connected", "A").Return(true).
+		On("SendCommand", mock.Anything, mock.Anything, mock.MatchedBy(func(c *model.AgentCommand) bool {
+			return c.Type == model.AgentCommandReloadConfig
+		})).Return(nil)
+
+	testManager.handleDriftDetection()
+
+	agent, err = testMapstore.Agent("A")
+	require.NoError(t, err)
+	require.Equal(t, model.AgentDriftLocallyModified, agent.DriftStatus())
+	require.Len(t, agent.CommandsWithStatus(model.AgentCommandSent), 1)
+	testProtocol.AssertExpectations(t)
+
+	ids, err := search.Field(context.TODO(), testMapstore.AgentIndex(), "drift", "true")
+	require.NoError(t, err)
+	require.Equal(t, []string{"A"}, ids)
+
+	reports, err := testManager.AgentsDrift(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	for _, report := range reports {
+		if report.AgentID == "A" {
+			require.Contains(t, report.Diff, "-  hostmetrics:")
+			require.Contains(t, report.Diff, "+  filelog:")
+		}
+	}
+}
@@ -16,6 +16,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"math"
 	"sync"
 	"time"
@@ -24,7 +25,11 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/backup"
+	"github.com/observiq/bindplane-op/internal/catalog"
+	"github.com/observiq/bindplane-op/internal/diagnostics"
 	"github.com/observiq/bindplane-op/internal/eventbus"
+	"github.com/observiq/bindplane-op/internal/resourcesync"
 	"github.com/observiq/bindplane-op/internal/store"
 	"github.com/observiq/bindplane-op/model"
 )
@@ -38,6 +43,18 @@ const (
 	AgentCleanupTTL = 15 * time.Minute
 	// AgentHeartbeatInterval is the default interval for the heartbeat sent to the agent to keep the websocket live.
 	AgentHeartbeatInterval = 30 * time.Second
+	// AgentCommandTimeout is the time an agent has to complete a command after it has been sent.
+	AgentCommandTimeout = 5 * time.Minute
+	// AgentCommandQueueTimeout is the time a command will remain queued for an agent that is not connected.
+	AgentCommandQueueTimeout = 24 * time.Hour
+	// AgentCommandExpireInterval is the interval used to check for commands that have timed out.
+	AgentCommandExpireInterval = time.Minute
+	// DiagnosticsPruneInterval is the interval used to remove diagnostics bundles that have expired.
+	DiagnosticsPruneInterval = time.Hour
+	// DriftDetectionInterval is the interval used to compare the configuration of agents with their desired configuration.
+	DriftDetectionInterval = time.Minute
+	// ScheduledChangesInterval is the interval used to apply pending changes when the configuration schedule allows.
+	ScheduledChangesInterval = time.Minute
 )
 
 // Manager manages agent connects and communications with them
@@ -56,6 +73,27 @@ type Manager interface {
 	VerifySecretKey(ctx context.Context, secretKey string) bool
 	// ResourceStore provides access to the store to render configurations
 	ResourceStore() model.ResourceStore
+	// ExecuteAgentCommand queues a command for the agent and sends it immediately if the agent is connected. Agents that
+	// are not connected will receive the command when they connect.
+	ExecuteAgentCommand(ctx context.Context, agentID string, commandType model.AgentCommandType) (*model.AgentCommand, error)
+	// Diagnostics provides access to the diagnostics bundles collected from agents
+	Diagnostics() diagnostics.Store
+	// Catalog provides access to the resource type catalog or nil if no catalog is configured
+	Catalog() catalog.Catalog
+	// Backups provides access to backups of the store or nil if the store does not support backups
+	Backups() backup.Backups
+	// Migrations migrates the store to another store while the server is running
+	Migrations() store.Migrations
+	// Syncer syncs resources from a directory of resource yaml into the store
+	Syncer() resourcesync.Syncer
+	// AgentsDrift compares the effective configuration of the agents matching the options with their desired
+	// configuration and returns a report for each agent
+	AgentsDrift(ctx context.Context, options ...store.QueryOption) ([]*model.AgentDriftReport, error)
+	// AgentPendingChanges returns the configuration changes deferred by the schedule of the configuration for the agents
+	// matching the options
+	AgentPendingChanges(ctx context.Context, options ...store.QueryOption) ([]*model.AgentPendingChange, error)
+	// ApplyPendingChange applies the pending change of the agent without waiting for the schedule of the configuration
+	ApplyPendingChange(ctx context.Context, agentID string) (*model.AgentPendingChange, error)
 }
 
 // ----------------------------------------------------------------------
@@ -63,23 +101,73 @@ type Manager interface {
 type manager struct {
 	// agentCleanupTicker   *time.Ticker
 	// agentHeartbeatTicker *time.Ticker
-	store     store.Store
-	logger    *zap.Logger
-	protocols []Protocol
-	secretKey string
+	store            store.Store
+	diagnostics      diagnostics.Store
+	logger           *zap.Logger
+	protocols        []Protocol
+	secretKey        string
+	driftRemediation common.DriftRemediation
+	catalog          catalog.Catalog
+	backups          backup.Backups
+	migrations       store.Migrations
+	syncer           resourcesync.Syncer
 }
 
 var _ Manager = (*manager)(nil)
 
 // NewManager returns a new implementation of the Manager interface
-func NewManager(config *common.Server, store store.Store, logger *zap.Logger) (Manager, error) {
+func NewManager(config *common.Server, s store.Store, logger *zap.Logger) (Manager, error) {
+	var resourceTypeCatalog catalog.Catalog
+	if config.ResourceTypeCatalog != "" {
+		var err error
+		resourceTypeCatalog, err = catalog.NewCatalog(catalog.Settings{
+			URL:            config.ResourceTypeCatalog,
+			PublicKeyFile:  config.CatalogPublicKeyFile,
+			ConflictPolicy: config.CatalogConflictPolicy,
+			SyncInterval:   config.CatalogSyncInterval,
+			Store:          s,
+			Logger:         logger.Named("catalog"),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var backups backup.Backups
+	if source, ok := s.(backup.Source); ok {
+		backups = backup.NewBackups(backup.Settings{
+			Directory: config.BindPlaneBackupsPath(),
+			Interval:  config.BackupInterval,
+			Retention: config.BackupRetention,
+			Source:    source,
+			Logger:    logger.Named("backup"),
+		})
+	}
+
 	return &manager{
 		// agentCleanupTicker:   time.NewTicker(AgentCleanupInterval),
 		// agentHeartbeatTicker: time.NewTicker(AgentHeartbeatInterval),
-		store:     store,
-		logger:    logger,
-		protocols: []Protocol{},
-		secretKey: config.SecretKey,
+		store: s,
+		diagnostics: diagnostics.NewStore(diagnostics.Settings{
+			Directory:  config.BindPlaneDiagnosticsPath(),
+			MaxBundles: config.MaxDiagnosticsBundles,
+			MaxAge:     config.DiagnosticsRetention,
+			Logger:     logger.Named("diagnostics"),
+		}),
+		logger:           logger,
+		protocols:        []Protocol{},
+		secretKey:        config.SecretKey,
+		driftRemediation: config.DriftRemediation,
+		catalog:          resourceTypeCatalog,
+		backups:          backups,
+		migrations:       store.NewMigrations(s, config, logger.Named("migration")),
+		syncer: resourcesync.NewSyncer(resourcesync.Settings{
+			Directory: config.SyncDirectory,
+			Interval:  config.SyncInterval,
+			Prune:     config.SyncPrune,
+			Store:     s,
+			Logger:    logger.Named("sync"),
+		}),
 	}, nil
 }
 
@@ -92,6 +180,33 @@ func (m *manager) Start(ctx context.Context) {
 	updatesChannel, unsubscribe := eventbus.Subscribe(m.store.Updates(), eventbus.WithChannel(make(chan *store.Updates, 10_000)))
 	defer unsubscribe()
 
+	// periodic scans of the agents and other periodic work run in their own goroutines so that they do not delay the
+	// handling of updates or each other
+	runPeriodically(ctx, AgentCommandExpireInterval, m.handleAgentCommandTimeouts)
+	runPeriodically(ctx, DiagnosticsPruneInterval, func() {
+		if err := m.diagnostics.Prune(); err != nil {
+			m.logger.Error("unable to remove expired diagnostics bundles", zap.Error(err))
+		}
+	})
+	runPeriodically(ctx, DriftDetectionInterval, m.handleDriftDetection)
+	runPeriodically(ctx, ScheduledChangesInterval, m.handleScheduledChanges)
+
+	// the catalog is synced on start and periodically if it has a sync interval
+	if m.catalog != nil && m.catalog.SyncInterval() > 0 {
+		go m.handleCatalogSync(ctx)
+		runPeriodically(ctx, m.catalog.SyncInterval(), func() { m.handleCatalogSync(ctx) })
+	}
+
+	// the sync directory is synced on start and periodically if there is a sync directory
+	if m.syncer.Interval() > 0 {
+		go m.handleDirectorySync(ctx)
+		runPeriodically(ctx, m.syncer.Interval(), func() { m.handleDirectorySync(ctx) })
+	}
+
+	if m.backups != nil && m.backups.Interval() > 0 {
+		runPeriodically(ctx, m.backups.Interval(), func() { m.handleScheduledBackup(ctx) })
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -117,6 +232,23 @@ func (m *manager) Start(ctx context.Context) {
 	}
 }
 
+// runPeriodically calls handle every interval in a separate goroutine until the context is done. Calls do not overlap,
+// so a slow call delays the next call instead of running it concurrently.
+func runPeriodically(ctx context.Context, interval time.Duration, handle func()) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				handle()
+			}
+		}
+	}()
+}
+
 // helper for bookkeeping during updates
 type pendingAgentUpdate struct {
 	agent   *model.Agent
@@ -225,7 +357,7 @@ func (m *manager) handleUpdates(updates *store.Updates) {
 		if configuration, err := m.store.AgentConfiguration(agent.ID); err != nil {
 			m.logger.Error("unable to find new agent configuration", zap.String("agentID", agent.ID), zap.String("labels", agent.Labels.String()))
 		} else {
-			if configuration != nil {
+			if configuration = m.scheduledConfiguration(ctx, agent, configuration, time.Now()); configuration != nil {
 				m.logger.Info("updating configuration for agent with new labels", zap.String("agentID", agent.ID), zap.String("labels", agent.Labels.String()), zap.String("configuration.name", configuration.Name()))
 				pending.agent(agent).updates.Configuration = configuration
 			}
@@ -259,9 +391,9 @@ func (m *manager) handleUpdates(updates *store.Updates) {
 				// TODO(andy): we need a default configuration
 				// https://github.com/observIQ/bindplane/issues/279
 				// agentUpdates.Configuration = otel.EmptyConfig()
-			} else {
+			} else if scheduled := m.scheduledConfiguration(ctx, agent, configuration, time.Now()); scheduled != nil {
 				m.logger.Info("updating configuration for agent", zap.String("agentID", agent.ID))
-				pending.agent(agent).updates.Configuration = configuration
+				pending.agent(agent).updates.Configuration = scheduled
 			}
 		}
 
@@ -278,12 +410,14 @@ func (m *manager) UpsertAgent(ctx context.Context, agentID string, updater store
 	return m.store.UpsertAgent(ctx, agentID, updater)
 }
 
-// AgentUpdates returns the updates that should be applied to an agent based on the current bindplane configuration
+// AgentUpdates returns the updates that should be applied to an agent based on the current bindplane configuration. The
+// configuration is not included if the schedule of the configuration does not allow it to be applied.
 func (m *manager) AgentUpdates(ctx context.Context, agent *model.Agent) (*AgentUpdates, error) {
 	newConfiguration, err := m.store.AgentConfiguration(agent.ID)
 	if err != nil {
 		return nil, err
 	}
+	newConfiguration = m.scheduledConfiguration(ctx, agent, newConfiguration, time.Now())
 	newLabels := agent.Labels.Custom()
 	return &AgentUpdates{
 		Labels:        &newLabels,
@@ -302,6 +436,110 @@ func (m *manager) ResourceStore() model.ResourceStore {
 	return m.store
 }
 
+// Diagnostics provides access to the diagnostics bundles collected from agents
+func (m *manager) Diagnostics() diagnostics.Store {
+	return m.diagnostics
+}
+
+// Catalog provides access to the resource type catalog or nil if no catalog is configured
+func (m *manager) Catalog() catalog.Catalog {
+	return m.catalog
+}
+
+// Backups provides access to backups of the store or nil if the store does not support backups
+func (m *manager) Backups() backup.Backups {
+	return m.backups
+}
+
+// Migrations migrates the store to another store while the server is running
+func (m *manager) Migrations() store.Migrations {
+	return m.migrations
+}
+
+// Syncer syncs resources from a directory of resource yaml into the store
+func (m *manager) Syncer() resourcesync.Syncer {
+	return m.syncer
+}
+
+// ExecuteAgentCommand queues a command for the agent and sends it immediately if the agent is connected. Agents that
+// are not connected will receive the command when they connect.
+func (m *manager) ExecuteAgentCommand(ctx context.Context, agentID string, commandType model.AgentCommandType) (*model.AgentCommand, error) {
+	ctx, span := tracer.Start(ctx, "manager/ExecuteAgentCommand")
+	defer span.End()
+
+	agent, err := m.store.Agent(agentID)
+	if err != nil {
+		return nil, err
+	}
+	if agent == nil {
+		return nil, store.ErrResourceMissing
+	}
+
+	command := model.NewAgentCommand(agentID, commandType)
+	connected := m.connected(agentID)
+	if connected {
+		// mark the command as sent when it is added so that applyQueuedCommands will not also send it
+		command.SetStatus(model.AgentCommandSent, "")
+	}
+	agent, err = m.store.UpsertAgent(ctx, agentID, func(current *model.Agent) {
+		current.AddCommand(command)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !connected {
+		m.logger.Info("queued command for agent", zap.String("agentID", agentID), zap.String("command", string(commandType)))
+		return command, nil
+	}
+
+	status, message := m.sendAgentCommand(ctx, agent, command)
+	if status == model.AgentCommandSent {
+		return command, nil
+	}
+
+	agent, err = m.store.UpsertAgent(ctx, agentID, func(current *model.Agent) {
+		// the command is queued again if it could not be sent, unless the protocol has already advanced the status
+		if c := current.Command(command.ID); c != nil && c.Status == model.AgentCommandSent {
+			c.SetStatus(status, message)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if updated := agent.Command(command.ID); updated != nil {
+		return updated, nil
+	}
+	return command, nil
+}
+
+// handleAgentCommandTimeouts marks commands that have not completed in time as timed out
+func (m *manager) handleAgentCommandTimeouts() {
+	ctx, span := tracer.Start(context.TODO(), "manager/handleAgentCommandTimeouts")
+	defer span.End()
+
+	agents, err := m.store.Agents(ctx)
+	if err != nil {
+		m.logger.Error("unable to get agents to expire commands", zap.Error(err))
+		return
+	}
+
+	now := time.Now()
+	queuedBefore := now.Add(-AgentCommandQueueTimeout)
+	sentBefore := now.Add(-AgentCommandTimeout)
+	for _, agent := range agents {
+		if !agent.HasExpiredCommands(queuedBefore, sentBefore) {
+			continue
+		}
+		_, err := m.store.UpsertAgent(ctx, agent.ID, func(current *model.Agent) {
+			current.ExpireCommands(queuedBefore, sentBefore)
+		})
+		if err != nil {
+			m.logger.Error("unable to expire agent commands", zap.String("agentID", agent.ID), zap.Error(err))
+		}
+	}
+}
+
 // handleAgentCleanup removes disconnected agents from the store.
 func (m *manager) handleAgentCleanup() {
 	_, span := tracer.Start(context.TODO(), "manager/handleAgentCleanup")
@@ -378,3 +616,20 @@ func (m *manager) updateAgent(ctx context.Context, agent *model.Agent, updates *
 		}
 	}
 }
+
+// sendAgentCommand sends the command using the first protocol that is connected to the agent and returns the new status
+// of the command. The command remains queued if it could not be sent.
+func (m *manager) sendAgentCommand(ctx context.Context, agent *model.Agent, command *model.AgentCommand) (model.AgentCommandStatus, string) {
+	for _, p := range m.protocols {
+		err := p.SendCommand(ctx, agent, command)
+		switch {
+		case err == nil:
+			return model.AgentCommandSent, ""
+		case errors.Is(err, ErrCommandNotSupported):
+			return model.AgentCommandFailed, err.Error()
+		case !errors.Is(err, ErrAgentNotConnected):
+			m.logger.Error("unable to send command to agent", zap.String("agentID", agent.ID), zap.String("command", string(command.Type)), zap.Error(err))
+		}
+	}
+	return model.AgentCommandQueued, ""
+}
@@ -17,6 +17,7 @@ package server
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/observiq/bindplane-op/internal/store"
 	"github.com/observiq/bindplane-op/model"
@@ -236,6 +237,134 @@ func TestHandleUpdatesNewConfigurationAndLabels(t *testing.T) {
 	testProtocol.AssertExpectations(t)
 }
 
+func TestExecuteAgentCommand(t *testing.T) {
+	t.Run("missing agent", func(t *testing.T) {
+		managerTestReset()
+		_, err := testManager.ExecuteAgentCommand(context.TODO(), "missing", model.AgentCommandRestart)
+		require.ErrorIs(t, err, store.ErrResourceMissing)
+	})
+
+	t.Run("disconnected agent is queued", func(t *testing.T) {
+		managerTestReset()
+		testAgent := makeTestAgent("A")
+		testProtocol.On("Connected", testAgent.ID).Return(false)
+
+		command, err := testManager.ExecuteAgentCommand(context.TODO(), testAgent.ID, model.AgentCommandRestart)
+		require.NoError(t, err)
+		require.Equal(t, model.AgentCommandQueued, command.Status)
+
+		agent, err := testMapstore.Agent(testAgent.ID)
+		require.NoError(t, err)
+		require.Equal(t, []*model.AgentCommand{command}, agent.CommandsWithStatus(model.AgentCommandQueued))
+		testProtocol.AssertExpectations(t)
+	})
+
+	t.Run("connected agent is sent", func(t *testing.T) {
+		managerTestReset()
+		testAgent := makeTestAgent("A")
+		testProtocol.
+			On("Connected", testAgent.ID).Return(true).
+			On("SendCommand", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		command, err := testManager.ExecuteAgentCommand(context.TODO(), testAgent.ID, model.AgentCommandRestart)
+		require.NoError(t, err)
+		require.Equal(t, model.AgentCommandSent, command.Status)
+		testProtocol.AssertExpectations(t)
+	})
+
+	t.Run("command being sent is not queued", func(t *testing.T) {
+		managerTestReset()
+		testAgent := makeTestAgent("A")
+		testProtocol.
+			On("Connected", testAgent.ID).Return(true).
+			On("SendCommand", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			// applyQueuedCommands must not find the command while it is being sent
+			agent, err := testMapstore.Agent(testAgent.ID)
+			require.NoError(t, err)
+			require.Empty(t, agent.CommandsWithStatus(model.AgentCommandQueued))
+		})
+
+		command, err := testManager.ExecuteAgentCommand(context.TODO(), testAgent.ID, model.AgentCommandRestart)
+		require.NoError(t, err)
+		require.Equal(t, model.AgentCommandSent, command.Status)
+		testProtocol.AssertExpectations(t)
+	})
+
+	t.Run("command is queued if the agent disconnects", func(t *testing.T) {
+		managerTestReset()
+		testAgent := makeTestAgent("A")
+		testProtocol.
+			On("Connected", testAgent.ID).Return(true).
+			On("SendCommand", mock.Anything, mock.Anything, mock.Anything).Return(ErrAgentNotConnected)
+
+		command, err := testManager.ExecuteAgentCommand(context.TODO(), testAgent.ID, model.AgentCommandRestart)
+		require.NoError(t, err)
+		require.Equal(t, model.AgentCommandQueued, command.Status)
+
+		agent, err := testMapstore.Agent(testAgent.ID)
+		require.NoError(t, err)
+		require.Equal(t, []*model.AgentCommand{command}, agent.CommandsWithStatus(model.AgentCommandQueued))
+		testProtocol.AssertExpectations(t)
+	})
+
+	t.Run("unsupported command fails", func(t *testing.T) {
+		managerTestReset()
+		testAgent := makeTestAgent("A")
+		testProtocol.
+			On("Connected", testAgent.ID).Return(true).
+			On("SendCommand", mock.Anything, mock.Anything, mock.Anything).Return(ErrCommandNotSupported)
+
+		command, err := testManager.ExecuteAgentCommand(context.TODO(), testAgent.ID, model.AgentCommandCollectDiagnostics)
+		require.NoError(t, err)
+		require.Equal(t, model.AgentCommandFailed, command.Status)
+		require.Equal(t, ErrCommandNotSupported.Error(), command.Message)
+		testProtocol.AssertExpectations(t)
+	})
+}
+
+func TestHandleAgentCommandTimeouts(t *testing.T) {
+	managerTestReset()
+	expired := time.Now().Add(-2 * AgentCommandQueueTimeout)
+	_, err := testMapstore.UpsertAgent(context.TODO(), "A", func(agent *model.Agent) {
+		agent.AddCommand(&model.AgentCommand{ID: "1", Status: model.AgentCommandQueued, CreatedAt: expired, UpdatedAt: expired})
+		agent.AddCommand(&model.AgentCommand{ID: "2", Status: model.AgentCommandSent, CreatedAt: expired, UpdatedAt: expired})
+		agent.AddCommand(model.NewAgentCommand("A", model.AgentCommandRestart))
+	})
+	require.NoError(t, err)
+
+	testManager.handleAgentCommandTimeouts()
+
+	agent, err := testMapstore.Agent("A")
+	require.NoError(t, err)
+	require.Len(t, agent.CommandsWithStatus(model.AgentCommandTimedOut), 2)
+	require.Len(t, agent.CommandsWithStatus(model.AgentCommandQueued), 1)
+}
+
+func TestRunPeriodically(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// a slow scan does not delay other periodic work
+	block := make(chan struct{})
+	defer close(block)
+	runPeriodically(ctx, time.Millisecond, func() { <-block })
+
+	calls := make(chan struct{}, 1)
+	runPeriodically(ctx, time.Millisecond, func() {
+		select {
+		case calls <- struct{}{}:
+		default:
+		}
+	})
+	for i := 0; i < 3; i++ {
+		select {
+		case <-calls:
+		case <-time.After(time.Second):
+			require.Fail(t, "timed out waiting for periodic calls")
+		}
+	}
+}
+
 func TestManagerVerifySecretKey(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -382,3 +511,17 @@ func (_m *mockProtocol) UpdateAgent(_a0 context.Context, _a1 *model.Agent, _a2 *
 
 	return r0
 }
+
+// SendCommand provides a mock function with given fields: _a0, _a1, _a2
+func (_m *mockProtocol) SendCommand(_a0 context.Context, _a1 *model.Agent, _a2 *model.AgentCommand) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Agent, *model.AgentCommand) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
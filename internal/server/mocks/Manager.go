@@ -5,9 +5,17 @@ package mocks
 import (
 	context "context"
 
+	backup "github.com/observiq/bindplane-op/internal/backup"
+
+	catalog "github.com/observiq/bindplane-op/internal/catalog"
+
+	diagnostics "github.com/observiq/bindplane-op/internal/diagnostics"
+
 	model "github.com/observiq/bindplane-op/model"
 	mock "github.com/stretchr/testify/mock"
 
+	resourcesync "github.com/observiq/bindplane-op/internal/resourcesync"
+
 	server "github.com/observiq/bindplane-op/internal/server"
 
 	store "github.com/observiq/bindplane-op/internal/store"
@@ -43,6 +51,36 @@ func (_m *Manager) Agent(ctx context.Context, agentID string) (*model.Agent, err
 	return r0, r1
 }
 
+// AgentPendingChanges provides a mock function with given fields: ctx, options
+func (_m *Manager) AgentPendingChanges(ctx context.Context, options ...store.QueryOption) ([]*model.AgentPendingChange, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []*model.AgentPendingChange
+	if rf, ok := ret.Get(0).(func(context.Context, ...store.QueryOption) []*model.AgentPendingChange); ok {
+		r0 = rf(ctx, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AgentPendingChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...store.QueryOption) error); ok {
+		r1 = rf(ctx, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AgentUpdates provides a mock function with given fields: ctx, agent
 func (_m *Manager) AgentUpdates(ctx context.Context, agent *model.Agent) (*server.AgentUpdates, error) {
 	ret := _m.Called(ctx, agent)
@@ -66,11 +104,151 @@ func (_m *Manager) AgentUpdates(ctx context.Context, agent *model.Agent) (*serve
 	return r0, r1
 }
 
+// AgentsDrift provides a mock function with given fields: ctx, options
+func (_m *Manager) AgentsDrift(ctx context.Context, options ...store.QueryOption) ([]*model.AgentDriftReport, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []*model.AgentDriftReport
+	if rf, ok := ret.Get(0).(func(context.Context, ...store.QueryOption) []*model.AgentDriftReport); ok {
+		r0 = rf(ctx, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AgentDriftReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...store.QueryOption) error); ok {
+		r1 = rf(ctx, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyPendingChange provides a mock function with given fields: ctx, agentID
+func (_m *Manager) ApplyPendingChange(ctx context.Context, agentID string) (*model.AgentPendingChange, error) {
+	ret := _m.Called(ctx, agentID)
+
+	var r0 *model.AgentPendingChange
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.AgentPendingChange); ok {
+		r0 = rf(ctx, agentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AgentPendingChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, agentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backups provides a mock function with given fields:
+func (_m *Manager) Backups() backup.Backups {
+	ret := _m.Called()
+
+	var r0 backup.Backups
+	if rf, ok := ret.Get(0).(func() backup.Backups); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(backup.Backups)
+		}
+	}
+
+	return r0
+}
+
+// Catalog provides a mock function with given fields:
+func (_m *Manager) Catalog() catalog.Catalog {
+	ret := _m.Called()
+
+	var r0 catalog.Catalog
+	if rf, ok := ret.Get(0).(func() catalog.Catalog); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(catalog.Catalog)
+		}
+	}
+
+	return r0
+}
+
+// Diagnostics provides a mock function with given fields:
+func (_m *Manager) Diagnostics() diagnostics.Store {
+	ret := _m.Called()
+
+	var r0 diagnostics.Store
+	if rf, ok := ret.Get(0).(func() diagnostics.Store); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(diagnostics.Store)
+		}
+	}
+
+	return r0
+}
+
 // EnableProtocol provides a mock function with given fields: _a0
 func (_m *Manager) EnableProtocol(_a0 server.Protocol) {
 	_m.Called(_a0)
 }
 
+// ExecuteAgentCommand provides a mock function with given fields: ctx, agentID, commandType
+func (_m *Manager) ExecuteAgentCommand(ctx context.Context, agentID string, commandType model.AgentCommandType) (*model.AgentCommand, error) {
+	ret := _m.Called(ctx, agentID, commandType)
+
+	var r0 *model.AgentCommand
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.AgentCommandType) *model.AgentCommand); ok {
+		r0 = rf(ctx, agentID, commandType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AgentCommand)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.AgentCommandType) error); ok {
+		r1 = rf(ctx, agentID, commandType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Migrations provides a mock function with given fields:
+func (_m *Manager) Migrations() store.Migrations {
+	ret := _m.Called()
+
+	var r0 store.Migrations
+	if rf, ok := ret.Get(0).(func() store.Migrations); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.Migrations)
+		}
+	}
+
+	return r0
+}
+
 // ResourceStore provides a mock function with given fields:
 func (_m *Manager) ResourceStore() model.ResourceStore {
 	ret := _m.Called()
@@ -92,6 +270,22 @@ func (_m *Manager) Start(ctx context.Context) {
 	_m.Called(ctx)
 }
 
+// Syncer provides a mock function with given fields:
+func (_m *Manager) Syncer() resourcesync.Syncer {
+	ret := _m.Called()
+
+	var r0 resourcesync.Syncer
+	if rf, ok := ret.Get(0).(func() resourcesync.Syncer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(resourcesync.Syncer)
+		}
+	}
+
+	return r0
+}
+
 // UpsertAgent provides a mock function with given fields: ctx, agentID, updater
 func (_m *Manager) UpsertAgent(ctx context.Context, agentID string, updater store.AgentUpdater) (*model.Agent, error) {
 	ret := _m.Called(ctx, agentID, updater)
@@ -16,10 +16,17 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	"github.com/observiq/bindplane-op/model"
 )
 
+// ErrCommandNotSupported is returned by Protocol.SendCommand when the protocol or agent does not support the command
+var ErrCommandNotSupported = errors.New("command not supported")
+
+// ErrAgentNotConnected is returned by Protocol.SendCommand when the agent is not connected using the protocol
+var ErrAgentNotConnected = errors.New("agent not connected")
+
 // AgentUpdates contains fields that can be modified on an Agent and should be sent to the agent. The model.Agent should
 // not be updated directly and will be updated when the agent reports its new status after the update is complete.
 type AgentUpdates struct {
@@ -49,6 +56,11 @@ type Protocol interface {
 
 	// SendHeartbeat sends a heartbeat to the agent to keep the websocket open
 	SendHeartbeat(agentID string) error
+
+	// SendCommand should send the command to the specified agent. It returns ErrCommandNotSupported if the command
+	// cannot be sent to this agent and ErrAgentNotConnected if the agent is not connected. The status of the command is
+	// updated by the caller.
+	SendCommand(context.Context, *model.Agent, *model.AgentCommand) error
 }
 
 // Empty returns true if the updates are empty because no changes need to be made to the agent
@@ -0,0 +1,187 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+	"github.com/observiq/bindplane-op/model/observiq"
+)
+
+// ErrNoPendingChange is returned by ApplyPendingChange when the agent does not have a pending change
+var ErrNoPendingChange = errors.New("agent does not have a pending change")
+
+// scheduledConfiguration returns the configuration if the schedule of the configuration allows it to be applied to the
+// agent at the specified time. Otherwise the change is recorded as pending on the agent and nil is returned.
+func (m *manager) scheduledConfiguration(ctx context.Context, agent *model.Agent, configuration *model.Configuration, now time.Time) *model.Configuration {
+	if configuration == nil {
+		return nil
+	}
+
+	next := configuration.Spec.Schedule.NextActivation(agent.Labels, now)
+	if !next.After(now) {
+		m.clearPendingChange(ctx, agent)
+		return configuration
+	}
+	if agent.Status == model.Configuring {
+		// a change is already being applied
+		return configuration
+	}
+
+	// agents that already have the configuration do not have a pending change
+	desired, err := configuration.Render(ctx, m.store)
+	if err != nil {
+		m.logger.Error("unable to render configuration to check for pending changes", zap.String("configuration.name", configuration.Name()), zap.Error(err))
+		return configuration
+	}
+	if agent.Configuration != nil {
+		if current, err := observiq.DecodeAgentConfiguration(agent.Configuration); err == nil && current.Collector == desired {
+			m.clearPendingChange(ctx, agent)
+			return configuration
+		}
+	}
+
+	pending := agent.PendingChange
+	if pending != nil && pending.Forced && pending.Configuration == configuration.Name() {
+		return configuration
+	}
+	if pending != nil && pending.Configuration == configuration.Name() && pending.NotBefore.Equal(next) {
+		return nil
+	}
+
+	m.logger.Info("deferring configuration change for agent", zap.String("agentID", agent.ID), zap.String("configuration.name", configuration.Name()), zap.Time("notBefore", next))
+	change := &model.AgentPendingChange{
+		AgentID:       agent.ID,
+		Configuration: configuration.Name(),
+		NotBefore:     next,
+		CreatedAt:     now,
+	}
+	if pending != nil && pending.Configuration == change.Configuration {
+		change.CreatedAt = pending.CreatedAt
+	}
+	_, err = m.store.UpsertAgent(ctx, agent.ID, func(current *model.Agent) {
+		current.PendingChange = change
+	})
+	if err != nil {
+		m.logger.Error("unable to record pending change for agent", zap.String("agentID", agent.ID), zap.Error(err))
+	}
+	return nil
+}
+
+func (m *manager) clearPendingChange(ctx context.Context, agent *model.Agent) {
+	if agent.PendingChange == nil {
+		return
+	}
+	_, err := m.store.UpsertAgent(ctx, agent.ID, func(current *model.Agent) {
+		current.PendingChange = nil
+	})
+	if err != nil {
+		m.logger.Error("unable to clear pending change for agent", zap.String("agentID", agent.ID), zap.Error(err))
+	}
+}
+
+// handleScheduledChanges applies the pending changes of connected agents when the schedule of the configuration allows
+func (m *manager) handleScheduledChanges() {
+	ctx, span := tracer.Start(context.TODO(), "manager/handleScheduledChanges")
+	defer span.End()
+
+	agents, err := m.store.Agents(ctx)
+	if err != nil {
+		m.logger.Error("unable to get agents to apply pending changes", zap.Error(err))
+		return
+	}
+
+	now := time.Now()
+	for _, agent := range agents {
+		if agent.PendingChange == nil || agent.PendingChange.NotBefore.After(now) {
+			continue
+		}
+		configuration, err := m.store.AgentConfiguration(agent.ID)
+		if err != nil {
+			m.logger.Error("unable to find agent configuration to apply pending change", zap.String("agentID", agent.ID), zap.Error(err))
+			continue
+		}
+		if configuration == nil {
+			m.clearPendingChange(ctx, agent)
+			continue
+		}
+		// the configuration may have changed since the change was deferred, so the schedule is checked again
+		if configuration = m.scheduledConfiguration(ctx, agent, configuration, now); configuration == nil {
+			continue
+		}
+		if m.connected(agent.ID) {
+			m.logger.Info("applying pending change for agent", zap.String("agentID", agent.ID), zap.String("configuration.name", configuration.Name()))
+			m.updateAgent(ctx, agent, &AgentUpdates{Configuration: configuration})
+		}
+	}
+}
+
+// AgentPendingChanges returns the pending changes of the agents matching the options
+func (m *manager) AgentPendingChanges(ctx context.Context, options ...store.QueryOption) ([]*model.AgentPendingChange, error) {
+	agents, err := m.store.Agents(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	changes := []*model.AgentPendingChange{}
+	for _, agent := range agents {
+		if agent.PendingChange != nil {
+			changes = append(changes, agent.PendingChange)
+		}
+	}
+	return changes, nil
+}
+
+// ApplyPendingChange applies the pending change of the agent without waiting for the schedule of the configuration.
+// Agents that are not connected will receive the change when they connect.
+func (m *manager) ApplyPendingChange(ctx context.Context, agentID string) (*model.AgentPendingChange, error) {
+	ctx, span := tracer.Start(ctx, "manager/ApplyPendingChange")
+	defer span.End()
+
+	agent, err := m.store.Agent(agentID)
+	if err != nil {
+		return nil, err
+	}
+	if agent == nil {
+		return nil, store.ErrResourceMissing
+	}
+	if agent.PendingChange == nil {
+		return nil, ErrNoPendingChange
+	}
+
+	configuration, err := m.store.AgentConfiguration(agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	agent, err = m.store.UpsertAgent(ctx, agentID, func(current *model.Agent) {
+		if current.PendingChange != nil {
+			current.PendingChange.Forced = true
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if configuration != nil && m.connected(agentID) {
+		m.updateAgent(ctx, agent, &AgentUpdates{Configuration: configuration})
+	}
+	return agent.PendingChange, nil
+}
@@ -0,0 +1,114 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestScheduledConfiguration(t *testing.T) {
+	managerTestReset()
+	activateAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	testAgent := makeTestAgentWithLabels("A", "configuration=test")
+	configuration := makeTestConfiguration(t, "test", "configuration=test", "raw:")
+	configuration.Spec.Schedule = &model.ConfigurationSchedule{ActivateAt: &activateAt}
+	_, err := testMapstore.ApplyResources([]model.Resource{configuration})
+	require.NoError(t, err)
+
+	// the change is deferred
+	updates := store.NewUpdates()
+	updates.Configurations.Include(configuration, store.EventTypeUpdate)
+	testProtocol.
+		On("Connected", testAgent.ID).Return(true).
+		// the agent index is not cleared between tests
+		On("Connected", mock.Anything).Return(false)
+	testManager.handleUpdates(updates)
+	testProtocol.AssertNotCalled(t, "UpdateAgent", mock.Anything, mock.Anything, mock.Anything)
+
+	agent, err := testMapstore.Agent(testAgent.ID)
+	require.NoError(t, err)
+	require.NotNil(t, agent.PendingChange)
+	require.Equal(t, "test", agent.PendingChange.Configuration)
+	require.True(t, activateAt.Equal(agent.PendingChange.NotBefore))
+
+	agentUpdates, err := testManager.AgentUpdates(context.TODO(), agent)
+	require.NoError(t, err)
+	require.Nil(t, agentUpdates.Configuration, "agents that connect do not receive deferred changes")
+
+	changes, err := testManager.AgentPendingChanges(context.TODO())
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+
+	// force the change
+	testProtocol.On("UpdateAgent", mock.Anything, mock.Anything, mock.MatchedBy(func(u *AgentUpdates) bool {
+		return u.Configuration != nil && u.Configuration.Name() == "test"
+	})).Return(nil).Once()
+	change, err := testManager.ApplyPendingChange(context.TODO(), testAgent.ID)
+	require.NoError(t, err)
+	require.True(t, change.Forced)
+	testProtocol.AssertExpectations(t)
+
+	agent, err = testMapstore.Agent(testAgent.ID)
+	require.NoError(t, err)
+	agentUpdates, err = testManager.AgentUpdates(context.TODO(), agent)
+	require.NoError(t, err)
+	require.NotNil(t, agentUpdates.Configuration, "forced changes are not deferred")
+}
+
+func TestHandleScheduledChanges(t *testing.T) {
+	managerTestReset()
+	activateAt := time.Now().Add(-time.Minute)
+
+	makeTestAgentWithLabels("A", "configuration=test")
+	configuration := makeTestConfiguration(t, "test", "configuration=test", "raw:")
+	configuration.Spec.Schedule = &model.ConfigurationSchedule{ActivateAt: &activateAt}
+	_, err := testMapstore.ApplyResources([]model.Resource{configuration})
+	require.NoError(t, err)
+
+	_, err = testMapstore.UpsertAgent(context.TODO(), "A", func(agent *model.Agent) {
+		agent.PendingChange = &model.AgentPendingChange{AgentID: "A", Configuration: "test", NotBefore: activateAt}
+	})
+	require.NoError(t, err)
+
+	testProtocol.
+		On("Connected", "A").Return(true).
+		On("UpdateAgent", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	testManager.handleScheduledChanges()
+
+	agent, err := testMapstore.Agent("A")
+	require.NoError(t, err)
+	require.Nil(t, agent.PendingChange)
+	testProtocol.AssertExpectations(t)
+}
+
+func TestApplyPendingChangeErrors(t *testing.T) {
+	managerTestReset()
+	_, err := testManager.ApplyPendingChange(context.TODO(), "missing")
+	require.ErrorIs(t, err, store.ErrResourceMissing)
+
+	makeTestAgent("A")
+	_, err = testManager.ApplyPendingChange(context.TODO(), "A")
+	require.ErrorIs(t, err, ErrNoPendingChange)
+}
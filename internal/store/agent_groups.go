@@ -0,0 +1,79 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"fmt"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+// AgentGroupMembers returns the agents that are members of the specified AgentGroup. Membership is computed from the
+// AgentIndex of the store.
+func AgentGroupMembers(s Store, group *model.AgentGroup) ([]*model.Agent, error) {
+	agents := []*model.Agent{}
+	for _, id := range group.AgentIDs(s.AgentIndex()) {
+		agent, err := s.Agent(id)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get agent [%s] of group [%s]: %w", id, group.Name(), err)
+		}
+		if agent != nil {
+			agents = append(agents, agent)
+		}
+	}
+	model.SortAgentsByName(agents)
+	return agents, nil
+}
+
+// isConfigurationForAgent returns true if the selector of the configuration matches the agent and the agent is a
+// member of the AgentGroup of the configuration, if one is specified.
+func isConfigurationForAgent(s Store, configuration *model.Configuration, agent *model.Agent) (bool, error) {
+	if !configuration.IsForAgent(agent) {
+		return false, nil
+	}
+	if configuration.Spec.AgentGroup == "" {
+		return true, nil
+	}
+	group, err := s.AgentGroup(configuration.Spec.AgentGroup)
+	if err != nil || group == nil {
+		return false, err
+	}
+	return group.IsMember(agent, s.AgentIndex()), nil
+}
+
+// agentsIDsMatchingConfiguration returns the IDs of the agents that match the selector of the configuration and are
+// members of the AgentGroup of the configuration, if one is specified.
+func agentsIDsMatchingConfiguration(s Store, configuration *model.Configuration) ([]string, error) {
+	ids := s.AgentIndex().Select(configuration.Spec.Selector.MatchLabels)
+	if configuration.Spec.AgentGroup == "" {
+		return ids, nil
+	}
+	group, err := s.AgentGroup(configuration.Spec.AgentGroup)
+	if err != nil || group == nil {
+		// a configuration for a group that doesn't exist doesn't apply to any agents
+		return []string{}, err
+	}
+	members := map[string]bool{}
+	for _, id := range group.AgentIDs(s.AgentIndex()) {
+		members[id] = true
+	}
+	result := []string{}
+	for _, id := range ids {
+		if members[id] {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -35,9 +36,10 @@ import (
 
 // bucket names
 const (
-	bucketResources = "Resources"
-	bucketTasks     = "Tasks"
-	bucketAgents    = "Agents"
+	bucketResources            = "Resources"
+	bucketTasks                = "Tasks"
+	bucketAgents               = "Agents"
+	bucketResourceTypeVersions = "ResourceTypeVersions"
 )
 
 type boltstore struct {
@@ -70,10 +72,14 @@ func NewBoltStore(ctx context.Context, db *bbolt.DB, options Options, logger *za
 	return store
 }
 
+// boltOpenTimeout is the maximum time to wait for the lock on the storage file, which is held by another process such
+// as a running server
+const boltOpenTimeout = 10 * time.Second
+
 // InitDB takes in the full path to a storage file and returns an opened bbolt database.
-// It will return an error if the file cannot be opened.
+// It will return an error if the file cannot be opened or is locked by another process.
 func InitDB(storageFilePath string) (*bbolt.DB, error) {
-	var db, err = bbolt.Open(storageFilePath, 0640, nil)
+	var db, err = bbolt.Open(storageFilePath, 0640, &bbolt.Options{Timeout: boltOpenTimeout})
 	if err != nil {
 		return nil, fmt.Errorf("error while opening bbolt storage file: %s, %w", storageFilePath, err)
 	}
@@ -82,6 +88,7 @@ func InitDB(storageFilePath string) (*bbolt.DB, error) {
 		bucketResources,
 		bucketTasks,
 		bucketAgents,
+		bucketResourceTypeVersions,
 	}
 
 	// make sure buckets exists, errors are ignored here because bucket names are
@@ -113,10 +120,10 @@ func (s *boltstore) AgentConfiguration(agentID string) (*model.Configuration, er
 		return s.Configuration(configurationName)
 	}
 
-	var result *model.Configuration
+	var candidates []*model.Configuration
 
 	err = s.db.View(func(tx *bbolt.Tx) error {
-		// iterate over the configurations looking for one that applies
+		// iterate over the configurations looking for those with a selector that applies
 		prefix := []byte(model.KindConfiguration)
 		cursor := resourcesBucket(tx).Cursor()
 
@@ -127,8 +134,7 @@ func (s *boltstore) AgentConfiguration(agentID string) (*model.Configuration, er
 				continue
 			}
 			if configuration.IsForAgent(agent) {
-				result = configuration
-				break
+				candidates = append(candidates, configuration)
 			}
 		}
 		return nil
@@ -138,13 +144,23 @@ func (s *boltstore) AgentConfiguration(agentID string) (*model.Configuration, er
 		return nil, fmt.Errorf("unable to retrieve agent configuration: %w", err)
 	}
 
-	return result, nil
+	// agent group membership is checked outside of the transaction because it reads the group from the store
+	for _, configuration := range candidates {
+		matches, err := isConfigurationForAgent(s, configuration, agent)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve agent configuration: %w", err)
+		}
+		if matches {
+			return configuration, nil
+		}
+	}
+
+	return nil, nil
 }
 
 // AgentsIDsMatchingConfiguration returns the list of agent IDs that are using the specified configuration
 func (s *boltstore) AgentsIDsMatchingConfiguration(configuration *model.Configuration) ([]string, error) {
-	ids := s.AgentIndex().Select(configuration.Spec.Selector.MatchLabels)
-	return ids, nil
+	return agentsIDsMatchingConfiguration(s, configuration)
 }
 
 func (s *boltstore) Updates() eventbus.Source[*Updates] {
@@ -167,6 +183,7 @@ func (s *boltstore) DeleteResources(resources []model.Resource) ([]model.Resourc
 			continue
 		}
 
+		empty.SetResourceVersion(r.ResourceVersion())
 		deleted, exists, err := deleteResource(s, r.GetKind(), r.Name(), empty)
 
 		switch err.(type) {
@@ -176,6 +193,12 @@ func (s *boltstore) DeleteResources(resources []model.Resource) ([]model.Resourc
 				*model.NewResourceStatusWithReason(r, model.StatusInUse, err.Error()))
 			continue
 
+		case *ConflictError:
+			deleteStatuses = append(
+				deleteStatuses,
+				*model.NewResourceStatusWithReason(r, model.StatusConflict, err.Error()))
+			continue
+
 		case nil:
 			break
 
@@ -216,24 +239,50 @@ func (s *boltstore) ApplyResources(resources []model.Resource) ([]model.Resource
 			continue
 		}
 
+		// the resourceVersion is assigned to a copy of the resource
+		stored, err := storedCopy(resource)
+		if err != nil {
+			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(resource, model.StatusInvalid, err.Error()))
+			continue
+		}
+
 		err = s.db.Update(func(tx *bbolt.Tx) error {
+			// reject the resource if it was modified since it was read
+			if err := checkResourceVersionTx(tx, stored); err != nil {
+				status := statusOf(err)
+				resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(stored, status, err.Error()))
+				if status == model.StatusConflict {
+					// conflicts are reported in the status and are not errors
+					return nil
+				}
+				return err
+			}
+
+			// keep the previous version of a resource type that is replaced by a different version
+			if updated := model.ResourceTypeOf(stored); updated != nil {
+				if err := archiveResourceTypeTx(tx, stored.GetKind(), updated); err != nil {
+					resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(stored, model.StatusError, err.Error()))
+					return err
+				}
+			}
+
 			// update the resource in the database
-			status, err := upsertResource(tx, resource, resource.GetKind())
+			status, err := upsertResource(tx, stored, stored.GetKind())
 			if err != nil {
-				resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(resource, model.StatusError, err.Error()))
+				resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(stored, model.StatusError, err.Error()))
 				return err
 			}
-			resourceStatuses = append(resourceStatuses, *model.NewResourceStatus(resource, status))
+			resourceStatuses = append(resourceStatuses, *model.NewResourceStatus(stored, status))
 
 			switch status {
 			case model.StatusCreated:
-				updates.IncludeResource(resource, EventTypeInsert)
+				updates.IncludeResource(stored, EventTypeInsert)
 			case model.StatusConfigured:
-				updates.IncludeResource(resource, EventTypeUpdate)
+				updates.IncludeResource(stored, EventTypeUpdate)
 			}
 
 			// some resources need special treatment
-			switch r := resource.(type) {
+			switch r := stored.(type) {
 			case *model.Configuration:
 				// update the index
 				err = s.configurationIndex.Upsert(r)
@@ -246,6 +295,9 @@ func (s *boltstore) ApplyResources(resources []model.Resource) ([]model.Resource
 		if err != nil {
 			errs = multierror.Append(errs, err)
 		}
+
+		// the applied resource keeps the id of the stored resource
+		resource.SetID(stored.ID())
 	}
 
 	s.notify(updates)
@@ -278,12 +330,14 @@ func (s *boltstore) Clear() {
 		_ = tx.DeleteBucket([]byte(bucketResources))
 		_ = tx.DeleteBucket([]byte(bucketTasks))
 		_ = tx.DeleteBucket([]byte(bucketAgents))
+		_ = tx.DeleteBucket([]byte(bucketResourceTypeVersions))
 
 		// create them again
 		// Disregarding errors because bucket names are valid.
 		_, _ = tx.CreateBucketIfNotExists([]byte(bucketResources))
 		_, _ = tx.CreateBucketIfNotExists([]byte(bucketTasks))
 		_, _ = tx.CreateBucketIfNotExists([]byte(bucketAgents))
+		_, _ = tx.CreateBucketIfNotExists([]byte(bucketResourceTypeVersions))
 		return nil
 	})
 }
@@ -364,7 +418,14 @@ func (s *boltstore) Agents(ctx context.Context, options ...QueryOption) ([]*mode
 		if err != nil {
 			return nil, err
 		}
-		return s.agentsByID(ids, opts)
+		agents, err := s.agentsByID(ids, opts)
+		if err != nil {
+			return nil, err
+		}
+		if opts.sort == "" {
+			opts.sort = defaultSort
+		}
+		return applySortOffsetAndLimit(agents, opts, agentField)
 	}
 
 	agents := []*model.Agent{}
@@ -394,14 +455,7 @@ func (s *boltstore) Agents(ctx context.Context, options ...QueryOption) ([]*mode
 	if opts.sort == "" {
 		opts.sort = "name"
 	}
-	return applySortOffsetAndLimit(agents, opts, func(field string, item *model.Agent) string {
-		switch field {
-		case "id":
-			return item.ID
-		default:
-			return item.Name
-		}
-	}), nil
+	return applySortOffsetAndLimit(agents, opts, agentField)
 }
 
 func (s *boltstore) DeleteAgents(ctx context.Context, agentIDs []string) ([]*model.Agent, error) {
@@ -513,12 +567,18 @@ func (s *boltstore) Configurations(options ...QueryOption) ([]*model.Configurati
 		if err != nil {
 			return nil, err
 		}
-		return resourcesByName[*model.Configuration](s, model.KindConfiguration, names, opts)
+		configurations, err := resourcesByName[*model.Configuration](s, model.KindConfiguration, names, opts)
+		if err != nil {
+			return configurations, err
+		}
+		return applyResourceQueryOptions(configurations, opts)
 	}
 
-	return resourcesWithFilter(s, model.KindConfiguration, func(c *model.Configuration) bool {
-		return opts.selector.Matches(c.GetLabels())
-	})
+	configurations, err := resources[*model.Configuration](s, model.KindConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(configurations, opts)
 }
 func (s *boltstore) Configuration(name string) (*model.Configuration, error) {
 	item, exists, err := resource[*model.Configuration](s, model.KindConfiguration, name)
@@ -542,8 +602,12 @@ func (s *boltstore) Source(name string) (*model.Source, error) {
 	}
 	return item, err
 }
-func (s *boltstore) Sources() ([]*model.Source, error) {
-	return resources[*model.Source](s, model.KindSource)
+func (s *boltstore) Sources(options ...QueryOption) ([]*model.Source, error) {
+	items, err := resources[*model.Source](s, model.KindSource)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
 }
 func (s *boltstore) DeleteSource(name string) (*model.Source, error) {
 	item, exists, err := deleteResourceAndNotify(s, model.KindSource, name, &model.Source{})
@@ -560,8 +624,12 @@ func (s *boltstore) SourceType(name string) (*model.SourceType, error) {
 	}
 	return item, err
 }
-func (s *boltstore) SourceTypes() ([]*model.SourceType, error) {
-	return resources[*model.SourceType](s, model.KindSourceType)
+func (s *boltstore) SourceTypes(options ...QueryOption) ([]*model.SourceType, error) {
+	items, err := resources[*model.SourceType](s, model.KindSourceType)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
 }
 func (s *boltstore) DeleteSourceType(name string) (*model.SourceType, error) {
 	item, exists, err := deleteResourceAndNotify(s, model.KindSourceType, name, &model.SourceType{})
@@ -578,8 +646,12 @@ func (s *boltstore) Processor(name string) (*model.Processor, error) {
 	}
 	return item, err
 }
-func (s *boltstore) Processors() ([]*model.Processor, error) {
-	return resources[*model.Processor](s, model.KindProcessor)
+func (s *boltstore) Processors(options ...QueryOption) ([]*model.Processor, error) {
+	items, err := resources[*model.Processor](s, model.KindProcessor)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
 }
 func (s *boltstore) DeleteProcessor(name string) (*model.Processor, error) {
 	item, exists, err := deleteResourceAndNotify(s, model.KindProcessor, name, &model.Processor{})
@@ -596,8 +668,12 @@ func (s *boltstore) ProcessorType(name string) (*model.ProcessorType, error) {
 	}
 	return item, err
 }
-func (s *boltstore) ProcessorTypes() ([]*model.ProcessorType, error) {
-	return resources[*model.ProcessorType](s, model.KindProcessorType)
+func (s *boltstore) ProcessorTypes(options ...QueryOption) ([]*model.ProcessorType, error) {
+	items, err := resources[*model.ProcessorType](s, model.KindProcessorType)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
 }
 func (s *boltstore) DeleteProcessorType(name string) (*model.ProcessorType, error) {
 	item, exists, err := deleteResourceAndNotify(s, model.KindProcessorType, name, &model.ProcessorType{})
@@ -614,8 +690,12 @@ func (s *boltstore) Destination(name string) (*model.Destination, error) {
 	}
 	return item, err
 }
-func (s *boltstore) Destinations() ([]*model.Destination, error) {
-	return resources[*model.Destination](s, model.KindDestination)
+func (s *boltstore) Destinations(options ...QueryOption) ([]*model.Destination, error) {
+	items, err := resources[*model.Destination](s, model.KindDestination)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
 }
 func (s *boltstore) DeleteDestination(name string) (*model.Destination, error) {
 	item, exists, err := deleteResourceAndNotify(s, model.KindDestination, name, &model.Destination{})
@@ -632,8 +712,12 @@ func (s *boltstore) DestinationType(name string) (*model.DestinationType, error)
 	}
 	return item, err
 }
-func (s *boltstore) DestinationTypes() ([]*model.DestinationType, error) {
-	return resources[*model.DestinationType](s, model.KindDestinationType)
+func (s *boltstore) DestinationTypes(options ...QueryOption) ([]*model.DestinationType, error) {
+	items, err := resources[*model.DestinationType](s, model.KindDestinationType)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
 }
 func (s *boltstore) DeleteDestinationType(name string) (*model.DestinationType, error) {
 	item, exists, err := deleteResourceAndNotify(s, model.KindDestinationType, name, &model.DestinationType{})
@@ -643,6 +727,106 @@ func (s *boltstore) DeleteDestinationType(name string) (*model.DestinationType,
 	return item, err
 }
 
+func (s *boltstore) Connector(name string) (*model.Connector, error) {
+	item, exists, err := resource[*model.Connector](s, model.KindConnector, name)
+	if !exists {
+		item = nil
+	}
+	return item, err
+}
+func (s *boltstore) Connectors(options ...QueryOption) ([]*model.Connector, error) {
+	items, err := resources[*model.Connector](s, model.KindConnector)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
+}
+func (s *boltstore) DeleteConnector(name string) (*model.Connector, error) {
+	item, exists, err := deleteResourceAndNotify(s, model.KindConnector, name, &model.Connector{})
+	if !exists {
+		return nil, err
+	}
+	return item, err
+}
+
+func (s *boltstore) ConnectorType(name string) (*model.ConnectorType, error) {
+	item, exists, err := resource[*model.ConnectorType](s, model.KindConnectorType, name)
+	if !exists {
+		item = nil
+	}
+	return item, err
+}
+func (s *boltstore) ConnectorTypes(options ...QueryOption) ([]*model.ConnectorType, error) {
+	items, err := resources[*model.ConnectorType](s, model.KindConnectorType)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
+}
+func (s *boltstore) DeleteConnectorType(name string) (*model.ConnectorType, error) {
+	item, exists, err := deleteResourceAndNotify(s, model.KindConnectorType, name, &model.ConnectorType{})
+	if !exists {
+		return nil, err
+	}
+	return item, err
+}
+
+func (s *boltstore) ResourceTypeVersion(kind model.Kind, name string, version string) (*model.ResourceType, error) {
+	return resourceTypeVersion(s, kind, name, version, func(key string) (resourceType *model.ResourceType, err error) {
+		err = s.db.View(func(tx *bbolt.Tx) error {
+			data := resourceTypeVersionsBucket(tx).Get([]byte(key))
+			if data == nil {
+				return nil
+			}
+			return json.Unmarshal(data, &resourceType)
+		})
+		return resourceType, err
+	})
+}
+func (s *boltstore) ResourceTypeVersions(kind model.Kind, name string) ([]*model.ResourceType, error) {
+	var previous []*model.ResourceType
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		prefix := []byte(resourceTypeVersionKey(kind, name, ""))
+		cursor := resourceTypeVersionsBucket(tx).Cursor()
+
+		for k, v := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cursor.Next() {
+			var resourceType model.ResourceType
+			if err := json.Unmarshal(v, &resourceType); err != nil {
+				s.logger.Error("failed to unmarshal resource type version", zap.String("key", string(k)), zap.Error(err))
+				continue
+			}
+			previous = append(previous, &resourceType)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resourceTypeVersions(s, kind, name, previous)
+}
+
+func (s *boltstore) AgentGroup(name string) (*model.AgentGroup, error) {
+	item, exists, err := resource[*model.AgentGroup](s, model.KindAgentGroup, name)
+	if !exists {
+		item = nil
+	}
+	return item, err
+}
+func (s *boltstore) AgentGroups(options ...QueryOption) ([]*model.AgentGroup, error) {
+	items, err := resources[*model.AgentGroup](s, model.KindAgentGroup)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
+}
+func (s *boltstore) DeleteAgentGroup(name string) (*model.AgentGroup, error) {
+	item, exists, err := deleteResourceAndNotify(s, model.KindAgentGroup, name, &model.AgentGroup{})
+	if !exists {
+		return nil, err
+	}
+	return item, err
+}
+
 // CleanupDisconnectedAgents removes agents that have disconnected before the specified time
 func (s *boltstore) CleanupDisconnectedAgents(since time.Time) error {
 	agents, err := s.Agents(context.TODO())
@@ -686,6 +870,18 @@ func (s *boltstore) UserSessions() sessions.Store {
 	return s.sessionStorage
 }
 
+// Backup writes a snapshot of the database to the writer. The snapshot is written in a read transaction so that it is
+// consistent while the store continues to be used.
+func (s *boltstore) Backup(writer io.Writer) (int64, error) {
+	var size int64
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		size, err = tx.WriteTo(writer)
+		return err
+	})
+	return size, err
+}
+
 // ----------------------------------------------------------------------
 
 func (s *boltstore) disconnectAllAgents(ctx context.Context) {
@@ -735,16 +931,61 @@ func resourcesBucket(tx *bbolt.Tx) *bbolt.Bucket {
 	return tx.Bucket([]byte(bucketResources))
 }
 
+func resourceTypeVersionsBucket(tx *bbolt.Tx) *bbolt.Bucket {
+	return tx.Bucket([]byte(bucketResourceTypeVersions))
+}
+
+// archiveResourceTypeTx copies the existing version of the resource type to the ResourceTypeVersions bucket if it is
+// being replaced by a different version
+func archiveResourceTypeTx(tx *bbolt.Tx, kind model.Kind, updated *model.ResourceType) error {
+	data := resourcesBucket(tx).Get(resourceKey(kind, updated.Name()))
+	if data == nil {
+		return nil
+	}
+	var existing model.ResourceType
+	if err := json.Unmarshal(data, &existing); err != nil {
+		return fmt.Errorf("archive resource type: %w", err)
+	}
+	if !shouldArchiveResourceType(&existing, updated) {
+		return nil
+	}
+	key := resourceTypeVersionKey(kind, existing.Name(), existing.Spec.Version)
+	if err := resourceTypeVersionsBucket(tx).Put([]byte(key), data); err != nil {
+		return fmt.Errorf("archive resource type: %w", err)
+	}
+	return nil
+}
+
+// checkResourceVersionTx returns a ConflictError if the resourceVersion of the resource is specified and does not match
+// the resourceVersion of the stored resource
+func checkResourceVersionTx(tx *bbolt.Tx, r model.Resource) error {
+	if r.ResourceVersion() == 0 {
+		return nil
+	}
+	data := resourcesBucket(tx).Get(resourceKey(r.GetKind(), r.Name()))
+	if data == nil {
+		return nil
+	}
+	var cur model.AnyResource
+	if err := json.Unmarshal(data, &cur); err != nil {
+		return err
+	}
+	return checkResourceVersion(r.ResourceVersion(), &cur)
+}
+
 func upsertResource(tx *bbolt.Tx, r model.Resource, kind model.Kind) (model.UpdateStatus, error) {
 	key := resourceKey(kind, r.Name())
 	bucket := resourcesBucket(tx)
 	existing := bucket.Get(key)
 
-	// preserve the id (if possible)
+	// preserve the id (if possible) and compare using the current resourceVersion
+	var current model.Resource
 	if len(existing) > 0 {
 		var cur model.AnyResource
 		if err := json.Unmarshal(existing, &cur); err == nil {
 			r.SetID(cur.ID())
+			r.SetResourceVersion(cur.ResourceVersion())
+			current = &cur
 		}
 	}
 
@@ -757,6 +998,12 @@ func upsertResource(tx *bbolt.Tx, r model.Resource, kind model.Kind) (model.Upda
 		return model.StatusUnchanged, nil
 	}
 
+	r.SetResourceVersion(nextResourceVersion(current))
+	if data, err = json.Marshal(r); err != nil {
+		// error, status unchanged
+		return model.StatusUnchanged, fmt.Errorf("upsert resource: %w", err)
+	}
+
 	if err = bucket.Put(key, data); err != nil {
 		// error, status unchanged
 		return model.StatusUnchanged, fmt.Errorf("upsert resource: %w", err)
@@ -890,11 +1137,13 @@ func deleteResourceAndNotify[R model.Resource](s *boltstore, kind model.Kind, na
 }
 
 // deleteResource removes the resource with the given kind and name. Returns ResourceMissingError if the resource wasn't
-// found. Returns DependencyError if the resource is referenced by another.
+// found. Returns DependencyError if the resource is referenced by another. Returns ConflictError if emptyResource has a
+// resourceVersion that does not match the stored resource.
 // emptyResource will be populated with the deleted resource. For convenience, if the delete is successful, the
 // populated resource will also be returned. If there was an error, nil will be returned for the resource.
 func deleteResource[R model.Resource](s *boltstore, kind model.Kind, name string, emptyResource R) (resource R, exists bool, err error) {
 	var dependencies DependentResources
+	resourceVersion := emptyResource.ResourceVersion()
 
 	err = s.db.Update(func(tx *bbolt.Tx) error {
 		key := resourceKey(kind, name)
@@ -904,6 +1153,7 @@ func deleteResource[R model.Resource](s *boltstore, kind model.Kind, name string
 
 		if bytes.Equal(k, key) {
 			// populate the emptyResource with the data before deleting
+			emptyResource.SetResourceVersion(0)
 			err := json.Unmarshal(v, emptyResource)
 			if err != nil {
 				return err
@@ -911,6 +1161,10 @@ func deleteResource[R model.Resource](s *boltstore, kind model.Kind, name string
 
 			exists = true
 
+			if err := checkResourceVersion(resourceVersion, emptyResource); err != nil {
+				return err
+			}
+
 			// Check if the resources is referenced by another
 			dependencies, err = FindDependentResources(context.TODO(), s, emptyResource)
 			if !dependencies.empty() {
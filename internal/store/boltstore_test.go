@@ -103,6 +103,8 @@ type mockUnknownResource struct{}
 func (x mockUnknownResource) ID() string                                  { return "" }
 func (x mockUnknownResource) SetID(string)                                {}
 func (x mockUnknownResource) EnsureID()                                   {}
+func (x mockUnknownResource) ResourceVersion() int64                      { return 0 }
+func (x mockUnknownResource) SetResourceVersion(int64)                    {}
 func (x mockUnknownResource) GetKind() model.Kind                         { return model.KindUnknown }
 func (x mockUnknownResource) Name() string                                { return "" }
 func (x mockUnknownResource) Description() string                         { return "" }
@@ -381,13 +383,13 @@ func TestInitDB(t *testing.T) {
 			require.NoError(t, db.Close())
 
 			// cursor count increases by 2 for every empty bucket created
-			// a count of 6 means we have three buckets.
-			bucketCount := 3
+			// a count of 8 means we have four buckets.
+			bucketCount := 4
 			require.Equal(t, bucketCount*2, db.Stats().TxStats.CursorCount)
 
-			// InitDB creates three buckets: Resources, Tasks, Agents
+			// InitDB creates four buckets: Resources, Tasks, Agents, ResourceTypeVersions
 			_ = db.Update(func(tx *bbolt.Tx) error {
-				for _, bucket := range []string{bucketResources, bucketTasks, bucketAgents} {
+				for _, bucket := range []string{bucketResources, bucketTasks, bucketAgents, bucketResourceTypeVersions} {
 					// Deleting the bucket
 					err := tx.DeleteBucket([]byte(bucket))
 					require.NoError(t, err, "expected bucket %s to exist", bucket)
@@ -432,6 +434,7 @@ func TestBucketNames(t *testing.T) {
 	require.Equal(t, "Resources", bucketResources)
 	require.Equal(t, "Tasks", bucketTasks)
 	require.Equal(t, "Agents", bucketAgents)
+	require.Equal(t, "ResourceTypeVersions", bucketResourceTypeVersions)
 }
 
 func TestBoltstoreDependentResources(t *testing.T) {
@@ -467,6 +470,28 @@ func TestBoltstorePaging(t *testing.T) {
 	runPagingTests(t, store)
 }
 
+func TestBoltstoreCursorPaging(t *testing.T) {
+	db, err := initTestDB(t)
+	require.NoError(t, err)
+	defer cleanupTestDB(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewBoltStore(ctx, db, testOptions, zap.NewNop())
+	runCursorPagingTests(t, store)
+}
+
+func TestBoltstoreResourceVersions(t *testing.T) {
+	db, err := initTestDB(t)
+	require.NoError(t, err)
+	defer cleanupTestDB(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewBoltStore(ctx, db, testOptions, zap.NewNop())
+	runResourceVersionTests(t, store)
+}
+
 func TestBoltStoreDeleteAgents(t *testing.T) {
 	db, err := initTestDB(t)
 	require.NoError(t, err)
@@ -503,6 +528,8 @@ func initTestDB(t *testing.T) (*bbolt.DB, error) {
 		require.NoError(t, err, "error while initializing test database, %w", err)
 		_, err = tx.CreateBucketIfNotExists([]byte(bucketAgents))
 		require.NoError(t, err, "error while initializing test database, %w", err)
+		_, err = tx.CreateBucketIfNotExists([]byte(bucketResourceTypeVersions))
+		require.NoError(t, err, "error while initializing test database, %w", err)
 
 		return nil
 	})
@@ -532,3 +559,25 @@ func storedAgentIDs(t *testing.T, store Store) []string {
 	}
 	return result
 }
+
+func TestBoltstoreAgentGroups(t *testing.T) {
+	db, err := initTestDB(t)
+	require.NoError(t, err)
+	defer cleanupTestDB(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewBoltStore(ctx, db, testOptions, zap.NewNop())
+	runAgentGroupTests(t, store)
+}
+
+func TestBoltstoreResourceTypeVersions(t *testing.T) {
+	db, err := initTestDB(t)
+	require.NoError(t, err)
+	defer cleanupTestDB(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewBoltStore(ctx, db, testOptions, zap.NewNop())
+	runResourceTypeVersionTests(t, store)
+}
@@ -0,0 +1,177 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"sort"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+// ResourceDependents returns the resources affected by a change to the resource and the ids of the agents using the
+// affected configurations. It uses the same relationships that are used to include transitive updates when a resource
+// changes. Resources are returned in dependency order.
+func ResourceDependents(s Store, resource model.Resource) (*model.ResourceDependents, error) {
+	updates := NewUpdates()
+	updates.IncludeResource(resource, EventTypeUpdate)
+	if err := updates.addTransitiveUpdates(s); err != nil {
+		return nil, err
+	}
+
+	resources := []model.Resource{}
+	resources = appendEventItems(resources, updates.Sources)
+	resources = appendEventItems(resources, updates.SourceTypes)
+	resources = appendEventItems(resources, updates.Processors)
+	resources = appendEventItems(resources, updates.ProcessorTypes)
+	resources = appendEventItems(resources, updates.Destinations)
+	resources = appendEventItems(resources, updates.DestinationTypes)
+	resources = appendEventItems(resources, updates.Connectors)
+	resources = appendEventItems(resources, updates.ConnectorTypes)
+	resources = appendEventItems(resources, updates.Configurations)
+	resources = appendEventItems(resources, updates.AgentGroups)
+
+	dependents := []model.Resource{}
+	for _, r := range resources {
+		if r.GetKind() != resource.GetKind() || r.Name() != resource.Name() {
+			dependents = append(dependents, r)
+		}
+	}
+	sortResources(dependents)
+
+	// the configuration itself is included in the updates so that its agents are included
+	agentIDs := []string{}
+	seen := map[string]bool{}
+	for _, event := range updates.Configurations {
+		ids, err := s.AgentsIDsMatchingConfiguration(event.Item)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				agentIDs = append(agentIDs, id)
+			}
+		}
+	}
+	sort.Strings(agentIDs)
+
+	return &model.ResourceDependents{
+		Resources: model.NewResourceReferences(dependents),
+		AgentIDs:  agentIDs,
+	}, nil
+}
+
+// ResourceDependencies returns the resources used by the resource directly or indirectly, e.g. the sources of a
+// configuration and the source types of those sources. It is the inverse of ResourceDependents. References to resources
+// that do not exist are ignored. Resources are returned in dependency order.
+func ResourceDependencies(s Store, resource model.Resource) ([]model.Resource, error) {
+	dependencies := []model.Resource{}
+	seen := map[string]bool{resourceReferenceKey(model.NewResourceReference(resource)): true}
+
+	pending := []model.Resource{resource}
+	for len(pending) > 0 {
+		next := pending[0]
+		pending = pending[1:]
+
+		for _, reference := range directDependencies(next) {
+			key := resourceReferenceKey(reference)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			dependency, err := CurrentResource(s, reference.Kind, reference.Name)
+			if err != nil {
+				return nil, err
+			}
+			if dependency == nil {
+				continue
+			}
+			dependencies = append(dependencies, dependency)
+			pending = append(pending, dependency)
+		}
+	}
+	sortResources(dependencies)
+	return dependencies, nil
+}
+
+// directDependencies returns references to the resources used by the resource, matching the relationships in
+// Updates.addTransitiveUpdates
+func directDependencies(resource model.Resource) []*model.ResourceReference {
+	references := []*model.ResourceReference{}
+	add := func(kind model.Kind, name string) {
+		if name != "" {
+			references = append(references, &model.ResourceReference{Kind: kind, Name: name})
+		}
+	}
+	addProcessors := func(processors []model.ResourceConfiguration) {
+		for _, processor := range processors {
+			add(model.KindProcessor, processor.Name)
+			add(model.KindProcessorType, processor.Type)
+		}
+	}
+
+	switch r := resource.(type) {
+	case *model.Source:
+		add(model.KindSourceType, r.Spec.Type)
+		addProcessors(r.Spec.Processors)
+
+	case *model.Processor:
+		add(model.KindProcessorType, r.Spec.Type)
+
+	case *model.Destination:
+		add(model.KindDestinationType, r.Spec.Type)
+
+	case *model.Connector:
+		add(model.KindConnectorType, r.Spec.Type)
+
+	case *model.Configuration:
+		for _, source := range r.Spec.Sources {
+			add(model.KindSource, source.Name)
+			add(model.KindSourceType, source.Type)
+		}
+		for _, destination := range r.Spec.Destinations {
+			add(model.KindDestination, destination.Name)
+			add(model.KindDestinationType, destination.Type)
+		}
+		for _, connector := range r.Spec.Connectors {
+			add(model.KindConnector, connector.Name)
+			add(model.KindConnectorType, connector.Type)
+		}
+		addProcessors(r.Spec.Processors)
+		addProcessors(destinationProcessors(r))
+		add(model.KindAgentGroup, r.Spec.AgentGroup)
+	}
+	return references
+}
+
+func appendEventItems[T model.Resource](resources []model.Resource, events Events[T]) []model.Resource {
+	for _, event := range events {
+		resources = append(resources, event.Item)
+	}
+	return resources
+}
+
+// sortResources sorts the resources in dependency order and by name
+func sortResources(resources []model.Resource) {
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Name() < resources[j].Name()
+	})
+	model.SortBundleResources(resources)
+}
+
+func resourceReferenceKey(reference *model.ResourceReference) string {
+	return string(reference.Kind) + "|" + reference.Name
+}
@@ -0,0 +1,125 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestResourceDependentsAndDependencies(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewMapStore(ctx, testOptions, zap.NewNop())
+	applyTestConfiguration(t, s)
+
+	// select only the agents labeled with the configuration
+	selected := model.NewConfigurationWithSpec(testConfiguration.Name(), testConfiguration.Spec)
+	selected.Spec.Selector = model.AgentSelector{
+		MatchLabels: model.MatchLabels{"configuration": testConfiguration.Name()},
+	}
+	statuses, err := s.ApplyResources([]model.Resource{selected})
+	require.NoError(t, err)
+	requireOkStatuses(t, statuses)
+
+	require.NoError(t, addAgent(s, &model.Agent{ID: "1", Labels: labels(map[string]string{"configuration": testConfiguration.Name()})}))
+	require.NoError(t, addAgent(s, &model.Agent{ID: "2", Labels: labels(map[string]string{"configuration": "other"})}))
+
+	dependentsTests := []struct {
+		description    string
+		resource       model.Resource
+		expect         []*model.ResourceReference
+		expectAgentIDs []string
+	}{
+		{
+			description: "source type affects its sources and their configurations",
+			resource:    macosSourceType,
+			expect: []*model.ResourceReference{
+				{Kind: model.KindSource, Name: macosSource.Name()},
+				{Kind: model.KindConfiguration, Name: testConfiguration.Name()},
+			},
+			expectAgentIDs: []string{"1"},
+		},
+		{
+			description: "destination affects its configurations",
+			resource:    cabinDestination1,
+			expect: []*model.ResourceReference{
+				{Kind: model.KindConfiguration, Name: testConfiguration.Name()},
+			},
+			expectAgentIDs: []string{"1"},
+		},
+		{
+			description:    "configuration affects its agents",
+			resource:       selected,
+			expect:         []*model.ResourceReference{},
+			expectAgentIDs: []string{"1"},
+		},
+		{
+			description:    "unused destination has no dependents",
+			resource:       cabinDestination2,
+			expect:         []*model.ResourceReference{},
+			expectAgentIDs: []string{},
+		},
+	}
+	for _, test := range dependentsTests {
+		t.Run(test.description, func(t *testing.T) {
+			dependents, err := ResourceDependents(s, test.resource)
+			require.NoError(t, err)
+			require.Equal(t, test.expect, dependents.Resources)
+			require.Equal(t, test.expectAgentIDs, dependents.AgentIDs)
+		})
+	}
+
+	dependenciesTests := []struct {
+		description string
+		resource    model.Resource
+		expect      []*model.ResourceReference
+	}{
+		{
+			description: "configuration uses its sources and destinations and their types",
+			resource:    testConfiguration,
+			expect: []*model.ResourceReference{
+				{Kind: model.KindSourceType, Name: macosSourceType.Name()},
+				{Kind: model.KindDestinationType, Name: cabinDestinationType.Name()},
+				{Kind: model.KindSource, Name: macosSource.Name()},
+				{Kind: model.KindDestination, Name: cabinDestination1.Name()},
+			},
+		},
+		{
+			description: "source uses its source type",
+			resource:    nginxSource,
+			expect: []*model.ResourceReference{
+				{Kind: model.KindSourceType, Name: nginxSourceType.Name()},
+			},
+		},
+		{
+			description: "source type has no dependencies",
+			resource:    nginxSourceType,
+			expect:      []*model.ResourceReference{},
+		},
+	}
+	for _, test := range dependenciesTests {
+		t.Run(test.description, func(t *testing.T) {
+			dependencies, err := ResourceDependencies(s, test.resource)
+			require.NoError(t, err)
+			require.Equal(t, test.expect, model.NewResourceReferences(dependencies))
+		})
+	}
+}
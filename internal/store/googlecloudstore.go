@@ -40,6 +40,9 @@ import (
 
 var tracer = otel.Tracer("googlecloudstore")
 
+// datastoreKindResourceTypeVersion is the datastore kind used for previous versions of resource types
+const datastoreKindResourceTypeVersion model.Kind = "ResourceTypeVersion"
+
 type googleCloudStore struct {
 	client             *datastore.Client
 	pubsub             *pubsubClient
@@ -114,6 +117,19 @@ func (s *googleCloudStore) Agents(ctx context.Context, options ...QueryOption) (
 		span.SetAttributes(attribute.String("bindplane.list.query", opts.query.Original))
 	}
 
+	if opts.cursor != "" {
+		// cursors are not supported by the datastore query, so the sort, cursor, offset, and limit are applied to the results
+		filter := queryOptions{selector: opts.selector, query: opts.query}
+		agents, err := getDatastoreResourcesWithQuery[*model.Agent](ctx, s, s.agentIndex, model.KindAgent, &filter)
+		if err != nil {
+			return nil, err
+		}
+		if opts.sort == "" {
+			opts.sort = defaultSort
+		}
+		return applySortOffsetAndLimit(agents, opts, agentField)
+	}
+
 	return getDatastoreResourcesWithQuery[*model.Agent](ctx, s, s.agentIndex, model.KindAgent, &opts)
 }
 
@@ -239,7 +255,13 @@ func (s *googleCloudStore) DeleteAgents(ctx context.Context, agentIDs []string)
 
 func (s *googleCloudStore) Configurations(options ...QueryOption) ([]*model.Configuration, error) {
 	opts := makeQueryOptions(options)
-	return getDatastoreResourcesWithQuery[*model.Configuration](context.TODO(), s, s.configurationIndex, model.KindConfiguration, &opts)
+	// sorting and paging of resources is not supported by the datastore query, so it is applied to the results
+	filter := queryOptions{selector: opts.selector, query: opts.query}
+	configurations, err := getDatastoreResourcesWithQuery[*model.Configuration](context.TODO(), s, s.configurationIndex, model.KindConfiguration, &filter)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(configurations, opts)
 }
 
 func (s *googleCloudStore) Configuration(name string) (*model.Configuration, error) {
@@ -264,8 +286,12 @@ func (s *googleCloudStore) Source(name string) (*model.Source, error) {
 	}
 	return item, err
 }
-func (s *googleCloudStore) Sources() ([]*model.Source, error) {
-	return getDatastoreResources[*model.Source](s, model.KindSource, nil)
+func (s *googleCloudStore) Sources(options ...QueryOption) ([]*model.Source, error) {
+	items, err := getDatastoreResources[*model.Source](s, model.KindSource, nil)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
 }
 func (s *googleCloudStore) DeleteSource(name string) (*model.Source, error) {
 	item, exists, err := deleteDatastoreResourceAndNotify[*model.Source](s, model.KindSource, name)
@@ -282,8 +308,12 @@ func (s *googleCloudStore) SourceType(name string) (*model.SourceType, error) {
 	}
 	return item, err
 }
-func (s *googleCloudStore) SourceTypes() ([]*model.SourceType, error) {
-	return getDatastoreResources[*model.SourceType](s, model.KindSourceType, nil)
+func (s *googleCloudStore) SourceTypes(options ...QueryOption) ([]*model.SourceType, error) {
+	items, err := getDatastoreResources[*model.SourceType](s, model.KindSourceType, nil)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
 }
 func (s *googleCloudStore) DeleteSourceType(name string) (*model.SourceType, error) {
 	item, exists, err := deleteDatastoreResourceAndNotify[*model.SourceType](s, model.KindSourceType, name)
@@ -300,8 +330,12 @@ func (s *googleCloudStore) Processor(name string) (*model.Processor, error) {
 	}
 	return item, err
 }
-func (s *googleCloudStore) Processors() ([]*model.Processor, error) {
-	return getDatastoreResources[*model.Processor](s, model.KindProcessor, nil)
+func (s *googleCloudStore) Processors(options ...QueryOption) ([]*model.Processor, error) {
+	items, err := getDatastoreResources[*model.Processor](s, model.KindProcessor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
 }
 func (s *googleCloudStore) DeleteProcessor(name string) (*model.Processor, error) {
 	item, exists, err := deleteDatastoreResourceAndNotify[*model.Processor](s, model.KindProcessor, name)
@@ -318,8 +352,12 @@ func (s *googleCloudStore) ProcessorType(name string) (*model.ProcessorType, err
 	}
 	return item, err
 }
-func (s *googleCloudStore) ProcessorTypes() ([]*model.ProcessorType, error) {
-	return getDatastoreResources[*model.ProcessorType](s, model.KindProcessorType, nil)
+func (s *googleCloudStore) ProcessorTypes(options ...QueryOption) ([]*model.ProcessorType, error) {
+	items, err := getDatastoreResources[*model.ProcessorType](s, model.KindProcessorType, nil)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
 }
 func (s *googleCloudStore) DeleteProcessorType(name string) (*model.ProcessorType, error) {
 	item, exists, err := deleteDatastoreResourceAndNotify[*model.ProcessorType](s, model.KindProcessorType, name)
@@ -336,8 +374,12 @@ func (s *googleCloudStore) Destination(name string) (*model.Destination, error)
 	}
 	return item, err
 }
-func (s *googleCloudStore) Destinations() ([]*model.Destination, error) {
-	return getDatastoreResources[*model.Destination](s, model.KindDestination, nil)
+func (s *googleCloudStore) Destinations(options ...QueryOption) ([]*model.Destination, error) {
+	items, err := getDatastoreResources[*model.Destination](s, model.KindDestination, nil)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
 }
 func (s *googleCloudStore) DeleteDestination(name string) (*model.Destination, error) {
 	item, exists, err := deleteDatastoreResourceAndNotify[*model.Destination](s, model.KindDestination, name)
@@ -354,8 +396,12 @@ func (s *googleCloudStore) DestinationType(name string) (*model.DestinationType,
 	}
 	return item, err
 }
-func (s *googleCloudStore) DestinationTypes() ([]*model.DestinationType, error) {
-	return getDatastoreResources[*model.DestinationType](s, model.KindDestinationType, nil)
+func (s *googleCloudStore) DestinationTypes(options ...QueryOption) ([]*model.DestinationType, error) {
+	items, err := getDatastoreResources[*model.DestinationType](s, model.KindDestinationType, nil)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
 }
 func (s *googleCloudStore) DeleteDestinationType(name string) (*model.DestinationType, error) {
 	item, exists, err := deleteDatastoreResourceAndNotify[*model.DestinationType](s, model.KindDestinationType, name)
@@ -365,6 +411,121 @@ func (s *googleCloudStore) DeleteDestinationType(name string) (*model.Destinatio
 	return item, err
 }
 
+func (s *googleCloudStore) Connector(name string) (*model.Connector, error) {
+	item, exists, err := getDatastoreResource[*model.Connector](s, model.KindConnector, name)
+	if !exists {
+		item = nil
+	}
+	return item, err
+}
+func (s *googleCloudStore) Connectors(options ...QueryOption) ([]*model.Connector, error) {
+	items, err := getDatastoreResources[*model.Connector](s, model.KindConnector, nil)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
+}
+func (s *googleCloudStore) DeleteConnector(name string) (*model.Connector, error) {
+	item, exists, err := deleteDatastoreResourceAndNotify[*model.Connector](s, model.KindConnector, name)
+	if !exists {
+		return nil, err
+	}
+	return item, err
+}
+
+func (s *googleCloudStore) ConnectorType(name string) (*model.ConnectorType, error) {
+	item, exists, err := getDatastoreResource[*model.ConnectorType](s, model.KindConnectorType, name)
+	if !exists {
+		item = nil
+	}
+	return item, err
+}
+func (s *googleCloudStore) ConnectorTypes(options ...QueryOption) ([]*model.ConnectorType, error) {
+	items, err := getDatastoreResources[*model.ConnectorType](s, model.KindConnectorType, nil)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
+}
+func (s *googleCloudStore) DeleteConnectorType(name string) (*model.ConnectorType, error) {
+	item, exists, err := deleteDatastoreResourceAndNotify[*model.ConnectorType](s, model.KindConnectorType, name)
+	if !exists {
+		return nil, err
+	}
+	return item, err
+}
+
+func (s *googleCloudStore) ResourceTypeVersion(kind model.Kind, name string, version string) (*model.ResourceType, error) {
+	return resourceTypeVersion(s, kind, name, version, func(key string) (*model.ResourceType, error) {
+		item, exists, err := getDatastoreResource[*model.ResourceType](s, datastoreKindResourceTypeVersion, key)
+		if !exists {
+			item = nil
+		}
+		return item, err
+	})
+}
+func (s *googleCloudStore) ResourceTypeVersions(kind model.Kind, name string) ([]*model.ResourceType, error) {
+	query := datastore.NewQuery(string(datastoreKindResourceTypeVersion)).Filter("name=", name)
+	var list []datastoreResource
+	if _, err := s.client.GetAll(context.TODO(), query, &list); err != nil {
+		return nil, err
+	}
+
+	previous := make([]*model.ResourceType, 0, len(list))
+	for _, dsr := range list {
+		dsr := dsr // copy to local variable to securely pass a reference to a loop variable
+		var resourceType *model.ResourceType
+		if err := decodeDatastoreResource(&dsr, &resourceType); err != nil {
+			s.logger.Error("unable to decode resource type version", zap.String("name", dsr.Name), zap.String("kind", string(kind)))
+			continue
+		}
+		if resourceType.GetKind() == kind {
+			previous = append(previous, resourceType)
+		}
+	}
+	return resourceTypeVersions(s, kind, name, previous)
+}
+
+// archiveDatastoreResourceType stores the existing version of the resource type if it is being replaced by a different version
+func archiveDatastoreResourceType(tx *datastore.Transaction, kind model.Kind, existing, updated *model.ResourceType) error {
+	if !shouldArchiveResourceType(existing, updated) {
+		return nil
+	}
+	data, err := json.Marshal(existing)
+	if err != nil {
+		return err
+	}
+	dsr := &datastoreResource{
+		Key:  datastoreKey(datastoreKindResourceTypeVersion, resourceTypeVersionKey(kind, existing.Name(), existing.Spec.Version)),
+		Name: existing.Name(),
+		Body: data,
+	}
+	_, err = tx.Put(dsr.Key, dsr)
+	return err
+}
+
+func (s *googleCloudStore) AgentGroup(name string) (*model.AgentGroup, error) {
+	item, exists, err := getDatastoreResource[*model.AgentGroup](s, model.KindAgentGroup, name)
+	if !exists {
+		item = nil
+	}
+	return item, err
+}
+func (s *googleCloudStore) AgentGroups(options ...QueryOption) ([]*model.AgentGroup, error) {
+	items, err := getDatastoreResources[*model.AgentGroup](s, model.KindAgentGroup, nil)
+	if err != nil {
+		return nil, err
+	}
+	return applyResourceQueryOptions(items, makeQueryOptions(options))
+}
+func (s *googleCloudStore) DeleteAgentGroup(name string) (*model.AgentGroup, error) {
+	item, exists, err := deleteDatastoreResourceAndNotify[*model.AgentGroup](s, model.KindAgentGroup, name)
+	if !exists {
+		return nil, err
+	}
+	return item, err
+}
+
 // ----------------------------------------------------------------------
 
 func (s *googleCloudStore) ApplyResources(resources []model.Resource) ([]model.ResourceStatus, error) {
@@ -385,19 +546,31 @@ func (s *googleCloudStore) ApplyResources(resources []model.Resource) ([]model.R
 			continue
 		}
 
-		status, err := upsertAnyDatastoreResource(s, resource)
+		// the resourceVersion is assigned to a copy of the resource
+		stored, err := storedCopy(resource)
 		if err != nil {
-			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(resource, model.StatusError, err.Error()))
-			errs = multierror.Append(errs, err)
+			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(resource, model.StatusInvalid, err.Error()))
 			continue
 		}
-		resourceStatuses = append(resourceStatuses, *model.NewResourceStatus(resource, status))
+
+		status, err := upsertAnyDatastoreResource(s, stored)
+		if err != nil {
+			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(stored, status, err.Error()))
+			if status != model.StatusConflict {
+				errs = multierror.Append(errs, err)
+			}
+			continue
+		}
+		resourceStatuses = append(resourceStatuses, *model.NewResourceStatus(stored, status))
+
+		// the applied resource keeps the id of the stored resource
+		resource.SetID(stored.ID())
 
 		switch status {
 		case model.StatusCreated:
-			updates.IncludeResource(resource, EventTypeInsert)
+			updates.IncludeResource(stored, EventTypeInsert)
 		case model.StatusConfigured:
-			updates.IncludeResource(resource, EventTypeUpdate)
+			updates.IncludeResource(stored, EventTypeUpdate)
 		}
 
 	}
@@ -429,7 +602,7 @@ func (s *googleCloudStore) DeleteResources(resources []model.Resource) ([]model.
 			break
 
 		default:
-			deleteStatuses = append(deleteStatuses, *model.NewResourceStatusWithReason(r, model.StatusError, err.Error()))
+			deleteStatuses = append(deleteStatuses, *model.NewResourceStatusWithReason(r, statusOf(err), err.Error()))
 			continue
 		}
 
@@ -470,7 +643,11 @@ func (s *googleCloudStore) AgentConfiguration(agentID string) (*model.Configurat
 		return nil, err
 	}
 	for _, configuration := range configurations {
-		if configuration.IsForAgent(agent) {
+		matches, err := isConfigurationForAgent(s, configuration, agent)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
 			return configuration, nil
 		}
 	}
@@ -480,8 +657,7 @@ func (s *googleCloudStore) AgentConfiguration(agentID string) (*model.Configurat
 
 // AgentsIDsMatchingConfiguration returns the list of agent IDs that are using the specified configuration
 func (s *googleCloudStore) AgentsIDsMatchingConfiguration(configuration *model.Configuration) ([]string, error) {
-	ids := s.AgentIndex().Select(configuration.Spec.Selector.MatchLabels)
-	return ids, nil
+	return agentsIDsMatchingConfiguration(s, configuration)
 }
 
 // CleanupDisconnectedAgents removes agents that have disconnected before the specified time
@@ -695,6 +871,12 @@ func upsertAnyDatastoreResource(s *googleCloudStore, r model.Resource) (model.Up
 		return upsertDatastoreResource(s, r.(*model.Destination))
 	case model.KindDestinationType:
 		return upsertDatastoreResource(s, r.(*model.DestinationType))
+	case model.KindConnector:
+		return upsertDatastoreResource(s, r.(*model.Connector))
+	case model.KindConnectorType:
+		return upsertDatastoreResource(s, r.(*model.ConnectorType))
+	case model.KindAgentGroup:
+		return upsertDatastoreResource(s, r.(*model.AgentGroup))
 	default:
 		return model.StatusError, fmt.Errorf("unable to use ApplyResource with %s", string(r.GetKind()))
 	}
@@ -715,28 +897,64 @@ func upsertDatastoreAgent(s *googleCloudStore, agent *model.Agent) error {
 }
 
 func upsertDatastoreResource[R model.Resource](s *googleCloudStore, r R) (model.UpdateStatus, error) {
-	successStatus := model.StatusCreated
-	existing, exists, err := getDatastoreResource[R](s, r.GetKind(), r.Name())
-	if err != nil {
-		return model.StatusError, err
-	} else if exists {
-		successStatus = model.StatusConfigured
-		// preserve the id (if possible)
-		r.SetID(existing.ID())
-	}
+	// the transaction function may be retried, so keep the requested resourceVersion before it is replaced
+	resourceVersion := r.ResourceVersion()
 
-	dsr, err := newDatastoreResource(r)
-	if err != nil {
-		return model.StatusUnchanged, fmt.Errorf("failed to marshal the resource: %w", err)
-	}
+	var status model.UpdateStatus
+	_, err := s.client.RunInTransaction(context.TODO(), func(tx *datastore.Transaction) error {
+		existing, exists, err := getDatastoreResourceTx[R](tx, r.GetKind(), r.Name())
+		if err != nil {
+			return err
+		}
 
-	_, err = s.client.Put(context.TODO(), dsr.Key, dsr)
+		var current model.Resource
+		if exists {
+			current = existing
+		}
+		if err := checkResourceVersion(resourceVersion, current); err != nil {
+			return err
+		}
+
+		switch {
+		case !exists:
+			status = model.StatusCreated
+			r.SetResourceVersion(nextResourceVersion(nil))
+
+		case resourcesEqual(existing, r):
+			// preserve the id and resourceVersion of an unchanged resource
+			status = model.StatusUnchanged
+			r.SetID(existing.ID())
+			r.SetResourceVersion(existing.ResourceVersion())
+			return nil
+
+		default:
+			status = model.StatusConfigured
+			// preserve the id (if possible)
+			r.SetID(existing.ID())
+			r.SetResourceVersion(nextResourceVersion(existing))
+
+			// keep the previous version of a resource type that is replaced by a different version
+			if updated := model.ResourceTypeOf(r); updated != nil {
+				if err := archiveDatastoreResourceType(tx, r.GetKind(), model.ResourceTypeOf(existing), updated); err != nil {
+					return fmt.Errorf("failed to archive the resource type: %w", err)
+				}
+			}
+		}
+
+		dsr, err := newDatastoreResource(r)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the resource: %w", err)
+		}
+
+		if _, err = tx.Put(dsr.Key, dsr); err != nil {
+			return fmt.Errorf("failed to put the resource: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return model.StatusUnchanged, fmt.Errorf("failed to put the resource: %w", err)
+		return statusOf(err), err
 	}
-
-	// note that we don't know if the item existed before
-	return successStatus, err
+	return status, nil
 }
 
 func getDatastoreResource[R any](s *googleCloudStore, kind model.Kind, name string) (resource R, exists bool, err error) {
@@ -757,8 +975,25 @@ func getDatastoreResource[R any](s *googleCloudStore, kind model.Kind, name stri
 	return resource, true, nil
 }
 
+func getDatastoreResourceTx[R any](tx *datastore.Transaction, kind model.Kind, name string) (resource R, exists bool, err error) {
+	var dsr datastoreResource
+
+	if err = tx.Get(datastoreKey(kind, name), &dsr); err != nil {
+		if errors.Is(err, datastore.ErrNoSuchEntity) {
+			return resource, false, nil
+		}
+		return resource, true, fmt.Errorf("failed to get the resource: %w", err)
+	}
+
+	if err = decodeDatastoreResource(&dsr, &resource); err != nil {
+		return resource, true, fmt.Errorf("failed to unmarshal the resource: %w", err)
+	}
+
+	return resource, true, nil
+}
+
 func deleteDatastoreResourceAndNotify[R model.Resource](s *googleCloudStore, kind model.Kind, name string) (resource R, exists bool, err error) {
-	deleted, exists, err := deleteDatastoreResource[R](s, kind, name)
+	deleted, exists, err := deleteDatastoreResource[R](s, kind, name, 0)
 
 	if err == nil && exists {
 		updates := NewUpdates()
@@ -773,46 +1008,59 @@ func deleteAnyDatastoreResource(s *googleCloudStore, r model.Resource) (model.Re
 	// TODO if resource type and kind get out of sync, this will cause issues
 	switch r.GetKind() {
 	case model.KindConfiguration:
-		return deleteDatastoreResource[*model.Configuration](s, r.GetKind(), r.Name())
+		return deleteDatastoreResource[*model.Configuration](s, r.GetKind(), r.Name(), r.ResourceVersion())
 	case model.KindSource:
-		return deleteDatastoreResource[*model.Source](s, r.GetKind(), r.Name())
+		return deleteDatastoreResource[*model.Source](s, r.GetKind(), r.Name(), r.ResourceVersion())
 	case model.KindSourceType:
-		return deleteDatastoreResource[*model.SourceType](s, r.GetKind(), r.Name())
+		return deleteDatastoreResource[*model.SourceType](s, r.GetKind(), r.Name(), r.ResourceVersion())
 	case model.KindProcessor:
-		return deleteDatastoreResource[*model.Processor](s, r.GetKind(), r.Name())
+		return deleteDatastoreResource[*model.Processor](s, r.GetKind(), r.Name(), r.ResourceVersion())
 	case model.KindProcessorType:
-		return deleteDatastoreResource[*model.ProcessorType](s, r.GetKind(), r.Name())
+		return deleteDatastoreResource[*model.ProcessorType](s, r.GetKind(), r.Name(), r.ResourceVersion())
 	case model.KindDestination:
-		return deleteDatastoreResource[*model.Destination](s, r.GetKind(), r.Name())
+		return deleteDatastoreResource[*model.Destination](s, r.GetKind(), r.Name(), r.ResourceVersion())
 	case model.KindDestinationType:
-		return deleteDatastoreResource[*model.DestinationType](s, r.GetKind(), r.Name())
+		return deleteDatastoreResource[*model.DestinationType](s, r.GetKind(), r.Name(), r.ResourceVersion())
+	case model.KindConnector:
+		return deleteDatastoreResource[*model.Connector](s, r.GetKind(), r.Name(), r.ResourceVersion())
+	case model.KindConnectorType:
+		return deleteDatastoreResource[*model.ConnectorType](s, r.GetKind(), r.Name(), r.ResourceVersion())
+	case model.KindAgentGroup:
+		return deleteDatastoreResource[*model.AgentGroup](s, r.GetKind(), r.Name(), r.ResourceVersion())
 	default:
 		return nil, false, fmt.Errorf("unable to use DeleteResources with %s", string(r.GetKind()))
 	}
 }
 
-func deleteDatastoreResource[R model.Resource](s *googleCloudStore, kind model.Kind, name string) (resource R, exists bool, err error) {
-	var dsr datastoreResource
-	if err = s.client.Get(context.TODO(), datastoreKey(kind, name), &dsr); err != nil {
-		if errors.Is(err, datastore.ErrNoSuchEntity) {
-			return resource, false, nil
+// deleteDatastoreResource deletes the resource with the specified kind and name. If resourceVersion is not 0, it must
+// match the resourceVersion of the existing resource.
+func deleteDatastoreResource[R model.Resource](s *googleCloudStore, kind model.Kind, name string, resourceVersion int64) (resource R, exists bool, err error) {
+	_, err = s.client.RunInTransaction(context.TODO(), func(tx *datastore.Transaction) error {
+		var txErr error
+		resource, exists, txErr = getDatastoreResourceTx[R](tx, kind, name)
+		if txErr != nil || !exists {
+			return txErr
 		}
-		return resource, true, fmt.Errorf("failed to check if resource exists: %w", err)
-	}
-	if err = decodeDatastoreResource(&dsr, &resource); err != nil {
-		return resource, true, fmt.Errorf("failed to unmarshal existing resource: %w", err)
-	}
 
-	// Check if the resources is referenced by another
-	dependencies, err := FindDependentResources(context.TODO(), s, resource)
-	if !dependencies.empty() {
-		return resource, true, ErrResourceInUse
-	}
+		if txErr = checkResourceVersion(resourceVersion, resource); txErr != nil {
+			return txErr
+		}
 
-	if err = s.client.Delete(context.TODO(), datastoreKey(kind, name)); err != nil {
-		return resource, true, fmt.Errorf("failed to delete the resource: %w", err)
-	}
-	return resource, true, nil
+		// Check if the resources is referenced by another
+		dependencies, txErr := FindDependentResources(context.TODO(), s, resource)
+		if txErr != nil {
+			return txErr
+		}
+		if !dependencies.empty() {
+			return ErrResourceInUse
+		}
+
+		if txErr = tx.Delete(datastoreKey(kind, name)); txErr != nil {
+			return fmt.Errorf("failed to delete the resource: %w", txErr)
+		}
+		return nil
+	})
+	return resource, exists, err
 }
 
 func getDatastoreResources[R any](s *googleCloudStore, kind model.Kind, opts *queryOptions) ([]R, error) {
@@ -42,6 +42,11 @@ type mapStore struct {
 	processorTypes   resourceStore[*model.ProcessorType]
 	destinations     resourceStore[*model.Destination]
 	destinationTypes resourceStore[*model.DestinationType]
+	connectors       resourceStore[*model.Connector]
+	connectorTypes   resourceStore[*model.ConnectorType]
+	agentGroups      resourceStore[*model.AgentGroup]
+
+	resourceTypeVersions resourceTypeVersionStore
 
 	updates            *storeUpdates
 	agentIndex         search.Index
@@ -57,14 +62,20 @@ var _ Store = (*mapStore)(nil)
 // NewMapStore returns an in memory Store
 func NewMapStore(ctx context.Context, options Options, logger *zap.Logger) Store {
 	return &mapStore{
-		agents:             make(map[string]*model.Agent),
-		configurations:     newResourceStore[*model.Configuration](),
-		sources:            newResourceStore[*model.Source](),
-		sourceTypes:        newResourceStore[*model.SourceType](),
-		processors:         newResourceStore[*model.Processor](),
-		processorTypes:     newResourceStore[*model.ProcessorType](),
-		destinations:       newResourceStore[*model.Destination](),
-		destinationTypes:   newResourceStore[*model.DestinationType](),
+		agents:           make(map[string]*model.Agent),
+		configurations:   newResourceStore[*model.Configuration](),
+		sources:          newResourceStore[*model.Source](),
+		sourceTypes:      newResourceStore[*model.SourceType](),
+		processors:       newResourceStore[*model.Processor](),
+		processorTypes:   newResourceStore[*model.ProcessorType](),
+		destinations:     newResourceStore[*model.Destination](),
+		destinationTypes: newResourceStore[*model.DestinationType](),
+		connectors:       newResourceStore[*model.Connector](),
+		connectorTypes:   newResourceStore[*model.ConnectorType](),
+		agentGroups:      newResourceStore[*model.AgentGroup](),
+		resourceTypeVersions: resourceTypeVersionStore{
+			store: map[string]*model.ResourceType{},
+		},
 		updates:            newStoreUpdates(ctx, options.MaxEventsToMerge),
 		agentIndex:         search.NewInMemoryIndex("agent"),
 		configurationIndex: search.NewInMemoryIndex("configuration"),
@@ -93,42 +104,60 @@ func (r *resourceStore[T]) get(name string) T {
 	return r.store[name]
 }
 
+// add adds or replaces the resource. If the resourceVersion of the resource is specified and does not match the current
+// resource, the resource is not added and StatusConflict is returned.
 func (r *resourceStore[T]) add(resource T) *model.ResourceStatus {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
 
+	existing, ok := r.store[resource.Name()]
+	var current model.Resource
+	if ok {
+		current = existing
+	}
+	if err := checkResourceVersion(resource.ResourceVersion(), current); err != nil {
+		return model.NewResourceStatusWithReason(resource, model.StatusConflict, err.Error())
+	}
+
 	// generate a uuid if none supplied
 	if resource.ID() == "" {
 		resource.SetID(uuid.NewString())
 	}
-	existing, ok := r.store[resource.Name()]
 	if ok && existing.ID() != "" {
 		resource.SetID(existing.ID())
 	}
 
-	r.store[resource.Name()] = resource
-
 	var status model.UpdateStatus
 	switch {
 	case !ok:
 		status = model.StatusCreated
+		resource.SetResourceVersion(nextResourceVersion(nil))
 	case !resourcesEqual(existing, resource):
 		status = model.StatusConfigured
+		resource.SetResourceVersion(nextResourceVersion(existing))
 	default:
 		status = model.StatusUnchanged
+		resource.SetResourceVersion(existing.ResourceVersion())
 	}
 
+	r.store[resource.Name()] = resource
+
 	return model.NewResourceStatus(resource, status)
 }
 
-func (r *resourceStore[T]) remove(name string) (item T, exists bool) {
+// remove removes the resource with the specified name. If resourceVersion is specified and does not match the current
+// resource, the resource is not removed and a ConflictError is returned.
+func (r *resourceStore[T]) remove(name string, resourceVersion int64) (item T, exists bool, err error) {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
 	existing, ok := r.store[name]
 	if ok {
+		if err := checkResourceVersion(resourceVersion, existing); err != nil {
+			return existing, ok, err
+		}
 		delete(r.store, name)
 	}
-	return existing, ok
+	return existing, ok, nil
 }
 
 func (r *resourceStore[T]) removeAndNotify(name string, store *mapStore) (item T, exists bool, err error) {
@@ -171,6 +200,42 @@ func (r *resourceStore[T]) clear() {
 	r.store = map[string]T{}
 }
 
+// resourceTypeVersionStore stores previous versions of resource types and has its own lock
+type resourceTypeVersionStore struct {
+	store map[string]*model.ResourceType
+	mtx   sync.RWMutex
+}
+
+func (r *resourceTypeVersionStore) get(key string) (*model.ResourceType, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	return r.store[key], nil
+}
+
+func (r *resourceTypeVersionStore) add(kind model.Kind, resourceType *model.ResourceType) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.store[resourceTypeVersionKey(kind, resourceType.Name(), resourceType.Spec.Version)] = resourceType
+}
+
+func (r *resourceTypeVersionStore) list(kind model.Kind, name string) []*model.ResourceType {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	var result []*model.ResourceType
+	for _, resourceType := range r.store {
+		if resourceType.Name() == name && resourceType.GetKind() == kind {
+			result = append(result, resourceType)
+		}
+	}
+	return result
+}
+
+func (r *resourceTypeVersionStore) clear() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.store = map[string]*model.ResourceType{}
+}
+
 // ----------------------------------------------------------------------
 
 func (mapstore *mapStore) Clear() {
@@ -184,6 +249,10 @@ func (mapstore *mapStore) Clear() {
 	mapstore.sourceTypes.clear()
 	mapstore.destinations.clear()
 	mapstore.destinationTypes.clear()
+	mapstore.connectors.clear()
+	mapstore.connectorTypes.clear()
+	mapstore.agentGroups.clear()
+	mapstore.resourceTypeVersions.clear()
 }
 
 func (mapstore *mapStore) UpsertAgents(ctx context.Context, agentIDs []string, updater AgentUpdater) ([]*model.Agent, error) {
@@ -230,14 +299,7 @@ func (mapstore *mapStore) Agents(ctx context.Context, options ...QueryOption) ([
 	if opts.sort == "" {
 		opts.sort = "name"
 	}
-	return applySortOffsetAndLimit(result, opts, func(field string, item *model.Agent) string {
-		switch field {
-		case "id":
-			return item.ID
-		default:
-			return item.Name
-		}
-	}), nil
+	return applySortOffsetAndLimit(result, opts, agentField)
 }
 
 func (mapstore *mapStore) AgentsCount(ctx context.Context, options ...QueryOption) (int, error) {
@@ -288,15 +350,7 @@ func (mapstore *mapStore) DeleteAgents(ctx context.Context, agentIDs []string) (
 }
 
 func (mapstore *mapStore) Configurations(options ...QueryOption) ([]*model.Configuration, error) {
-	opts := makeQueryOptions(options)
-	config := mapstore.configurations.list()
-	if opts.sort == "" {
-		opts.sort = "name"
-	}
-	return applySortOffsetAndLimit(config, opts, func(field string, item *model.Configuration) string {
-		// we currently only support sorting by name
-		return item.Name()
-	}), nil
+	return applyResourceQueryOptions(mapstore.configurations.list(), makeQueryOptions(options))
 }
 func (mapstore *mapStore) Configuration(name string) (*model.Configuration, error) {
 	return mapstore.configurations.get(name), nil
@@ -310,14 +364,17 @@ func (mapstore *mapStore) DeleteConfiguration(name string) (*model.Configuration
 	if !exists {
 		return nil, nil
 	}
+	if err := mapstore.configurationIndex.Remove(item); err != nil {
+		mapstore.logger.Error("error removing configuration from the search index", zap.Error(err))
+	}
 	return item, nil
 }
 
 func (mapstore *mapStore) Source(name string) (*model.Source, error) {
 	return mapstore.sources.get(name), nil
 }
-func (mapstore *mapStore) Sources() ([]*model.Source, error) {
-	return mapstore.sources.list(), nil
+func (mapstore *mapStore) Sources(options ...QueryOption) ([]*model.Source, error) {
+	return applyResourceQueryOptions(mapstore.sources.list(), makeQueryOptions(options))
 }
 func (mapstore *mapStore) DeleteSource(name string) (*model.Source, error) {
 	item, exists, err := mapstore.sources.removeAndNotify(name, mapstore)
@@ -334,8 +391,8 @@ func (mapstore *mapStore) DeleteSource(name string) (*model.Source, error) {
 func (mapstore *mapStore) SourceType(name string) (*model.SourceType, error) {
 	return mapstore.sourceTypes.get(name), nil
 }
-func (mapstore *mapStore) SourceTypes() ([]*model.SourceType, error) {
-	return mapstore.sourceTypes.list(), nil
+func (mapstore *mapStore) SourceTypes(options ...QueryOption) ([]*model.SourceType, error) {
+	return applyResourceQueryOptions(mapstore.sourceTypes.list(), makeQueryOptions(options))
 }
 func (mapstore *mapStore) DeleteSourceType(name string) (*model.SourceType, error) {
 	item, exists, err := mapstore.sourceTypes.removeAndNotify(name, mapstore)
@@ -352,8 +409,8 @@ func (mapstore *mapStore) DeleteSourceType(name string) (*model.SourceType, erro
 func (mapstore *mapStore) Processor(name string) (*model.Processor, error) {
 	return mapstore.processors.get(name), nil
 }
-func (mapstore *mapStore) Processors() ([]*model.Processor, error) {
-	return mapstore.processors.list(), nil
+func (mapstore *mapStore) Processors(options ...QueryOption) ([]*model.Processor, error) {
+	return applyResourceQueryOptions(mapstore.processors.list(), makeQueryOptions(options))
 }
 func (mapstore *mapStore) DeleteProcessor(name string) (*model.Processor, error) {
 	item, exists, err := mapstore.processors.removeAndNotify(name, mapstore)
@@ -370,8 +427,8 @@ func (mapstore *mapStore) DeleteProcessor(name string) (*model.Processor, error)
 func (mapstore *mapStore) ProcessorType(name string) (*model.ProcessorType, error) {
 	return mapstore.processorTypes.get(name), nil
 }
-func (mapstore *mapStore) ProcessorTypes() ([]*model.ProcessorType, error) {
-	return mapstore.processorTypes.list(), nil
+func (mapstore *mapStore) ProcessorTypes(options ...QueryOption) ([]*model.ProcessorType, error) {
+	return applyResourceQueryOptions(mapstore.processorTypes.list(), makeQueryOptions(options))
 }
 func (mapstore *mapStore) DeleteProcessorType(name string) (*model.ProcessorType, error) {
 	item, exists, err := mapstore.processorTypes.removeAndNotify(name, mapstore)
@@ -388,8 +445,8 @@ func (mapstore *mapStore) DeleteProcessorType(name string) (*model.ProcessorType
 func (mapstore *mapStore) Destination(name string) (*model.Destination, error) {
 	return mapstore.destinations.get(name), nil
 }
-func (mapstore *mapStore) Destinations() ([]*model.Destination, error) {
-	return mapstore.destinations.list(), nil
+func (mapstore *mapStore) Destinations(options ...QueryOption) ([]*model.Destination, error) {
+	return applyResourceQueryOptions(mapstore.destinations.list(), makeQueryOptions(options))
 }
 func (mapstore *mapStore) DeleteDestination(name string) (*model.Destination, error) {
 	item, exists, err := mapstore.destinations.removeAndNotify(name, mapstore)
@@ -406,8 +463,8 @@ func (mapstore *mapStore) DeleteDestination(name string) (*model.Destination, er
 func (mapstore *mapStore) DestinationType(name string) (*model.DestinationType, error) {
 	return mapstore.destinationTypes.get(name), nil
 }
-func (mapstore *mapStore) DestinationTypes() ([]*model.DestinationType, error) {
-	return mapstore.destinationTypes.list(), nil
+func (mapstore *mapStore) DestinationTypes(options ...QueryOption) ([]*model.DestinationType, error) {
+	return applyResourceQueryOptions(mapstore.destinationTypes.list(), makeQueryOptions(options))
 }
 func (mapstore *mapStore) DeleteDestinationType(name string) (*model.DestinationType, error) {
 	item, exists, err := mapstore.destinationTypes.removeAndNotify(name, mapstore)
@@ -421,6 +478,67 @@ func (mapstore *mapStore) DeleteDestinationType(name string) (*model.Destination
 	return item, nil
 }
 
+func (mapstore *mapStore) Connector(name string) (*model.Connector, error) {
+	return mapstore.connectors.get(name), nil
+}
+func (mapstore *mapStore) Connectors(options ...QueryOption) ([]*model.Connector, error) {
+	return applyResourceQueryOptions(mapstore.connectors.list(), makeQueryOptions(options))
+}
+func (mapstore *mapStore) DeleteConnector(name string) (*model.Connector, error) {
+	item, exists, err := mapstore.connectors.removeAndNotify(name, mapstore)
+	if err != nil {
+		return item, err
+	}
+
+	if !exists {
+		return nil, nil
+	}
+	return item, nil
+}
+
+func (mapstore *mapStore) ConnectorType(name string) (*model.ConnectorType, error) {
+	return mapstore.connectorTypes.get(name), nil
+}
+func (mapstore *mapStore) ConnectorTypes(options ...QueryOption) ([]*model.ConnectorType, error) {
+	return applyResourceQueryOptions(mapstore.connectorTypes.list(), makeQueryOptions(options))
+}
+func (mapstore *mapStore) DeleteConnectorType(name string) (*model.ConnectorType, error) {
+	item, exists, err := mapstore.connectorTypes.removeAndNotify(name, mapstore)
+	if err != nil {
+		return item, err
+	}
+
+	if !exists {
+		return nil, nil
+	}
+	return item, nil
+}
+
+func (mapstore *mapStore) ResourceTypeVersion(kind model.Kind, name string, version string) (*model.ResourceType, error) {
+	return resourceTypeVersion(mapstore, kind, name, version, mapstore.resourceTypeVersions.get)
+}
+func (mapstore *mapStore) ResourceTypeVersions(kind model.Kind, name string) ([]*model.ResourceType, error) {
+	return resourceTypeVersions(mapstore, kind, name, mapstore.resourceTypeVersions.list(kind, name))
+}
+
+func (mapstore *mapStore) AgentGroup(name string) (*model.AgentGroup, error) {
+	return mapstore.agentGroups.get(name), nil
+}
+func (mapstore *mapStore) AgentGroups(options ...QueryOption) ([]*model.AgentGroup, error) {
+	return applyResourceQueryOptions(mapstore.agentGroups.list(), makeQueryOptions(options))
+}
+func (mapstore *mapStore) DeleteAgentGroup(name string) (*model.AgentGroup, error) {
+	item, exists, err := mapstore.agentGroups.removeAndNotify(name, mapstore)
+	if err != nil {
+		return item, err
+	}
+
+	if !exists {
+		return nil, nil
+	}
+	return item, nil
+}
+
 func (mapstore *mapStore) ApplyResources(resources []model.Resource) ([]model.ResourceStatus, error) {
 	mapstore.Lock()
 	defer mapstore.Unlock()
@@ -436,8 +554,21 @@ func (mapstore *mapStore) ApplyResources(resources []model.Resource) ([]model.Re
 			continue
 		}
 
+		// the current version of a resource type is archived if it is replaced by a different version
+		var existingType *model.ResourceType
+		if model.ResourceTypeOf(resource) != nil {
+			existingType, _ = CurrentResourceType(mapstore, resource.GetKind(), resource.Name())
+		}
+
+		// the resourceVersion is checked and assigned when a copy of the resource is added
+		stored, err := storedCopy(resource)
+		if err != nil {
+			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(resource, model.StatusInvalid, err.Error()))
+			continue
+		}
+
 		var resourceStatus *model.ResourceStatus
-		switch r := resource.(type) {
+		switch r := stored.(type) {
 		case *model.Configuration:
 			resourceStatus = mapstore.configurations.add(r)
 			if err := mapstore.configurationIndex.Upsert(resourceStatus.Resource); err != nil {
@@ -455,6 +586,12 @@ func (mapstore *mapStore) ApplyResources(resources []model.Resource) ([]model.Re
 			resourceStatus = mapstore.destinations.add(r)
 		case *model.DestinationType:
 			resourceStatus = mapstore.destinationTypes.add(r)
+		case *model.Connector:
+			resourceStatus = mapstore.connectors.add(r)
+		case *model.ConnectorType:
+			resourceStatus = mapstore.connectorTypes.add(r)
+		case *model.AgentGroup:
+			resourceStatus = mapstore.agentGroups.add(r)
 		default:
 			resourceStatus = model.NewResourceStatusWithReason(resource, model.StatusInvalid, fmt.Sprintf("unknown resource type in apply: %s", r.Name()))
 		}
@@ -462,11 +599,19 @@ func (mapstore *mapStore) ApplyResources(resources []model.Resource) ([]model.Re
 		if resourceStatus != nil {
 			resourceStatuses = append(resourceStatuses, *resourceStatus)
 
+			// the applied resource keeps the id of the stored resource
+			resource.SetID(stored.ID())
+
 			switch resourceStatus.Status {
 			case model.StatusCreated:
-				updates.IncludeResource(resource, EventTypeInsert)
+				updates.IncludeResource(stored, EventTypeInsert)
 			case model.StatusConfigured:
-				updates.IncludeResource(resource, EventTypeUpdate)
+				updates.IncludeResource(stored, EventTypeUpdate)
+
+				// keep the previous version of a resource type that is replaced by a different version
+				if updated := model.ResourceTypeOf(stored); updated != nil && shouldArchiveResourceType(existingType, updated) {
+					mapstore.resourceTypeVersions.add(stored.GetKind(), existingType)
+				}
 			}
 		}
 	}
@@ -502,36 +647,52 @@ func (mapstore *mapStore) DeleteResources(resources []model.Resource) ([]model.R
 			continue
 		}
 
+		// the resourceVersion is checked when the resource is removed
 		var exists bool
 		switch r := r.(type) {
 		case *model.Configuration:
-			c, e := mapstore.configurations.remove(r.Name())
-			if err := mapstore.configurationIndex.Remove(c); err != nil {
-				mapstore.logger.Error("error removing configuration from the search index", zap.Error(err))
+			var c *model.Configuration
+			c, exists, err = mapstore.configurations.remove(r.Name(), r.ResourceVersion())
+			if exists && err == nil {
+				if err := mapstore.configurationIndex.Remove(c); err != nil {
+					mapstore.logger.Error("error removing configuration from the search index", zap.Error(err))
+				}
 			}
-			exists = e
 
 		case *model.Source:
-			_, exists = mapstore.sources.remove(r.Name())
+			_, exists, err = mapstore.sources.remove(r.Name(), r.ResourceVersion())
 
 		case *model.SourceType:
-			_, exists = mapstore.sourceTypes.remove(r.Name())
+			_, exists, err = mapstore.sourceTypes.remove(r.Name(), r.ResourceVersion())
 
 		case *model.Processor:
-			_, exists = mapstore.processors.remove(r.Name())
+			_, exists, err = mapstore.processors.remove(r.Name(), r.ResourceVersion())
 
 		case *model.ProcessorType:
-			_, exists = mapstore.processorTypes.remove(r.Name())
+			_, exists, err = mapstore.processorTypes.remove(r.Name(), r.ResourceVersion())
 
 		case *model.Destination:
-			_, exists = mapstore.destinations.remove(r.Name())
+			_, exists, err = mapstore.destinations.remove(r.Name(), r.ResourceVersion())
 
 		case *model.DestinationType:
-			_, exists = mapstore.destinationTypes.remove(r.Name())
+			_, exists, err = mapstore.destinationTypes.remove(r.Name(), r.ResourceVersion())
+
+		case *model.Connector:
+			_, exists, err = mapstore.connectors.remove(r.Name(), r.ResourceVersion())
+
+		case *model.ConnectorType:
+			_, exists, err = mapstore.connectorTypes.remove(r.Name(), r.ResourceVersion())
+
+		case *model.AgentGroup:
+			_, exists, err = mapstore.agentGroups.remove(r.Name(), r.ResourceVersion())
 
 		default:
 			continue
 		}
+		if err != nil {
+			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(r, statusOf(err), err.Error()))
+			continue
+		}
 		if exists {
 			resourceStatuses = append(resourceStatuses, *model.NewResourceStatus(r, model.StatusDeleted))
 			updates.IncludeResource(r, EventTypeRemove)
@@ -552,12 +713,14 @@ func (mapstore *mapStore) AgentConfiguration(agentID string) (*model.Configurati
 		return nil, fmt.Errorf("cannot return configuration for unknown agent: %w", err)
 	}
 
-	labels := agent.Labels
-
 	// look through all of the configurations and check their selector to see if they match this agent. there are more
 	// efficient implementations, but this is fine for mapstore.
-	for _, c := range mapstore.configurations.store {
-		if c.AgentSelector().Matches(labels) {
+	for _, c := range mapstore.configurations.list() {
+		matches, err := isConfigurationForAgent(mapstore, c, agent)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
 			return c, nil
 		}
 	}
@@ -567,8 +730,7 @@ func (mapstore *mapStore) AgentConfiguration(agentID string) (*model.Configurati
 
 // AgentsIDsMatchingConfiguration returns the list of agent IDs that are using the specified configuration
 func (mapstore *mapStore) AgentsIDsMatchingConfiguration(configuration *model.Configuration) ([]string, error) {
-	ids := mapstore.agentIndex.Select(configuration.Spec.Selector.MatchLabels)
-	return ids, nil
+	return agentsIDsMatchingConfiguration(mapstore, configuration)
 }
 
 func (mapstore *mapStore) Updates() eventbus.Source[*Updates] {
@@ -670,5 +832,7 @@ func resourcesEqual(r1 model.Resource, r2 model.Resource) bool {
 
 	r1Any.Metadata.ID = ""
 	r2Any.Metadata.ID = ""
+	r1Any.Metadata.ResourceVersion = 0
+	r2Any.Metadata.ResourceVersion = 0
 	return reflect.DeepEqual(r1Any, r2Any)
 }
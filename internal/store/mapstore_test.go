@@ -159,6 +159,13 @@ func TestMapstorePaging(t *testing.T) {
 	runPagingTests(t, store)
 }
 
+func TestMapstoreCursorPaging(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewMapStore(ctx, testOptions, zap.NewNop())
+	runCursorPagingTests(t, store)
+}
+
 func TestMapstoreDeleteAgents(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -172,3 +179,24 @@ func TestMapstoreUpsertAgents(t *testing.T) {
 	store := NewMapStore(ctx, testOptions, zap.NewNop())
 	runTestUpsertAgents(t, store)
 }
+
+func TestMapstoreAgentGroups(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewMapStore(ctx, testOptions, zap.NewNop())
+	runAgentGroupTests(t, store)
+}
+
+func TestMapstoreResourceTypeVersions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewMapStore(ctx, testOptions, zap.NewNop())
+	runResourceTypeVersionTests(t, store)
+}
+
+func TestMapstoreResourceVersions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewMapStore(ctx, testOptions, zap.NewNop())
+	runResourceVersionTests(t, store)
+}
@@ -0,0 +1,226 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/eventbus"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// ErrMigrationRunning is returned when a migration is started while another migration is following changes
+var ErrMigrationRunning = errors.New("a store migration is already running")
+
+// ErrNoMigration is returned when a migration is stopped but no migration is following changes
+var ErrNoMigration = errors.New("no store migration is running")
+
+// ErrInvalidMigrationTarget is returned when the target of a migration is the store of the server
+var ErrInvalidMigrationTarget = errors.New("the target of the store migration must be a different store")
+
+// Migrations migrate the store of a running server to another store. The store of the server is read and followed in
+// place, so it is not opened a second time and changes made by the server while the migration is following are copied
+// to the target. Only one migration runs at a time.
+type Migrations interface {
+	// Start opens the target store and copies all resources and agents to it. If follow is true, changes to the store
+	// are copied until Stop is called. Otherwise the stores are verified and the target is closed.
+	Start(ctx context.Context, target *common.Server, follow bool) (*model.StoreMigration, error)
+
+	// Stop stops following changes, copies and verifies the stores one last time, and closes the target so that a
+	// server can be started with it
+	Stop(ctx context.Context) (*model.StoreMigration, error)
+}
+
+type migrations struct {
+	source       Store
+	sourceConfig *common.Server
+	logger       *zap.Logger
+
+	// mtx ensures that only one migration is started or stopped at a time
+	mtx     sync.Mutex
+	running *runningMigration
+}
+
+// runningMigration is a migration that is following changes
+type runningMigration struct {
+	migrator *Migrator
+	close    func() error
+	stop     context.CancelFunc
+	done     chan struct{}
+}
+
+var _ Migrations = (*migrations)(nil)
+
+// NewMigrations returns Migrations of the source store, which is the store of the server configured by sourceConfig.
+// Targets use the sessions secret of the server so that user sessions remain valid after switching stores.
+func NewMigrations(source Store, sourceConfig *common.Server, logger *zap.Logger) Migrations {
+	return &migrations{
+		source:       source,
+		sourceConfig: sourceConfig,
+		logger:       logger,
+	}
+}
+
+func (m *migrations) Start(ctx context.Context, target *common.Server, follow bool) (*model.StoreMigration, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.running != nil {
+		return nil, ErrMigrationRunning
+	}
+	if isBolt(target) && isBolt(m.sourceConfig) && target.BoltDatabasePath() == m.sourceConfig.BoltDatabasePath() {
+		return nil, ErrInvalidMigrationTarget
+	}
+
+	config := *target
+	config.SessionsSecret = m.sourceConfig.SessionsSecret
+
+	// the target outlives the request when following changes
+	followCtx, stop := context.WithCancel(context.Background())
+	to, closeTarget, err := openMigrationTarget(followCtx, &config, m.logger)
+	if err != nil {
+		stop()
+		return nil, fmt.Errorf("failed to open the target store: %w", err)
+	}
+	migrator := NewMigrator(m.source, to, m.logger)
+
+	// subscribe before copying so that changes made during the copy are not missed
+	var updates <-chan *Updates
+	unsubscribe := func() {}
+	if follow {
+		updates, unsubscribe = eventbus.Subscribe(m.source.Updates(), eventbus.WithChannel(make(chan *Updates, 10_000)))
+	}
+
+	counts, err := migrator.Copy(ctx)
+	if err != nil || !follow {
+		unsubscribe()
+		stop()
+		return m.finish(ctx, migrator, closeTarget, counts, err)
+	}
+
+	running := &runningMigration{
+		migrator: migrator,
+		close:    closeTarget,
+		stop:     stop,
+		done:     make(chan struct{}),
+	}
+	go func() {
+		defer close(running.done)
+		defer unsubscribe()
+		migrator.Follow(followCtx, updates)
+	}()
+	m.running = running
+
+	return &model.StoreMigration{
+		Following: true,
+		Counts:    migrationCounts(counts),
+	}, nil
+}
+
+func (m *migrations) Stop(ctx context.Context) (*model.StoreMigration, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	running := m.running
+	if running == nil {
+		return nil, ErrNoMigration
+	}
+	m.running = nil
+
+	running.stop()
+	<-running.done
+
+	// catch up with anything missed before verifying
+	counts, err := running.migrator.Copy(ctx)
+	return m.finish(ctx, running.migrator, running.close, counts, err)
+}
+
+// finish verifies the stores after a successful copy and closes the target
+func (m *migrations) finish(ctx context.Context, migrator *Migrator, closeTarget func() error, counts map[model.Kind]*MigrateCounts, copyErr error) (*model.StoreMigration, error) {
+	defer func() {
+		if err := closeTarget(); err != nil {
+			m.logger.Error("failed to close the target store", zap.Error(err))
+		}
+	}()
+
+	if copyErr != nil {
+		return nil, fmt.Errorf("failed to copy the store: %w", copyErr)
+	}
+	checks, err := migrator.Verify(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify the migration: %w", err)
+	}
+	return &model.StoreMigration{
+		Counts: migrationCounts(counts),
+		Checks: migrationChecks(checks),
+	}, nil
+}
+
+// openMigrationTarget opens the target store and returns a function that closes it. The bbolt file of a bbolt target
+// is closed so that a server can be started with it.
+func openMigrationTarget(ctx context.Context, config *common.Server, logger *zap.Logger) (Store, func() error, error) {
+	if !isBolt(config) {
+		s, err := NewStore(ctx, config, logger)
+		return s, func() error { return nil }, err
+	}
+	db, err := InitDB(config.BoltDatabasePath())
+	if err != nil {
+		return nil, nil, err
+	}
+	return NewBoltStore(ctx, db, Options{
+		SessionsSecret:   config.SessionsSecret,
+		MaxEventsToMerge: 100,
+	}, logger), db.Close, nil
+}
+
+// isBolt returns true if the configuration uses the bbolt store, which is the default
+func isBolt(config *common.Server) bool {
+	return config.StoreType != common.StoreTypeMap && config.StoreType != common.StoreTypeGoogleCloud
+}
+
+// migrationCounts returns the counts of each kind in the order they are copied
+func migrationCounts(counts map[model.Kind]*MigrateCounts) []*model.StoreMigrationCounts {
+	var result []*model.StoreMigrationCounts
+	for _, kind := range model.BundleKinds {
+		if c, ok := counts[kind]; ok {
+			result = append(result, &model.StoreMigrationCounts{
+				Kind:      kind,
+				Copied:    c.Copied,
+				Unchanged: c.Unchanged,
+				Deleted:   c.Deleted,
+			})
+		}
+	}
+	return result
+}
+
+func migrationChecks(checks []*MigrateCheck) []*model.StoreMigrationCheck {
+	result := make([]*model.StoreMigrationCheck, 0, len(checks))
+	for _, check := range checks {
+		result = append(result, &model.StoreMigrationCheck{
+			Kind:        check.Kind,
+			SourceCount: check.SourceCount,
+			TargetCount: check.TargetCount,
+			Matches:     check.Matches(),
+		})
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func requireMigrationVerified(t *testing.T, migration *model.StoreMigration) {
+	require.NotEmpty(t, migration.Checks)
+	for _, check := range migration.Checks {
+		require.True(t, check.Matches, "%s source %d target %d", check.Kind, check.SourceCount, check.TargetCount)
+	}
+}
+
+func TestMigrations(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	sourceConfig := &common.Server{StorageFilePath: filepath.Join(dir, "source"), SessionsSecret: "secret"}
+	source := NewMapStore(ctx, testOptions, zap.NewNop())
+	migrations := NewMigrations(source, sourceConfig, zap.NewNop())
+
+	applyTestConfiguration(t, source)
+	require.NoError(t, addAgent(source, &model.Agent{ID: "1", Name: "agent-1"}))
+
+	target := &common.Server{StorageFilePath: filepath.Join(dir, "target")}
+
+	// targetResource returns the resource from the target, which is closed when a migration is not following changes
+	targetResource := func(kind model.Kind, name string) model.Resource {
+		db, err := InitDB(target.StorageFilePath)
+		require.NoError(t, err)
+		defer db.Close()
+		resource, err := CurrentResource(NewBoltStore(ctx, db, testOptions, zap.NewNop()), kind, name)
+		require.NoError(t, err)
+		return resource
+	}
+
+	t.Run("copies and verifies", func(t *testing.T) {
+		migration, err := migrations.Start(ctx, target, false)
+		require.NoError(t, err)
+		require.False(t, migration.Following)
+		requireMigrationVerified(t, migration)
+		require.NotNil(t, targetResource(model.KindConfiguration, testConfiguration.Name()))
+	})
+
+	t.Run("follows changes until stopped", func(t *testing.T) {
+		migration, err := migrations.Start(ctx, target, true)
+		require.NoError(t, err)
+		require.True(t, migration.Following)
+		require.Empty(t, migration.Checks)
+
+		_, err = migrations.Start(ctx, target, true)
+		require.ErrorIs(t, err, ErrMigrationRunning)
+
+		// changes made by the server while following are copied
+		_, err = source.ApplyResources([]model.Resource{model.NewProcessorType("batch", nil)})
+		require.NoError(t, err)
+
+		migration, err = migrations.Stop(ctx)
+		require.NoError(t, err)
+		require.False(t, migration.Following)
+		requireMigrationVerified(t, migration)
+		require.NotNil(t, targetResource(model.KindProcessorType, "batch"))
+	})
+
+	t.Run("requires a running migration to stop", func(t *testing.T) {
+		_, err := migrations.Stop(ctx)
+		require.ErrorIs(t, err, ErrNoMigration)
+	})
+
+	t.Run("rejects the store of the server as the target", func(t *testing.T) {
+		_, err := migrations.Start(ctx, &common.Server{StorageFilePath: sourceConfig.StorageFilePath}, false)
+		require.ErrorIs(t, err, ErrInvalidMigrationTarget)
+	})
+}
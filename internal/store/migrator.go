@@ -0,0 +1,553 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+// migrateKinds are the kinds of resources copied by the Migrator in dependency order. Agents are copied separately.
+var migrateKinds = withoutAgents(model.BundleKinds)
+
+func withoutAgents(kinds []model.Kind) []model.Kind {
+	result := make([]model.Kind, 0, len(kinds))
+	for _, kind := range kinds {
+		if kind != model.KindAgent {
+			result = append(result, kind)
+		}
+	}
+	return result
+}
+
+// Migrator copies resources and agents from one Store to another, e.g. to move from bbolt to Google Cloud. The copy
+// can be repeated to catch up with changes made to the source after the first copy.
+type Migrator struct {
+	from   Store
+	to     Store
+	logger *zap.Logger
+}
+
+// MigrateCounts are the number of resources or agents of a kind that were copied, unchanged, or deleted from the
+// target because they no longer exist in the source
+type MigrateCounts struct {
+	Copied    int
+	Unchanged int
+	Deleted   int
+}
+
+// MigrateCheck compares the number of resources or agents of a kind and a checksum of their contents in the source and
+// target stores
+type MigrateCheck struct {
+	Kind           model.Kind
+	SourceCount    int
+	TargetCount    int
+	SourceChecksum string
+	TargetChecksum string
+}
+
+// Matches returns true if the source and target contain the same resources
+func (c *MigrateCheck) Matches() bool {
+	return c.SourceCount == c.TargetCount && c.SourceChecksum == c.TargetChecksum
+}
+
+// NewMigrator returns a new Migrator that copies resources and agents from one store to another
+func NewMigrator(from, to Store, logger *zap.Logger) *Migrator {
+	return &Migrator{
+		from:   from,
+		to:     to,
+		logger: logger,
+	}
+}
+
+// Copy copies all resources and agents from the source store to the target store. Resources that are already the same
+// in the target are left unchanged and resources and agents that do not exist in the source are deleted from the target
+// so that it can be run again to catch up with changes.
+func (m *Migrator) Copy(ctx context.Context) (map[model.Kind]*MigrateCounts, error) {
+	counts := map[model.Kind]*MigrateCounts{}
+	var errs error
+
+	// apply in dependency order
+	for _, kind := range migrateKinds {
+		kindCounts, err := m.copyResources(kind)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to copy %s resources: %w", kind, err))
+		}
+		counts[kind] = kindCounts
+	}
+
+	// delete in reverse dependency order so that resources are no longer in use when they are deleted
+	for i := len(migrateKinds) - 1; i >= 0; i-- {
+		kind := migrateKinds[i]
+		deleted, err := m.deleteResources(kind)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("failed to delete %s resources: %w", kind, err))
+		}
+		counts[kind].Deleted = deleted
+	}
+
+	agentCounts, err := m.copyAgents(ctx)
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("failed to copy agents: %w", err))
+	}
+	counts[model.KindAgent] = agentCounts
+
+	return counts, errs
+}
+
+// Verify compares the number of resources and agents of each kind and a checksum of their contents in the source and
+// target stores. The resourceVersion and id of resources are not included in the checksum because they are assigned by
+// each store.
+func (m *Migrator) Verify(ctx context.Context) ([]*MigrateCheck, error) {
+	var checks []*MigrateCheck
+	for _, kind := range migrateKinds {
+		check := &MigrateCheck{Kind: kind}
+		var err error
+		if check.SourceCount, check.SourceChecksum, err = resourcesChecksum(m.from, kind); err != nil {
+			return nil, err
+		}
+		if check.TargetCount, check.TargetChecksum, err = resourcesChecksum(m.to, kind); err != nil {
+			return nil, err
+		}
+		checks = append(checks, check)
+	}
+
+	check := &MigrateCheck{Kind: model.KindAgent}
+	var err error
+	if check.SourceCount, check.SourceChecksum, err = agentsChecksum(ctx, m.from); err != nil {
+		return nil, err
+	}
+	if check.TargetCount, check.TargetChecksum, err = agentsChecksum(ctx, m.to); err != nil {
+		return nil, err
+	}
+	return append(checks, check), nil
+}
+
+// Follow copies the resources and agents included in updates from the source store until the context is done or the
+// channel is closed. Subscribe to the Updates of the source store before calling Copy so that no changes are missed.
+func (m *Migrator) Follow(ctx context.Context, updates <-chan *Updates) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case u, ok := <-updates:
+			if !ok {
+				return
+			}
+			if err := m.ApplyUpdates(ctx, u); err != nil {
+				m.logger.Error("failed to copy updates", zap.Error(err))
+			}
+		}
+	}
+}
+
+// ApplyUpdates copies the current version of each resource and agent included in the updates from the source store to
+// the target store. Resources and agents that no longer exist in the source are deleted from the target.
+func (m *Migrator) ApplyUpdates(ctx context.Context, updates *Updates) error {
+	names := map[model.Kind][]string{
+		model.KindSourceType:      eventKeys(updates.SourceTypes),
+		model.KindProcessorType:   eventKeys(updates.ProcessorTypes),
+		model.KindDestinationType: eventKeys(updates.DestinationTypes),
+		model.KindConnectorType:   eventKeys(updates.ConnectorTypes),
+		model.KindSource:          eventKeys(updates.Sources),
+		model.KindProcessor:       eventKeys(updates.Processors),
+		model.KindDestination:     eventKeys(updates.Destinations),
+		model.KindConnector:       eventKeys(updates.Connectors),
+		model.KindAgentGroup:      eventKeys(updates.AgentGroups),
+		model.KindConfiguration:   eventKeys(updates.Configurations),
+	}
+
+	var errs error
+	var applied, deleted []model.Resource
+	for _, kind := range migrateKinds {
+		for _, name := range names[kind] {
+			current, err := CurrentResource(m.from, kind, name)
+			if err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+			if current == nil {
+				deleted = append(deleted, newDeletedResource(kind, name))
+				continue
+			}
+			if isResourceType(kind) {
+				existing, err := CurrentResource(m.to, kind, name)
+				if err == nil {
+					err = m.copyResourceTypeVersions(current, existing != nil)
+				}
+				if err != nil {
+					errs = multierror.Append(errs, err)
+				}
+				continue
+			}
+			resource, err := unversionedCopy(current)
+			if err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+			applied = append(applied, resource)
+		}
+	}
+
+	if len(applied) > 0 {
+		statuses, err := m.to.ApplyResources(applied)
+		if err == nil {
+			err = statusesError(statuses)
+		}
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if len(deleted) > 0 {
+		// delete in reverse dependency order so that resources are no longer in use when they are deleted
+		for i, j := 0, len(deleted)-1; i < j; i, j = i+1, j-1 {
+			deleted[i], deleted[j] = deleted[j], deleted[i]
+		}
+		statuses, err := m.to.DeleteResources(deleted)
+		if err == nil {
+			err = statusesError(statuses)
+		}
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	for _, id := range eventKeys(updates.Agents) {
+		agent, err := m.from.Agent(id)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		if agent == nil {
+			if _, err := m.to.DeleteAgents(ctx, []string{id}); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+			continue
+		}
+		if _, err := m.to.UpsertAgent(ctx, id, copyAgentUpdater(agent)); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// ----------------------------------------------------------------------
+
+func (m *Migrator) copyResources(kind model.Kind) (*MigrateCounts, error) {
+	counts := &MigrateCounts{}
+	resources, err := ResourcesOfKind(m.from, kind)
+	if err != nil {
+		return counts, err
+	}
+
+	if isResourceType(kind) {
+		var errs error
+		for _, resource := range resources {
+			existing, err := CurrentResource(m.to, kind, resource.Name())
+			if err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+			if existing != nil && resourceChecksum(existing) == resourceChecksum(resource) {
+				counts.Unchanged++
+				continue
+			}
+			if err := m.copyResourceTypeVersions(resource, existing != nil); err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+			counts.Copied++
+		}
+		return counts, errs
+	}
+
+	copies := make([]model.Resource, 0, len(resources))
+	for _, resource := range resources {
+		resource, err := unversionedCopy(resource)
+		if err != nil {
+			return counts, err
+		}
+		copies = append(copies, resource)
+	}
+	if len(copies) == 0 {
+		return counts, nil
+	}
+
+	statuses, err := m.to.ApplyResources(copies)
+	if err != nil {
+		return counts, err
+	}
+	for _, status := range statuses {
+		switch status.Status {
+		case model.StatusUnchanged:
+			counts.Unchanged++
+		case model.StatusCreated, model.StatusConfigured:
+			counts.Copied++
+		}
+	}
+	return counts, statusesError(statuses)
+}
+
+// copyResourceTypeVersions copies the resource type and its previous versions. If currentOnly is true, only the current
+// version is copied because the target already has a version of the resource type and applying previous versions would
+// replace it.
+func (m *Migrator) copyResourceTypeVersions(current model.Resource, currentOnly bool) error {
+	var versions []model.Resource
+	if !currentOnly {
+		previous, err := m.from.ResourceTypeVersions(current.GetKind(), current.Name())
+		if err != nil {
+			return err
+		}
+		currentType, err := CurrentResourceType(m.from, current.GetKind(), current.Name())
+		if err != nil {
+			return err
+		}
+		for _, version := range previous {
+			if currentType != nil && version.Spec.Version == currentType.Spec.Version {
+				continue
+			}
+			versions = append(versions, version)
+		}
+	}
+	// the current version is applied last so that the previous versions are archived
+	versions = append(versions, current)
+
+	for _, version := range versions {
+		resource, err := unversionedCopy(version)
+		if err != nil {
+			return err
+		}
+		statuses, err := m.to.ApplyResources([]model.Resource{resource})
+		if err != nil {
+			return err
+		}
+		if err := statusesError(statuses); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteResources deletes the resources of the specified kind that exist in the target but not in the source
+func (m *Migrator) deleteResources(kind model.Kind) (int, error) {
+	targetResources, err := ResourcesOfKind(m.to, kind)
+	if err != nil {
+		return 0, err
+	}
+	var deleted []model.Resource
+	for _, resource := range targetResources {
+		existing, err := CurrentResource(m.from, kind, resource.Name())
+		if err != nil {
+			return 0, err
+		}
+		if existing == nil {
+			deleted = append(deleted, newDeletedResource(kind, resource.Name()))
+		}
+	}
+	if len(deleted) == 0 {
+		return 0, nil
+	}
+	statuses, err := m.to.DeleteResources(deleted)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, status := range statuses {
+		if status.Status == model.StatusDeleted {
+			count++
+		}
+	}
+	return count, statusesError(statuses)
+}
+
+func (m *Migrator) copyAgents(ctx context.Context) (*MigrateCounts, error) {
+	counts := &MigrateCounts{}
+	agents, err := m.from.Agents(ctx)
+	if err != nil {
+		return counts, err
+	}
+	var errs error
+	sourceIDs := map[string]bool{}
+	for _, agent := range agents {
+		sourceIDs[agent.ID] = true
+		existing, err := m.to.Agent(agent.ID)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		if existing != nil && agentChecksum(existing) == agentChecksum(agent) {
+			counts.Unchanged++
+			continue
+		}
+		if _, err := m.to.UpsertAgent(ctx, agent.ID, copyAgentUpdater(agent)); err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		counts.Copied++
+	}
+
+	targetAgents, err := m.to.Agents(ctx)
+	if err != nil {
+		return counts, multierror.Append(errs, err)
+	}
+	var deleteIDs []string
+	for _, agent := range targetAgents {
+		if !sourceIDs[agent.ID] {
+			deleteIDs = append(deleteIDs, agent.ID)
+		}
+	}
+	if len(deleteIDs) > 0 {
+		deleted, err := m.to.DeleteAgents(ctx, deleteIDs)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		counts.Deleted = len(deleted)
+	}
+	return counts, errs
+}
+
+// ----------------------------------------------------------------------
+
+// copyAgentUpdater replaces the agent in the target store with a copy of the agent from the source store
+func copyAgentUpdater(agent *model.Agent) AgentUpdater {
+	return func(current *model.Agent) {
+		*current = *agent
+		current.Labels = model.LabelsFromValidatedMap(agent.Labels.AsMap())
+	}
+}
+
+// unversionedCopy returns a copy of the resource without a resourceVersion so that it can be applied to the target
+// store which assigns its own resourceVersion
+func unversionedCopy(resource model.Resource) (model.Resource, error) {
+	copied, err := copyResource(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy %s %s: %w", resource.GetKind(), resource.Name(), err)
+	}
+	copied.SetResourceVersion(0)
+	return copied, nil
+}
+
+func newDeletedResource(kind model.Kind, name string) model.Resource {
+	return &model.AnyResource{
+		ResourceMeta: model.ResourceMeta{
+			Kind:     kind,
+			Metadata: model.Metadata{Name: name},
+		},
+	}
+}
+
+// statusesError returns an error if any resource could not be applied or deleted
+func statusesError(statuses []model.ResourceStatus) error {
+	var errs error
+	for _, status := range statuses {
+		switch status.Status {
+		case model.StatusInvalid, model.StatusError, model.StatusConflict, model.StatusInUse:
+			errs = multierror.Append(errs, errors.New(status.String()))
+		}
+	}
+	return errs
+}
+
+func isResourceType(kind model.Kind) bool {
+	switch kind {
+	case model.KindSourceType, model.KindProcessorType, model.KindDestinationType, model.KindConnectorType:
+		return true
+	}
+	return false
+}
+
+func eventKeys[T model.HasUniqueKey](events Events[T]) []string {
+	keys := make([]string, 0, len(events))
+	for key := range events {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ----------------------------------------------------------------------
+// checksums
+
+// resourcesChecksum returns the number of resources of the kind and a checksum of their contents sorted by name
+func resourcesChecksum(s Store, kind model.Kind) (int, string, error) {
+	resources, err := ResourcesOfKind(s, kind)
+	if err != nil {
+		return 0, "", err
+	}
+	checksums := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		checksums = append(checksums, resource.Name()+" "+resourceChecksum(resource))
+	}
+	return len(resources), combinedChecksum(checksums), nil
+}
+
+// agentsChecksum returns the number of agents and a checksum of their contents sorted by id
+func agentsChecksum(ctx context.Context, s Store) (int, string, error) {
+	agents, err := s.Agents(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+	checksums := make([]string, 0, len(agents))
+	for _, agent := range agents {
+		checksums = append(checksums, agent.ID+" "+agentChecksum(agent))
+	}
+	return len(agents), combinedChecksum(checksums), nil
+}
+
+// resourceChecksum returns a checksum of the json of the resource without its id and resourceVersion
+func resourceChecksum(resource model.Resource) string {
+	copied, err := copyResource(resource)
+	if err != nil {
+		return ""
+	}
+	copied.SetID("")
+	copied.SetResourceVersion(0)
+	return jsonChecksum(copied)
+}
+
+func agentChecksum(agent *model.Agent) string {
+	return jsonChecksum(agent)
+}
+
+func jsonChecksum(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func combinedChecksum(checksums []string) string {
+	sort.Strings(checksums)
+	hash := sha256.New()
+	for _, checksum := range checksums {
+		hash.Write([]byte(checksum))
+		hash.Write([]byte("\n"))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,161 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/internal/eventbus"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func requireVerified(t *testing.T, m *Migrator) {
+	checks, err := m.Verify(context.Background())
+	require.NoError(t, err)
+	for _, check := range checks {
+		require.True(t, check.Matches(), "%s source %d target %d", check.Kind, check.SourceCount, check.TargetCount)
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	db, err := initTestDB(t)
+	require.NoError(t, err)
+	defer cleanupTestDB(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	from := NewMapStore(ctx, testOptions, zap.NewNop())
+	to := NewBoltStore(ctx, db, testOptions, zap.NewNop())
+	m := NewMigrator(from, to, zap.NewNop())
+
+	v1 := model.NewSourceTypeWithSpec("versioned", model.ResourceTypeSpec{
+		Version:    "1.0.0",
+		Parameters: []model.ParameterDefinition{{Name: "host", Type: "string"}},
+	})
+	v2 := model.NewSourceTypeWithSpec("versioned", model.ResourceTypeSpec{
+		Version:    "2.0.0",
+		Parameters: []model.ParameterDefinition{{Name: "endpoint", Type: "string"}},
+	})
+	statuses, err := from.ApplyResources([]model.Resource{v1})
+	require.NoError(t, err)
+	requireOkStatuses(t, statuses)
+	statuses, err = from.ApplyResources([]model.Resource{v2})
+	require.NoError(t, err)
+	requireOkStatuses(t, statuses)
+
+	applyTestConfiguration(t, from)
+
+	// the processor must be copied before the source that references it by name
+	statuses, err = from.ApplyResources([]model.Resource{
+		model.NewProcessorType("batch", nil),
+		model.NewProcessor("batch", "batch", nil),
+		model.NewSourceWithSpec("batched", model.ParameterizedSpec{
+			Type:       macosSourceType.Name(),
+			Processors: []model.ResourceConfiguration{{Name: "batch"}},
+		}),
+	})
+	require.NoError(t, err)
+	requireOkStatuses(t, statuses)
+	require.NoError(t, addAgent(from, &model.Agent{ID: "1", Name: "agent-1", Labels: labels(map[string]string{"env": "dev"})}))
+
+	t.Run("copies resources and agents", func(t *testing.T) {
+		counts, err := m.Copy(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 3, counts[model.KindSourceType].Copied)
+		require.Equal(t, 1, counts[model.KindProcessor].Copied)
+		require.Equal(t, 3, counts[model.KindSource].Copied)
+		require.Equal(t, 1, counts[model.KindConfiguration].Copied)
+		require.Equal(t, 1, counts[model.KindAgent].Copied)
+		requireVerified(t, m)
+
+		versions, err := to.ResourceTypeVersions(model.KindSourceType, "versioned")
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+
+		batched, err := to.Source("batched")
+		require.NoError(t, err)
+		require.Equal(t, "batch", batched.Spec.Processors[0].Name)
+
+		agent, err := to.Agent("1")
+		require.NoError(t, err)
+		require.Equal(t, "dev", agent.Labels.Get("env"))
+	})
+
+	t.Run("copies only changes", func(t *testing.T) {
+		statuses, err := from.ApplyResources([]model.Resource{macosSourceChanged})
+		require.NoError(t, err)
+		requireOkStatuses(t, statuses)
+		_, err = from.DeleteResources([]model.Resource{cabinDestination2})
+		require.NoError(t, err)
+
+		checks, err := m.Verify(ctx)
+		require.NoError(t, err)
+		mismatched := []model.Kind{}
+		for _, check := range checks {
+			if !check.Matches() {
+				mismatched = append(mismatched, check.Kind)
+			}
+		}
+		require.Equal(t, []model.Kind{model.KindSource, model.KindDestination}, mismatched)
+
+		counts, err := m.Copy(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, counts[model.KindSourceType].Copied)
+		require.Equal(t, 3, counts[model.KindSourceType].Unchanged)
+		require.Equal(t, 1, counts[model.KindSource].Copied)
+		require.Equal(t, 1, counts[model.KindDestination].Deleted)
+		require.Equal(t, 1, counts[model.KindAgent].Unchanged)
+		requireVerified(t, m)
+	})
+
+	t.Run("follows updates", func(t *testing.T) {
+		updates, unsubscribe := eventbus.Subscribe(from.Updates(), eventbus.WithChannel(make(chan *Updates, 100)))
+		defer unsubscribe()
+
+		followCtx, stopFollowing := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			m.Follow(followCtx, updates)
+			close(done)
+		}()
+
+		statuses, err := from.ApplyResources([]model.Resource{nginxSourceChanged})
+		require.NoError(t, err)
+		requireOkStatuses(t, statuses)
+		require.NoError(t, addAgent(from, &model.Agent{ID: "2", Name: "agent-2"}))
+		_, err = from.DeleteAgents(ctx, []string{"1"})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			checks, err := m.Verify(ctx)
+			require.NoError(t, err)
+			for _, check := range checks {
+				if !check.Matches() {
+					return false
+				}
+			}
+			return true
+		}, 5*time.Second, 10*time.Millisecond)
+
+		stopFollowing()
+		<-done
+	})
+}
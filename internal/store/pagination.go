@@ -0,0 +1,168 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+// defaultSort is the sort used for lists when no sort is specified
+const defaultSort = "name"
+
+// ErrInvalidCursor is returned by queries using WithCursor when the cursor cannot be decoded or was created for a
+// different sort than the one requested
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// AgentSortFields are the fields that can be used with WithSort when listing agents
+var AgentSortFields = []string{"name", "id", "version"}
+
+// ResourceSortFields are the fields that can be used with WithSort when listing resources
+var ResourceSortFields = []string{"name", "id", "description"}
+
+// cursor identifies the last item of a page of results. It contains the value of the sort field so that the next page
+// starts at the same position even if items are inserted or removed between requests. The key is used to order items
+// with the same sort value.
+type cursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	Key   string `json:"k"`
+}
+
+func encodeCursor(c cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(encoded string) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// sortField splits a sort value like -name into the field and the direction
+func sortField(sort string) (field string, ascending bool) {
+	if sort == "" {
+		return defaultSort, true
+	}
+	if sort[0] == '-' {
+		return sort[1:], false
+	}
+	return sort, true
+}
+
+func newCursor[T any](sort string, item T, fieldAccessor fieldAccessor[T]) string {
+	if sort == "" {
+		sort = defaultSort
+	}
+	field, _ := sortField(sort)
+	return encodeCursor(cursor{
+		Sort:  sort,
+		Value: fieldAccessor(field, item),
+		Key:   fieldAccessor(keyField, item),
+	})
+}
+
+// AgentCursor returns a cursor that can be used with WithCursor to list the agents following the specified agent when
+// sorted by sort
+func AgentCursor(sort string, agent *model.Agent) string {
+	return newCursor(sort, agent, agentField)
+}
+
+// ResourceCursor returns a cursor that can be used with WithCursor to list the resources following the specified
+// resource when sorted by sort
+func ResourceCursor(sort string, resource model.Resource) string {
+	return newCursor(sort, resource, resourceField[model.Resource])
+}
+
+// Page trims a list that was queried with a limit of limit+1 to limit items and returns the cursor for the next page.
+// The cursor is empty if there are no more items or limit is 0.
+func Page[T any](list []T, limit int, cursor func(T) string) ([]T, string) {
+	if limit <= 0 || len(list) <= limit {
+		return list, ""
+	}
+	list = list[:limit]
+	return list, cursor(list[limit-1])
+}
+
+// ----------------------------------------------------------------------
+// field accessors
+
+// keyField is the field used to uniquely identify an item in a cursor and to order items with the same sort value
+const keyField = "key"
+
+func agentField(field string, agent *model.Agent) string {
+	switch field {
+	case "id", keyField:
+		return agent.ID
+	case "version":
+		return agent.Version
+	default:
+		return agent.Name
+	}
+}
+
+func resourceField[R model.Resource](field string, resource R) string {
+	switch field {
+	case "id":
+		return resource.ID()
+	case "description":
+		return resource.Description()
+	default:
+		return resource.Name()
+	}
+}
+
+// applyResourceQueryOptions filters the resources using the selector and applies the sort, cursor, offset, and limit
+func applyResourceQueryOptions[R model.Resource](list []R, opts queryOptions) ([]R, error) {
+	if !opts.selector.Empty() {
+		filtered := make([]R, 0, len(list))
+		for _, item := range list {
+			if opts.selector.Matches(item.GetLabels()) {
+				filtered = append(filtered, item)
+			}
+		}
+		list = filtered
+	}
+	if opts.sort == "" {
+		opts.sort = defaultSort
+	}
+	return applySortOffsetAndLimit(list, opts, resourceField[R])
+}
+
+// applyCursor returns the items of the sorted list that follow the item identified by the cursor
+func applyCursor[T any](list []T, opts queryOptions, byField byField[T]) ([]T, error) {
+	c, err := decodeCursor(opts.cursor)
+	if err != nil {
+		return nil, err
+	}
+	if c.Sort != opts.sort {
+		return nil, ErrInvalidCursor
+	}
+	start := sort.Search(len(list), func(i int) bool {
+		return byField.less(c.Value, c.Key, byField.fieldAccessor(byField.field, list[i]), byField.fieldAccessor(keyField, list[i]))
+	})
+	return list[start:], nil
+}
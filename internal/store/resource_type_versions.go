@@ -0,0 +1,371 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+// pinnedReference is a reference to a version of a resource type pinned by a resource or by a source, processor, or
+// destination within a resource. The version and parameters point into the resource so that they can be migrated.
+type pinnedReference struct {
+	path       string
+	typeKind   model.Kind
+	typeName   string
+	version    *string
+	parameters *[]model.Parameter
+	// overrides is true for parameters that override the parameters of a named resource
+	overrides bool
+}
+
+// migrate updates the parameters and version of the reference to use the current version of the resource type
+func (p *pinnedReference) migrate(current *model.ResourceType) error {
+	migrate := current.Spec.MigrateParameters
+	if p.overrides {
+		migrate = current.Spec.MigrateParameterOverrides
+	}
+	parameters, err := migrate(*p.version, *p.parameters)
+	if err != nil {
+		return err
+	}
+	*p.parameters = parameters
+	*p.version = current.Spec.Version
+	return nil
+}
+
+// outdatedReference returns the current version of the resource type if the reference is pinned to an earlier version
+// and nil otherwise
+func outdatedReference(s Store, ref pinnedReference) (*model.ResourceType, error) {
+	current, err := CurrentResourceType(s, ref.typeKind, ref.typeName)
+	if err != nil || current == nil {
+		return nil, err
+	}
+	if model.CompareVersions(*ref.version, current.Spec.Version) >= 0 {
+		return nil, nil
+	}
+	return current, nil
+}
+
+// pinnedReferences returns the references to resource type versions pinned by the resource
+func pinnedReferences(s Store, resource model.Resource) ([]pinnedReference, error) {
+	var refs []pinnedReference
+	addSpec := func(typeKind model.Kind, spec *model.ParameterizedSpec) error {
+		if spec.TypeVersion != "" {
+			refs = append(refs, pinnedReference{
+				typeKind:   typeKind,
+				typeName:   spec.Type,
+				version:    &spec.TypeVersion,
+				parameters: &spec.Parameters,
+			})
+		}
+		return addResourceConfigurations(s, &refs, "processors", model.KindProcessor, spec.Processors)
+	}
+
+	switch r := resource.(type) {
+	case *model.Source:
+		return refs, addSpec(model.KindSourceType, &r.Spec)
+	case *model.Processor:
+		return refs, addSpec(model.KindProcessorType, &r.Spec)
+	case *model.Destination:
+		return refs, addSpec(model.KindDestinationType, &r.Spec)
+	case *model.Connector:
+		return refs, addSpec(model.KindConnectorType, &r.Spec)
+	case *model.Configuration:
+		err := addResourceConfigurations(s, &refs, "sources", model.KindSource, r.Spec.Sources)
+		if err != nil {
+			return nil, err
+		}
+		err = addResourceConfigurations(s, &refs, "destinations", model.KindDestination, r.Spec.Destinations)
+		if err != nil {
+			return nil, err
+		}
+		err = addResourceConfigurations(s, &refs, "connectors", model.KindConnector, r.Spec.Connectors)
+		if err != nil {
+			return nil, err
+		}
+		return refs, addResourceConfigurations(s, &refs, "processors", model.KindProcessor, r.Spec.Processors)
+	}
+	return nil, nil
+}
+
+// addResourceConfigurations adds references for the resource configurations that are pinned, including their
+// processors
+func addResourceConfigurations(s Store, refs *[]pinnedReference, path string, kind model.Kind, list []model.ResourceConfiguration) error {
+	for i := range list {
+		rc := &list[i]
+		rcPath := fmt.Sprintf("%s[%d]", path, i)
+		if rc.TypeVersion != "" {
+			typeName := rc.Type
+			if rc.Name != "" {
+				var err error
+				typeName, err = namedResourceTypeName(s, kind, rc.Name)
+				if err != nil {
+					return err
+				}
+			}
+			if typeName != "" {
+				*refs = append(*refs, pinnedReference{
+					path:       rcPath,
+					typeKind:   resourceTypeKind(kind),
+					typeName:   typeName,
+					version:    &rc.TypeVersion,
+					parameters: &rc.Parameters,
+					overrides:  rc.Name != "",
+				})
+			}
+		}
+		if err := addResourceConfigurations(s, refs, rcPath+".processors", model.KindProcessor, rc.Processors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceTypeKind returns the kind of the resource type used by resources of the specified kind
+func resourceTypeKind(kind model.Kind) model.Kind {
+	switch kind {
+	case model.KindSource:
+		return model.KindSourceType
+	case model.KindProcessor:
+		return model.KindProcessorType
+	case model.KindDestination:
+		return model.KindDestinationType
+	case model.KindConnector:
+		return model.KindConnectorType
+	}
+	return model.KindUnknown
+}
+
+// namedResourceTypeName returns the name of the resource type of the named Source, Processor, Destination, or
+// Connector or "" if it does not exist
+func namedResourceTypeName(s Store, kind model.Kind, name string) (string, error) {
+	switch kind {
+	case model.KindSource:
+		item, err := s.Source(name)
+		if item == nil {
+			return "", err
+		}
+		return item.Spec.Type, err
+	case model.KindProcessor:
+		item, err := s.Processor(name)
+		if item == nil {
+			return "", err
+		}
+		return item.Spec.Type, err
+	case model.KindDestination:
+		item, err := s.Destination(name)
+		if item == nil {
+			return "", err
+		}
+		return item.Spec.Type, err
+	case model.KindConnector:
+		item, err := s.Connector(name)
+		if item == nil {
+			return "", err
+		}
+		return item.Spec.Type, err
+	}
+	return "", nil
+}
+
+// pinnableResources returns the Sources, Processors, Destinations, Connectors, and Configurations in the store. If kind is
+// specified, only resources of that kind are returned and if name is also specified, only the resource with that name
+// is returned.
+func pinnableResources(s Store, kind model.Kind, name string) ([]model.Resource, error) {
+	var resources []model.Resource
+	include := func(k model.Kind) bool {
+		return kind == "" || kind == k
+	}
+	if include(model.KindSource) {
+		items, err := s.Sources()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			resources = append(resources, item)
+		}
+	}
+	if include(model.KindProcessor) {
+		items, err := s.Processors()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			resources = append(resources, item)
+		}
+	}
+	if include(model.KindDestination) {
+		items, err := s.Destinations()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			resources = append(resources, item)
+		}
+	}
+	if include(model.KindConnector) {
+		items, err := s.Connectors()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			resources = append(resources, item)
+		}
+	}
+	if include(model.KindConfiguration) {
+		items, err := s.Configurations()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			resources = append(resources, item)
+		}
+	}
+	if name == "" {
+		return resources, nil
+	}
+	var named []model.Resource
+	for _, resource := range resources {
+		if resource.Name() == name {
+			named = append(named, resource)
+		}
+	}
+	return named, nil
+}
+
+// OutdatedResources returns the Sources, Processors, Destinations, Connectors, and Configurations that are pinned to a version of
+// their resource type that is earlier than the current version. Resources that are not pinned always use the current
+// version and are never outdated.
+func OutdatedResources(s Store) ([]*model.OutdatedResource, error) {
+	resources, err := pinnableResources(s, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.OutdatedResource{}
+	for _, resource := range resources {
+		refs, err := pinnedReferences(s, resource)
+		if err != nil {
+			return nil, err
+		}
+		for _, ref := range refs {
+			current, err := outdatedReference(s, ref)
+			if err != nil {
+				return nil, err
+			}
+			if current == nil {
+				continue
+			}
+			_, err = current.Spec.MigrateParameters(*ref.version, *ref.parameters)
+			result = append(result, &model.OutdatedResource{
+				Kind:           resource.GetKind(),
+				Name:           resource.Name(),
+				Path:           ref.path,
+				ResourceType:   ref.typeName,
+				TypeVersion:    *ref.version,
+				CurrentVersion: current.Spec.Version,
+				Migratable:     err == nil,
+			})
+		}
+	}
+	return result, nil
+}
+
+// MigrateResources updates outdated resources to use the current version of their resource types, applying the
+// migrations of the resource types to their parameters. If kind is specified, only resources of that kind are migrated
+// and if name is also specified, only the resource with that name is migrated. Resources that cannot be migrated are
+// returned with StatusInvalid and are not modified.
+func MigrateResources(s Store, kind model.Kind, name string) ([]model.ResourceStatus, error) {
+	resources, err := pinnableResources(s, kind, name)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := []model.ResourceStatus{}
+	var migrated []model.Resource
+	var errs error
+	for _, resource := range resources {
+		// migrate a copy so that the resource in the store is only changed by ApplyResources
+		resource, err := copyResource(resource)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		refs, err := pinnedReferences(s, resource)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+
+		changed := false
+		var migrateErrs error
+		for _, ref := range refs {
+			current, err := outdatedReference(s, ref)
+			if err != nil {
+				migrateErrs = multierror.Append(migrateErrs, err)
+				continue
+			}
+			if current == nil {
+				continue
+			}
+			if err := ref.migrate(current); err != nil {
+				migrateErrs = multierror.Append(migrateErrs, fmt.Errorf("%s%s: %w", ref.typeName, pathSuffix(ref.path), err))
+				continue
+			}
+			changed = true
+		}
+
+		switch {
+		case migrateErrs != nil:
+			statuses = append(statuses, *model.NewResourceStatusWithReason(resource, model.StatusInvalid, migrateErrs.Error()))
+		case changed:
+			migrated = append(migrated, resource)
+		}
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	if len(migrated) == 0 {
+		return statuses, nil
+	}
+
+	applied, err := s.ApplyResources(migrated)
+	return append(statuses, applied...), err
+}
+
+func pathSuffix(path string) string {
+	if path == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", path)
+}
+
+// copyResource returns a deep copy of the resource
+func copyResource(resource model.Resource) (model.Resource, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	var anyResource model.AnyResource
+	if err := json.Unmarshal(data, &anyResource); err != nil {
+		return nil, err
+	}
+	return model.ParseResource(&anyResource)
+}
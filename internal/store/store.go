@@ -16,15 +16,18 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/fs"
 	"net/http"
+	"reflect"
 	"sort"
 	"time"
 
 	"github.com/gorilla/sessions"
 	"github.com/hashicorp/go-multierror"
+	"github.com/observiq/bindplane-op/common"
 	"github.com/observiq/bindplane-op/internal/eventbus"
 	"github.com/observiq/bindplane-op/internal/store/search"
 	"github.com/observiq/bindplane-op/model"
@@ -41,6 +44,42 @@ type Options struct {
 	MaxEventsToMerge int
 }
 
+// NewStore returns the Store specified by the StoreType of the server configuration. The bbolt store is used if no
+// StoreType is specified.
+func NewStore(ctx context.Context, config *common.Server, logger *zap.Logger) (Store, error) {
+	if config.SessionsSecret == "" {
+		return nil, errors.New("cannot create store with unset value for sessions-secret, run bindplane init server to set value")
+	}
+
+	switch config.StoreType {
+	case common.StoreTypeMap:
+		return NewMapStore(ctx, Options{
+			SessionsSecret:   config.SessionsSecret,
+			MaxEventsToMerge: 100,
+		}, logger), nil
+
+	case common.StoreTypeGoogleCloud:
+		logger.Info("Using Google Cloud Datastore and Pub/Sub")
+		return NewGoogleCloudStore(ctx, config, logger)
+
+	default:
+		// case common.StoreTypeBbolt:
+		storageFilePath := config.BoltDatabasePath()
+
+		db, err := InitDB(storageFilePath)
+		// Exit if DB creation is unsuccessful.
+		if err != nil {
+			return nil, fmt.Errorf("BBolt storage file failed to open: %w", err)
+		}
+
+		logger.Info("Using BBolt Storage", zap.String("storageFilePath", storageFilePath))
+		return NewBoltStore(ctx, db, Options{
+			SessionsSecret:   config.SessionsSecret,
+			MaxEventsToMerge: 100,
+		}, logger), nil
+	}
+}
+
 // Store handles interacting with a storage backend,
 type Store interface {
 	Clear()
@@ -58,29 +97,49 @@ type Store interface {
 	DeleteConfiguration(string) (*model.Configuration, error)
 
 	Source(name string) (*model.Source, error)
-	Sources() ([]*model.Source, error)
+	Sources(options ...QueryOption) ([]*model.Source, error)
 	DeleteSource(name string) (*model.Source, error)
 
 	SourceType(name string) (*model.SourceType, error)
-	SourceTypes() ([]*model.SourceType, error)
+	SourceTypes(options ...QueryOption) ([]*model.SourceType, error)
 	DeleteSourceType(name string) (*model.SourceType, error)
 
 	Processor(name string) (*model.Processor, error)
-	Processors() ([]*model.Processor, error)
+	Processors(options ...QueryOption) ([]*model.Processor, error)
 	DeleteProcessor(name string) (*model.Processor, error)
 
 	ProcessorType(name string) (*model.ProcessorType, error)
-	ProcessorTypes() ([]*model.ProcessorType, error)
+	ProcessorTypes(options ...QueryOption) ([]*model.ProcessorType, error)
 	DeleteProcessorType(name string) (*model.ProcessorType, error)
 
 	Destination(name string) (*model.Destination, error)
-	Destinations() ([]*model.Destination, error)
+	Destinations(options ...QueryOption) ([]*model.Destination, error)
 	DeleteDestination(name string) (*model.Destination, error)
 
 	DestinationType(name string) (*model.DestinationType, error)
-	DestinationTypes() ([]*model.DestinationType, error)
+	DestinationTypes(options ...QueryOption) ([]*model.DestinationType, error)
 	DeleteDestinationType(name string) (*model.DestinationType, error)
 
+	Connector(name string) (*model.Connector, error)
+	Connectors(options ...QueryOption) ([]*model.Connector, error)
+	DeleteConnector(name string) (*model.Connector, error)
+
+	ConnectorType(name string) (*model.ConnectorType, error)
+	ConnectorTypes(options ...QueryOption) ([]*model.ConnectorType, error)
+	DeleteConnectorType(name string) (*model.ConnectorType, error)
+
+	// ResourceTypeVersion returns the specified version of a SourceType, ProcessorType, DestinationType, or
+	// ConnectorType or nil if it is not available. Previous versions are kept when a resource type is replaced by a
+	// different version.
+	ResourceTypeVersion(kind model.Kind, name string, version string) (*model.ResourceType, error)
+	// ResourceTypeVersions returns the available versions of a SourceType, ProcessorType, DestinationType, or
+	// ConnectorType, including the current version, sorted from earliest to latest
+	ResourceTypeVersions(kind model.Kind, name string) ([]*model.ResourceType, error)
+
+	AgentGroup(name string) (*model.AgentGroup, error)
+	AgentGroups(options ...QueryOption) ([]*model.AgentGroup, error)
+	DeleteAgentGroup(name string) (*model.AgentGroup, error)
+
 	ApplyResources([]model.Resource) ([]model.ResourceStatus, error)
 	// Batch delete of a slice of resources, returns the successfully deleted resources or an error.
 	DeleteResources([]model.Resource) ([]model.ResourceStatus, error)
@@ -132,6 +191,7 @@ type queryOptions struct {
 	offset   int
 	limit    int
 	sort     string
+	cursor   string
 }
 
 func makeQueryOptions(options []QueryOption) queryOptions {
@@ -186,6 +246,15 @@ func WithSort(field string) QueryOption {
 	}
 }
 
+// WithCursor returns the results following the item identified by the cursor. Cursors are returned by AgentCursor and
+// ResourceCursor and must be used with the same sort that was used to create them. Unlike WithOffset, the next page is
+// stable when items are inserted or removed between requests.
+func WithCursor(cursor string) QueryOption {
+	return func(opts *queryOptions) {
+		opts.cursor = cursor
+	}
+}
+
 // ----------------------------------------------------------------------
 // seeding resources
 
@@ -290,6 +359,68 @@ func newDependencyError(d DependentResources) error {
 	}
 }
 
+// ConflictError is returned when the resourceVersion of a resource being applied or deleted does not match the
+// resourceVersion of the stored resource.
+type ConflictError struct {
+	resourceVersion        int64
+	currentResourceVersion int64
+}
+
+func (ce *ConflictError) Error() string {
+	return fmt.Sprintf("resourceVersion %d does not match the current resourceVersion %d", ce.resourceVersion, ce.currentResourceVersion)
+}
+
+// checkResourceVersion returns a ConflictError if the resourceVersion is specified and does not match the
+// resourceVersion of the current resource. A resourceVersion of 0 or a current resource of nil never conflicts.
+func checkResourceVersion(resourceVersion int64, current model.Resource) error {
+	if resourceVersion == 0 || current == nil || current.ResourceVersion() == resourceVersion {
+		return nil
+	}
+	return &ConflictError{
+		resourceVersion:        resourceVersion,
+		currentResourceVersion: current.ResourceVersion(),
+	}
+}
+
+// statusOf returns StatusConflict for a ConflictError and StatusError for any other error
+func statusOf(err error) model.UpdateStatus {
+	var conflict *ConflictError
+	if errors.As(err, &conflict) {
+		return model.StatusConflict
+	}
+	return model.StatusError
+}
+
+// nextResourceVersion returns the resourceVersion for a resource that is created or modified. current will be nil if
+// the resource is being created.
+func nextResourceVersion(current model.Resource) int64 {
+	if current == nil {
+		return 1
+	}
+	return current.ResourceVersion() + 1
+}
+
+// storedCopy returns a copy of an applied resource to store. The store assigns the resourceVersion of the copy, which
+// is returned in the ResourceStatus, so that the resource passed to ApplyResources is not modified.
+func storedCopy(resource model.Resource) (model.Resource, error) {
+	t := reflect.TypeOf(resource)
+	if t == nil || t.Kind() != reflect.Pointer {
+		return nil, fmt.Errorf("unknown resource type in apply: %T", resource)
+	}
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy %s %s: %w", resource.GetKind(), resource.Name(), err)
+	}
+	stored, ok := reflect.New(t.Elem()).Interface().(model.Resource)
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type in apply: %T", resource)
+	}
+	if err := json.Unmarshal(data, stored); err != nil {
+		return nil, fmt.Errorf("failed to copy %s %s: %w", resource.GetKind(), resource.Name(), err)
+	}
+	return stored, nil
+}
+
 // ----------------------------------------------------------------------
 
 // FindDependentResources finds the dependent resources using the ConfigurationIndex provided by the Store.
@@ -314,28 +445,216 @@ func FindDependentResources(ctx context.Context, s Store, r model.Resource) (Dep
 		for _, id := range ids {
 			dependencies.add(dependency{name: id, kind: model.KindConfiguration})
 		}
+
+	case model.KindConnector:
+		ids, err := search.Field(ctx, s.ConfigurationIndex(), "connector", r.Name())
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			dependencies.add(dependency{name: id, kind: model.KindConfiguration})
+		}
+
+	case model.KindProcessor:
+		ids, err := search.Field(ctx, s.ConfigurationIndex(), "processor", r.Name())
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			dependencies.add(dependency{name: id, kind: model.KindConfiguration})
+		}
+
+	case model.KindAgentGroup:
+		ids, err := search.Field(ctx, s.ConfigurationIndex(), "agentGroup", r.Name())
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			dependencies.add(dependency{name: id, kind: model.KindConfiguration})
+		}
 	}
 
 	return dependencies, nil
 }
 
+// CurrentResource returns the resource with the specified kind and name or nil if it does not exist
+func CurrentResource(s Store, kind model.Kind, name string) (model.Resource, error) {
+	switch kind {
+	case model.KindConfiguration:
+		return resourceOrNil(s.Configuration(name))
+	case model.KindSource:
+		return resourceOrNil(s.Source(name))
+	case model.KindSourceType:
+		return resourceOrNil(s.SourceType(name))
+	case model.KindProcessor:
+		return resourceOrNil(s.Processor(name))
+	case model.KindProcessorType:
+		return resourceOrNil(s.ProcessorType(name))
+	case model.KindDestination:
+		return resourceOrNil(s.Destination(name))
+	case model.KindDestinationType:
+		return resourceOrNil(s.DestinationType(name))
+	case model.KindConnector:
+		return resourceOrNil(s.Connector(name))
+	case model.KindConnectorType:
+		return resourceOrNil(s.ConnectorType(name))
+	case model.KindAgentGroup:
+		return resourceOrNil(s.AgentGroup(name))
+	}
+	return nil, fmt.Errorf("unknown resource kind: %s", kind)
+}
+
+// ResourcesOfKind returns all of the resources of the specified kind
+func ResourcesOfKind(s Store, kind model.Kind) ([]model.Resource, error) {
+	switch kind {
+	case model.KindConfiguration:
+		return asResources(s.Configurations())
+	case model.KindSource:
+		return asResources(s.Sources())
+	case model.KindSourceType:
+		return asResources(s.SourceTypes())
+	case model.KindProcessor:
+		return asResources(s.Processors())
+	case model.KindProcessorType:
+		return asResources(s.ProcessorTypes())
+	case model.KindDestination:
+		return asResources(s.Destinations())
+	case model.KindDestinationType:
+		return asResources(s.DestinationTypes())
+	case model.KindConnector:
+		return asResources(s.Connectors())
+	case model.KindConnectorType:
+		return asResources(s.ConnectorTypes())
+	case model.KindAgentGroup:
+		return asResources(s.AgentGroups())
+	}
+	return nil, fmt.Errorf("unknown resource kind: %s", kind)
+}
+
+func asResources[R model.Resource](list []R, err error) ([]model.Resource, error) {
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]model.Resource, 0, len(list))
+	for _, resource := range list {
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// resourceOrNil avoids returning a nil pointer wrapped in a non-nil model.Resource
+func resourceOrNil[T any, R interface {
+	*T
+	model.Resource
+}](r R, err error) (model.Resource, error) {
+	if r == nil {
+		return nil, err
+	}
+	return r, err
+}
+
+// ----------------------------------------------------------------------
+// resource type versions
+
+// CurrentResourceType returns the current version of the SourceType, ProcessorType, DestinationType, or ConnectorType
+// with the specified name or nil if it does not exist
+func CurrentResourceType(s Store, kind model.Kind, name string) (*model.ResourceType, error) {
+	switch kind {
+	case model.KindSourceType:
+		item, err := s.SourceType(name)
+		if item == nil {
+			return nil, err
+		}
+		return &item.ResourceType, err
+	case model.KindProcessorType:
+		item, err := s.ProcessorType(name)
+		if item == nil {
+			return nil, err
+		}
+		return &item.ResourceType, err
+	case model.KindDestinationType:
+		item, err := s.DestinationType(name)
+		if item == nil {
+			return nil, err
+		}
+		return &item.ResourceType, err
+	case model.KindConnectorType:
+		item, err := s.ConnectorType(name)
+		if item == nil {
+			return nil, err
+		}
+		return &item.ResourceType, err
+	}
+	return nil, fmt.Errorf("%s is not a resource type", kind)
+}
+
+// shouldArchiveResourceType returns true if the existing resource type should be kept as a previous version when it is
+// replaced by the updated resource type. Only versioned resource types are kept and only when the version changes.
+func shouldArchiveResourceType(existing, updated *model.ResourceType) bool {
+	return existing != nil && existing.Spec.Version != "" && existing.Spec.Version != updated.Spec.Version
+}
+
+// resourceTypeVersionKey is used by stores to uniquely identify a version of a resource type
+func resourceTypeVersionKey(kind model.Kind, name string, version string) string {
+	return fmt.Sprintf("%s|%s|%s", kind, name, version)
+}
+
+// resourceTypeVersion returns the current version of the resource type if it is the specified version and uses
+// archived to find previous versions otherwise
+func resourceTypeVersion(s Store, kind model.Kind, name string, version string, archived func(key string) (*model.ResourceType, error)) (*model.ResourceType, error) {
+	current, err := CurrentResourceType(s, kind, name)
+	if err != nil || current == nil {
+		return nil, err
+	}
+	if current.Spec.Version == version {
+		return current, nil
+	}
+	return archived(resourceTypeVersionKey(kind, name, version))
+}
+
+// resourceTypeVersions combines the current version of the resource type with the previous versions and sorts them
+// from earliest to latest
+func resourceTypeVersions(s Store, kind model.Kind, name string, previous []*model.ResourceType) ([]*model.ResourceType, error) {
+	current, err := CurrentResourceType(s, kind, name)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]*model.ResourceType, 0, len(previous)+1)
+	for _, version := range previous {
+		// a previous version may have been reapplied and is now the current version
+		if current == nil || version.Spec.Version != current.Spec.Version {
+			versions = append(versions, version)
+		}
+	}
+	if current != nil {
+		versions = append(versions, current)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return model.CompareVersions(versions[i].Spec.Version, versions[j].Spec.Version) < 0
+	})
+	return versions, nil
+}
+
 // ----------------------------------------------------------------------
 // generic helpers for sorting and paging
 
-func applySortOffsetAndLimit[T any](list []T, opts queryOptions, fieldAccessor fieldAccessor[T]) []T {
+func applySortOffsetAndLimit[T any](list []T, opts queryOptions, fieldAccessor fieldAccessor[T]) ([]T, error) {
 	if opts.sort != "" {
-		sortField := opts.sort
-		ascending := true
-		if opts.sort[0] == '-' {
-			sortField = opts.sort[1:]
-			ascending = false
-		}
-		sort.Sort(byField[T]{
+		sortField, ascending := sortField(opts.sort)
+		byField := byField[T]{
 			list:          list,
 			field:         sortField,
 			ascending:     ascending,
 			fieldAccessor: fieldAccessor,
-		})
+		}
+		sort.Sort(byField)
+
+		if opts.cursor != "" {
+			var err error
+			if list, err = applyCursor(list, opts, byField); err != nil {
+				return nil, err
+			}
+		}
 	}
 	if opts.offset != 0 {
 		offset := opts.offset
@@ -351,7 +670,7 @@ func applySortOffsetAndLimit[T any](list []T, opts queryOptions, fieldAccessor f
 		}
 		list = list[:limit]
 	}
-	return list
+	return list, nil
 }
 
 func newBPCookieStore(secret string) *sessions.CookieStore {
@@ -384,8 +703,17 @@ func (r byField[T]) Swap(i, j int) {
 
 // Less returns true if the i'th item is less than the j'th
 func (r byField[T]) Less(i, j int) bool {
-	f1 := r.fieldAccessor(r.field, r.list[i])
-	f2 := r.fieldAccessor(r.field, r.list[j])
+	return r.less(
+		r.fieldAccessor(r.field, r.list[i]), r.fieldAccessor(keyField, r.list[i]),
+		r.fieldAccessor(r.field, r.list[j]), r.fieldAccessor(keyField, r.list[j]),
+	)
+}
+
+// less compares the field values of two items, using the keys to order items with the same field value
+func (r byField[T]) less(f1, k1, f2, k2 string) bool {
+	if f1 == f2 {
+		return k1 < k2
+	}
 	if r.ascending {
 		return f1 < f2
 	}
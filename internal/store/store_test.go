@@ -19,6 +19,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
@@ -135,6 +136,18 @@ var (
 	testRawConfiguration2 = model.NewRawConfiguration("test-configuration-2", "raw:")
 )
 
+// withResourceVersion returns a copy of the resource with the resourceVersion assigned by the store. Applying a resource
+// does not modify it, so the stored resource is compared with a copy at the expected resourceVersion.
+func withResourceVersion[R model.Resource](t *testing.T, r R, resourceVersion int64) R {
+	if reflect.ValueOf(r).IsNil() {
+		return r
+	}
+	stored, err := storedCopy(r)
+	require.NoError(t, err)
+	stored.SetResourceVersion(resourceVersion)
+	return stored.(R)
+}
+
 func applyTestTypes(t *testing.T, store Store) {
 	statuses, err := store.ApplyResources([]model.Resource{
 		cabinDestinationType,
@@ -664,7 +677,17 @@ func runApplyResourceReturnTests(t *testing.T, store Store) {
 			statuses, err := store.ApplyResources(test.applyResources)
 			require.NoError(t, err, "expect no error in valid apply call")
 
-			assert.ElementsMatch(t, test.expect, statuses)
+			// each resource is created once and changed at most once
+			resourceVersions := map[model.UpdateStatus]int64{
+				model.StatusCreated:    1,
+				model.StatusUnchanged:  1,
+				model.StatusConfigured: 2,
+			}
+			expect := make([]model.ResourceStatus, 0, len(test.expect))
+			for _, status := range test.expect {
+				expect = append(expect, *model.NewResourceStatus(withResourceVersion(t, status.Resource, resourceVersions[status.Status]), status.Status))
+			}
+			assert.ElementsMatch(t, expect, statuses)
 		})
 	}
 }
@@ -900,7 +923,7 @@ func runIndividualDeleteTests(t *testing.T, store Store) {
 			setup()
 
 			src, err := store.DeleteSource(test.source)
-			assert.Equal(t, test.expectSource, src)
+			assert.Equal(t, withResourceVersion(t, test.expectSource, 1), src)
 			assert.Equal(t, test.expectError, err)
 		}
 	})
@@ -941,7 +964,7 @@ func runIndividualDeleteTests(t *testing.T, store Store) {
 			setup()
 
 			dest, err := store.DeleteDestination(test.destination)
-			assert.Equal(t, test.expectSource, dest)
+			assert.Equal(t, withResourceVersion(t, test.expectSource, 1), dest)
 			assert.Equal(t, test.expectError, err)
 		}
 	})
@@ -1125,7 +1148,10 @@ func runConfigurationsTests(t *testing.T, store Store) {
 
 		configs, err := store.Configurations()
 		assert.NoError(t, err)
-		assert.ElementsMatch(t, []*model.Configuration{testRawConfiguration1, testRawConfiguration2}, configs)
+		assert.ElementsMatch(t, []*model.Configuration{
+			withResourceVersion(t, testRawConfiguration1, 1),
+			withResourceVersion(t, testRawConfiguration2, 1),
+		}, configs)
 	})
 }
 
@@ -1138,7 +1164,7 @@ func runConfigurationTests(t *testing.T, store Store) {
 
 		config, err := store.Configuration(testRawConfiguration1.Name())
 		assert.NoError(t, err)
-		assert.Equal(t, testRawConfiguration1, config)
+		assert.Equal(t, withResourceVersion(t, testRawConfiguration1, 1), config)
 	})
 }
 
@@ -1225,6 +1251,123 @@ func runPagingTests(t *testing.T, store Store) {
 	})
 }
 
+func runCursorPagingTests(t *testing.T, store Store) {
+	applyTestTypes(t, store)
+	resources := []model.Resource{}
+	for i := 0; i < 25; i++ {
+		source := model.NewSource(fmt.Sprintf("source-%02d", i), "macos", []model.Parameter{})
+		source.Metadata.Labels = labels(map[string]string{"even": fmt.Sprintf("%t", i%2 == 0)})
+		resources = append(resources, source)
+	}
+	statuses, err := store.ApplyResources(resources)
+	require.NoError(t, err)
+	requireOkStatuses(t, statuses)
+
+	// page returns the names of the sources in the page and the cursor for the next page
+	page := func(t *testing.T, sort, cursor string, limit int, options ...QueryOption) ([]string, string) {
+		options = append(options, WithSort(sort), WithLimit(limit+1))
+		if cursor != "" {
+			options = append(options, WithCursor(cursor))
+		}
+		sources, err := store.Sources(options...)
+		require.NoError(t, err)
+		sources, next := Page(sources, limit, func(s *model.Source) string { return ResourceCursor(sort, s) })
+		names := []string{}
+		for _, source := range sources {
+			names = append(names, source.Name())
+		}
+		return names, next
+	}
+
+	t.Run("pages through all sources", func(t *testing.T) {
+		all := []string{}
+		cursor := ""
+		for {
+			names, next := page(t, "name", cursor, 10)
+			all = append(all, names...)
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+		require.Len(t, all, 25)
+		require.Equal(t, "source-00", all[0])
+		require.Equal(t, "source-24", all[24])
+	})
+
+	t.Run("stable when sources are inserted before the cursor", func(t *testing.T) {
+		first, cursor := page(t, "name", "", 5)
+		require.Equal(t, []string{"source-00", "source-01", "source-02", "source-03", "source-04"}, first)
+
+		inserted := model.NewSource("source-01a", "macos", []model.Parameter{})
+		_, err := store.ApplyResources([]model.Resource{inserted})
+		require.NoError(t, err)
+		defer func() {
+			_, err := store.DeleteSource("source-01a")
+			require.NoError(t, err)
+		}()
+
+		second, _ := page(t, "name", cursor, 5)
+		require.Equal(t, []string{"source-05", "source-06", "source-07", "source-08", "source-09"}, second)
+	})
+
+	t.Run("descending", func(t *testing.T) {
+		first, cursor := page(t, "-name", "", 2)
+		require.Equal(t, []string{"source-24", "source-23"}, first)
+		second, _ := page(t, "-name", cursor, 2)
+		require.Equal(t, []string{"source-22", "source-21"}, second)
+	})
+
+	t.Run("with selector", func(t *testing.T) {
+		selector, err := model.SelectorFromString("even=true")
+		require.NoError(t, err)
+		first, cursor := page(t, "name", "", 3, WithSelector(selector))
+		require.Equal(t, []string{"source-00", "source-02", "source-04"}, first)
+		second, _ := page(t, "name", cursor, 3, WithSelector(selector))
+		require.Equal(t, []string{"source-06", "source-08", "source-10"}, second)
+	})
+
+	t.Run("last page has no cursor", func(t *testing.T) {
+		names, next := page(t, "name", "", 25)
+		require.Len(t, names, 25)
+		require.Empty(t, next)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := store.Sources(WithSort("name"), WithCursor("not a cursor"))
+		require.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("cursor for a different sort", func(t *testing.T) {
+		_, cursor := page(t, "name", "", 5)
+		_, err := store.Sources(WithSort("-name"), WithCursor(cursor))
+		require.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("agents", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			_, err := store.UpsertAgent(context.TODO(), fmt.Sprintf("cursor-%d", i), func(current *model.Agent) {
+				current.Name = "cursor-agent"
+			})
+			require.NoError(t, err)
+		}
+		agents, err := store.Agents(context.TODO(), WithSort("name"), WithLimit(3))
+		require.NoError(t, err)
+		agents, cursor := Page(agents, 2, func(a *model.Agent) string { return AgentCursor("name", a) })
+		require.Equal(t, "cursor-0", agents[0].ID)
+		require.Equal(t, "cursor-1", agents[1].ID)
+
+		// agents with the same name are ordered by id
+		agents, err = store.Agents(context.TODO(), WithSort("name"), WithCursor(cursor))
+		require.NoError(t, err)
+		ids := []string{}
+		for _, agent := range agents {
+			ids = append(ids, agent.ID)
+		}
+		require.Equal(t, []string{"cursor-2", "cursor-3", "cursor-4"}, ids)
+	})
+}
+
 func runTestUpsertAgents(t *testing.T, store Store) {
 	t.Run("can insert new agents", func(t *testing.T) {
 		store.Clear()
@@ -1312,3 +1455,295 @@ func requireOkStatuses(t *testing.T, statuses []model.ResourceStatus) {
 		}, status.Status)
 	}
 }
+
+func runAgentGroupTests(t *testing.T, store Store) {
+	store.Clear()
+
+	for _, agent := range []*model.Agent{
+		{ID: "1", Platform: "linux", Labels: labels(map[string]string{"env": "prod"})},
+		{ID: "2", Platform: "windows", Labels: labels(map[string]string{"env": "prod"})},
+		{ID: "3", Platform: "linux", Labels: labels(map[string]string{"env": "dev"})},
+	} {
+		require.NoError(t, addAgent(store, agent))
+	}
+
+	prod := model.NewAgentGroup("prod", model.MatchLabels{"env": "prod"}, "")
+	prodLinux := model.NewAgentGroup("prod-linux", model.MatchLabels{"env": "prod"}, "platform:linux")
+	configuration := model.NewRawConfiguration("prod-linux-config", "raw:")
+	configuration.Spec.AgentGroup = prodLinux.Name()
+
+	statuses, err := store.ApplyResources([]model.Resource{prod, prodLinux, configuration})
+	require.NoError(t, err)
+	requireOkStatuses(t, statuses)
+
+	t.Run("lists and gets agent groups", func(t *testing.T) {
+		groups, err := store.AgentGroups()
+		require.NoError(t, err)
+		require.Len(t, groups, 2)
+
+		group, err := store.AgentGroup(prodLinux.Name())
+		require.NoError(t, err)
+		require.Equal(t, prodLinux.Spec, group.Spec)
+	})
+
+	t.Run("computes membership from the agent index", func(t *testing.T) {
+		require.ElementsMatch(t, []string{"1", "2"}, prod.AgentIDs(store.AgentIndex()))
+		require.ElementsMatch(t, []string{"1"}, prodLinux.AgentIDs(store.AgentIndex()))
+
+		members, err := AgentGroupMembers(store, prod)
+		require.NoError(t, err)
+		require.Len(t, members, 2)
+	})
+
+	t.Run("configuration targets the agent group", func(t *testing.T) {
+		c, err := store.AgentConfiguration("1")
+		require.NoError(t, err)
+		require.NotNil(t, c)
+		require.Equal(t, configuration.Name(), c.Name())
+
+		c, err = store.AgentConfiguration("2")
+		require.NoError(t, err)
+		require.Nil(t, c)
+
+		ids, err := store.AgentsIDsMatchingConfiguration(configuration)
+		require.NoError(t, err)
+		require.Equal(t, []string{"1"}, ids)
+	})
+
+	t.Run("cannot delete an agent group used by a configuration", func(t *testing.T) {
+		_, err := store.DeleteAgentGroup(prodLinux.Name())
+		require.Error(t, err)
+		require.IsType(t, &DependencyError{}, err)
+
+		deleted, err := store.DeleteAgentGroup(prod.Name())
+		require.NoError(t, err)
+		require.NotNil(t, deleted)
+	})
+}
+
+func runResourceTypeVersionTests(t *testing.T, store Store) {
+	store.Clear()
+
+	sourceType := func(version string, parameter string) *model.SourceType {
+		return model.NewSourceTypeWithSpec("versioned", model.ResourceTypeSpec{
+			Version:    version,
+			Parameters: []model.ParameterDefinition{{Name: parameter, Type: "string"}},
+		})
+	}
+	v1 := sourceType("1.0.0", "host")
+	v2 := sourceType("2.0.0", "endpoint")
+	v2.Spec.Migrations = []model.ResourceTypeMigration{
+		{From: "1.0.0", To: "2.0.0", Rename: []model.ParameterRename{{From: "host", To: "endpoint"}}},
+	}
+
+	statuses, err := store.ApplyResources([]model.Resource{v1})
+	require.NoError(t, err)
+	requireOkStatuses(t, statuses)
+
+	pinned := model.NewSource("pinned", "versioned", []model.Parameter{{Name: "host", Value: "localhost"}})
+	pinned.Spec.TypeVersion = "1.0.0"
+	statuses, err = store.ApplyResources([]model.Resource{pinned, v2})
+	require.NoError(t, err)
+	requireOkStatuses(t, statuses)
+
+	t.Run("keeps previous versions", func(t *testing.T) {
+		versions, err := store.ResourceTypeVersions(model.KindSourceType, "versioned")
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		require.Equal(t, "1.0.0", versions[0].Spec.Version)
+		require.Equal(t, "2.0.0", versions[1].Spec.Version)
+	})
+
+	t.Run("gets a version", func(t *testing.T) {
+		previous, err := store.ResourceTypeVersion(model.KindSourceType, "versioned", "1.0.0")
+		require.NoError(t, err)
+		require.NotNil(t, previous)
+		require.Equal(t, "host", previous.Spec.Parameters[0].Name)
+
+		current, err := store.ResourceTypeVersion(model.KindSourceType, "versioned", "2.0.0")
+		require.NoError(t, err)
+		require.NotNil(t, current)
+		require.Equal(t, "endpoint", current.Spec.Parameters[0].Name)
+
+		missing, err := store.ResourceTypeVersion(model.KindSourceType, "versioned", "3.0.0")
+		require.NoError(t, err)
+		require.Nil(t, missing)
+	})
+
+	t.Run("pinned resources use the previous version", func(t *testing.T) {
+		// applying the source again validates it against version 1.0.0
+		statuses, err := store.ApplyResources([]model.Resource{pinned})
+		require.NoError(t, err)
+		require.Equal(t, model.StatusUnchanged, statuses[0].Status)
+	})
+
+	t.Run("reapplying the same version does not duplicate versions", func(t *testing.T) {
+		statuses, err := store.ApplyResources([]model.Resource{v2})
+		require.NoError(t, err)
+		requireOkStatuses(t, statuses)
+
+		versions, err := store.ResourceTypeVersions(model.KindSourceType, "versioned")
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+	})
+
+	configuration := model.NewConfigurationWithSpec("pinned-configuration", model.ConfigurationSpec{
+		Sources: []model.ResourceConfiguration{
+			{Type: "versioned", TypeVersion: "1.0.0", Parameters: []model.Parameter{{Name: "host", Value: "localhost"}}},
+			{Name: pinned.Name()},
+		},
+	})
+	statuses, err = store.ApplyResources([]model.Resource{configuration})
+	require.NoError(t, err)
+	requireOkStatuses(t, statuses)
+
+	t.Run("lists outdated resources", func(t *testing.T) {
+		outdated, err := OutdatedResources(store)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []*model.OutdatedResource{
+			{Kind: model.KindSource, Name: "pinned", ResourceType: "versioned", TypeVersion: "1.0.0", CurrentVersion: "2.0.0", Migratable: true},
+			{Kind: model.KindConfiguration, Name: "pinned-configuration", Path: "sources[0]", ResourceType: "versioned", TypeVersion: "1.0.0", CurrentVersion: "2.0.0", Migratable: true},
+		}, outdated)
+	})
+
+	t.Run("migrates outdated resources", func(t *testing.T) {
+		statuses, err := MigrateResources(store, model.KindSource, "pinned")
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		require.Equal(t, model.StatusConfigured, statuses[0].Status)
+
+		source, err := store.Source("pinned")
+		require.NoError(t, err)
+		require.Equal(t, "2.0.0", source.Spec.TypeVersion)
+		require.Equal(t, []model.Parameter{{Name: "endpoint", Value: "localhost"}}, source.Spec.Parameters)
+
+		outdated, err := OutdatedResources(store)
+		require.NoError(t, err)
+		require.Len(t, outdated, 1)
+
+		statuses, err = MigrateResources(store, "", "")
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		require.Equal(t, model.StatusConfigured, statuses[0].Status)
+
+		c, err := store.Configuration("pinned-configuration")
+		require.NoError(t, err)
+		require.Equal(t, "2.0.0", c.Spec.Sources[0].TypeVersion)
+		require.Equal(t, []model.Parameter{{Name: "endpoint", Value: "localhost"}}, c.Spec.Sources[0].Parameters)
+
+		outdated, err = OutdatedResources(store)
+		require.NoError(t, err)
+		require.Empty(t, outdated)
+	})
+
+	t.Run("resources without migrations are not migrated", func(t *testing.T) {
+		unknown := model.NewSource("unmigratable", "versioned", []model.Parameter{{Name: "host", Value: "localhost"}})
+		unknown.Spec.TypeVersion = "1.0.0"
+		statuses, err := store.ApplyResources([]model.Resource{unknown})
+		require.NoError(t, err)
+		requireOkStatuses(t, statuses)
+
+		v3 := sourceType("3.0.0", "endpoint")
+		statuses, err = store.ApplyResources([]model.Resource{v3})
+		require.NoError(t, err)
+		requireOkStatuses(t, statuses)
+
+		outdated, err := OutdatedResources(store)
+		require.NoError(t, err)
+		require.Len(t, outdated, 3)
+		for _, o := range outdated {
+			require.False(t, o.Migratable)
+		}
+
+		statuses, err = MigrateResources(store, model.KindSource, "unmigratable")
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		require.Equal(t, model.StatusInvalid, statuses[0].Status)
+		require.Contains(t, statuses[0].Reason, "no migration from version 1.0.0 to 3.0.0")
+	})
+
+	t.Run("unknown resource type has no versions", func(t *testing.T) {
+		versions, err := store.ResourceTypeVersions(model.KindDestinationType, "versioned")
+		require.NoError(t, err)
+		require.Empty(t, versions)
+	})
+}
+
+func runResourceVersionTests(t *testing.T, store Store) {
+	store.Clear()
+	applyTestTypes(t, store)
+
+	source := func(value string, resourceVersion int64) *model.Source {
+		s := model.NewSource("versioned", "macos", []model.Parameter{{Name: "s", Value: value}})
+		s.SetResourceVersion(resourceVersion)
+		return s
+	}
+	apply := func(r model.Resource) model.ResourceStatus {
+		statuses, err := store.ApplyResources([]model.Resource{r})
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		return statuses[0]
+	}
+	current := func() int64 {
+		s, err := store.Source("versioned")
+		require.NoError(t, err)
+		return s.ResourceVersion()
+	}
+
+	t.Run("create ignores the resourceVersion", func(t *testing.T) {
+		status := apply(source("1", 5))
+		require.Equal(t, model.StatusCreated, status.Status)
+		require.Equal(t, int64(1), current())
+	})
+
+	t.Run("unchanged keeps the resourceVersion", func(t *testing.T) {
+		status := apply(source("1", 0))
+		require.Equal(t, model.StatusUnchanged, status.Status)
+		require.Equal(t, int64(1), status.Resource.ResourceVersion())
+		require.Equal(t, int64(1), current())
+	})
+
+	t.Run("change increments the resourceVersion", func(t *testing.T) {
+		applied := source("2", 1)
+		status := apply(applied)
+		require.Equal(t, model.StatusConfigured, status.Status)
+		require.Equal(t, int64(2), status.Resource.ResourceVersion())
+		require.Equal(t, int64(2), current())
+
+		// the new resourceVersion is returned in the status and the applied resource is not modified
+		require.Equal(t, int64(1), applied.ResourceVersion())
+	})
+
+	t.Run("stale resourceVersion conflicts on apply", func(t *testing.T) {
+		status := apply(source("3", 1))
+		require.Equal(t, model.StatusConflict, status.Status)
+		require.Equal(t, "resourceVersion 1 does not match the current resourceVersion 2", status.Reason)
+
+		s, err := store.Source("versioned")
+		require.NoError(t, err)
+		require.Equal(t, "2", s.Spec.Parameters[0].Value)
+		require.Equal(t, int64(2), s.ResourceVersion())
+	})
+
+	t.Run("stale resourceVersion conflicts on delete", func(t *testing.T) {
+		statuses, err := store.DeleteResources([]model.Resource{source("", 1)})
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		require.Equal(t, model.StatusConflict, statuses[0].Status)
+
+		s, err := store.Source("versioned")
+		require.NoError(t, err)
+		require.NotNil(t, s)
+	})
+
+	t.Run("current resourceVersion deletes", func(t *testing.T) {
+		statuses, err := store.DeleteResources([]model.Resource{source("", 2)})
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		require.Equal(t, model.StatusDeleted, statuses[0].Status)
+
+		s, err := store.Source("versioned")
+		require.NoError(t, err)
+		require.Nil(t, s)
+	})
+}
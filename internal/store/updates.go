@@ -32,7 +32,10 @@ type Updates struct {
 	ProcessorTypes   Events[*model.ProcessorType]
 	Destinations     Events[*model.Destination]
 	DestinationTypes Events[*model.DestinationType]
+	Connectors       Events[*model.Connector]
+	ConnectorTypes   Events[*model.ConnectorType]
 	Configurations   Events[*model.Configuration]
+	AgentGroups      Events[*model.AgentGroup]
 }
 
 // NewUpdates returns a New Updates struct
@@ -46,7 +49,10 @@ func NewUpdates() *Updates {
 		ProcessorTypes:   NewEvents[*model.ProcessorType](),
 		Destinations:     NewEvents[*model.Destination](),
 		DestinationTypes: NewEvents[*model.DestinationType](),
+		Connectors:       NewEvents[*model.Connector](),
+		ConnectorTypes:   NewEvents[*model.ConnectorType](),
 		Configurations:   NewEvents[*model.Configuration](),
+		AgentGroups:      NewEvents[*model.AgentGroup](),
 	}
 }
 
@@ -72,8 +78,14 @@ func (updates *Updates) IncludeResource(r model.Resource, eventType EventType) {
 		updates.Destinations.Include(r, eventType)
 	case *model.DestinationType:
 		updates.DestinationTypes.Include(r, eventType)
+	case *model.Connector:
+		updates.Connectors.Include(r, eventType)
+	case *model.ConnectorType:
+		updates.ConnectorTypes.Include(r, eventType)
 	case *model.Configuration:
 		updates.Configurations.Include(r, eventType)
+	case *model.AgentGroup:
+		updates.AgentGroups.Include(r, eventType)
 	}
 }
 
@@ -91,7 +103,10 @@ func (updates *Updates) Size() int {
 		len(updates.ProcessorTypes) +
 		len(updates.Destinations) +
 		len(updates.DestinationTypes) +
-		len(updates.Configurations)
+		len(updates.Connectors) +
+		len(updates.ConnectorTypes) +
+		len(updates.Configurations) +
+		len(updates.AgentGroups)
 }
 
 // ----------------------------------------------------------------------
@@ -105,9 +120,12 @@ func (updates *Updates) addTransitiveUpdates(s Store) error {
 	// for sourceTypes, add sources
 	// for processorTypes, add sources and processors
 	// for destinationTypes, add destinations
+	// for connectorTypes, add connectors
 	// for sources and sourceTypes, add configurations
 	// for processors and processorTypes, add configurations
 	// for destinations and destinationTypes, add configurations
+	// for connectors and connectorTypes, add configurations
+	// for agentGroups, add configurations
 
 	var errs error
 
@@ -126,6 +144,11 @@ func (updates *Updates) addTransitiveUpdates(s Store) error {
 		errs = multierror.Append(errs, err)
 	}
 
+	err = updates.addConnectorUpdates(s)
+	if err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
 	err = updates.addConfigurationUpdates(s)
 	if err != nil {
 		errs = multierror.Append(errs, err)
@@ -235,6 +258,33 @@ func (updates *Updates) addDestinationUpdates(s Store) error {
 	return nil
 }
 
+func (updates *Updates) addConnectorUpdates(s Store) error {
+	if updates.ConnectorTypes.Empty() {
+		return nil
+	}
+
+	// get all of the connectors
+	connectors, err := s.Connectors()
+	if err != nil {
+		return err
+	}
+
+	// updates to a ConnectorType will trigger updates of all of the Connectors that use that ConnectorType.
+	for _, connectorTypeEvent := range updates.ConnectorTypes {
+		if connectorTypeEvent.Type == EventTypeUpdate {
+			connectorTypeName := connectorTypeEvent.Item.Name()
+
+			for _, connector := range connectors {
+				if connector.Spec.Type == connectorTypeName {
+					updates.Connectors.Include(connector, EventTypeUpdate)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func (updates *Updates) addConfigurationUpdates(s Store) error {
 	configurations, err := s.Configurations()
 	if err != nil {
@@ -273,6 +323,46 @@ func (updates *Updates) addConfigurationUpdatesFromComponents(configuration *mod
 			return
 		}
 	}
+	for _, connector := range configuration.Spec.Connectors {
+		if _, ok := updates.Connectors[connector.Name]; ok {
+			updates.Configurations.Include(configuration, EventTypeUpdate)
+			return
+		}
+		if _, ok := updates.ConnectorTypes[connector.Type]; ok {
+			updates.Configurations.Include(configuration, EventTypeUpdate)
+			return
+		}
+	}
+	// updates to a Processor or ProcessorType used by the configuration-wide processors or the processors of a
+	// destination will change the rendered configuration
+	for _, list := range [][]model.ResourceConfiguration{configuration.Spec.Processors, destinationProcessors(configuration)} {
+		for _, processor := range list {
+			if _, ok := updates.Processors[processor.Name]; ok {
+				updates.Configurations.Include(configuration, EventTypeUpdate)
+				return
+			}
+			if _, ok := updates.ProcessorTypes[processor.Type]; ok {
+				updates.Configurations.Include(configuration, EventTypeUpdate)
+				return
+			}
+		}
+	}
+	// updates to an AgentGroup can change the agents that receive the configuration
+	if configuration.Spec.AgentGroup != "" {
+		if _, ok := updates.AgentGroups[configuration.Spec.AgentGroup]; ok {
+			updates.Configurations.Include(configuration, EventTypeUpdate)
+			return
+		}
+	}
+}
+
+// destinationProcessors returns the processors of all of the destinations of the configuration
+func destinationProcessors(configuration *model.Configuration) []model.ResourceConfiguration {
+	var processors []model.ResourceConfiguration
+	for _, destination := range configuration.Spec.Destinations {
+		processors = append(processors, destination.Processors...)
+	}
+	return processors
 }
 
 // ----------------------------------------------------------------------
@@ -285,7 +375,10 @@ func mergeUpdates(into, single *Updates) bool {
 		into.SourceTypes.CanSafelyMerge(single.SourceTypes) &&
 		into.Destinations.CanSafelyMerge(single.Destinations) &&
 		into.DestinationTypes.CanSafelyMerge(single.DestinationTypes) &&
-		into.Configurations.CanSafelyMerge(single.Configurations)
+		into.Connectors.CanSafelyMerge(single.Connectors) &&
+		into.ConnectorTypes.CanSafelyMerge(single.ConnectorTypes) &&
+		into.Configurations.CanSafelyMerge(single.Configurations) &&
+		into.AgentGroups.CanSafelyMerge(single.AgentGroups)
 
 	if !safe {
 		return false
@@ -297,7 +390,10 @@ func mergeUpdates(into, single *Updates) bool {
 	into.SourceTypes.Merge(single.SourceTypes)
 	into.Destinations.Merge(single.Destinations)
 	into.DestinationTypes.Merge(single.DestinationTypes)
+	into.Connectors.Merge(single.Connectors)
+	into.ConnectorTypes.Merge(single.ConnectorTypes)
 	into.Configurations.Merge(single.Configurations)
+	into.AgentGroups.Merge(single.AgentGroups)
 
 	return true
 }
@@ -65,6 +65,10 @@ func updatesTestSetup(t *testing.T) {
 		newTestConfiguration("c5", nil, nil, nil, nil),
 		newTestConfiguration("c6", []string{"s4"}, nil, []string{"d3"}, nil),
 		newTestConfiguration("c7", nil, []string{"st5"}, []string{"d3"}, nil),
+		model.NewAgentGroup("g1", model.MatchLabels{"env": "prod"}, ""),
+		newTestAgentGroupConfiguration("c8", "g1"),
+		newTestProcessorsConfiguration("c9", []model.ResourceConfiguration{{Type: "pt3"}}, nil),
+		newTestProcessorsConfiguration("c10", nil, []model.ResourceConfiguration{{Name: "p1"}}),
 	}
 	for _, resource := range resources {
 		resourceMap[resource.Name()] = resource
@@ -129,6 +133,19 @@ func newTestConfiguration(name string, sources []string, sourceTypes []string, d
 	return c
 }
 
+func newTestAgentGroupConfiguration(name string, agentGroup string) *model.Configuration {
+	c := newTestConfiguration(name, nil, nil, nil, nil)
+	c.Spec.AgentGroup = agentGroup
+	return c
+}
+
+func newTestProcessorsConfiguration(name string, processors []model.ResourceConfiguration, destinationProcessors []model.ResourceConfiguration) *model.Configuration {
+	c := newTestConfiguration(name, nil, nil, nil, []string{"dt1"})
+	c.Spec.Processors = processors
+	c.Spec.Destinations[0].Processors = destinationProcessors
+	return c
+}
+
 func addUpdates[T model.Resource](t *testing.T, names []string, events Events[T]) {
 	for _, name := range names {
 		resource, ok := resourceMap[name]
@@ -149,6 +166,7 @@ func TestTransitiveUpdates(t *testing.T) {
 		Destinations     []string
 		DestinationTypes []string
 		Configurations   []string
+		AgentGroups      []string
 
 		ExpectSources          []string
 		ExpectSourceTypes      []string
@@ -219,7 +237,7 @@ func TestTransitiveUpdates(t *testing.T) {
 			Processors:           []string{"p1"},
 			ExpectProcessors:     []string{"p1"},
 			ExpectSources:        []string{"s4"},
-			ExpectConfigurations: []string{"c6"},
+			ExpectConfigurations: []string{"c6", "c10"},
 		},
 		{
 			Name:                 "pt2",
@@ -234,7 +252,18 @@ func TestTransitiveUpdates(t *testing.T) {
 			ExpectSources:        []string{"s4"},
 			ExpectProcessors:     []string{"p1"},
 			ExpectProcessorTypes: []string{"pt1"},
-			ExpectConfigurations: []string{"c6"},
+			ExpectConfigurations: []string{"c6", "c10"},
+		},
+		{
+			Name:                 "pt3",
+			ProcessorTypes:       []string{"pt3"},
+			ExpectProcessorTypes: []string{"pt3"},
+			ExpectConfigurations: []string{"c9"},
+		},
+		{
+			Name:                 "g1",
+			AgentGroups:          []string{"g1"},
+			ExpectConfigurations: []string{"c8"},
 		},
 	}
 
@@ -250,6 +279,7 @@ func TestTransitiveUpdates(t *testing.T) {
 			addUpdates(t, test.Destinations, updates.Destinations)
 			addUpdates(t, test.DestinationTypes, updates.DestinationTypes)
 			addUpdates(t, test.Configurations, updates.Configurations)
+			addUpdates(t, test.AgentGroups, updates.AgentGroups)
 
 			// add transitive
 			err := updates.addTransitiveUpdates(updatesTestStore)
@@ -16,6 +16,7 @@ package model
 
 import (
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/observiq/bindplane-op/internal/store/search"
@@ -74,6 +75,15 @@ type Agent struct {
 	ConnectedAt    *time.Time  `json:"connectedAt,omitempty" yaml:"connectedAt,omitempty"`
 	DisconnectedAt *time.Time  `json:"disconnectedAt,omitempty" yaml:"disconnectedAt,omitempty"`
 
+	// Commands sent to the agent, most recent last
+	Commands []*AgentCommand `json:"commands,omitempty" yaml:"commands,omitempty"`
+
+	// Drift is updated periodically by comparing the effective configuration with the desired configuration
+	Drift *AgentDrift `json:"drift,omitempty" yaml:"drift,omitempty"`
+
+	// PendingChange is a configuration change deferred by the schedule of the configuration
+	PendingChange *AgentPendingChange `json:"pendingChange,omitempty" yaml:"pendingChange,omitempty"`
+
 	// used by the agent management protocol
 	Protocol string      `json:"protocol,omitempty" yaml:"protocol,omitempty"`
 	State    interface{} `json:"state,omitempty" yaml:"state,omitempty"`
@@ -202,6 +212,11 @@ func (a *Agent) IndexFields(index search.Indexer) {
 	index("macAddress", a.MacAddress)
 	index("type", a.Type)
 	index("status", a.StatusDisplayText())
+	index("drift", strconv.FormatBool(a.DriftStatus().Drifted()))
+	if a.Drift != nil {
+		index("driftStatus", string(a.Drift.Status))
+	}
+	index("pending", strconv.FormatBool(a.PendingChange != nil))
 }
 
 // IndexLabels returns a map of label name to label value to be stored in the index
@@ -0,0 +1,266 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AgentCommandType is the type of command that can be sent to an agent
+type AgentCommandType string
+
+const (
+	// AgentCommandRestart restarts the agent process
+	AgentCommandRestart AgentCommandType = "restart"
+
+	// AgentCommandReloadConfig instructs the agent to reload its configuration
+	AgentCommandReloadConfig AgentCommandType = "reload-config"
+
+	// AgentCommandCollectDiagnostics instructs the agent to collect a diagnostics bundle
+	AgentCommandCollectDiagnostics AgentCommandType = "collect-diagnostics"
+)
+
+// AgentCommandTypes is the list of all supported command types. Flushing queues and rotating logs are not supported
+// because OpAMP v0.2.0 only has a restart command and no custom messages to deliver them.
+var AgentCommandTypes = []AgentCommandType{
+	AgentCommandRestart,
+	AgentCommandReloadConfig,
+	AgentCommandCollectDiagnostics,
+}
+
+// ParseAgentCommandType returns the AgentCommandType for the specified name or an error if the command type is not
+// supported.
+func ParseAgentCommandType(name string) (AgentCommandType, error) {
+	for _, commandType := range AgentCommandTypes {
+		if string(commandType) == name {
+			return commandType, nil
+		}
+	}
+	return "", fmt.Errorf("unknown agent command: %s", name)
+}
+
+// AgentCommandStatus is the status of a command sent to an agent
+type AgentCommandStatus string
+
+const (
+	// AgentCommandQueued is the status of a command that has not been sent to the agent. Commands for agents that are
+	// not connected will remain queued until the agent connects.
+	AgentCommandQueued AgentCommandStatus = "queued"
+
+	// AgentCommandSent is the status of a command that has been sent to the agent
+	AgentCommandSent AgentCommandStatus = "sent"
+
+	// AgentCommandAcknowledged is the status of a command that the agent has received but not yet completed
+	AgentCommandAcknowledged AgentCommandStatus = "acknowledged"
+
+	// AgentCommandSucceeded is the status of a command that the agent completed successfully
+	AgentCommandSucceeded AgentCommandStatus = "succeeded"
+
+	// AgentCommandFailed is the status of a command that could not be sent or that the agent failed to complete
+	AgentCommandFailed AgentCommandStatus = "failed"
+
+	// AgentCommandTimedOut is the status of a command that did not complete before its deadline
+	AgentCommandTimedOut AgentCommandStatus = "timed-out"
+)
+
+// Complete returns true if the status is a final status and will not change
+func (s AgentCommandStatus) Complete() bool {
+	switch s {
+	case AgentCommandSucceeded, AgentCommandFailed, AgentCommandTimedOut:
+		return true
+	}
+	return false
+}
+
+// MaxAgentCommands is the maximum number of commands stored on an agent. When exceeded, the oldest completed commands
+// are removed.
+const MaxAgentCommands = 20
+
+// AgentCommand is a command sent to an agent along with its current status
+type AgentCommand struct {
+	ID      string             `json:"id" yaml:"id"`
+	AgentID string             `json:"agentId" yaml:"agentId"`
+	Type    AgentCommandType   `json:"type" yaml:"type"`
+	Status  AgentCommandStatus `json:"status" yaml:"status"`
+
+	// Message contains details about the status, typically an error message if the command failed
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt" yaml:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt" yaml:"updatedAt"`
+}
+
+// NewAgentCommand creates a new queued command for the specified agent
+func NewAgentCommand(agentID string, commandType AgentCommandType) *AgentCommand {
+	now := time.Now()
+	return &AgentCommand{
+		ID:        uuid.NewString(),
+		AgentID:   agentID,
+		Type:      commandType,
+		Status:    AgentCommandQueued,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Complete returns true if the command has a final status
+func (c *AgentCommand) Complete() bool {
+	return c.Status.Complete()
+}
+
+// SetStatus changes the status and message of the command. The status of a completed command will not be changed.
+func (c *AgentCommand) SetStatus(status AgentCommandStatus, message string) {
+	if c.Complete() {
+		return
+	}
+	c.Status = status
+	c.Message = message
+	c.UpdatedAt = time.Now()
+}
+
+// ----------------------------------------------------------------------
+// agent command list
+
+// AddCommand adds a command to the agent, removing the oldest completed commands if there are more than
+// MaxAgentCommands.
+func (a *Agent) AddCommand(command *AgentCommand) {
+	a.Commands = append(a.Commands, command)
+
+	excess := len(a.Commands) - MaxAgentCommands
+	if excess <= 0 {
+		return
+	}
+	commands := make([]*AgentCommand, 0, len(a.Commands))
+	for _, c := range a.Commands {
+		if excess > 0 && c.Complete() {
+			excess--
+			continue
+		}
+		commands = append(commands, c)
+	}
+	a.Commands = commands
+}
+
+// Command returns the command with the specified ID or nil if it does not exist
+func (a *Agent) Command(id string) *AgentCommand {
+	for _, c := range a.Commands {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// CommandsWithStatus returns the commands with the specified status in the order they were added
+func (a *Agent) CommandsWithStatus(status AgentCommandStatus) []*AgentCommand {
+	var commands []*AgentCommand
+	for _, c := range a.Commands {
+		if c.Status == status {
+			commands = append(commands, c)
+		}
+	}
+	return commands
+}
+
+// SetCommandStatus changes the status of the command with the specified ID. It returns false if the command does not
+// exist.
+func (a *Agent) SetCommandStatus(id string, status AgentCommandStatus, message string) bool {
+	command := a.Command(id)
+	if command == nil {
+		return false
+	}
+	command.SetStatus(status, message)
+	return true
+}
+
+// HasExpiredCommands returns true if ExpireCommands would expire any commands
+func (a *Agent) HasExpiredCommands(queuedBefore, sentBefore time.Time) bool {
+	for _, c := range a.Commands {
+		if c.expired(queuedBefore, sentBefore) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpireCommands changes the status of commands that have not completed to AgentCommandTimedOut. Queued commands
+// expire if they were created before queuedBefore and other incomplete commands expire if they were last updated
+// before sentBefore. It returns true if any commands expired.
+func (a *Agent) ExpireCommands(queuedBefore, sentBefore time.Time) bool {
+	expired := false
+	for _, c := range a.Commands {
+		if !c.expired(queuedBefore, sentBefore) {
+			continue
+		}
+		if c.Status == AgentCommandQueued {
+			c.SetStatus(AgentCommandTimedOut, "agent did not connect before the command expired")
+		} else {
+			c.SetStatus(AgentCommandTimedOut, "agent did not complete the command before it expired")
+		}
+		expired = true
+	}
+	return expired
+}
+
+func (c *AgentCommand) expired(queuedBefore, sentBefore time.Time) bool {
+	switch {
+	case c.Complete():
+		return false
+	case c.Status == AgentCommandQueued:
+		return c.CreatedAt.Before(queuedBefore)
+	default:
+		return c.UpdatedAt.Before(sentBefore)
+	}
+}
+
+// ----------------------------------------------------------------------
+// Printable
+
+// PrintableKindSingular returns the singular form of the Kind, e.g. "Command"
+func (c *AgentCommand) PrintableKindSingular() string {
+	return "Command"
+}
+
+// PrintableKindPlural returns the plural form of the Kind, e.g. "Commands"
+func (c *AgentCommand) PrintableKindPlural() string {
+	return "Commands"
+}
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (c *AgentCommand) PrintableFieldTitles() []string {
+	return []string{"ID", "Agent", "Type", "Status", "Age", "Message"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (c *AgentCommand) PrintableFieldValue(title string) string {
+	switch title {
+	case "ID":
+		return c.ID
+	case "Agent":
+		return c.AgentID
+	case "Type":
+		return string(c.Type)
+	case "Status":
+		return string(c.Status)
+	case "Age":
+		return durationDisplay(&c.CreatedAt)
+	case "Message":
+		return c.Message
+	}
+	return ""
+}
@@ -0,0 +1,99 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAgentCommandType(t *testing.T) {
+	for _, commandType := range AgentCommandTypes {
+		t.Run(string(commandType), func(t *testing.T) {
+			parsed, err := ParseAgentCommandType(string(commandType))
+			require.NoError(t, err)
+			require.Equal(t, commandType, parsed)
+		})
+	}
+	t.Run("unknown", func(t *testing.T) {
+		_, err := ParseAgentCommandType("explode")
+		require.Error(t, err)
+	})
+}
+
+func TestAgentCommandSetStatus(t *testing.T) {
+	command := NewAgentCommand("1", AgentCommandRestart)
+	require.Equal(t, AgentCommandQueued, command.Status)
+	require.Equal(t, "1", command.AgentID)
+
+	command.SetStatus(AgentCommandSent, "")
+	require.Equal(t, AgentCommandSent, command.Status)
+
+	command.SetStatus(AgentCommandFailed, "oops")
+	require.Equal(t, AgentCommandFailed, command.Status)
+	require.Equal(t, "oops", command.Message)
+
+	// completed commands do not change
+	command.SetStatus(AgentCommandSucceeded, "")
+	require.Equal(t, AgentCommandFailed, command.Status)
+	require.Equal(t, "oops", command.Message)
+}
+
+func TestAgentAddCommand(t *testing.T) {
+	agent := &Agent{ID: "1"}
+
+	queued := NewAgentCommand(agent.ID, AgentCommandRestart)
+	agent.AddCommand(queued)
+	for i := 0; i < MaxAgentCommands+5; i++ {
+		command := NewAgentCommand(agent.ID, AgentCommandReloadConfig)
+		command.SetStatus(AgentCommandSucceeded, "")
+		agent.AddCommand(command)
+	}
+
+	require.Len(t, agent.Commands, MaxAgentCommands)
+	require.Equal(t, queued, agent.Commands[0], "incomplete commands are never removed")
+	require.Equal(t, queued, agent.Command(queued.ID))
+	require.Equal(t, []*AgentCommand{queued}, agent.CommandsWithStatus(AgentCommandQueued))
+
+	require.True(t, agent.SetCommandStatus(queued.ID, AgentCommandSent, ""))
+	require.Equal(t, AgentCommandSent, queued.Status)
+	require.False(t, agent.SetCommandStatus("missing", AgentCommandSent, ""))
+}
+
+func TestAgentExpireCommands(t *testing.T) {
+	now := time.Now()
+	hourAgo := now.Add(-time.Hour)
+
+	oldQueued := &AgentCommand{ID: "1", Status: AgentCommandQueued, CreatedAt: hourAgo, UpdatedAt: hourAgo}
+	newQueued := &AgentCommand{ID: "2", Status: AgentCommandQueued, CreatedAt: now, UpdatedAt: now}
+	oldSent := &AgentCommand{ID: "3", Status: AgentCommandSent, CreatedAt: hourAgo, UpdatedAt: hourAgo}
+	newSent := &AgentCommand{ID: "4", Status: AgentCommandAcknowledged, CreatedAt: hourAgo, UpdatedAt: now}
+	done := &AgentCommand{ID: "5", Status: AgentCommandSucceeded, CreatedAt: hourAgo, UpdatedAt: hourAgo}
+
+	agent := &Agent{ID: "1", Commands: []*AgentCommand{oldQueued, newQueued, oldSent, newSent, done}}
+
+	require.True(t, agent.HasExpiredCommands(now.Add(-time.Minute), now.Add(-time.Minute)))
+	require.True(t, agent.ExpireCommands(now.Add(-time.Minute), now.Add(-time.Minute)))
+	require.Equal(t, AgentCommandTimedOut, oldQueued.Status)
+	require.Equal(t, AgentCommandQueued, newQueued.Status)
+	require.Equal(t, AgentCommandTimedOut, oldSent.Status)
+	require.Equal(t, AgentCommandAcknowledged, newSent.Status)
+	require.Equal(t, AgentCommandSucceeded, done.Status)
+
+	require.False(t, agent.HasExpiredCommands(now.Add(-time.Minute), now.Add(-time.Minute)))
+	require.False(t, agent.ExpireCommands(now.Add(-time.Minute), now.Add(-time.Minute)))
+}
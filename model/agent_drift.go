@@ -0,0 +1,115 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// AgentDriftStatus describes how the effective configuration reported by an agent compares to the configuration it
+// should be running
+type AgentDriftStatus string
+
+const (
+	// AgentDriftInSync is the status of an agent running the configuration it should be running
+	AgentDriftInSync AgentDriftStatus = "in-sync"
+
+	// AgentDriftPending is the status of an agent that has not yet applied a change to its configuration because it is
+	// currently configuring, it is not connected and will receive the change when it connects, or the change is deferred
+	// by the schedule of the configuration
+	AgentDriftPending AgentDriftStatus = "pending"
+
+	// AgentDriftDrifted is the status of an agent that is connected but not running the configuration it should be
+	// running, typically because the configuration failed to apply
+	AgentDriftDrifted AgentDriftStatus = "drifted"
+
+	// AgentDriftLocallyModified is the status of an agent that was running the configuration it should be running but
+	// has since reported a different configuration without any change on the server, typically because the configuration
+	// was modified on the agent host
+	AgentDriftLocallyModified AgentDriftStatus = "locally-modified"
+
+	// AgentDriftUnmanaged is the status of an agent that does not match any configuration
+	AgentDriftUnmanaged AgentDriftStatus = "unmanaged"
+)
+
+// Drifted returns true if the agent is not running the configuration it should be running and is not expected to apply
+// it on its own
+func (s AgentDriftStatus) Drifted() bool {
+	return s == AgentDriftDrifted || s == AgentDriftLocallyModified
+}
+
+// AgentDrift is the most recent drift status of an agent
+type AgentDrift struct {
+	Status AgentDriftStatus `json:"status" yaml:"status"`
+
+	// Configuration is the name of the configuration the agent should be running
+	Configuration string `json:"configuration,omitempty" yaml:"configuration,omitempty"`
+
+	// SyncedHash is the hash of the desired collector configuration the last time the agent was in sync. It is used to
+	// distinguish local modifications from changes on the server.
+	SyncedHash string `json:"syncedHash,omitempty" yaml:"syncedHash,omitempty"`
+
+	// UpdatedAt is the time the status last changed
+	UpdatedAt time.Time `json:"updatedAt" yaml:"updatedAt"`
+}
+
+// DriftStatus returns the drift status of the agent or "" if drift has not been checked
+func (a *Agent) DriftStatus() AgentDriftStatus {
+	if a.Drift == nil {
+		return ""
+	}
+	return a.Drift.Status
+}
+
+// AgentDriftReport describes the drift of a single agent, including a unified diff from the desired collector
+// configuration to the effective collector configuration
+type AgentDriftReport struct {
+	AgentID       string           `json:"agentId" yaml:"agentId"`
+	AgentName     string           `json:"agentName" yaml:"agentName"`
+	Status        AgentDriftStatus `json:"status" yaml:"status"`
+	Configuration string           `json:"configuration,omitempty" yaml:"configuration,omitempty"`
+	Diff          string           `json:"diff,omitempty" yaml:"diff,omitempty"`
+}
+
+// ----------------------------------------------------------------------
+// Printable
+
+// PrintableKindSingular returns the singular form of the Kind, e.g. "Drift"
+func (r *AgentDriftReport) PrintableKindSingular() string {
+	return "Drift"
+}
+
+// PrintableKindPlural returns the plural form of the Kind, e.g. "Drift"
+func (r *AgentDriftReport) PrintableKindPlural() string {
+	return "Drift"
+}
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (r *AgentDriftReport) PrintableFieldTitles() []string {
+	return []string{"ID", "Name", "Status", "Configuration"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (r *AgentDriftReport) PrintableFieldValue(title string) string {
+	switch title {
+	case "ID":
+		return r.AgentID
+	case "Name":
+		return r.AgentName
+	case "Status":
+		return string(r.Status)
+	case "Configuration":
+		return r.Configuration
+	}
+	return ""
+}
@@ -0,0 +1,237 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/observiq/bindplane-op/internal/store/search"
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+// AgentGroup is a named set of agents that is defined by a label selector and/or a search query. Membership is not
+// stored with the group. It is computed from the agent index and always reflects the current labels and fields of the
+// agents.
+type AgentGroup struct {
+	ResourceMeta `yaml:",inline" json:",inline" mapstructure:",squash"`
+	Spec         AgentGroupSpec `json:"spec" yaml:"spec" mapstructure:"spec"`
+}
+
+// AgentGroupSpec is the spec for an AgentGroup resource. An agent is a member of the group if it matches both the
+// selector and the query. At least one of them must be specified.
+type AgentGroupSpec struct {
+	Selector AgentSelector `json:"selector" yaml:"selector" mapstructure:"selector"`
+	Query    string        `json:"query,omitempty" yaml:"query,omitempty" mapstructure:"query"`
+}
+
+var _ Resource = (*AgentGroup)(nil)
+
+// NewAgentGroup creates a new AgentGroup with the specified name, selector, and query
+func NewAgentGroup(name string, matchLabels MatchLabels, query string) *AgentGroup {
+	return NewAgentGroupWithSpec(name, AgentGroupSpec{
+		Selector: AgentSelector{MatchLabels: matchLabels},
+		Query:    query,
+	})
+}
+
+// NewAgentGroupWithSpec creates a new AgentGroup with the specified spec
+func NewAgentGroupWithSpec(name string, spec AgentGroupSpec) *AgentGroup {
+	return &AgentGroup{
+		ResourceMeta: ResourceMeta{
+			APIVersion: V1Alpha,
+			Kind:       KindAgentGroup,
+			Metadata: Metadata{
+				Name:   name,
+				Labels: MakeLabels(),
+			},
+		},
+		Spec: spec,
+	}
+}
+
+// GetKind returns "AgentGroup"
+func (g *AgentGroup) GetKind() Kind {
+	return KindAgentGroup
+}
+
+// ValidateWithStore checks that the agent group is valid, returning an error if it is not
+func (g *AgentGroup) ValidateWithStore(store ResourceStore) error {
+	errors := validation.NewErrors()
+
+	g.validate(errors)
+	g.Spec.validate(errors)
+
+	return errors.Result()
+}
+
+func (s *AgentGroupSpec) validate(errors validation.Errors) {
+	s.Selector.validate(errors)
+	if len(s.Selector.MatchLabels) == 0 && strings.TrimSpace(s.Query) == "" {
+		errors.Add(fmt.Errorf("agent group must specify a selector or a query"))
+	}
+}
+
+// AgentSelector returns the Selector for this group that can be used to match agents
+func (g *AgentGroup) AgentSelector() Selector {
+	return g.Spec.Selector.Selector()
+}
+
+// query returns the parsed query or nil if the group does not specify a query
+func (g *AgentGroup) query() *search.Query {
+	if strings.TrimSpace(g.Spec.Query) == "" {
+		return nil
+	}
+	return search.ParseQuery(g.Spec.Query)
+}
+
+// IsMember returns true if the agent matches the selector of the group and the query of the group. The query is
+// evaluated against the specified index.
+func (g *AgentGroup) IsMember(agent *Agent, index search.Index) bool {
+	if agent == nil || !g.AgentSelector().Matches(agent.Labels) {
+		return false
+	}
+	if query := g.query(); query != nil {
+		return index.Matches(query, agent.ID)
+	}
+	return true
+}
+
+// AgentIDs returns the IDs of the agents in the specified index that are members of the group
+func (g *AgentGroup) AgentIDs(index search.Index) []string {
+	ids := index.Select(g.Spec.Selector.MatchLabels)
+	query := g.query()
+	if query == nil {
+		return ids
+	}
+	members := []string{}
+	for _, id := range ids {
+		if index.Matches(query, id) {
+			members = append(members, id)
+		}
+	}
+	return members
+}
+
+// ----------------------------------------------------------------------
+// Printable
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (g *AgentGroup) PrintableFieldTitles() []string {
+	return []string{"Name", "Match", "Query"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (g *AgentGroup) PrintableFieldValue(title string) string {
+	switch title {
+	case "Name":
+		return g.Name()
+	case "Match":
+		return g.AgentSelector().String()
+	case "Query":
+		return g.Spec.Query
+	default:
+		return "-"
+	}
+}
+
+// ----------------------------------------------------------------------
+// summary
+
+// AgentGroupCount is the number of agents in a group with a specific value, e.g. a status or version
+type AgentGroupCount struct {
+	Value string `json:"value" yaml:"value"`
+	Count int    `json:"count" yaml:"count"`
+}
+
+// AgentGroupSummary summarizes the agents that are members of an AgentGroup
+type AgentGroupSummary struct {
+	Name     string             `json:"name" yaml:"name"`
+	Agents   int                `json:"agents" yaml:"agents"`
+	Statuses []*AgentGroupCount `json:"statuses" yaml:"statuses"`
+	Versions []*AgentGroupCount `json:"versions" yaml:"versions"`
+}
+
+// NewAgentGroupSummary returns the summary of the specified agents, which should be the members of the group
+func NewAgentGroupSummary(group *AgentGroup, agents []*Agent) *AgentGroupSummary {
+	statuses := map[string]int{}
+	versions := map[string]int{}
+	for _, agent := range agents {
+		statuses[agent.StatusDisplayText()]++
+		versions[agent.Version]++
+	}
+	return &AgentGroupSummary{
+		Name:     group.Name(),
+		Agents:   len(agents),
+		Statuses: agentGroupCounts(statuses),
+		Versions: agentGroupCounts(versions),
+	}
+}
+
+func agentGroupCounts(counts map[string]int) []*AgentGroupCount {
+	result := make([]*AgentGroupCount, 0, len(counts))
+	for value, count := range counts {
+		result = append(result, &AgentGroupCount{Value: value, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Value < result[j].Value
+	})
+	return result
+}
+
+// Count returns the number of agents with the specified status
+func (s *AgentGroupSummary) Count(status string) int {
+	for _, c := range s.Statuses {
+		if c.Value == status {
+			return c.Count
+		}
+	}
+	return 0
+}
+
+// PrintableKindSingular returns the singular form of the Kind, e.g. "AgentGroupSummary"
+func (s *AgentGroupSummary) PrintableKindSingular() string {
+	return "AgentGroupSummary"
+}
+
+// PrintableKindPlural returns the plural form of the Kind, e.g. "AgentGroupSummaries"
+func (s *AgentGroupSummary) PrintableKindPlural() string {
+	return "AgentGroupSummaries"
+}
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (s *AgentGroupSummary) PrintableFieldTitles() []string {
+	return []string{"Name", "Agents", "Connected", "Disconnected", "Error", "Versions"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (s *AgentGroupSummary) PrintableFieldValue(title string) string {
+	switch title {
+	case "Name":
+		return s.Name
+	case "Agents":
+		return fmt.Sprint(s.Agents)
+	case "Connected", "Disconnected", "Error":
+		return fmt.Sprint(s.Count(title))
+	case "Versions":
+		versions := make([]string, 0, len(s.Versions))
+		for _, v := range s.Versions {
+			versions = append(versions, fmt.Sprintf("%s=%d", v.Value, v.Count))
+		}
+		return strings.Join(versions, ",")
+	}
+	return ""
+}
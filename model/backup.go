@@ -0,0 +1,69 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+// Backup is a snapshot of the bbolt store saved to the backups folder
+type Backup struct {
+	// Name identifies the backup and is derived from the time it was created, e.g. bindplane-20221019T120000.000Z
+	Name      string    `json:"name" yaml:"name"`
+	CreatedAt time.Time `json:"createdAt" yaml:"createdAt"`
+
+	// Size is the size of the snapshot in bytes
+	Size int64 `json:"size" yaml:"size"`
+
+	// Checksum is the hex encoded sha256 checksum of the snapshot, used to verify its integrity before it is restored
+	Checksum string `json:"checksum" yaml:"checksum"`
+}
+
+// ----------------------------------------------------------------------
+// Printable
+
+// PrintableKindSingular returns the singular form of the Kind, e.g. "Backup"
+func (b *Backup) PrintableKindSingular() string {
+	return "Backup"
+}
+
+// PrintableKindPlural returns the plural form of the Kind, e.g. "Backups"
+func (b *Backup) PrintableKindPlural() string {
+	return "Backups"
+}
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (b *Backup) PrintableFieldTitles() []string {
+	return []string{"Name", "Size", "Age", "Checksum"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (b *Backup) PrintableFieldValue(title string) string {
+	switch title {
+	case "Name":
+		return b.Name
+	case "Size":
+		return strconv.FormatInt(b.Size, 10)
+	case "Age":
+		return durationDisplay(&b.CreatedAt)
+	case "Checksum":
+		if len(b.Checksum) > 12 {
+			return b.Checksum[:12]
+		}
+		return b.Checksum
+	}
+	return ""
+}
@@ -0,0 +1,393 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v2"
+)
+
+// BundleKinds are the kinds of resources that can be exported to a bundle, in dependency order. Resources are applied
+// in this order so that each resource is applied after the resources it references, e.g. processors are applied before
+// the sources and destinations that reference them by name. Agents are included in a bundle with only their id, name,
+// and labels.
+var BundleKinds = []Kind{
+	KindSourceType,
+	KindProcessorType,
+	KindDestinationType,
+	KindConnectorType,
+	KindProcessor,
+	KindSource,
+	KindDestination,
+	KindConnector,
+	KindAgentGroup,
+	KindConfiguration,
+	KindAgent,
+}
+
+// BundleFormat is the format of an exported bundle of resources
+type BundleFormat string
+
+const (
+	// BundleFormatYAML is a multi-document yaml file
+	BundleFormatYAML BundleFormat = "yaml"
+
+	// BundleFormatTar is a tar archive with a yaml file for each resource
+	BundleFormatTar BundleFormat = "tar"
+
+	// BundleFormatTarGzip is a gzip compressed tar archive with a yaml file for each resource
+	BundleFormatTarGzip BundleFormat = "tar.gz"
+)
+
+// ParseBundleFormat parses the format name, returning an error if it is not yaml, tar, or tar.gz
+func ParseBundleFormat(format string) (BundleFormat, error) {
+	switch f := BundleFormat(strings.ToLower(format)); f {
+	case BundleFormatYAML, BundleFormatTar, BundleFormatTarGzip:
+		return f, nil
+	case "yml":
+		return BundleFormatYAML, nil
+	case "tgz":
+		return BundleFormatTarGzip, nil
+	}
+	return "", fmt.Errorf("unknown bundle format %s, expected yaml, tar, or tar.gz", format)
+}
+
+// BundleFormatOfFile returns the format of a bundle based on the extension of the filename, defaulting to yaml
+func BundleFormatOfFile(filename string) BundleFormat {
+	switch {
+	case strings.HasSuffix(filename, ".tar"):
+		return BundleFormatTar
+	case strings.HasSuffix(filename, ".tar.gz"), strings.HasSuffix(filename, ".tgz"):
+		return BundleFormatTarGzip
+	}
+	return BundleFormatYAML
+}
+
+// NewAgentBundleResource returns the resource used to include the labels of an agent in a bundle
+func NewAgentBundleResource(agent *Agent) *AnyResource {
+	return &AnyResource{
+		ResourceMeta: ResourceMeta{
+			APIVersion: V1Alpha,
+			Kind:       KindAgent,
+			Metadata: Metadata{
+				ID:     agent.ID,
+				Name:   agent.Name,
+				Labels: agent.Labels,
+			},
+		},
+	}
+}
+
+// SortBundleResources sorts the resources in dependency order using the order of BundleKinds. The order of resources of
+// the same kind is preserved.
+func SortBundleResources[R Resource](resources []R) {
+	order := func(kind Kind) int {
+		if i := slices.Index(BundleKinds, kind); i >= 0 {
+			return i
+		}
+		return len(BundleKinds)
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		return order(resources[i].GetKind()) < order(resources[j].GetKind())
+	})
+}
+
+// BundleFilter selects the resources to include when exporting or importing a bundle
+type BundleFilter struct {
+	// Kinds are the kinds of resources to include. All kinds are included if empty.
+	Kinds []Kind
+
+	// Selector selects the resources to include by label
+	Selector Selector
+}
+
+// Matches returns true if the resource should be included
+func (f *BundleFilter) Matches(resource Resource) bool {
+	if len(f.Kinds) > 0 && !slices.Contains(f.Kinds, resource.GetKind()) {
+		return false
+	}
+	return f.Selector.Matches(resource.GetLabels())
+}
+
+// ----------------------------------------------------------------------
+// reading and writing
+
+// WriteBundle writes the resources to the writer in the specified format. Resources are written in the order provided
+// and the resourceVersion of each resource is omitted so that the bundle can be applied at any time.
+func WriteBundle(writer io.Writer, format BundleFormat, resources []Resource) error {
+	switch format {
+	case BundleFormatYAML:
+		for _, resource := range resources {
+			data, err := bundleYaml(resource)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(writer, "---\n%s", data); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case BundleFormatTar:
+		return writeBundleTar(writer, resources)
+
+	case BundleFormatTarGzip:
+		gz := gzip.NewWriter(writer)
+		if err := writeBundleTar(gz, resources); err != nil {
+			return err
+		}
+		return gz.Close()
+	}
+	return fmt.Errorf("unknown bundle format %s", format)
+}
+
+// writeBundleTar writes a tar archive with a file named kind/name.yaml for each resource
+func writeBundleTar(writer io.Writer, resources []Resource) error {
+	now := time.Now()
+	tw := tar.NewWriter(writer)
+	for _, resource := range resources {
+		data, err := bundleYaml(resource)
+		if err != nil {
+			return err
+		}
+		header := &tar.Header{
+			Name:    bundleFilename(resource),
+			Mode:    0600,
+			Size:    int64(len(data)),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// bundleFilename returns the name of the file for the resource in a tar archive. Agents are identified by id because
+// agent names are not unique.
+func bundleFilename(resource Resource) string {
+	name := resource.Name()
+	if resource.GetKind() == KindAgent {
+		name = resource.ID()
+	}
+	return path.Join(strings.ToLower(string(resource.GetKind())), name+".yaml")
+}
+
+// bundleYaml returns the yaml of the resource without the resourceVersion
+func bundleYaml(resource Resource) ([]byte, error) {
+	resourceVersion := resource.ResourceVersion()
+	resource.SetResourceVersion(0)
+	defer resource.SetResourceVersion(resourceVersion)
+
+	data, err := yaml.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s %s: %w", resource.GetKind(), resource.Name(), err)
+	}
+	return data, nil
+}
+
+// gzipMagic are the first bytes of gzip compressed data
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// ReadBundle reads the resources from a bundle written by WriteBundle. The format is detected from the contents and
+// the resources are returned in dependency order.
+func ReadBundle(reader io.Reader) ([]*AnyResource, error) {
+	buffered := bufio.NewReader(reader)
+	header, _ := buffered.Peek(512)
+
+	var resources []*AnyResource
+	var err error
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		gz, gzErr := gzip.NewReader(buffered)
+		if gzErr != nil {
+			return nil, gzErr
+		}
+		resources, err = readBundleTar(gz)
+	case isTar(header):
+		resources, err = readBundleTar(buffered)
+	default:
+		resources, err = ResourcesFromReader(buffered)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	SortBundleResources(resources)
+	return resources, nil
+}
+
+// isTar returns true if the header is the header of a tar archive, which contains ustar at offset 257
+func isTar(header []byte) bool {
+	return len(header) >= 262 && string(header[257:262]) == "ustar"
+}
+
+func readBundleTar(reader io.Reader) ([]*AnyResource, error) {
+	var resources []*AnyResource
+	tr := tar.NewReader(reader)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return resources, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg || !strings.HasSuffix(header.Name, ".yaml") {
+			continue
+		}
+		fileResources, err := ResourcesFromReader(tr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", header.Name, err)
+		}
+		resources = append(resources, fileResources...)
+	}
+}
+
+// ----------------------------------------------------------------------
+// renaming
+
+// Renames maps the names of resources of each kind to new names. References to renamed resources are also updated,
+// e.g. the source types of sources and the sources and destinations of configurations.
+type Renames map[Kind]map[string]string
+
+// Add renames the resource of the specified kind from one name to another
+func (r Renames) Add(kind Kind, from, to string) {
+	if r[kind] == nil {
+		r[kind] = map[string]string{}
+	}
+	r[kind][from] = to
+}
+
+// Apply returns the resources with the renames applied. Resources without any renames are returned unmodified.
+func (r Renames) Apply(resources []*AnyResource) ([]*AnyResource, error) {
+	if len(r) == 0 {
+		return resources, nil
+	}
+	result := make([]*AnyResource, 0, len(resources))
+	for _, resource := range resources {
+		renamed, err := r.apply(resource)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, renamed)
+	}
+	return result, nil
+}
+
+func (r Renames) apply(resource *AnyResource) (*AnyResource, error) {
+	if resource.Kind == KindAgent {
+		renamed := *resource
+		renamed.Metadata.Labels = MakeLabels()
+		for name, value := range resource.Metadata.Labels.Set {
+			if name == "configuration" {
+				value = r.name(KindConfiguration, value)
+			}
+			renamed.Metadata.Labels.Set[name] = value
+		}
+		return &renamed, nil
+	}
+
+	parsed, err := ParseResource(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	switch p := parsed.(type) {
+	case *Configuration:
+		p.Metadata.Name = r.name(KindConfiguration, p.Metadata.Name)
+		r.renameConfigurations(p.Spec.Sources, KindSource, KindSourceType)
+		r.renameConfigurations(p.Spec.Processors, KindProcessor, KindProcessorType)
+		r.renameConfigurations(p.Spec.Destinations, KindDestination, KindDestinationType)
+		r.renameConfigurations(p.Spec.Connectors, KindConnector, KindConnectorType)
+		if p.Spec.AgentGroup != "" {
+			p.Spec.AgentGroup = r.name(KindAgentGroup, p.Spec.AgentGroup)
+		}
+		if name, ok := p.Spec.Selector.MatchLabels["configuration"]; ok {
+			p.Spec.Selector.MatchLabels["configuration"] = r.name(KindConfiguration, name)
+		}
+	case *Source:
+		p.Metadata.Name = r.name(KindSource, p.Metadata.Name)
+		r.renameParameterizedSpec(&p.Spec, KindSourceType)
+	case *Processor:
+		p.Metadata.Name = r.name(KindProcessor, p.Metadata.Name)
+		r.renameParameterizedSpec(&p.Spec, KindProcessorType)
+	case *Destination:
+		p.Metadata.Name = r.name(KindDestination, p.Metadata.Name)
+		r.renameParameterizedSpec(&p.Spec, KindDestinationType)
+	case *Connector:
+		p.Metadata.Name = r.name(KindConnector, p.Metadata.Name)
+		r.renameParameterizedSpec(&p.Spec, KindConnectorType)
+	case *SourceType:
+		p.Metadata.Name = r.name(KindSourceType, p.Metadata.Name)
+	case *ProcessorType:
+		p.Metadata.Name = r.name(KindProcessorType, p.Metadata.Name)
+	case *DestinationType:
+		p.Metadata.Name = r.name(KindDestinationType, p.Metadata.Name)
+	case *ConnectorType:
+		p.Metadata.Name = r.name(KindConnectorType, p.Metadata.Name)
+	case *AgentGroup:
+		p.Metadata.Name = r.name(KindAgentGroup, p.Metadata.Name)
+	}
+
+	renamed := &AnyResource{}
+	if err := mapstructure.Decode(parsed, renamed); err != nil {
+		return nil, fmt.Errorf("failed to rename %s %s: %w", resource.Kind, resource.Name(), err)
+	}
+	return renamed, nil
+}
+
+func (r Renames) renameParameterizedSpec(spec *ParameterizedSpec, typeKind Kind) {
+	spec.Type = r.name(typeKind, spec.Type)
+	r.renameConfigurations(spec.Processors, KindProcessor, KindProcessorType)
+}
+
+// renameConfigurations renames the named resources and resource types used by the ResourceConfigurations
+func (r Renames) renameConfigurations(configurations []ResourceConfiguration, kind Kind, typeKind Kind) {
+	for i := range configurations {
+		c := &configurations[i]
+		if c.Name != "" {
+			c.Name = r.name(kind, c.Name)
+		}
+		if c.Type != "" {
+			c.Type = r.name(typeKind, c.Type)
+		}
+		r.renameConfigurations(c.Processors, KindProcessor, KindProcessorType)
+	}
+}
+
+// name returns the new name of the resource or the same name if it is not renamed
+func (r Renames) name(kind Kind, name string) string {
+	if renamed, ok := r[kind][name]; ok {
+		return renamed
+	}
+	return name
+}
@@ -0,0 +1,180 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testBundleResources() []Resource {
+	configuration := NewConfigurationWithSpec("cabin", ConfigurationSpec{
+		Sources: []ResourceConfiguration{
+			{Name: "logs"},
+		},
+		Destinations: []ResourceConfiguration{
+			{Name: "gateway"},
+		},
+		Selector: AgentSelector{
+			MatchLabels: MatchLabels{"configuration": "cabin"},
+		},
+	})
+	configuration.Metadata.ResourceVersion = 3
+
+	agent := &Agent{ID: "1", Name: "cabin-agent", Labels: LabelsFromValidatedMap(map[string]string{"configuration": "cabin"})}
+
+	return []Resource{
+		NewAgentBundleResource(agent),
+		configuration,
+		NewDestination("gateway", "otlp", nil),
+		NewSourceWithSpec("logs", ParameterizedSpec{
+			Type:       "file",
+			Processors: []ResourceConfiguration{{Name: "batch"}},
+		}),
+		NewProcessor("batch", "batch", nil),
+		NewSourceType("file", nil),
+		NewProcessorType("batch", nil),
+		NewDestinationType("otlp", nil),
+	}
+}
+
+func TestSortBundleResources(t *testing.T) {
+	resources := testBundleResources()
+	SortBundleResources(resources)
+
+	var kinds []Kind
+	for _, resource := range resources {
+		kinds = append(kinds, resource.GetKind())
+	}
+	require.Equal(t, []Kind{KindSourceType, KindProcessorType, KindDestinationType, KindProcessor, KindSource, KindDestination, KindConfiguration, KindAgent}, kinds)
+}
+
+func TestBundleRoundTrip(t *testing.T) {
+	for _, format := range []BundleFormat{BundleFormatYAML, BundleFormatTar, BundleFormatTarGzip} {
+		t.Run(string(format), func(t *testing.T) {
+			resources := testBundleResources()
+
+			var buffer bytes.Buffer
+			require.NoError(t, WriteBundle(&buffer, format, resources))
+
+			read, err := ReadBundle(&buffer)
+			require.NoError(t, err)
+			require.Len(t, read, len(resources))
+
+			SortBundleResources(resources)
+			for i, resource := range read {
+				require.Equal(t, resources[i].GetKind(), resource.GetKind())
+				require.Equal(t, resources[i].Name(), resource.Name())
+				require.Zero(t, resource.ResourceVersion())
+			}
+
+			// the resourceVersion of the exported resource is unchanged
+			require.Equal(t, int64(3), resources[6].ResourceVersion())
+
+			require.Equal(t, "cabin", read[7].GetLabels().Get("configuration"))
+
+			parsed, err := ParseResources(read[:7])
+			require.NoError(t, err)
+			require.Equal(t, "batch", parsed[4].(*Source).Spec.Processors[0].Name)
+			require.Equal(t, "logs", parsed[6].(*Configuration).Spec.Sources[0].Name)
+		})
+	}
+}
+
+func TestParseBundleFormat(t *testing.T) {
+	tests := map[string]BundleFormat{
+		"yaml":   BundleFormatYAML,
+		"yml":    BundleFormatYAML,
+		"tar":    BundleFormatTar,
+		"tar.gz": BundleFormatTarGzip,
+		"tgz":    BundleFormatTarGzip,
+	}
+	for name, expect := range tests {
+		format, err := ParseBundleFormat(name)
+		require.NoError(t, err)
+		require.Equal(t, expect, format)
+	}
+
+	_, err := ParseBundleFormat("zip")
+	require.Error(t, err)
+
+	require.Equal(t, BundleFormatTarGzip, BundleFormatOfFile("export.tgz"))
+	require.Equal(t, BundleFormatTar, BundleFormatOfFile("export.tar"))
+	require.Equal(t, BundleFormatYAML, BundleFormatOfFile("export.yaml"))
+}
+
+func TestBundleFilter(t *testing.T) {
+	source := NewSource("logs", "file", nil)
+	source.Metadata.Labels = LabelsFromValidatedMap(map[string]string{"env": "prod"})
+	destination := NewDestination("gateway", "otlp", nil)
+
+	selector, err := SelectorFromString("env=prod")
+	require.NoError(t, err)
+
+	filter := BundleFilter{Selector: selector}
+	require.True(t, filter.Matches(source))
+	require.False(t, filter.Matches(destination))
+
+	filter = BundleFilter{Kinds: []Kind{KindDestination}, Selector: EverythingSelector()}
+	require.False(t, filter.Matches(source))
+	require.True(t, filter.Matches(destination))
+}
+
+func TestRenames(t *testing.T) {
+	resources := testBundleResources()
+	SortBundleResources(resources)
+
+	var buffer bytes.Buffer
+	require.NoError(t, WriteBundle(&buffer, BundleFormatYAML, resources))
+	read, err := ReadBundle(&buffer)
+	require.NoError(t, err)
+
+	renames := Renames{}
+	renames.Add(KindSourceType, "file", "filelog")
+	renames.Add(KindProcessor, "batch", "batch-logs")
+	renames.Add(KindSource, "logs", "app-logs")
+	renames.Add(KindConfiguration, "cabin", "lodge")
+
+	renamed, err := renames.Apply(read)
+	require.NoError(t, err)
+
+	require.Equal(t, "filelog", renamed[0].Name())
+	require.Equal(t, "batch", renamed[1].Name())
+	require.Equal(t, "otlp", renamed[2].Name())
+
+	parsed, err := ParseResources(renamed[:7])
+	require.NoError(t, err)
+
+	require.Equal(t, "batch-logs", parsed[3].Name())
+
+	source := parsed[4].(*Source)
+	require.Equal(t, "app-logs", source.Name())
+	require.Equal(t, "filelog", source.Spec.Type)
+	require.Equal(t, "batch-logs", source.Spec.Processors[0].Name)
+
+	configuration := parsed[6].(*Configuration)
+	require.Equal(t, "lodge", configuration.Name())
+	require.Equal(t, "app-logs", configuration.Spec.Sources[0].Name)
+	require.Equal(t, "gateway", configuration.Spec.Destinations[0].Name)
+	require.Equal(t, "lodge", configuration.Spec.Selector.MatchLabels["configuration"])
+
+	require.Equal(t, "lodge", renamed[7].GetLabels().Get("configuration"))
+	require.Equal(t, "1", renamed[7].ID())
+
+	// the original resources are unchanged
+	require.Equal(t, "cabin", read[7].GetLabels().Get("configuration"))
+}
@@ -0,0 +1,151 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// LabelBindPlaneCatalogDigest is the label of a resource type synced from the resource type catalog. The value is the
+// digest of the resource type when it was synced and is used to detect resource types modified since they were synced.
+const LabelBindPlaneCatalogDigest = "bindplane/catalog-digest"
+
+// catalogDigestLength is the number of hex characters of the sha256 used as the digest. Label values are limited to 63
+// characters.
+const catalogDigestLength = 32
+
+// CatalogIndex lists the resource types in a resource type catalog
+type CatalogIndex struct {
+	ResourceTypes []*CatalogEntry `json:"resourceTypes" yaml:"resourceTypes"`
+}
+
+// CatalogEntry is a single resource type in a CatalogIndex
+type CatalogEntry struct {
+	Kind    Kind   `json:"kind" yaml:"kind"`
+	Name    string `json:"name" yaml:"name"`
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+	// Path is the path of the file containing the resource type, relative to the index
+	Path string `json:"path" yaml:"path"`
+	// SHA256 is the hex encoded sha256 checksum of the file containing the resource type
+	SHA256 string `json:"sha256" yaml:"sha256"`
+}
+
+// CatalogStatus describes how a resource type in the catalog compares to the resource type in the store or the result
+// of syncing it
+type CatalogStatus string
+
+const (
+	// CatalogStatusNew is the status of a resource type that is not in the store
+	CatalogStatusNew CatalogStatus = "new"
+
+	// CatalogStatusUpdate is the status of a resource type that differs from the resource type in the store, which has
+	// not been modified since it was synced
+	CatalogStatusUpdate CatalogStatus = "update"
+
+	// CatalogStatusUnchanged is the status of a resource type that matches the resource type in the store
+	CatalogStatusUnchanged CatalogStatus = "unchanged"
+
+	// CatalogStatusConflict is the status of a resource type that differs from a resource type in the store that was not
+	// synced from the catalog or was modified since it was synced
+	CatalogStatusConflict CatalogStatus = "conflict"
+
+	// CatalogStatusOutdated is the status of a resource type with an earlier version than the resource type in the store.
+	// Outdated resource types are never synced.
+	CatalogStatusOutdated CatalogStatus = "outdated"
+
+	// CatalogStatusInvalid is the status of a resource type that failed checksum verification, could not be parsed, or is
+	// not valid
+	CatalogStatusInvalid CatalogStatus = "invalid"
+
+	// CatalogStatusCreated is the status of a new resource type that was synced
+	CatalogStatusCreated CatalogStatus = "created"
+
+	// CatalogStatusUpdated is the status of a changed resource type that was synced
+	CatalogStatusUpdated CatalogStatus = "updated"
+)
+
+// CatalogResourceType describes a resource type in the catalog compared with the resource type in the store
+type CatalogResourceType struct {
+	Kind    Kind   `json:"kind" yaml:"kind"`
+	Name    string `json:"name" yaml:"name"`
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+	// InstalledVersion is the version of the resource type in the store
+	InstalledVersion string        `json:"installedVersion,omitempty" yaml:"installedVersion,omitempty"`
+	Status           CatalogStatus `json:"status" yaml:"status"`
+	// Reason explains conflict and invalid statuses
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+	// Diff is a unified diff from the resource type in the store to the resource type in the catalog
+	Diff string `json:"diff,omitempty" yaml:"diff,omitempty"`
+}
+
+// CatalogDigest returns the digest of a resource type used to detect changes. The ID and resourceVersion of the
+// resource and the LabelBindPlaneCatalogDigest label are ignored.
+func CatalogDigest(resourceType *ResourceType) (string, error) {
+	digested := *resourceType
+	digested.Metadata.ID = ""
+	digested.Metadata.ResourceVersion = 0
+	digested.Metadata.Labels = MakeLabels()
+	for name, value := range resourceType.Metadata.Labels.Set {
+		if name != LabelBindPlaneCatalogDigest {
+			digested.Metadata.Labels.Set[name] = value
+		}
+	}
+
+	bytes, err := json.Marshal(&digested)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:])[:catalogDigestLength], nil
+}
+
+// ----------------------------------------------------------------------
+// Printable
+
+// PrintableKindSingular returns the singular form of the Kind, e.g. "ResourceType"
+func (c *CatalogResourceType) PrintableKindSingular() string {
+	return "ResourceType"
+}
+
+// PrintableKindPlural returns the plural form of the Kind, e.g. "ResourceTypes"
+func (c *CatalogResourceType) PrintableKindPlural() string {
+	return "ResourceTypes"
+}
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (c *CatalogResourceType) PrintableFieldTitles() []string {
+	return []string{"Kind", "Name", "Version", "Installed", "Status", "Reason"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (c *CatalogResourceType) PrintableFieldValue(title string) string {
+	switch title {
+	case "Kind":
+		return string(c.Kind)
+	case "Name":
+		return c.Name
+	case "Version":
+		return c.Version
+	case "Installed":
+		return c.InstalledVersion
+	case "Status":
+		return string(c.Status)
+	case "Reason":
+		return c.Reason
+	}
+	return ""
+}
@@ -0,0 +1,57 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogDigest(t *testing.T) {
+	st := fileResource[*SourceType](t, "testfiles/sourcetype-versioned.yaml")
+	digest, err := CatalogDigest(&st.ResourceType)
+	require.NoError(t, err)
+	require.Len(t, digest, 32)
+
+	// the ID and digest label are ignored
+	labeled := fileResource[*SourceType](t, "testfiles/sourcetype-versioned.yaml")
+	labeled.Metadata.ID = "b1a2c3"
+	labeled.Metadata.Labels = LabelsFromValidatedMap(map[string]string{LabelBindPlaneCatalogDigest: digest})
+	labeledDigest, err := CatalogDigest(&labeled.ResourceType)
+	require.NoError(t, err)
+	require.Equal(t, digest, labeledDigest)
+
+	// stores may encode resource types as json
+	data, err := json.Marshal(labeled)
+	require.NoError(t, err)
+	decoded := &SourceType{}
+	require.NoError(t, json.Unmarshal(data, decoded))
+	decodedDigest, err := CatalogDigest(&decoded.ResourceType)
+	require.NoError(t, err)
+	require.Equal(t, digest, decodedDigest)
+
+	// other changes change the digest
+	labeled.Metadata.Labels.Set["env"] = "production"
+	changedDigest, err := CatalogDigest(&labeled.ResourceType)
+	require.NoError(t, err)
+	require.NotEqual(t, digest, changedDigest)
+
+	st.Spec.Parameters[0].Default = "changed"
+	changedDigest, err = CatalogDigest(&st.ResourceType)
+	require.NoError(t, err)
+	require.NotEqual(t, digest, changedDigest)
+}
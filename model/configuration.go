@@ -91,14 +91,32 @@ type ConfigurationSpec struct {
 	Sources      []ResourceConfiguration `json:"sources,omitempty" yaml:"sources,omitempty" mapstructure:"sources"`
 	Destinations []ResourceConfiguration `json:"destinations,omitempty" yaml:"destinations,omitempty" mapstructure:"destinations"`
 	Selector     AgentSelector           `json:"selector" yaml:"selector" mapstructure:"selector"`
+
+	// Connectors join the pipelines of the sources to the pipelines of the destinations, e.g. to generate metrics from
+	// traces. The processors of a connector process the telemetry emitted by the connector.
+	Connectors []ResourceConfiguration `json:"connectors,omitempty" yaml:"connectors,omitempty" mapstructure:"connectors"`
+
+	// Processors apply to all of the telemetry sent to the destinations, e.g. for batching or resource attribute
+	// enrichment. They are placed after the processors of each source and before the processors of each destination.
+	Processors []ResourceConfiguration `json:"processors,omitempty" yaml:"processors,omitempty" mapstructure:"processors"`
+
+	// AgentGroup limits the configuration to agents that are members of the AgentGroup with this name
+	AgentGroup string `json:"agentGroup,omitempty" yaml:"agentGroup,omitempty" mapstructure:"agentGroup"`
+
+	// Schedule restricts when changes to the configuration are applied to agents
+	Schedule *ConfigurationSchedule `json:"schedule,omitempty" yaml:"schedule,omitempty" mapstructure:"schedule"`
 }
 
-// ResourceConfiguration represents a source or destination configuration
+// ResourceConfiguration represents a source, destination, or connector configuration
 type ResourceConfiguration struct {
 	Name       string                  `json:"name,omitempty" yaml:"name,omitempty" mapstructure:"name"`
 	Type       string                  `json:"type,omitempty" yaml:"type,omitempty" mapstructure:"type"`
 	Parameters []Parameter             `json:"parameters,omitempty" yaml:"parameters,omitempty" mapstructure:"parameters"`
 	Processors []ResourceConfiguration `json:"processors,omitempty" yaml:"processors,omitempty" mapstructure:"processors"`
+
+	// TypeVersion pins the resource to a version of the resource type. For named resources, it overrides the version
+	// pinned by the resource.
+	TypeVersion string `json:"typeVersion,omitempty" yaml:"typeVersion,omitempty" mapstructure:"typeVersion"`
 }
 
 // Validate validates most of the configuration, but if a store is available, ValidateWithStore should be used to
@@ -153,6 +171,11 @@ type ResourceStore interface {
 	ProcessorType(name string) (*ProcessorType, error)
 	Destination(name string) (*Destination, error)
 	DestinationType(name string) (*DestinationType, error)
+	Connector(name string) (*Connector, error)
+	ConnectorType(name string) (*ConnectorType, error)
+	// ResourceTypeVersion returns the specified version of a SourceType, ProcessorType, DestinationType, or
+	// ConnectorType or nil if the version is not available
+	ResourceTypeVersion(kind Kind, name string, version string) (*ResourceType, error)
 }
 
 // Render converts the Configuration model to a configuration that can be sent to an agent
@@ -183,7 +206,7 @@ func (c *Configuration) otelConfiguration(store ResourceStore) (*otel.Configurat
 	configuration := otel.NewConfiguration()
 
 	// match each source with each destination to produce a pipeline
-	sources, destinations, err := c.evalComponents(store)
+	sources, processors, destinations, connectors, err := c.evalComponents(store)
 	if err != nil {
 		return nil, err
 	}
@@ -191,16 +214,20 @@ func (c *Configuration) otelConfiguration(store ResourceStore) (*otel.Configurat
 	for sourceName, source := range sources {
 		for destinationName, destination := range destinations {
 			name := fmt.Sprintf("%s__%s", sourceName, destinationName)
-			configuration.AddPipeline(name, otel.Logs, source, destination)
-			configuration.AddPipeline(name, otel.Metrics, source, destination)
-			configuration.AddPipeline(name, otel.Traces, source, destination)
+			configuration.AddPipeline(name, otel.Logs, source, processors, destination)
+			configuration.AddPipeline(name, otel.Metrics, source, processors, destination)
+			configuration.AddPipeline(name, otel.Traces, source, processors, destination)
 		}
 	}
 
+	for connectorName, connector := range connectors {
+		connector.addPipelines(configuration, connectorName, sources, processors, destinations)
+	}
+
 	return configuration, nil
 }
 
-func (c *Configuration) evalComponents(store ResourceStore) (sources map[string]otel.Partials, destinations map[string]otel.Partials, err error) {
+func (c *Configuration) evalComponents(store ResourceStore) (sources map[string]otel.Partials, processors otel.Partials, destinations map[string]otel.Partials, connectors map[string]*connectorPartials, err error) {
 	errorHandler := func(e error) {
 		if e != nil {
 			err = multierror.Append(err, e)
@@ -208,7 +235,9 @@ func (c *Configuration) evalComponents(store ResourceStore) (sources map[string]
 	}
 
 	sources = map[string]otel.Partials{}
+	processors = newPartials()
 	destinations = map[string]otel.Partials{}
+	connectors = map[string]*connectorPartials{}
 
 	for i, source := range c.Spec.Sources {
 		source := source // copy to local variable to securely pass a reference to a loop variable
@@ -216,13 +245,31 @@ func (c *Configuration) evalComponents(store ResourceStore) (sources map[string]
 		sources[sourceName] = srcParts
 	}
 
+	// configuration-wide processors are combined in the order they are specified
+	for i, processor := range c.Spec.Processors {
+		processor := processor // copy to local variable to securely pass a reference to a loop variable
+		_, processorParts := evalProcessor(&processor, fmt.Sprintf("processor%d", i), store, errorHandler)
+		if processorParts == nil {
+			continue
+		}
+		processors.Add(processorParts)
+	}
+
 	for i, destination := range c.Spec.Destinations {
 		destination := destination // copy to local variable to securely pass a reference to a loop variable
 		destName, destParts := evalDestination(&destination, fmt.Sprintf("destination%d", i), store, errorHandler)
 		destinations[destName] = destParts
 	}
 
-	return sources, destinations, err
+	for i, connector := range c.Spec.Connectors {
+		connector := connector // copy to local variable to securely pass a reference to a loop variable
+		connName, connParts := evalConnector(&connector, fmt.Sprintf("connector%d", i), store, errorHandler)
+		if connParts != nil {
+			connectors[connName] = connParts
+		}
+	}
+
+	return sources, processors, destinations, connectors, err
 }
 
 func evalSource(source *ResourceConfiguration, defaultName string, store ResourceStore, errorHandler TemplateErrorHandler) (string, otel.Partials) {
@@ -265,7 +312,132 @@ func evalDestination(destination *ResourceConfiguration, defaultName string, sto
 		return "", nil
 	}
 
-	return dest.Name(), destType.eval(dest, errorHandler)
+	destName := dest.Name()
+
+	// evaluate the processors associated with the destination, which are placed before any processors of the
+	// destination type
+	partials := newPartials()
+	for i, processor := range destination.Processors {
+		processor := processor
+		_, processorParts := evalProcessor(&processor, fmt.Sprintf("%s__processor%d", destName, i), store, errorHandler)
+		if processorParts == nil {
+			continue
+		}
+		partials.Add(processorParts)
+	}
+	partials.Add(destType.eval(dest, errorHandler))
+
+	return destName, partials
+}
+
+// newPartials returns empty partial configurations for each telemetry type
+func newPartials() otel.Partials {
+	return otel.Partials{
+		otel.Logs:    &otel.Partial{},
+		otel.Metrics: &otel.Partial{},
+		otel.Traces:  &otel.Partial{},
+	}
+}
+
+// connectorPartials are the partial configurations of a connector. The connectors are exporters of the input pipelines
+// and receivers of the output pipelines.
+type connectorPartials struct {
+	// inputs contain the connectors rendered for each telemetry type consumed by the connector
+	inputs otel.Partials
+	// outputs contain the connectors and processors of the connector for each telemetry type emitted by the connector
+	outputs otel.Partials
+}
+
+func evalConnector(connector *ResourceConfiguration, defaultName string, store ResourceStore, errorHandler TemplateErrorHandler) (string, *connectorPartials) {
+	conn, connType, err := findConnectorAndType(connector, defaultName, store)
+	if err != nil {
+		errorHandler(err)
+		return "", nil
+	}
+
+	connName := fmt.Sprintf("%s__%s", conn.Spec.Type, conn.Name())
+	inputs := connType.eval(conn, errorHandler)
+
+	// the same connector can consume multiple telemetry types but only needs to be listed once as a receiver
+	connectors := otel.ComponentList{}
+	ids := map[otel.ComponentID]bool{}
+	for _, pipelineType := range []otel.PipelineType{otel.Logs, otel.Metrics, otel.Traces} {
+		for _, component := range inputs[pipelineType].Connectors {
+			for id := range component {
+				if !ids[id] {
+					ids[id] = true
+					connectors = append(connectors, component)
+				}
+			}
+		}
+	}
+
+	outputs := newPartials()
+	for _, pipelineType := range connType.Spec.ConnectorOutputs {
+		if output, ok := outputs[pipelineType]; ok {
+			output.Connectors = connectors
+		}
+	}
+
+	// evaluate the processors associated with the connector which process the telemetry emitted by the connector
+	for i, processor := range connector.Processors {
+		processor := processor
+		_, processorParts := evalProcessor(&processor, fmt.Sprintf("%s__processor%d", connName, i), store, errorHandler)
+		if processorParts == nil {
+			continue
+		}
+		for _, pipelineType := range connType.Spec.ConnectorOutputs {
+			if output, ok := outputs[pipelineType]; ok {
+				output.Add(processorParts[pipelineType])
+			}
+		}
+	}
+
+	return connName, &connectorPartials{inputs: inputs, outputs: outputs}
+}
+
+// addPipelines adds a pipeline from each source to the connector for each telemetry type consumed by the connector and
+// a pipeline from the connector to each destination for each telemetry type emitted by the connector. A connector must
+// be both an exporter and a receiver, so no pipelines are added unless there is at least one of each. The
+// configuration-wide processors are only added to the pipelines to the destinations so that telemetry is not processed
+// by them twice.
+func (cp *connectorPartials) addPipelines(configuration *otel.Configuration, connectorName string, sources map[string]otel.Partials, processors otel.Partials, destinations map[string]otel.Partials) {
+	pipelineTypes := []otel.PipelineType{otel.Logs, otel.Metrics, otel.Traces}
+
+	consumes := func(source otel.Partials, pipelineType otel.PipelineType) bool {
+		return len(cp.inputs[pipelineType].Connectors) > 0 && len(source[pipelineType].Receivers) > 0
+	}
+	emits := func(destination otel.Partials, pipelineType otel.PipelineType) bool {
+		return len(cp.outputs[pipelineType].Connectors) > 0 && len(destination[pipelineType].Exporters) > 0
+	}
+	matchAny := func(partials map[string]otel.Partials, match func(otel.Partials, otel.PipelineType) bool) bool {
+		for _, p := range partials {
+			for _, pipelineType := range pipelineTypes {
+				if match(p, pipelineType) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if !matchAny(sources, consumes) || !matchAny(destinations, emits) {
+		return
+	}
+
+	for sourceName, source := range sources {
+		for _, pipelineType := range pipelineTypes {
+			if consumes(source, pipelineType) {
+				configuration.AddPipeline(fmt.Sprintf("%s__%s", sourceName, connectorName), pipelineType, source, nil, cp.inputs)
+			}
+		}
+	}
+	for destinationName, destination := range destinations {
+		for _, pipelineType := range pipelineTypes {
+			if emits(destination, pipelineType) {
+				configuration.AddPipeline(fmt.Sprintf("%s__%s", connectorName, destinationName), pipelineType, cp.outputs, processors, destination)
+			}
+		}
+	}
 }
 
 func findSourceAndType(source *ResourceConfiguration, defaultName string, store ResourceStore) (*Source, *SourceType, error) {
@@ -282,6 +454,14 @@ func findSourceAndType(source *ResourceConfiguration, defaultName string, store
 		return src, nil, err
 	}
 
+	pinned, err := src.Spec.resolveTypeVersion(KindSourceType, &srcType.ResourceType, store)
+	if err != nil {
+		return src, nil, err
+	}
+	if pinned != &srcType.ResourceType {
+		srcType = &SourceType{ResourceType: *pinned}
+	}
+
 	return src, srcType, nil
 }
 
@@ -299,6 +479,14 @@ func findProcessorAndType(source *ResourceConfiguration, defaultName string, sto
 		return prc, nil, err
 	}
 
+	pinned, err := prc.Spec.resolveTypeVersion(KindProcessorType, &prcType.ResourceType, store)
+	if err != nil {
+		return prc, nil, err
+	}
+	if pinned != &prcType.ResourceType {
+		prcType = &ProcessorType{ResourceType: *pinned}
+	}
+
 	return prc, prcType, nil
 }
 
@@ -316,9 +504,42 @@ func findDestinationAndType(destination *ResourceConfiguration, defaultName stri
 		return dest, nil, err
 	}
 
+	pinned, err := dest.Spec.resolveTypeVersion(KindDestinationType, &destType.ResourceType, store)
+	if err != nil {
+		return dest, nil, err
+	}
+	if pinned != &destType.ResourceType {
+		destType = &DestinationType{ResourceType: *pinned}
+	}
+
 	return dest, destType, nil
 }
 
+func findConnectorAndType(connector *ResourceConfiguration, defaultName string, store ResourceStore) (*Connector, *ConnectorType, error) {
+	conn, err := FindConnector(connector, defaultName, store)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	connType, err := store.ConnectorType(conn.Spec.Type)
+	if err == nil && connType == nil {
+		err = fmt.Errorf("unknown %s: %s", KindConnectorType, conn.Spec.Type)
+	}
+	if err != nil {
+		return conn, nil, err
+	}
+
+	pinned, err := conn.Spec.resolveTypeVersion(KindConnectorType, &connType.ResourceType, store)
+	if err != nil {
+		return conn, nil, err
+	}
+	if pinned != &connType.ResourceType {
+		connType = &ConnectorType{ResourceType: *pinned}
+	}
+
+	return conn, connType, nil
+}
+
 func findResourceAndType(resourceKind Kind, resource *ResourceConfiguration, defaultName string, store ResourceStore) (Resource, *ResourceType, error) {
 	switch resourceKind {
 	case KindSource:
@@ -339,6 +560,12 @@ func findResourceAndType(resourceKind Kind, resource *ResourceConfiguration, def
 			return dest, nil, err
 		}
 		return dest, &destType.ResourceType, err
+	case KindConnector:
+		conn, connType, err := findConnectorAndType(resource, defaultName, store)
+		if connType == nil {
+			return conn, nil, err
+		}
+		return conn, &connType.ResourceType, err
 	}
 	return nil, nil, nil
 }
@@ -349,11 +576,15 @@ func (cs *ConfigurationSpec) validate(errors validation.Errors) {
 	cs.validateSpecFields(errors)
 	cs.validateRaw(errors)
 	cs.Selector.validate(errors)
+	if cs.AgentGroup != "" {
+		validation.IsName(errors, cs.AgentGroup)
+	}
+	cs.Schedule.validate(errors)
 }
 
 func (cs *ConfigurationSpec) validateSpecFields(errors validation.Errors) {
 	if cs.Raw != "" {
-		if len(cs.Destinations) > 0 || len(cs.Sources) > 0 {
+		if len(cs.Destinations) > 0 || len(cs.Sources) > 0 || len(cs.Connectors) > 0 || len(cs.Processors) > 0 {
 			errors.Add(fmt.Errorf("configuration must specify raw or sources and destinations"))
 		}
 	}
@@ -377,6 +608,17 @@ func (cs *ConfigurationSpec) validateSourcesAndDestinations(errors validation.Er
 	for _, destination := range cs.Destinations {
 		destination.validate(KindDestination, errors, store)
 	}
+	for _, connector := range cs.Connectors {
+		connector.validate(KindConnector, errors, store)
+	}
+	cs.validateProcessors(errors, store)
+}
+
+// validateProcessors validates the configuration-wide processors
+func (cs *ConfigurationSpec) validateProcessors(errors validation.Errors, store ResourceStore) {
+	for _, processor := range cs.Processors {
+		processor.validate(KindProcessor, errors, store)
+	}
 }
 
 func (rc *ResourceConfiguration) validate(resourceKind Kind, errors validation.Errors, store ResourceStore) {
@@ -402,26 +644,18 @@ func (rc *ResourceConfiguration) validateParameters(resourceKind Kind, errors va
 			errors.Add(fmt.Errorf("all %s parameters must have a name", resourceKind))
 		}
 	}
-	_, resourceType, err := findResourceAndType(resourceKind, rc, string(resourceKind), store)
+	resource, resourceType, err := findResourceAndType(resourceKind, rc, string(resourceKind), store)
 	if err != nil {
 		errors.Add(err)
 		return
 	}
-	// ensure parameters are valid
-	for _, parameter := range rc.Parameters {
-		if parameter.Name == "" {
-			continue
-		}
-		def := resourceType.Spec.ParameterDefinition(parameter.Name)
-		if def == nil {
-			errors.Add(fmt.Errorf("parameter %s not defined in type %s", parameter.Name, resourceType.Name()))
-			continue
-		}
-		err := def.validateValue(parameter.Value)
-		if err != nil {
-			errors.Add(err)
-		}
+
+	// parameters of the configuration override the parameters of named resources
+	parameters := rc.Parameters
+	if named, ok := resource.(parameterizedResource); ok && rc.Name != "" {
+		parameters = append(append([]Parameter{}, named.ResourceParameters()...), rc.Parameters...)
 	}
+	resourceType.Spec.validateParameterValues(resourceType.Name(), rc.Parameters, parameters, errors)
 }
 
 func (rc *ResourceConfiguration) validateProcessors(resourceKind Kind, errors validation.Errors, store ResourceStore) {
@@ -470,6 +704,28 @@ func (c *Configuration) IndexFields(index search.Indexer) {
 		destination.indexFields("destination", "destinationType", index)
 	}
 
+	// add connector, connectorType fields
+	for _, connector := range c.Spec.Connectors {
+		connector.indexFields("connector", "connectorType", index)
+	}
+
+	// add processor, processorType fields for the configuration-wide processors and the processors of each source,
+	// destination, and connector
+	for _, processor := range c.Spec.Processors {
+		processor.indexFields("processor", "processorType", index)
+	}
+	for _, list := range [][]ResourceConfiguration{c.Spec.Sources, c.Spec.Destinations, c.Spec.Connectors} {
+		for _, rc := range list {
+			for _, processor := range rc.Processors {
+				processor.indexFields("processor", "processorType", index)
+			}
+		}
+	}
+
+	if c.Spec.AgentGroup != "" {
+		index("agentGroup", c.Spec.AgentGroup)
+	}
+
 	// add pipeline fields
 	//
 	// TODO(andy): I was going to add pipeline:traces, pipeline:logs, and pipeline:metrics because I thought it would be a
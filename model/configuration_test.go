@@ -16,6 +16,7 @@ package model
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -51,6 +52,11 @@ type testResourceStore struct {
 	processorTypes   map[string]*ProcessorType
 	destinations     map[string]*Destination
 	destinationTypes map[string]*DestinationType
+	connectors       map[string]*Connector
+	connectorTypes   map[string]*ConnectorType
+
+	// resourceTypeVersions are previous versions of resource types keyed by kind|name|version
+	resourceTypeVersions map[string]*ResourceType
 }
 
 func newTestResourceStore() *testResourceStore {
@@ -61,6 +67,10 @@ func newTestResourceStore() *testResourceStore {
 		processorTypes:   map[string]*ProcessorType{},
 		destinations:     map[string]*Destination{},
 		destinationTypes: map[string]*DestinationType{},
+		connectors:       map[string]*Connector{},
+		connectorTypes:   map[string]*ConnectorType{},
+
+		resourceTypeVersions: map[string]*ResourceType{},
 	}
 }
 
@@ -84,6 +94,15 @@ func (s *testResourceStore) Destination(name string) (*Destination, error) {
 func (s *testResourceStore) DestinationType(name string) (*DestinationType, error) {
 	return s.destinationTypes[name], nil
 }
+func (s *testResourceStore) Connector(name string) (*Connector, error) {
+	return s.connectors[name], nil
+}
+func (s *testResourceStore) ConnectorType(name string) (*ConnectorType, error) {
+	return s.connectorTypes[name], nil
+}
+func (s *testResourceStore) ResourceTypeVersion(kind Kind, name string, version string) (*ResourceType, error) {
+	return s.resourceTypeVersions[fmt.Sprintf("%s|%s|%s", kind, name, version)], nil
+}
 
 func TestParseConfiguration(t *testing.T) {
 	path := filepath.Join("testfiles", "configuration-raw.yaml")
@@ -331,6 +350,151 @@ service:
 	require.Equal(t, expect, result)
 }
 
+func TestEvalConfigurationConnectors(t *testing.T) {
+	store := newTestResourceStore()
+
+	otlp := testResource[*SourceType](t, "sourcetype-otlp.yaml")
+	store.sourceTypes[otlp.Name()] = otlp
+
+	otlpDestinationType := testResource[*DestinationType](t, "destinationtype-otlp.yaml")
+	store.destinationTypes[otlpDestinationType.Name()] = otlpDestinationType
+
+	spanmetrics := testResource[*ConnectorType](t, "connectortype-spanmetrics.yaml")
+	store.connectorTypes[spanmetrics.Name()] = spanmetrics
+
+	configuration := testResource[*Configuration](t, "configuration-otlp-connector.yaml")
+	require.NoError(t, configuration.ValidateWithStore(store))
+	result, err := configuration.Render(context.TODO(), store)
+	require.NoError(t, err)
+
+	expect := strings.TrimLeft(`
+receivers:
+    otlp/otlp__source0:
+        protocols:
+            grpc: null
+            http: null
+processors:
+    batch/otlp__destination0: null
+exporters:
+    otlp/otlp__destination0:
+        endpoint: otelcol:4317
+connectors:
+    spanmetrics/spanmetrics__connector0:
+        dimensions:
+            - name: http.method
+        exemplars:
+            enabled: true
+service:
+    pipelines:
+        logs/otlp__source0__destination0:
+            receivers:
+                - otlp/otlp__source0
+            processors:
+                - batch/otlp__destination0
+            exporters:
+                - otlp/otlp__destination0
+        metrics/otlp__source0__destination0:
+            receivers:
+                - otlp/otlp__source0
+            processors:
+                - batch/otlp__destination0
+            exporters:
+                - otlp/otlp__destination0
+        metrics/spanmetrics__connector0__destination0:
+            receivers:
+                - spanmetrics/spanmetrics__connector0
+            processors:
+                - batch/otlp__destination0
+            exporters:
+                - otlp/otlp__destination0
+        traces/otlp__source0__destination0:
+            receivers:
+                - otlp/otlp__source0
+            processors:
+                - batch/otlp__destination0
+            exporters:
+                - otlp/otlp__destination0
+        traces/otlp__source0__spanmetrics__connector0:
+            receivers:
+                - otlp/otlp__source0
+            processors: []
+            exporters:
+                - spanmetrics/spanmetrics__connector0
+`, "\n")
+
+	require.Equal(t, expect, result)
+}
+
+func TestEvalConfigurationProcessors(t *testing.T) {
+	store := newTestResourceStore()
+
+	otlp := testResource[*SourceType](t, "sourcetype-otlp.yaml")
+	store.sourceTypes[otlp.Name()] = otlp
+
+	otlpDestinationType := testResource[*DestinationType](t, "destinationtype-otlp.yaml")
+	store.destinationTypes[otlpDestinationType.Name()] = otlpDestinationType
+
+	transposer := testResource[*ProcessorType](t, "processortype-resourceattributetransposer.yaml")
+	store.processorTypes[transposer.Name()] = transposer
+
+	configuration := testResource[*Configuration](t, "configuration-otlp-processors.yaml")
+	require.NoError(t, configuration.ValidateWithStore(store))
+	result, err := configuration.Render(context.TODO(), store)
+	require.NoError(t, err)
+
+	expect := strings.TrimLeft(`
+receivers:
+    otlp/otlp__source0:
+        protocols:
+            grpc: null
+            http: null
+processors:
+    batch/otlp__destination0: null
+    resourceattributetransposer/resource-attribute-transposer__destination0__processor0:
+        operations:
+            - from: service.name
+              to: service
+    resourceattributetransposer/resource-attribute-transposer__processor0:
+        operations:
+            - from: host.name
+              to: hostname
+exporters:
+    otlp/otlp__destination0:
+        endpoint: otelcol:4317
+service:
+    pipelines:
+        logs/otlp__source0__destination0:
+            receivers:
+                - otlp/otlp__source0
+            processors:
+                - resourceattributetransposer/resource-attribute-transposer__processor0
+                - resourceattributetransposer/resource-attribute-transposer__destination0__processor0
+                - batch/otlp__destination0
+            exporters:
+                - otlp/otlp__destination0
+        metrics/otlp__source0__destination0:
+            receivers:
+                - otlp/otlp__source0
+            processors:
+                - resourceattributetransposer/resource-attribute-transposer__processor0
+                - resourceattributetransposer/resource-attribute-transposer__destination0__processor0
+                - batch/otlp__destination0
+            exporters:
+                - otlp/otlp__destination0
+        traces/otlp__source0__destination0:
+            receivers:
+                - otlp/otlp__source0
+            processors:
+                - resourceattributetransposer/resource-attribute-transposer__processor0
+                - resourceattributetransposer/resource-attribute-transposer__destination0__processor0
+                - batch/otlp__destination0
+            exporters:
+                - otlp/otlp__destination0
+`, "\n")
+
+	require.Equal(t, expect, result)
+}
+
 func TestEvalConfiguration4(t *testing.T) {
 	store := newTestResourceStore()
 
@@ -0,0 +1,132 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/observiq/bindplane-op/model/otel"
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+// Connector joins pipelines in a configuration. It is an exporter of the pipelines of the telemetry types it consumes
+// and a receiver of the pipelines of the telemetry types it emits.
+type Connector struct {
+	// ResourceMeta TODO(doc)
+	ResourceMeta `yaml:",inline" json:",inline" mapstructure:",squash"`
+	// Spec TODO(doc)
+	Spec ParameterizedSpec `json:"spec" yaml:"spec" mapstructure:"spec"`
+}
+
+var _ parameterizedResource = (*Connector)(nil)
+
+// ValidateWithStore checks that the connector is valid, returning an error if it is not. It uses the store to retrieve
+// the connector type so that parameter values can be validated against the parameter definitions.
+func (c *Connector) ValidateWithStore(store ResourceStore) error {
+	errors := validation.NewErrors()
+
+	c.validate(errors)
+	c.Spec.validateTypeAndParameters(KindConnector, errors, store)
+
+	return errors.Result()
+}
+
+// GetKind returns "Connector"
+func (c *Connector) GetKind() Kind { return KindConnector }
+
+// ResourceTypeName is the name of the ResourceType that renders this resource type
+func (c *Connector) ResourceTypeName() string {
+	return c.Spec.Type
+}
+
+// ResourceParameters are the parameters passed to the ResourceType to generate the configuration
+func (c *Connector) ResourceParameters() []Parameter {
+	return c.Spec.Parameters
+}
+
+// ComponentID provides a unique component id for the specified component name
+func (c *Connector) ComponentID(name string) otel.ComponentID {
+	return otel.UniqueComponentID(name, c.Spec.Type, c.Name())
+}
+
+// NewConnector creates a new Connector with the specified name, type, and parameters
+func NewConnector(name string, connectorTypeName string, parameters []Parameter) *Connector {
+	return NewConnectorWithSpec(name, ParameterizedSpec{
+		Type:       connectorTypeName,
+		Parameters: parameters,
+	})
+}
+
+// NewConnectorWithSpec creates a new Connector with the specified spec
+func NewConnectorWithSpec(name string, spec ParameterizedSpec) *Connector {
+	return &Connector{
+		ResourceMeta: ResourceMeta{
+			APIVersion: V1Alpha,
+			Kind:       KindConnector,
+			Metadata: Metadata{
+				Name:   name,
+				Labels: MakeLabels(),
+			},
+		},
+		Spec: spec,
+	}
+}
+
+// FindConnector returns a Connector from the store if it exists. If it doesn't exist, it creates a new Connector with
+// the specified defaultName.
+func FindConnector(connector *ResourceConfiguration, defaultName string, store ResourceStore) (*Connector, error) {
+	if connector.Name == "" {
+		// inline connector
+		conn := NewConnector(defaultName, connector.Type, connector.Parameters)
+		conn.Spec.TypeVersion = connector.TypeVersion
+		return conn, nil
+	}
+	// find the connector and override parameters
+	conn, err := store.Connector(connector.Name)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		return nil, fmt.Errorf("unknown %s: %s", KindConnector, connector.Name)
+	}
+	spec := conn.Spec.overrideParameters(connector.Parameters)
+	if connector.TypeVersion != "" {
+		spec.TypeVersion = connector.TypeVersion
+	}
+	return NewConnectorWithSpec(conn.Name(), spec), nil
+}
+
+// ----------------------------------------------------------------------
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (c *Connector) PrintableFieldTitles() []string {
+	return []string{"Name", "Type", "Description"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (c *Connector) PrintableFieldValue(title string) string {
+	switch title {
+	case "ID":
+		return c.ID()
+	case "Name":
+		return c.Name()
+	case "Type":
+		return c.ResourceTypeName()
+	case "Description":
+		return c.Metadata.Description
+	default:
+		return "-"
+	}
+}
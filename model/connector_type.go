@@ -0,0 +1,48 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// ConnectorType is a ResourceType used to define connectors
+type ConnectorType struct {
+	ResourceType `yaml:",inline" json:",inline" mapstructure:",squash"`
+}
+
+// NewConnectorType creates a new connector-type with the specified name,
+func NewConnectorType(name string, parameters []ParameterDefinition) *ConnectorType {
+	return NewConnectorTypeWithSpec(name, ResourceTypeSpec{
+		Parameters: parameters,
+	})
+}
+
+// NewConnectorTypeWithSpec creates a new connector-type with the specified name and spec.
+func NewConnectorTypeWithSpec(name string, spec ResourceTypeSpec) *ConnectorType {
+	return &ConnectorType{
+		ResourceType: ResourceType{
+			ResourceMeta: ResourceMeta{
+				APIVersion: V1Alpha,
+				Kind:       KindConnectorType,
+				Metadata: Metadata{
+					Name: name,
+				},
+			},
+			Spec: spec,
+		},
+	}
+}
+
+// GetKind returns "ConnectorType"
+func (s *ConnectorType) GetKind() Kind {
+	return KindConnectorType
+}
@@ -0,0 +1,73 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// ResourceReference identifies a resource by kind and name
+type ResourceReference struct {
+	Kind Kind   `json:"kind" yaml:"kind"`
+	Name string `json:"name" yaml:"name"`
+}
+
+// NewResourceReference returns a reference to the resource
+func NewResourceReference(resource Resource) *ResourceReference {
+	return &ResourceReference{Kind: resource.GetKind(), Name: resource.Name()}
+}
+
+// NewResourceReferences returns references to the resources
+func NewResourceReferences[R Resource](resources []R) []*ResourceReference {
+	result := make([]*ResourceReference, len(resources))
+	for i, resource := range resources {
+		result[i] = NewResourceReference(resource)
+	}
+	return result
+}
+
+// ResourceDependents are the resources affected by a change to a resource and the agents using the affected
+// configurations
+type ResourceDependents struct {
+	Resources []*ResourceReference `json:"resources" yaml:"resources"`
+	// AgentIDs are the ids of the agents using the resource if it is a configuration or any of the configurations in
+	// Resources
+	AgentIDs []string `json:"agentIds" yaml:"agentIds"`
+}
+
+// ----------------------------------------------------------------------
+// Printable
+
+// PrintableKindSingular returns the singular form of the Kind, e.g. "Resource"
+func (r *ResourceReference) PrintableKindSingular() string {
+	return "Resource"
+}
+
+// PrintableKindPlural returns the plural form of the Kind, e.g. "Resources"
+func (r *ResourceReference) PrintableKindPlural() string {
+	return "Resources"
+}
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (r *ResourceReference) PrintableFieldTitles() []string {
+	return []string{"Kind", "Name"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (r *ResourceReference) PrintableFieldValue(title string) string {
+	switch title {
+	case "Kind":
+		return string(r.Kind)
+	case "Name":
+		return r.Name
+	}
+	return ""
+}
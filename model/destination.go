@@ -88,7 +88,9 @@ func NewDestinationWithSpec(name string, spec ParameterizedSpec) *Destination {
 func FindDestination(destination *ResourceConfiguration, defaultName string, store ResourceStore) (*Destination, error) {
 	if destination.Name == "" {
 		// inline destination
-		return NewDestination(defaultName, destination.Type, destination.Parameters), nil
+		dest := NewDestination(defaultName, destination.Type, destination.Parameters)
+		dest.Spec.TypeVersion = destination.TypeVersion
+		return dest, nil
 	}
 	// find the destination and override parameters
 	dest, err := store.Destination(destination.Name)
@@ -99,6 +101,9 @@ func FindDestination(destination *ResourceConfiguration, defaultName string, sto
 		return nil, fmt.Errorf("unknown %s: %s", KindDestination, destination.Name)
 	}
 	spec := dest.Spec.overrideParameters(destination.Parameters)
+	if destination.TypeVersion != "" {
+		spec.TypeVersion = destination.TypeVersion
+	}
 	return NewDestinationWithSpec(dest.Name(), spec), nil
 }
 
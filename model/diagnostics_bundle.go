@@ -0,0 +1,74 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// DiagnosticsBundle describes a bundle of diagnostics collected from an agent. The bundle contents are stored
+// separately as a gzipped tar archive containing the files listed in Files.
+type DiagnosticsBundle struct {
+	// ID is the ID of the bundle which matches the ID of the collect-diagnostics command that requested it
+	ID        string    `json:"id" yaml:"id"`
+	AgentID   string    `json:"agentId" yaml:"agentId"`
+	CreatedAt time.Time `json:"createdAt" yaml:"createdAt"`
+
+	// Size is the size of the archive in bytes
+	Size  int64    `json:"size" yaml:"size"`
+	Files []string `json:"files" yaml:"files"`
+}
+
+// FileName returns the name to use when saving the bundle archive, e.g. "agent-1-diagnostics-bundle-id.tar.gz"
+func (b *DiagnosticsBundle) FileName() string {
+	return fmt.Sprintf("%s-diagnostics-%s.tar.gz", b.AgentID, b.ID)
+}
+
+// ----------------------------------------------------------------------
+// Printable
+
+// PrintableKindSingular returns the singular form of the Kind, e.g. "Diagnostics"
+func (b *DiagnosticsBundle) PrintableKindSingular() string {
+	return "Diagnostics"
+}
+
+// PrintableKindPlural returns the plural form of the Kind, e.g. "Diagnostics"
+func (b *DiagnosticsBundle) PrintableKindPlural() string {
+	return "Diagnostics"
+}
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (b *DiagnosticsBundle) PrintableFieldTitles() []string {
+	return []string{"ID", "Agent", "Files", "Size", "Age"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (b *DiagnosticsBundle) PrintableFieldValue(title string) string {
+	switch title {
+	case "ID":
+		return b.ID
+	case "Agent":
+		return b.AgentID
+	case "Files":
+		return strconv.Itoa(len(b.Files))
+	case "Size":
+		return strconv.FormatInt(b.Size, 10)
+	case "Age":
+		return durationDisplay(&b.CreatedAt)
+	}
+	return ""
+}
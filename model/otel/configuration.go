@@ -32,7 +32,7 @@ const (
 	Traces  PipelineType = "traces"
 )
 
-// ComponentID is a the name of an individual receiver, processor, exporter, or extension.
+// ComponentID is a the name of an individual receiver, processor, exporter, extension, or connector.
 type ComponentID string
 
 // ComponentMap is a map of individual receivers, processors, etc.
@@ -70,6 +70,7 @@ type Configuration struct {
 	Processors ComponentMap `yaml:"processors,omitempty"`
 	Exporters  ComponentMap `yaml:"exporters,omitempty"`
 	Extensions ComponentMap `yaml:"extensions,omitempty"`
+	Connectors ComponentMap `yaml:"connectors,omitempty"`
 	Service    Service      `yaml:"service"`
 }
 
@@ -80,6 +81,7 @@ func NewConfiguration() *Configuration {
 		Processors: ComponentMap{},
 		Exporters:  ComponentMap{},
 		Extensions: ComponentMap{},
+		Connectors: ComponentMap{},
 		Service: Service{
 			Pipelines: Pipelines{},
 		},
@@ -130,11 +132,12 @@ type Partial struct {
 	Processors ComponentList
 	Exporters  ComponentList
 	Extensions ComponentList
+	Connectors ComponentList
 }
 
 // Size returns the number of components in the partial configuration
 func (p *Partial) Size() int {
-	return len(p.Receivers) + len(p.Processors) + len(p.Exporters) + len(p.Extensions)
+	return len(p.Receivers) + len(p.Processors) + len(p.Exporters) + len(p.Extensions) + len(p.Connectors)
 }
 
 // Add adds components from another partial by appending each of the component lists together
@@ -143,6 +146,7 @@ func (p *Partial) Add(o *Partial) {
 	p.Processors = append(p.Processors, o.Processors...)
 	p.Exporters = append(p.Exporters, o.Exporters...)
 	p.Extensions = append(p.Extensions, o.Extensions...)
+	p.Connectors = append(p.Connectors, o.Connectors...)
 }
 
 // Partials represents a fragments of configuration for each type of telemetry.
@@ -193,8 +197,14 @@ func (c *Configuration) AddExtension(name ComponentID, extension any) {
 	}
 }
 
-// AddPipeline adds a pipeline and all of the corresponding components to the configuration
-func (c *Configuration) AddPipeline(name string, pipelineType PipelineType, source, destination Partials) {
+// AddPipeline adds a pipeline and all of the corresponding components to the configuration. Connectors of the source
+// are receivers of the pipeline and connectors of the destination are exporters of the pipeline, which allows a
+// connector to join a pipeline of one telemetry type to a pipeline of another.
+//
+// The processors of the pipeline are always in the same order: the processors of the source, followed by the
+// configuration-wide processors, followed by the processors of the destination. processors may be nil if there are no
+// configuration-wide processors.
+func (c *Configuration) AddPipeline(name string, pipelineType PipelineType, source, processors, destination Partials) {
 	s := source[pipelineType]
 	d := destination[pipelineType]
 	if s.Size() == 0 || d.Size() == 0 {
@@ -207,14 +217,19 @@ func (c *Configuration) AddPipeline(name string, pipelineType PipelineType, sour
 	// add any receivers specified
 	p.AddReceivers(c.Receivers.addComponents(s.Receivers))
 	p.AddReceivers(c.Receivers.addComponents(d.Receivers))
+	p.AddReceivers(c.Connectors.addComponents(s.Connectors))
 
 	// add any processors specified
 	p.AddProcessors(c.Processors.addComponents(s.Processors))
+	if pp := processors[pipelineType]; pp != nil {
+		p.AddProcessors(c.Processors.addComponents(pp.Processors))
+	}
 	p.AddProcessors(c.Processors.addComponents(d.Processors))
 
 	// add any exporters specified
 	p.AddExporters(c.Exporters.addComponents(s.Exporters))
 	p.AddExporters(c.Exporters.addComponents(d.Exporters))
+	p.AddExporters(c.Connectors.addComponents(d.Connectors))
 
 	// skip any incomplete pipelines
 	if p.Incomplete() {
@@ -229,6 +244,26 @@ func (c *Configuration) AddPipeline(name string, pipelineType PipelineType, sour
 	c.Service.Pipelines[pipelineID] = p
 }
 
+// AddPartial adds a pipeline with the components of a single partial configuration. Unlike AddPipeline, the pipeline is
+// added even if it is incomplete, which is useful to render the components of an individual resource.
+func (c *Configuration) AddPartial(name string, pipelineType PipelineType, partial *Partial) {
+	if partial == nil || partial.Size() == 0 {
+		return
+	}
+
+	p := Pipeline{
+		Receivers:  c.Receivers.addComponents(partial.Receivers),
+		Processors: c.Processors.addComponents(partial.Processors),
+		Exporters:  c.Exporters.addComponents(partial.Exporters),
+	}
+	// connectors consume the telemetry of the pipeline
+	p.AddExporters(c.Connectors.addComponents(partial.Connectors))
+	c.AddExtensions(partial.Extensions)
+
+	pipelineID := fmt.Sprintf("%s/%s", pipelineType, name)
+	c.Service.Pipelines[pipelineID] = p
+}
+
 // addComponents adds the components to the map and returns their ids as a convenience to build the pipeline
 func (c ComponentMap) addComponents(componentList ComponentList) []ComponentID {
 	ids := []ComponentID{}
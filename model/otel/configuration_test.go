@@ -83,3 +83,64 @@ func TestNilConfiguration(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, NoopConfig, yaml)
 }
+
+func TestAddPartial(t *testing.T) {
+	c := NewConfiguration()
+	c.AddPartial("empty", Logs, &Partial{})
+	c.AddPartial("nil", Logs, nil)
+	require.False(t, c.HasPipelines())
+
+	c.AddPartial("source", Metrics, &Partial{
+		Receivers:  ComponentList{{"hostmetrics/source": map[string]any{}}},
+		Extensions: ComponentList{{"file_storage/source": map[string]any{}}},
+	})
+	require.Equal(t, Pipelines{
+		"metrics/source": {
+			Receivers:  []ComponentID{"hostmetrics/source"},
+			Processors: []ComponentID{},
+			Exporters:  []ComponentID{},
+		},
+	}, c.Service.Pipelines)
+	require.Contains(t, c.Receivers, ComponentID("hostmetrics/source"))
+	require.Equal(t, []ComponentID{"file_storage/source"}, c.Service.Extensions)
+}
+
+func TestAddPipelineProcessorOrder(t *testing.T) {
+	partials := func(receivers, processors, exporters []ComponentID) Partials {
+		partial := &Partial{}
+		for _, id := range receivers {
+			partial.Receivers = append(partial.Receivers, map[ComponentID]any{id: nil})
+		}
+		for _, id := range processors {
+			partial.Processors = append(partial.Processors, map[ComponentID]any{id: nil})
+		}
+		for _, id := range exporters {
+			partial.Exporters = append(partial.Exporters, map[ComponentID]any{id: nil})
+		}
+		return Partials{Logs: partial, Metrics: &Partial{}, Traces: &Partial{}}
+	}
+
+	source := partials([]ComponentID{"filelog/source"}, []ComponentID{"filter/source"}, nil)
+	processors := partials(nil, []ComponentID{"resource/global", "batch/global"}, nil)
+	destination := partials(nil, []ComponentID{"attributes/destination"}, []ComponentID{"otlp/destination"})
+
+	t.Run("with configuration-wide processors", func(t *testing.T) {
+		c := NewConfiguration()
+		c.AddPipeline("source__destination", Logs, source, processors, destination)
+		c.AddPipeline("source__destination", Metrics, source, processors, destination)
+		require.Equal(t, Pipelines{
+			"logs/source__destination": {
+				Receivers:  []ComponentID{"filelog/source"},
+				Processors: []ComponentID{"filter/source", "resource/global", "batch/global", "attributes/destination"},
+				Exporters:  []ComponentID{"otlp/destination"},
+			},
+		}, c.Service.Pipelines)
+		require.Len(t, c.Processors, 4)
+	})
+
+	t.Run("without configuration-wide processors", func(t *testing.T) {
+		c := NewConfiguration()
+		c.AddPipeline("source__destination", Logs, source, nil, destination)
+		require.Equal(t, []ComponentID{"filter/source", "attributes/destination"}, c.Service.Pipelines["logs/source__destination"].Processors)
+	})
+}
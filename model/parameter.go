@@ -17,8 +17,17 @@ package model
 import (
 	"fmt"
 	"go/token"
+	"math"
+	"net"
 	"reflect"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
+
+	// timezone parameters are validated with time.LoadLocation which requires the timezone database even if the host
+	// does not provide one
+	_ "time/tzdata"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/observiq/bindplane-op/model/validation"
@@ -27,16 +36,34 @@ import (
 )
 
 const (
-	stringType  = "string"
-	boolType    = "bool"
-	intType     = "int"
-	stringsType = "strings"
-	enumType    = "enum"
-	enumsType   = "enums"
-	yamlType    = "yaml"
-	mapType     = "map"
+	stringType   = "string"
+	boolType     = "bool"
+	intType      = "int"
+	stringsType  = "strings"
+	enumType     = "enum"
+	enumsType    = "enums"
+	yamlType     = "yaml"
+	mapType      = "map"
+	floatType    = "float"
+	durationType = "duration"
+	byteSizeType = "byteSize"
+	regexType    = "regex"
+	timezoneType = "timezone"
+	filePathType = "filePath"
+	hostPortType = "hostPort"
+	objectType   = "object"
+	objectsType  = "objects"
 )
 
+// parameterTypes are all of the supported parameter types
+var parameterTypes = []string{
+	stringType, boolType, intType, stringsType, enumType, enumsType, yamlType, mapType, floatType, durationType,
+	byteSizeType, regexType, timezoneType, filePathType, hostPortType, objectType, objectsType,
+}
+
+// parameterTypesHint is the remedy used in errors for invalid parameter types
+var parameterTypesHint = fmt.Sprintf("ensure that the type is one of '%s'", strings.Join(parameterTypes, "', '"))
+
 // ParameterDefinition is a basic description of a definition's parameter. This implementation comes directly from
 // stanza plugin parameters with slight modifications for mapstructure.
 type ParameterDefinition struct {
@@ -45,12 +72,26 @@ type ParameterDefinition struct {
 	Description string `json:"description" yaml:"description"`
 	Required    bool   `json:"required" yaml:"required"`
 
-	// "string", "int", "bool", "strings", or "enum"
+	// "string", "int", "bool", "strings", "enum", "enums", "yaml", "map", "float", "duration", "byteSize", "regex",
+	// "timezone", "filePath", "hostPort", "object", or "objects"
 	Type string `json:"type" yaml:"type"`
 
-	// only useable if Type == "enum"
+	// only useable if Type == "enum" or Type == "enums"
 	ValidValues []string `json:"validValues,omitempty" yaml:"validValues,omitempty" mapstructure:"validValues"`
 
+	// Min and Max constrain the value of "int" and "float" parameters, the number of bytes of "byteSize" parameters, the
+	// number of seconds of "duration" parameters, and the number of items of "strings", "enums", and "objects"
+	// parameters.
+	Min *float64 `json:"min,omitempty" yaml:"min,omitempty" mapstructure:"min"`
+	Max *float64 `json:"max,omitempty" yaml:"max,omitempty" mapstructure:"max"`
+
+	// Pattern is a regular expression that must match the value of "string", "strings", "filePath", and "hostPort"
+	// parameters
+	Pattern string `json:"pattern,omitempty" yaml:"pattern,omitempty" mapstructure:"pattern"`
+
+	// Properties is the schema of each field of "object" parameters and of each item of "objects" parameters
+	Properties []ParameterDefinition `json:"properties,omitempty" yaml:"properties,omitempty" mapstructure:"properties"`
+
 	// Must be valid according to Type & ValidValues
 	Default        interface{}           `json:"default,omitempty" yaml:"default,omitempty"`
 	RelevantIf     []RelevantIfCondition `json:"relevantIf,omitempty" yaml:"relevantIf,omitempty" mapstructure:"relevantIf"`
@@ -58,11 +99,14 @@ type ParameterDefinition struct {
 	AdvancedConfig bool                  `json:"advancedConfig" yaml:"advancedConfig" mapstructure:"advancedConfig"`
 }
 
-// RelevantIfCondition specifies a condition under which a parameter is deemed relevant.
+// RelevantIfCondition specifies a condition under which a parameter is deemed relevant. The Name refers to another
+// parameter which is compared with the Value using the Operator. The "all" and "any" operators combine Conditions
+// instead and do not use the Name or Value.
 type RelevantIfCondition struct {
-	Name     string `json:"name" yaml:"name" mapstructure:"name"`
-	Operator string `json:"operator" yaml:"operator" mapstructure:"operator"`
-	Value    any    `json:"value" yaml:"value" mapstructure:"value"`
+	Name       string                `json:"name" yaml:"name,omitempty" mapstructure:"name"`
+	Operator   string                `json:"operator" yaml:"operator" mapstructure:"operator"`
+	Value      any                   `json:"value" yaml:"value,omitempty" mapstructure:"value"`
+	Conditions []RelevantIfCondition `json:"conditions,omitempty" yaml:"conditions,omitempty" mapstructure:"conditions"`
 }
 
 func (p ParameterDefinition) validateValue(value interface{}) error {
@@ -82,6 +126,12 @@ func (p ParameterDefinition) validateDefinition(errs validation.Errors) {
 		errs.Add(err)
 	}
 
+	if err := p.validateConstraints(); err != nil {
+		errs.Add(err)
+	}
+
+	p.validateProperties(errs)
+
 	if err := p.validateDefault(); err != nil {
 		errs.Add(err)
 	}
@@ -107,40 +157,108 @@ func (p ParameterDefinition) validateType() error {
 	if p.Type == "" {
 		return errors.NewError(
 			fmt.Sprintf("missing type for '%s'", p.Name),
-			"ensure that the type is one of 'string', 'int', 'bool', 'strings', or 'enum'",
+			parameterTypesHint,
 		)
 	}
-	switch p.Type {
-	case stringType, intType, boolType, stringsType, enumType, enumsType, mapType, yamlType: // ok
-	default:
-		return errors.NewError(
-			fmt.Sprintf("invalid type '%s' for '%s'", p.Type, p.Name),
-			"ensure that the type is one of 'string', 'int', 'bool', 'strings', or 'enum'",
-		)
+	for _, t := range parameterTypes {
+		if p.Type == t {
+			return nil
+		}
 	}
-	return nil
+	return errors.NewError(
+		fmt.Sprintf("invalid type '%s' for '%s'", p.Type, p.Name),
+		parameterTypesHint,
+	)
 }
 
 func (p ParameterDefinition) validateValidValues() error {
 	switch p.Type {
-	case stringType, intType, boolType, stringsType, yamlType, mapType:
+	case enumType, enumsType:
+		if len(p.ValidValues) == 0 {
+			return errors.NewError(
+				"parameter of type 'enum' or 'enums' must have 'validValues' specified",
+				"specify an array that includes one or more valid values",
+			)
+		}
+	default:
 		if len(p.ValidValues) > 0 {
 			return errors.NewError(
 				fmt.Sprintf("validValues is undefined for parameter of type '%s'", p.Type),
 				"remove 'validValues' field or change type to 'enum' or 'enums",
 			)
 		}
-	case enumType, enumsType:
-		if len(p.ValidValues) == 0 {
+	}
+	return nil
+}
+
+func (p ParameterDefinition) validateConstraints() error {
+	if p.Min != nil || p.Max != nil {
+		switch p.Type {
+		case intType, floatType, byteSizeType, durationType, stringsType, enumsType, objectsType: // ok
+		default:
 			return errors.NewError(
-				"parameter of type 'enum' or 'enums' must have 'validValues' specified",
-				"specify an array that includes one or more valid values",
+				fmt.Sprintf("min and max are undefined for parameter '%s' of type '%s'", p.Name, p.Type),
+				"remove the 'min' and 'max' fields or change the type",
 			)
 		}
 	}
+	if p.Min != nil && p.Max != nil && *p.Min > *p.Max {
+		return errors.NewError(
+			fmt.Sprintf("min %v is greater than max %v for parameter '%s'", *p.Min, *p.Max, p.Name),
+			"ensure that min is less than or equal to max",
+		)
+	}
+
+	if p.Pattern == "" {
+		return nil
+	}
+	switch p.Type {
+	case stringType, stringsType, filePathType, hostPortType: // ok
+	default:
+		return errors.NewError(
+			fmt.Sprintf("pattern is undefined for parameter '%s' of type '%s'", p.Name, p.Type),
+			"remove the 'pattern' field or change the type",
+		)
+	}
+	if _, err := regexp.Compile(p.Pattern); err != nil {
+		return errors.NewError(
+			fmt.Sprintf("invalid pattern for parameter '%s': %s", p.Name, err),
+			"ensure that the pattern is a valid regular expression",
+		)
+	}
 	return nil
 }
 
+func (p ParameterDefinition) validateProperties(errs validation.Errors) {
+	switch p.Type {
+	case objectType, objectsType:
+		if len(p.Properties) == 0 {
+			errs.Add(errors.NewError(
+				fmt.Sprintf("parameter '%s' of type '%s' must have 'properties' specified", p.Name, p.Type),
+				"specify the definition of each field of the object",
+			))
+		}
+		names := map[string]bool{}
+		for _, property := range p.Properties {
+			if names[property.Name] {
+				errs.Add(errors.NewError(
+					fmt.Sprintf("duplicate property '%s' for parameter '%s'", property.Name, p.Name),
+					"ensure that each property has a unique name",
+				))
+			}
+			names[property.Name] = true
+			property.validateDefinition(errs)
+		}
+	default:
+		if len(p.Properties) > 0 {
+			errs.Add(errors.NewError(
+				fmt.Sprintf("properties is undefined for parameter '%s' of type '%s'", p.Name, p.Type),
+				"remove the 'properties' field or change type to 'object' or 'objects'",
+			))
+		}
+	}
+}
+
 func (p ParameterDefinition) validateDefault() error {
 	if p.Default == nil {
 		return nil
@@ -177,38 +295,61 @@ func (p ParameterDefinition) validateValueType(fieldType parameterFieldType, val
 		return p.validateMapValue(fieldType, value)
 	case yamlType:
 		return p.validateYamlValue(fieldType, value)
+	case floatType:
+		return p.validateFloatValue(fieldType, value)
+	case durationType:
+		return p.validateDurationValue(fieldType, value)
+	case byteSizeType:
+		return p.validateByteSizeValue(fieldType, value)
+	case regexType:
+		return p.validateRegexValue(fieldType, value)
+	case timezoneType:
+		return p.validateTimezoneValue(fieldType, value)
+	case filePathType:
+		return p.validateFilePathValue(fieldType, value)
+	case hostPortType:
+		return p.validateHostPortValue(fieldType, value)
+	case objectType:
+		return p.validateObjectValue(fieldType, value)
+	case objectsType:
+		return p.validateObjectsValue(fieldType, value)
 	default:
 		return errors.NewError(
 			"invalid type for parameter",
-			"ensure that the type is one of 'string', 'int', 'bool', 'strings', or 'enum'",
+			parameterTypesHint,
 		)
 	}
 }
 
 func (p ParameterDefinition) validateStringValue(fieldType parameterFieldType, value any) error {
-	if _, ok := value.(string); !ok {
+	str, ok := value.(string)
+	if !ok {
 		return errors.NewError(
 			fmt.Sprintf("%s value for '%s' must be a string", fieldType, p.Name),
 			fmt.Sprintf("ensure that the %s value is a string", fieldType),
 		)
 	}
-	return nil
+	return p.validatePattern(fieldType, str)
 }
 
 func (p ParameterDefinition) validateIntValue(fieldType parameterFieldType, value any) error {
 	isIntValue := false
+	var number float64
 
-	if _, ok := value.(int); ok {
+	if intValue, ok := value.(int); ok {
 		// obvious case of integer
 		isIntValue = true
+		number = float64(intValue)
 	} else if floatValue, ok := value.(float64); ok {
 		// less obvious case of float64
 		if floatValue == float64(int(floatValue)) {
 			isIntValue = true
+			number = floatValue
 		}
 	} else if stringValue, ok := value.(string); ok {
-		_, err := strconv.Atoi(stringValue)
+		intValue, err := strconv.Atoi(stringValue)
 		isIntValue = err == nil
+		number = float64(intValue)
 	}
 
 	if !isIntValue {
@@ -217,7 +358,7 @@ func (p ParameterDefinition) validateIntValue(fieldType parameterFieldType, valu
 			fmt.Sprintf("ensure that the %s value is an integer", fieldType),
 		)
 	}
-	return nil
+	return p.validateRange(fieldType, number, "")
 }
 
 func (p ParameterDefinition) validateBoolValue(fieldType parameterFieldType, value any) error {
@@ -240,25 +381,32 @@ func (p ParameterDefinition) validateBoolValue(fieldType parameterFieldType, val
 }
 
 func (p ParameterDefinition) validateStringArrayValue(fieldType parameterFieldType, value any) error {
-	if _, ok := value.([]string); ok {
-		return nil
-	}
-	valueList, ok := value.([]interface{})
+	strs, ok := value.([]string)
 	if !ok {
-		return errors.NewError(
-			fmt.Sprintf("%s value for '%s' must be an array of strings", fieldType, p.Name),
-			fmt.Sprintf("ensure that the %s value is an array of string", fieldType),
-		)
-	}
-	for _, s := range valueList {
-		if _, ok := s.(string); !ok {
+		valueList, ok := value.([]interface{})
+		if !ok {
 			return errors.NewError(
 				fmt.Sprintf("%s value for '%s' must be an array of strings", fieldType, p.Name),
 				fmt.Sprintf("ensure that the %s value is an array of string", fieldType),
 			)
 		}
+		for _, s := range valueList {
+			str, ok := s.(string)
+			if !ok {
+				return errors.NewError(
+					fmt.Sprintf("%s value for '%s' must be an array of strings", fieldType, p.Name),
+					fmt.Sprintf("ensure that the %s value is an array of string", fieldType),
+				)
+			}
+			strs = append(strs, str)
+		}
 	}
-	return nil
+	for _, str := range strs {
+		if err := p.validatePattern(fieldType, str); err != nil {
+			return err
+		}
+	}
+	return p.validateRange(fieldType, float64(len(strs)), " items")
 }
 
 func (p ParameterDefinition) validateEnumValue(fieldType parameterFieldType, value any) error {
@@ -289,6 +437,10 @@ func (p ParameterDefinition) validateEnumsValue(fieldType parameterFieldType, va
 		)
 	}
 
+	if err := p.validateRange(fieldType, float64(len(def)), " items"); err != nil {
+		return err
+	}
+
 	// Make sure all strings in the value are a validValue
 	err := &multierror.Error{}
 	for _, str := range def {
@@ -348,3 +500,310 @@ func (p ParameterDefinition) validateMapValue(fieldType parameterFieldType, valu
 	}
 	return nil
 }
+
+func (p ParameterDefinition) validateFloatValue(fieldType parameterFieldType, value any) error {
+	var number float64
+	var err error
+	switch v := value.(type) {
+	case float64:
+		number = v
+	case float32:
+		number = float64(v)
+	case int:
+		number = float64(v)
+	case int64:
+		number = float64(v)
+	case string:
+		number, err = strconv.ParseFloat(v, 64)
+	default:
+		err = fmt.Errorf("unexpected type %T", value)
+	}
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be a number", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is a number", fieldType),
+		)
+	}
+	return p.validateRange(fieldType, number, "")
+}
+
+func (p ParameterDefinition) validateDurationValue(fieldType parameterFieldType, value any) error {
+	str, ok := value.(string)
+	duration, err := time.ParseDuration(str)
+	if !ok || err != nil {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be a duration", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is a duration with a unit, e.g. 30s or 1h30m", fieldType),
+		)
+	}
+	return p.validateRange(fieldType, duration.Seconds(), " seconds")
+}
+
+func (p ParameterDefinition) validateByteSizeValue(fieldType parameterFieldType, value any) error {
+	var size float64
+	var err error
+	switch v := value.(type) {
+	case int:
+		size = float64(v)
+	case float64:
+		size = v
+		if v != math.Trunc(v) {
+			err = fmt.Errorf("fractional number of bytes")
+		}
+	case string:
+		size, err = parseByteSize(v)
+	default:
+		err = fmt.Errorf("unexpected type %T", value)
+	}
+	if err != nil || size < 0 {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be a byte size", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is a number of bytes with an optional unit, e.g. 512KiB or 1GB", fieldType),
+		)
+	}
+	return p.validateRange(fieldType, size, " bytes")
+}
+
+func (p ParameterDefinition) validateRegexValue(fieldType parameterFieldType, value any) error {
+	str, ok := value.(string)
+	if !ok {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be a regular expression", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is a string", fieldType),
+		)
+	}
+	if _, err := regexp.Compile(str); err != nil {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' is not a valid regular expression: %s", fieldType, p.Name, err),
+			fmt.Sprintf("ensure that the %s value is a valid regular expression", fieldType),
+		)
+	}
+	return nil
+}
+
+func (p ParameterDefinition) validateTimezoneValue(fieldType parameterFieldType, value any) error {
+	str, ok := value.(string)
+	// Local and the empty string are accepted by LoadLocation but depend on the host where the value is used
+	if ok && str != "" && str != "Local" {
+		_, err := time.LoadLocation(str)
+		ok = err == nil
+	} else {
+		ok = false
+	}
+	if !ok {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be a timezone", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is a timezone name from the IANA Time Zone database, e.g. UTC or America/New_York", fieldType),
+		)
+	}
+	return nil
+}
+
+func (p ParameterDefinition) validateFilePathValue(fieldType parameterFieldType, value any) error {
+	str, ok := value.(string)
+	if !ok || str == "" || strings.ContainsAny(str, "\x00\n\r") {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be a file path", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is a file path on a single line", fieldType),
+		)
+	}
+	return p.validatePattern(fieldType, str)
+}
+
+func (p ParameterDefinition) validateHostPortValue(fieldType parameterFieldType, value any) error {
+	str, ok := value.(string)
+	if ok {
+		_, port, err := net.SplitHostPort(str)
+		if err == nil {
+			_, err = strconv.ParseUint(port, 10, 16)
+		}
+		ok = err == nil
+	}
+	if !ok {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be a host and port", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is formatted as host:port, e.g. localhost:4317 or :4317", fieldType),
+		)
+	}
+	return p.validatePattern(fieldType, str)
+}
+
+func (p ParameterDefinition) validateObjectValue(fieldType parameterFieldType, value any) error {
+	object, ok := objectValue(value)
+	if !ok {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be an object", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is an object with the fields %s", fieldType, p.propertyNames()),
+		)
+	}
+	return p.validateObjectProperties(fieldType, p.Name, object)
+}
+
+func (p ParameterDefinition) validateObjectsValue(fieldType parameterFieldType, value any) error {
+	reflectValue := reflect.ValueOf(value)
+	if reflectValue.Kind() != reflect.Slice {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be an array of objects", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is an array of objects with the fields %s", fieldType, p.propertyNames()),
+		)
+	}
+	for i := 0; i < reflectValue.Len(); i++ {
+		name := fmt.Sprintf("%s[%d]", p.Name, i)
+		object, ok := objectValue(reflectValue.Index(i).Interface())
+		if !ok {
+			return errors.NewError(
+				fmt.Sprintf("%s value for '%s' must be an object", fieldType, name),
+				fmt.Sprintf("ensure that each item is an object with the fields %s", p.propertyNames()),
+			)
+		}
+		if err := p.validateObjectProperties(fieldType, name, object); err != nil {
+			return err
+		}
+	}
+	return p.validateRange(fieldType, float64(reflectValue.Len()), " items")
+}
+
+// validateObjectProperties validates each field of the object using the definition of the property with the same
+// name. The name is used in errors to identify the object, e.g. "headers[1]".
+func (p ParameterDefinition) validateObjectProperties(fieldType parameterFieldType, name string, object map[string]any) error {
+	err := &multierror.Error{}
+	for key := range object {
+		if p.property(key) == nil {
+			multierror.Append(err, errors.NewError(
+				fmt.Sprintf("%s value for '%s' has unknown field '%s'", fieldType, name, key),
+				fmt.Sprintf("ensure that the fields are in %s", p.propertyNames()),
+			))
+		}
+	}
+	for _, property := range p.Properties {
+		propertyValue, ok := object[property.Name]
+		if !ok || propertyValue == nil {
+			if property.Required {
+				multierror.Append(err, errors.NewError(
+					fmt.Sprintf("%s value for '%s' is missing required field '%s'", fieldType, name, property.Name),
+					"specify a value for the field",
+				))
+			}
+			continue
+		}
+		property.Name = fmt.Sprintf("%s.%s", name, property.Name)
+		if propertyErr := property.validateValueType(fieldType, propertyValue); propertyErr != nil {
+			multierror.Append(err, propertyErr)
+		}
+	}
+	return err.ErrorOrNil()
+}
+
+func (p ParameterDefinition) property(name string) *ParameterDefinition {
+	for i, property := range p.Properties {
+		if property.Name == name {
+			return &p.Properties[i]
+		}
+	}
+	return nil
+}
+
+func (p ParameterDefinition) propertyNames() []string {
+	names := make([]string, len(p.Properties))
+	for i, property := range p.Properties {
+		names[i] = property.Name
+	}
+	return names
+}
+
+// validatePattern returns an error if a Pattern is specified and the value does not match it
+func (p ParameterDefinition) validatePattern(fieldType parameterFieldType, value string) error {
+	if p.Pattern == "" {
+		return nil
+	}
+	pattern, err := regexp.Compile(p.Pattern)
+	if err != nil {
+		// invalid patterns are reported when validating the definition
+		return nil
+	}
+	if !pattern.MatchString(value) {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must match the pattern %s", fieldType, p.Name, p.Pattern),
+			fmt.Sprintf("ensure that the %s value matches the pattern", fieldType),
+		)
+	}
+	return nil
+}
+
+// validateRange returns an error if the value is less than Min or greater than Max. The unit is appended to the
+// limits in errors, e.g. " items".
+func (p ParameterDefinition) validateRange(fieldType parameterFieldType, value float64, unit string) error {
+	if p.Min != nil && value < *p.Min {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be at least %v%s", fieldType, p.Name, *p.Min, unit),
+			fmt.Sprintf("ensure that the %s value is between the min and max", fieldType),
+		)
+	}
+	if p.Max != nil && value > *p.Max {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be at most %v%s", fieldType, p.Name, *p.Max, unit),
+			fmt.Sprintf("ensure that the %s value is between the min and max", fieldType),
+		)
+	}
+	return nil
+}
+
+// objectValue returns the value as a map with string keys. Values decoded from yaml may have interface{} keys.
+func objectValue(value any) (map[string]any, bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		return v, true
+	case map[any]any:
+		object := make(map[string]any, len(v))
+		for key, fieldValue := range v {
+			name, ok := key.(string)
+			if !ok {
+				return nil, false
+			}
+			object[name] = fieldValue
+		}
+		return object, true
+	}
+	return nil, false
+}
+
+// byteSizeUnits are the multipliers of the units supported by parseByteSize, using lowercase names
+var byteSizeUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"k":   1e3,
+	"kb":  1e3,
+	"m":   1e6,
+	"mb":  1e6,
+	"g":   1e9,
+	"gb":  1e9,
+	"t":   1e12,
+	"tb":  1e12,
+	"ki":  1 << 10,
+	"kib": 1 << 10,
+	"mi":  1 << 20,
+	"mib": 1 << 20,
+	"gi":  1 << 30,
+	"gib": 1 << 30,
+	"ti":  1 << 40,
+	"tib": 1 << 40,
+}
+
+var byteSizeRegex = regexp.MustCompile(`^\s*([0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z]*)\s*$`)
+
+// parseByteSize parses a number of bytes with an optional decimal (KB, MB, ...) or binary (KiB, MiB, ...) unit
+func parseByteSize(value string) (float64, error) {
+	match := byteSizeRegex.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf("invalid byte size: %s", value)
+	}
+	multiplier, ok := byteSizeUnits[strings.ToLower(match[2])]
+	if !ok {
+		return 0, fmt.Errorf("invalid byte size unit: %s", match[2])
+	}
+	number, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, err
+	}
+	return math.Round(number * multiplier), nil
+}
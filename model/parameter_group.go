@@ -0,0 +1,77 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	// embed the shared parameter groups
+	_ "embed"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+// parameterGroupsYAML contains the groups of parameters shared by resource types, e.g. the retry and sending queue
+// settings of exporters
+//
+//go:embed parameter_groups.yaml
+var parameterGroupsYAML []byte
+
+// parameterGroups returns the shared groups of parameters by name. The groups are parsed for each call so that resource
+// types never share the parameter definitions.
+func parameterGroups() (map[string][]ParameterDefinition, error) {
+	groups := map[string][]ParameterDefinition{}
+	if err := yaml.Unmarshal(parameterGroupsYAML, &groups); err != nil {
+		return nil, fmt.Errorf("unable to parse parameter groups: %w", err)
+	}
+	return groups, nil
+}
+
+// addParameterGroups appends the parameters of the ParameterGroups of the spec that are not already defined by the spec.
+// Unknown groups are ignored and reported by validate.
+func (s *ResourceTypeSpec) addParameterGroups() error {
+	if len(s.ParameterGroups) == 0 {
+		return nil
+	}
+	groups, err := parameterGroups()
+	if err != nil {
+		return err
+	}
+	for _, name := range s.ParameterGroups {
+		for _, parameter := range groups[name] {
+			if s.ParameterDefinition(parameter.Name) == nil {
+				s.Parameters = append(s.Parameters, parameter)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *ResourceTypeSpec) validateParameterGroups(errs validation.Errors) {
+	if len(s.ParameterGroups) == 0 {
+		return
+	}
+	groups, err := parameterGroups()
+	if err != nil {
+		errs.Add(err)
+		return
+	}
+	for _, name := range s.ParameterGroups {
+		if _, ok := groups[name]; !ok {
+			errs.Add(fmt.Errorf("unknown parameter group %s", name))
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+func TestParseResourceTypeParameterGroups(t *testing.T) {
+	resources, err := ResourcesFromReader(strings.NewReader(`
+apiVersion: bindplane.observiq.com/v1beta
+kind: DestinationType
+metadata:
+  name: test
+spec:
+  parameters:
+    - name: hostname
+      type: string
+    - name: sending_queue_queue_size
+      type: int
+      default: 10
+  parameterGroups:
+    - retry_on_failure
+    - sending_queue
+`))
+	require.NoError(t, err)
+	parsed, err := ParseResources(resources)
+	require.NoError(t, err)
+	destinationType := parsed[0].(*DestinationType)
+
+	names := []string{}
+	for _, p := range destinationType.Spec.Parameters {
+		names = append(names, p.Name)
+	}
+	require.Equal(t, []string{
+		"hostname",
+		"sending_queue_queue_size",
+		"retry_on_failure_enabled",
+		"retry_initial_interval",
+		"retry_max_interval",
+		"retry_max_elapsed_time",
+		"sending_queue_enabled",
+		"sending_queue_num_consumers",
+	}, names)
+
+	// parameters defined by the resource type are kept
+	require.Equal(t, 10, destinationType.Spec.ParameterDefinition("sending_queue_queue_size").Default)
+	require.Equal(t, "5s", destinationType.Spec.ParameterDefinition("retry_initial_interval").Default)
+
+	// adding the groups again does not add duplicates
+	require.NoError(t, destinationType.Spec.addParameterGroups())
+	require.Len(t, destinationType.Spec.Parameters, len(names))
+	require.NoError(t, destinationType.Validate())
+}
+
+func TestValidateParameterGroups(t *testing.T) {
+	spec := ResourceTypeSpec{ParameterGroups: []string{"retry_on_failure", "unknown"}}
+	errs := validation.NewErrors()
+	spec.validateParameterGroups(errs)
+	require.EqualError(t, errs.Result(), "1 error occurred:\n\t* unknown parameter group unknown\n\n")
+}
@@ -18,6 +18,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model/validation"
 )
 
 func TestValidateDefault(t *testing.T) {
@@ -139,7 +141,7 @@ func TestValidateDefault(t *testing.T) {
 			"InvalidTypeDefault",
 			true,
 			ParameterDefinition{
-				Type:    "float",
+				Type:    "complex",
 				Default: 5,
 			},
 		},
@@ -288,7 +290,7 @@ func TestValidateValue(t *testing.T) {
 			"InvalidType",
 			true,
 			ParameterDefinition{
-				Type:    "float",
+				Type:    "complex",
 				Default: 5,
 			},
 			5,
@@ -393,6 +395,286 @@ eleven:
 				"one", "seven",
 			},
 		},
+		{
+			"ValidFloat",
+			false,
+			ParameterDefinition{
+				Type: "float",
+				Min:  floatPtr(0),
+				Max:  floatPtr(1),
+			},
+			0.5,
+		},
+		{
+			"ValidFloatString",
+			false,
+			ParameterDefinition{
+				Type: "float",
+			},
+			"1.5e3",
+		},
+		{
+			"InvalidFloat",
+			true,
+			ParameterDefinition{
+				Type: "float",
+			},
+			"test",
+		},
+		{
+			"FloatAboveMax",
+			true,
+			ParameterDefinition{
+				Type: "float",
+				Max:  floatPtr(1),
+			},
+			1.5,
+		},
+		{
+			"IntBelowMin",
+			true,
+			ParameterDefinition{
+				Type: "int",
+				Min:  floatPtr(1),
+			},
+			0,
+		},
+		{
+			"ValidDuration",
+			false,
+			ParameterDefinition{
+				Type: "duration",
+				Max:  floatPtr(60),
+			},
+			"1m",
+		},
+		{
+			"InvalidDuration",
+			true,
+			ParameterDefinition{
+				Type: "duration",
+			},
+			"60",
+		},
+		{
+			"DurationAboveMax",
+			true,
+			ParameterDefinition{
+				Type: "duration",
+				Max:  floatPtr(60),
+			},
+			"1h",
+		},
+		{
+			"ValidByteSize",
+			false,
+			ParameterDefinition{
+				Type: "byteSize",
+				Max:  floatPtr(1 << 20),
+			},
+			"512KiB",
+		},
+		{
+			"ValidByteSizeInt",
+			false,
+			ParameterDefinition{
+				Type: "byteSize",
+			},
+			1024,
+		},
+		{
+			"InvalidByteSize",
+			true,
+			ParameterDefinition{
+				Type: "byteSize",
+			},
+			"12 parsecs",
+		},
+		{
+			"ByteSizeAboveMax",
+			true,
+			ParameterDefinition{
+				Type: "byteSize",
+				Max:  floatPtr(1 << 20),
+			},
+			"2MB",
+		},
+		{
+			"ValidRegex",
+			false,
+			ParameterDefinition{
+				Type: "regex",
+			},
+			`^(?P<time>\d+) (?P<message>.*)$`,
+		},
+		{
+			"InvalidRegex",
+			true,
+			ParameterDefinition{
+				Type: "regex",
+			},
+			`^(?P<time>\d+`,
+		},
+		{
+			"ValidTimezone",
+			false,
+			ParameterDefinition{
+				Type: "timezone",
+			},
+			"America/New_York",
+		},
+		{
+			"InvalidTimezone",
+			true,
+			ParameterDefinition{
+				Type: "timezone",
+			},
+			"Mars/Olympus_Mons",
+		},
+		{
+			"LocalTimezone",
+			true,
+			ParameterDefinition{
+				Type: "timezone",
+			},
+			"Local",
+		},
+		{
+			"ValidFilePath",
+			false,
+			ParameterDefinition{
+				Type:    "filePath",
+				Pattern: `\.log$`,
+			},
+			"/var/log/*.log",
+		},
+		{
+			"InvalidFilePath",
+			true,
+			ParameterDefinition{
+				Type: "filePath",
+			},
+			"/var/log/\nsyslog",
+		},
+		{
+			"FilePathMismatchedPattern",
+			true,
+			ParameterDefinition{
+				Type:    "filePath",
+				Pattern: `\.log$`,
+			},
+			"/var/log/syslog",
+		},
+		{
+			"ValidHostPort",
+			false,
+			ParameterDefinition{
+				Type: "hostPort",
+			},
+			"localhost:4317",
+		},
+		{
+			"ValidHostPortWithoutHost",
+			false,
+			ParameterDefinition{
+				Type: "hostPort",
+			},
+			":4317",
+		},
+		{
+			"InvalidHostPort",
+			true,
+			ParameterDefinition{
+				Type: "hostPort",
+			},
+			"localhost",
+		},
+		{
+			"InvalidHostPortPort",
+			true,
+			ParameterDefinition{
+				Type: "hostPort",
+			},
+			"localhost:70000",
+		},
+		{
+			"StringMismatchedPattern",
+			true,
+			ParameterDefinition{
+				Type:    "string",
+				Pattern: `^[a-z]+$`,
+			},
+			"ABC",
+		},
+		{
+			"StringsAboveMax",
+			true,
+			ParameterDefinition{
+				Type: "strings",
+				Max:  floatPtr(1),
+			},
+			[]any{"one", "two"},
+		},
+		{
+			"ValidObject",
+			false,
+			testObjectParameter("object"),
+			map[string]any{"key": "x-api-key", "value": "secret"},
+		},
+		{
+			"ValidObjectWithInterfaceKeys",
+			false,
+			testObjectParameter("object"),
+			map[any]any{"key": "x-api-key"},
+		},
+		{
+			"ObjectMissingRequiredField",
+			true,
+			testObjectParameter("object"),
+			map[string]any{"value": "secret"},
+		},
+		{
+			"ObjectUnknownField",
+			true,
+			testObjectParameter("object"),
+			map[string]any{"key": "x-api-key", "other": "value"},
+		},
+		{
+			"ObjectInvalidField",
+			true,
+			testObjectParameter("object"),
+			map[string]any{"key": 5},
+		},
+		{
+			"InvalidObject",
+			true,
+			testObjectParameter("object"),
+			"key=value",
+		},
+		{
+			"ValidObjects",
+			false,
+			testObjectParameter("objects"),
+			[]any{
+				map[string]any{"key": "x-api-key", "value": "secret"},
+				map[string]any{"key": "x-tenant"},
+			},
+		},
+		{
+			"InvalidObjectsItem",
+			true,
+			testObjectParameter("objects"),
+			[]any{
+				map[string]any{"key": "x-api-key", "value": "secret"},
+				map[string]any{"value": "missing key"},
+			},
+		},
+		{
+			"InvalidObjects",
+			true,
+			testObjectParameter("objects"),
+			map[string]any{"key": "x-api-key"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -408,3 +690,174 @@ eleven:
 		})
 	}
 }
+
+func TestValidateDefinition(t *testing.T) {
+	testCases := []struct {
+		name      string
+		expectErr string
+		param     ParameterDefinition
+	}{
+		{
+			"ValidConstraints",
+			"",
+			ParameterDefinition{
+				Name:    "count",
+				Type:    "int",
+				Min:     floatPtr(1),
+				Max:     floatPtr(10),
+				Default: 5,
+			},
+		},
+		{
+			"MinGreaterThanMax",
+			"min 10 is greater than max 1",
+			ParameterDefinition{
+				Name: "count",
+				Type: "int",
+				Min:  floatPtr(10),
+				Max:  floatPtr(1),
+			},
+		},
+		{
+			"MinOnString",
+			"min and max are undefined for parameter 'name' of type 'string'",
+			ParameterDefinition{
+				Name: "name",
+				Type: "string",
+				Min:  floatPtr(1),
+			},
+		},
+		{
+			"InvalidPattern",
+			"invalid pattern for parameter 'name'",
+			ParameterDefinition{
+				Name:    "name",
+				Type:    "string",
+				Pattern: "[a-z",
+			},
+		},
+		{
+			"PatternOnInt",
+			"pattern is undefined for parameter 'count' of type 'int'",
+			ParameterDefinition{
+				Name:    "count",
+				Type:    "int",
+				Pattern: "[0-9]+",
+			},
+		},
+		{
+			"DefaultOutOfRange",
+			"default value for 'count' must be at most 10",
+			ParameterDefinition{
+				Name:    "count",
+				Type:    "int",
+				Max:     floatPtr(10),
+				Default: 11,
+			},
+		},
+		{
+			"ValidObject",
+			"",
+			testObjectParameter("objects"),
+		},
+		{
+			"ObjectWithoutProperties",
+			"parameter 'headers' of type 'object' must have 'properties' specified",
+			ParameterDefinition{
+				Name: "headers",
+				Type: "object",
+			},
+		},
+		{
+			"InvalidProperty",
+			"invalid type 'complex' for 'key'",
+			ParameterDefinition{
+				Name: "headers",
+				Type: "object",
+				Properties: []ParameterDefinition{
+					{Name: "key", Type: "complex"},
+				},
+			},
+		},
+		{
+			"DuplicateProperty",
+			"duplicate property 'key' for parameter 'headers'",
+			ParameterDefinition{
+				Name: "headers",
+				Type: "object",
+				Properties: []ParameterDefinition{
+					{Name: "key", Type: "string"},
+					{Name: "key", Type: "string"},
+				},
+			},
+		},
+		{
+			"PropertiesOnString",
+			"properties is undefined for parameter 'name' of type 'string'",
+			ParameterDefinition{
+				Name: "name",
+				Type: "string",
+				Properties: []ParameterDefinition{
+					{Name: "key", Type: "string"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validation.NewErrors()
+			tc.param.validateDefinition(errs)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, errs.Result(), tc.expectErr)
+			} else {
+				require.NoError(t, errs.Result())
+			}
+		})
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	testCases := []struct {
+		value     string
+		expect    float64
+		expectErr bool
+	}{
+		{value: "100", expect: 100},
+		{value: "100B", expect: 100},
+		{value: "1.5KB", expect: 1500},
+		{value: "2 MiB", expect: 2 << 20},
+		{value: "1gib", expect: 1 << 30},
+		{value: "1Ti", expect: 1 << 40},
+		{value: "", expectErr: true},
+		{value: "-1MB", expectErr: true},
+		{value: "1 parsec", expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			size, err := parseByteSize(tc.value)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, size)
+		})
+	}
+}
+
+func testObjectParameter(parameterType string) ParameterDefinition {
+	return ParameterDefinition{
+		Name: "headers",
+		Type: parameterType,
+		Properties: []ParameterDefinition{
+			{Name: "key", Type: "string", Required: true},
+			{Name: "value", Type: "string"},
+		},
+	}
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
@@ -24,6 +24,9 @@ type ParameterizedSpec struct {
 	Type       string                  `yaml:"type" json:"type" mapstructure:"type"`
 	Parameters []Parameter             `yaml:"parameters" json:"parameters" mapstructure:"parameters"`
 	Processors []ResourceConfiguration `yaml:"processors" json:"processors" mapstructure:"processors"`
+
+	// TypeVersion pins the resource to a version of the resource type. If empty, the current version is used.
+	TypeVersion string `yaml:"typeVersion,omitempty" json:"typeVersion,omitempty" mapstructure:"typeVersion"`
 }
 
 // parameterizedResource is a resource based on a resource type which provides a specific resource value via templated
@@ -58,7 +61,7 @@ func (s ParameterizedSpec) overrideParameters(parameters []Parameter) Parameteri
 			s.Parameters = append(s.Parameters, p)
 		}
 	}
-	return ParameterizedSpec{Type: s.Type, Parameters: result, Processors: s.Processors}
+	return ParameterizedSpec{Type: s.Type, TypeVersion: s.TypeVersion, Parameters: result, Processors: s.Processors}
 }
 
 // validateTypeAndParameters is used by Source and Destination validation and uses methods created for Configuration
@@ -67,9 +70,10 @@ func (s *ParameterizedSpec) validateTypeAndParameters(kind Kind, errors validati
 	// ResourceConfiguration is a resource embedded in a Configuration, but it works equally well for Source and
 	// Destination validation.
 	rc := &ResourceConfiguration{
-		Type:       s.Type,
-		Parameters: s.Parameters,
-		Processors: s.Processors,
+		Type:        s.Type,
+		TypeVersion: s.TypeVersion,
+		Parameters:  s.Parameters,
+		Processors:  s.Processors,
 	}
 	rc.validateParameters(kind, errors, store)
 	rc.validateProcessors(kind, errors, store)
@@ -88,7 +88,9 @@ func NewProcessorWithSpec(name string, spec ParameterizedSpec) *Processor {
 func FindProcessor(processor *ResourceConfiguration, defaultName string, store ResourceStore) (*Processor, error) {
 	if processor.Name == "" {
 		// inline source
-		return NewProcessor(defaultName, processor.Type, processor.Parameters), nil
+		prc := NewProcessor(defaultName, processor.Type, processor.Parameters)
+		prc.Spec.TypeVersion = processor.TypeVersion
+		return prc, nil
 	}
 	// find the processor and override parameters
 	prc, err := store.Processor(processor.Name)
@@ -99,6 +101,9 @@ func FindProcessor(processor *ResourceConfiguration, defaultName string, store R
 		return nil, fmt.Errorf("unknown %s: %s", KindProcessor, processor.Name)
 	}
 	spec := prc.Spec.overrideParameters(processor.Parameters)
+	if processor.TypeVersion != "" {
+		spec.TypeVersion = processor.TypeVersion
+	}
 	return NewProcessorWithSpec(prc.Name(), spec), nil
 }
 
@@ -0,0 +1,240 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+const (
+	relevantIfEquals      = "equals"
+	relevantIfNotEquals   = "notEquals"
+	relevantIfIn          = "in"
+	relevantIfNotIn       = "notIn"
+	relevantIfContainsAny = "containsAny"
+	relevantIfExists      = "exists"
+	relevantIfAll         = "all"
+	relevantIfAny         = "any"
+)
+
+// relevant returns true if all of the RelevantIf conditions of the parameter are satisfied by the specified parameter
+// values. Parameters without conditions are always relevant.
+func (p ParameterDefinition) relevant(values map[string]any) bool {
+	for _, condition := range p.RelevantIf {
+		if !condition.satisfied(values) {
+			return false
+		}
+	}
+	return true
+}
+
+// satisfied returns true if the condition is satisfied by the specified parameter values
+func (c RelevantIfCondition) satisfied(values map[string]any) bool {
+	switch c.Operator {
+	case relevantIfAll:
+		for _, condition := range c.Conditions {
+			if !condition.satisfied(values) {
+				return false
+			}
+		}
+		return true
+
+	case relevantIfAny:
+		for _, condition := range c.Conditions {
+			if condition.satisfied(values) {
+				return true
+			}
+		}
+		return false
+	}
+
+	value := values[c.Name]
+	switch c.Operator {
+	case relevantIfEquals:
+		return relevantIfEqual(value, c.Value)
+	case relevantIfNotEquals:
+		return !relevantIfEqual(value, c.Value)
+	case relevantIfIn:
+		return relevantIfContains(c.Value, value)
+	case relevantIfNotIn:
+		return !relevantIfContains(c.Value, value)
+	case relevantIfContainsAny:
+		for _, item := range relevantIfItems(value) {
+			if relevantIfContains(c.Value, item) {
+				return true
+			}
+		}
+		return false
+	case relevantIfExists:
+		// exists: false can be used to check that a parameter is not set
+		expected, ok := c.Value.(bool)
+		if !ok {
+			expected = true
+		}
+		return !relevantIfEmpty(value) == expected
+	}
+	return false
+}
+
+// relevantIfEqual compares values that may have been decoded into different types, e.g. int and float64 or []string
+// and []any
+func relevantIfEqual(a, b any) bool {
+	if aNumber, ok := relevantIfNumber(a); ok {
+		bNumber, ok := relevantIfNumber(b)
+		return ok && aNumber == bNumber
+	}
+	aItems, bItems := relevantIfItems(a), relevantIfItems(b)
+	if aItems != nil && bItems != nil {
+		if len(aItems) != len(bItems) {
+			return false
+		}
+		for i := range aItems {
+			if !relevantIfEqual(aItems[i], bItems[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// relevantIfContains returns true if the list contains the value
+func relevantIfContains(list any, value any) bool {
+	for _, item := range relevantIfItems(list) {
+		if relevantIfEqual(item, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// relevantIfItems returns the items of a slice or nil if the value is not a slice
+func relevantIfItems(value any) []any {
+	reflectValue := reflect.ValueOf(value)
+	if reflectValue.Kind() != reflect.Slice {
+		return nil
+	}
+	items := make([]any, reflectValue.Len())
+	for i := range items {
+		items[i] = reflectValue.Index(i).Interface()
+	}
+	return items
+}
+
+func relevantIfNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+// relevantIfEmpty returns true if the value is nil, an empty string, or an empty slice or map
+func relevantIfEmpty(value any) bool {
+	if value == nil {
+		return true
+	}
+	reflectValue := reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		return reflectValue.Len() == 0
+	}
+	return false
+}
+
+// validateRelevantIf validates a condition of the RelevantIf of the parameter, including any nested conditions
+func (s *ResourceTypeSpec) validateRelevantIf(parameter ParameterDefinition, relevantIf RelevantIfCondition, errs validation.Errors) {
+	switch relevantIf.Operator {
+	case relevantIfAll, relevantIfAny:
+		if len(relevantIf.Conditions) == 0 {
+			errs.Add(fmt.Errorf("relevantIf '%s' for '%s' must have conditions", relevantIf.Operator, parameter.Name))
+		}
+		for _, condition := range relevantIf.Conditions {
+			s.validateRelevantIf(parameter, condition, errs)
+		}
+		return
+	}
+
+	if relevantIf.Name == "" {
+		errs.Add(fmt.Errorf("relevantIf for '%s' must have a name", parameter.Name))
+		return
+	}
+	ref := s.ParameterDefinition(relevantIf.Name)
+	if ref == nil {
+		errs.Add(fmt.Errorf("relevantIf for '%s' refers to nonexistant parameter '%s'", parameter.Name, relevantIf.Name))
+		return
+	}
+	if len(relevantIf.Conditions) > 0 {
+		errs.Add(fmt.Errorf("relevantIf '%s' for '%s' can only have conditions with the all or any operators", ref.Name, parameter.Name))
+	}
+
+	var err error
+	switch relevantIf.Operator {
+	case "":
+		errs.Add(fmt.Errorf("relevantIf '%s' for '%s' must have an operator", ref.Name, parameter.Name))
+		return
+
+	case relevantIfExists:
+		if _, ok := relevantIf.Value.(bool); relevantIf.Value != nil && !ok {
+			err = fmt.Errorf("value for the exists operator must be true or false")
+		}
+
+	case relevantIfEquals, relevantIfNotEquals:
+		if relevantIf.Value == nil {
+			errs.Add(fmt.Errorf("relevantIf '%s' for '%s' must have a value", ref.Name, parameter.Name))
+			return
+		}
+		err = ref.validateValueType(parameterFieldRelevantIf, relevantIf.Value)
+
+	case relevantIfIn, relevantIfNotIn, relevantIfContainsAny:
+		items := relevantIfItems(relevantIf.Value)
+		if len(items) == 0 {
+			errs.Add(fmt.Errorf("relevantIf '%s' for '%s' must have a list of values", ref.Name, parameter.Name))
+			return
+		}
+		itemDefinition := *ref
+		if relevantIf.Operator == relevantIfContainsAny {
+			switch ref.Type {
+			case stringsType:
+				itemDefinition.Type = stringType
+			case enumsType:
+				itemDefinition.Type = enumType
+			default:
+				err = fmt.Errorf("the containsAny operator requires a parameter of type strings or enums")
+			}
+		}
+		for _, item := range items {
+			if err != nil {
+				break
+			}
+			err = itemDefinition.validateValueType(parameterFieldRelevantIf, item)
+		}
+
+	default:
+		err = fmt.Errorf("unknown operator '%s'", relevantIf.Operator)
+	}
+	if err != nil {
+		errs.Add(fmt.Errorf("relevantIf '%s' for '%s': %w", ref.Name, parameter.Name, err))
+	}
+}
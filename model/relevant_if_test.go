@@ -0,0 +1,308 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+func TestRelevantIfSatisfied(t *testing.T) {
+	values := map[string]any{
+		"enabled":   true,
+		"transport": "tcp",
+		"count":     5,
+		"paths":     []any{"/var/log/a.log", "/var/log/b.log"},
+		"empty":     "",
+		"none":      []string{},
+	}
+
+	tests := []struct {
+		name      string
+		condition RelevantIfCondition
+		expect    bool
+	}{
+		{
+			name:      "equals",
+			condition: RelevantIfCondition{Name: "enabled", Operator: "equals", Value: true},
+			expect:    true,
+		},
+		{
+			name:      "equals number of a different type",
+			condition: RelevantIfCondition{Name: "count", Operator: "equals", Value: 5.0},
+			expect:    true,
+		},
+		{
+			name:      "equals list of a different type",
+			condition: RelevantIfCondition{Name: "paths", Operator: "equals", Value: []string{"/var/log/a.log", "/var/log/b.log"}},
+			expect:    true,
+		},
+		{
+			name:      "equals missing",
+			condition: RelevantIfCondition{Name: "missing", Operator: "equals", Value: true},
+			expect:    false,
+		},
+		{
+			name:      "notEquals",
+			condition: RelevantIfCondition{Name: "transport", Operator: "notEquals", Value: "unix"},
+			expect:    true,
+		},
+		{
+			name:      "notEquals same",
+			condition: RelevantIfCondition{Name: "transport", Operator: "notEquals", Value: "tcp"},
+			expect:    false,
+		},
+		{
+			name:      "in",
+			condition: RelevantIfCondition{Name: "transport", Operator: "in", Value: []any{"unix", "tcp"}},
+			expect:    true,
+		},
+		{
+			name:      "in missing",
+			condition: RelevantIfCondition{Name: "transport", Operator: "in", Value: []any{"unix", "udp"}},
+			expect:    false,
+		},
+		{
+			name:      "notIn",
+			condition: RelevantIfCondition{Name: "transport", Operator: "notIn", Value: []any{"unix", "udp"}},
+			expect:    true,
+		},
+		{
+			name:      "containsAny",
+			condition: RelevantIfCondition{Name: "paths", Operator: "containsAny", Value: []any{"/var/log/b.log", "/var/log/c.log"}},
+			expect:    true,
+		},
+		{
+			name:      "containsAny none",
+			condition: RelevantIfCondition{Name: "paths", Operator: "containsAny", Value: []any{"/var/log/c.log"}},
+			expect:    false,
+		},
+		{
+			name:      "exists",
+			condition: RelevantIfCondition{Name: "paths", Operator: "exists"},
+			expect:    true,
+		},
+		{
+			name:      "exists empty string",
+			condition: RelevantIfCondition{Name: "empty", Operator: "exists"},
+			expect:    false,
+		},
+		{
+			name:      "exists empty list",
+			condition: RelevantIfCondition{Name: "none", Operator: "exists", Value: true},
+			expect:    false,
+		},
+		{
+			name:      "exists false",
+			condition: RelevantIfCondition{Name: "missing", Operator: "exists", Value: false},
+			expect:    true,
+		},
+		{
+			name: "all",
+			condition: RelevantIfCondition{Operator: "all", Conditions: []RelevantIfCondition{
+				{Name: "enabled", Operator: "equals", Value: true},
+				{Name: "transport", Operator: "equals", Value: "unix"},
+			}},
+			expect: false,
+		},
+		{
+			name: "any",
+			condition: RelevantIfCondition{Operator: "any", Conditions: []RelevantIfCondition{
+				{Name: "enabled", Operator: "equals", Value: false},
+				{Name: "transport", Operator: "equals", Value: "tcp"},
+			}},
+			expect: true,
+		},
+		{
+			name: "nested",
+			condition: RelevantIfCondition{Operator: "all", Conditions: []RelevantIfCondition{
+				{Name: "enabled", Operator: "equals", Value: true},
+				{Operator: "any", Conditions: []RelevantIfCondition{
+					{Name: "transport", Operator: "equals", Value: "unix"},
+					{Name: "count", Operator: "in", Value: []any{1, 5}},
+				}},
+			}},
+			expect: true,
+		},
+		{
+			name:      "unknown operator",
+			condition: RelevantIfCondition{Name: "enabled", Operator: "greaterThan", Value: true},
+			expect:    false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expect, test.condition.satisfied(values))
+		})
+	}
+}
+
+func TestValidateRelevantIf(t *testing.T) {
+	spec := &ResourceTypeSpec{
+		Parameters: []ParameterDefinition{
+			{Name: "enabled", Type: "bool"},
+			{Name: "transport", Type: "enum", ValidValues: []string{"tcp", "unix"}},
+			{Name: "paths", Type: "strings"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		condition RelevantIfCondition
+		expectErr string
+	}{
+		{
+			name:      "valid in",
+			condition: RelevantIfCondition{Name: "transport", Operator: "in", Value: []any{"tcp", "unix"}},
+		},
+		{
+			name:      "valid containsAny",
+			condition: RelevantIfCondition{Name: "paths", Operator: "containsAny", Value: []any{"/var/log/a.log"}},
+		},
+		{
+			name:      "valid exists",
+			condition: RelevantIfCondition{Name: "paths", Operator: "exists"},
+		},
+		{
+			name: "valid any",
+			condition: RelevantIfCondition{Operator: "any", Conditions: []RelevantIfCondition{
+				{Name: "enabled", Operator: "notEquals", Value: false},
+				{Name: "paths", Operator: "exists", Value: true},
+			}},
+		},
+		{
+			name:      "in without list",
+			condition: RelevantIfCondition{Name: "transport", Operator: "in", Value: "tcp"},
+			expectErr: "relevantIf 'transport' for 'test' must have a list of values",
+		},
+		{
+			name:      "in with invalid value",
+			condition: RelevantIfCondition{Name: "transport", Operator: "notIn", Value: []any{"tcp", "udp"}},
+			expectErr: "relevantIf value for 'transport' must be one of [tcp unix]",
+		},
+		{
+			name:      "containsAny without array parameter",
+			condition: RelevantIfCondition{Name: "transport", Operator: "containsAny", Value: []any{"tcp"}},
+			expectErr: "the containsAny operator requires a parameter of type strings or enums",
+		},
+		{
+			name:      "exists with value",
+			condition: RelevantIfCondition{Name: "paths", Operator: "exists", Value: "yes"},
+			expectErr: "value for the exists operator must be true or false",
+		},
+		{
+			name:      "unknown operator",
+			condition: RelevantIfCondition{Name: "enabled", Operator: "greaterThan", Value: true},
+			expectErr: "unknown operator 'greaterThan'",
+		},
+		{
+			name:      "all without conditions",
+			condition: RelevantIfCondition{Operator: "all"},
+			expectErr: "relevantIf 'all' for 'test' must have conditions",
+		},
+		{
+			name: "nested invalid condition",
+			condition: RelevantIfCondition{Operator: "any", Conditions: []RelevantIfCondition{
+				{Name: "missing", Operator: "exists"},
+			}},
+			expectErr: "relevantIf for 'test' refers to nonexistant parameter 'missing'",
+		},
+		{
+			name: "conditions without all or any",
+			condition: RelevantIfCondition{Name: "enabled", Operator: "equals", Value: true, Conditions: []RelevantIfCondition{
+				{Name: "paths", Operator: "exists"},
+			}},
+			expectErr: "can only have conditions with the all or any operators",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validation.NewErrors()
+			spec.validateRelevantIf(ParameterDefinition{Name: "test"}, test.condition, errs)
+			if test.expectErr == "" {
+				require.NoError(t, errs.Result())
+			} else {
+				require.ErrorContains(t, errs.Result(), test.expectErr)
+			}
+		})
+	}
+}
+
+func TestValidateParametersRelevantIf(t *testing.T) {
+	store := newTestResourceStore()
+	mysql := testResource[*SourceType](t, "sourcetype-relevant-if.yaml")
+	require.NoError(t, mysql.Validate())
+	store.sourceTypes[mysql.Name()] = mysql
+
+	tests := []struct {
+		name       string
+		parameters []Parameter
+		expectErr  string
+	}{
+		{
+			name: "relevant required parameter specified",
+			parameters: []Parameter{
+				{Name: "endpoint", Value: "localhost:3306"},
+			},
+		},
+		{
+			name:       "relevant required parameter missing",
+			parameters: []Parameter{},
+			expectErr:  "missing required parameter endpoint for type mysql",
+		},
+		{
+			name: "irrelevant required parameter missing",
+			parameters: []Parameter{
+				{Name: "transport", Value: "none"},
+			},
+		},
+		{
+			name: "irrelevant parameter with an invalid value is ignored",
+			parameters: []Parameter{
+				{Name: "enable_metrics", Value: false},
+				{Name: "endpoint", Value: "not a host and port"},
+				{Name: "collection_interval", Value: "often"},
+			},
+		},
+		{
+			name: "relevant parameter with an invalid value",
+			parameters: []Parameter{
+				{Name: "enable_metrics", Value: false},
+				{Name: "log_paths", Value: []any{"/var/log/mysql.log"}},
+				{Name: "collection_interval", Value: "often"},
+				{Name: "start_at", Value: "middle"},
+			},
+			expectErr: "2 errors occurred",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rc := &ResourceConfiguration{Type: "mysql", Parameters: test.parameters}
+			errs := validation.NewErrors()
+			rc.validateParameters(KindSource, errs, store)
+			if test.expectErr == "" {
+				require.NoError(t, errs.Result())
+			} else {
+				require.ErrorContains(t, errs.Result(), test.expectErr)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -56,6 +57,9 @@ const (
 	KindSourceType      Kind = "SourceType"
 	KindProcessorType   Kind = "ProcessorType"
 	KindDestinationType Kind = "DestinationType"
+	KindConnector       Kind = "Connector"
+	KindConnectorType   Kind = "ConnectorType"
+	KindAgentGroup      Kind = "AgentGroup"
 	KindUnknown         Kind = "Unknown"
 )
 
@@ -74,6 +78,9 @@ func createKindLookup() map[string]Kind {
 		KindSourceType,
 		KindProcessorType,
 		KindDestinationType,
+		KindConnector,
+		KindConnectorType,
+		KindAgentGroup,
 	} {
 		key := strings.ToLower(string(kind))
 		plural := fmt.Sprintf("%ss", key)
@@ -105,6 +112,12 @@ type Resource interface {
 	// EnsureID generates a new uuid for a resource if none exists
 	EnsureID()
 
+	// ResourceVersion returns the resourceVersion for this resource
+	ResourceVersion() int64
+
+	// SetResourceVersion replaces the resourceVersion for this resource
+	SetResourceVersion(version int64)
+
 	// Name returns the name for this resource
 	Name() string
 
@@ -144,6 +157,9 @@ type Metadata struct {
 	Description string `yaml:"description,omitempty" json:"description,omitempty" mapstructure:"description"`
 	Icon        string `yaml:"icon,omitempty" json:"icon,omitempty" mapstructure:"icon"`
 	Labels      Labels `yaml:"labels,omitempty" json:"labels" mapstructure:"labels"`
+	// ResourceVersion is incremented by the store each time the resource changes. When specified on apply or delete,
+	// it must match the current resourceVersion of the stored resource.
+	ResourceVersion int64 `yaml:"resourceVersion,omitempty" json:"resourceVersion,omitempty" mapstructure:"resourceVersion"`
 }
 
 // Parameter TODO(doc)
@@ -171,6 +187,16 @@ func (r *ResourceMeta) SetID(id string) {
 	r.Metadata.ID = id
 }
 
+// ResourceVersion returns the resourceVersion
+func (r *ResourceMeta) ResourceVersion() int64 {
+	return r.Metadata.ResourceVersion
+}
+
+// SetResourceVersion replaces the resourceVersion for this resource
+func (r *ResourceMeta) SetResourceVersion(version int64) {
+	r.Metadata.ResourceVersion = version
+}
+
 // GetKind returns the Kind of this resource.
 func (r *ResourceMeta) GetKind() Kind {
 	return r.Kind
@@ -252,15 +278,21 @@ func ParseResource(r *AnyResource) (Resource, error) {
 	case KindSource:
 		return parseResource(r, &Source{})
 	case KindSourceType:
-		return parseResource(r, &SourceType{})
+		return parseResourceType(r, &SourceType{})
 	case KindProcessor:
 		return parseResource(r, &Processor{})
 	case KindProcessorType:
-		return parseResource(r, &ProcessorType{})
+		return parseResourceType(r, &ProcessorType{})
 	case KindDestination:
 		return parseResource(r, &Destination{})
 	case KindDestinationType:
-		return parseResource(r, &DestinationType{})
+		return parseResourceType(r, &DestinationType{})
+	case KindConnector:
+		return parseResource(r, &Connector{})
+	case KindConnectorType:
+		return parseResourceType(r, &ConnectorType{})
+	case KindAgentGroup:
+		return parseResource(r, &AgentGroup{})
 	}
 
 	return nil, fmt.Errorf("unknown resource kind: %s", r.Kind)
@@ -296,6 +328,39 @@ func ResourcesFromFile(filename string) ([]*AnyResource, error) {
 	return resources, file.Close()
 }
 
+// ResourcesFromDirectory returns the resources in the yaml files in the directory and its subdirectories. Files are
+// read in lexical order and files without a .yaml or .yml extension are ignored.
+func ResourcesFromDirectory(directory string) ([]*AnyResource, error) {
+	resources := []*AnyResource{}
+	err := filepath.WalkDir(directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		switch {
+		case entry.IsDir() && path != directory && strings.HasPrefix(entry.Name(), "."):
+			// skip hidden directories like .git
+			return filepath.SkipDir
+		case entry.IsDir():
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml":
+		default:
+			return nil
+		}
+		fileResources, err := ResourcesFromFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read %s: %w", path, err)
+		}
+		resources = append(resources, fileResources...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
 // ResourcesFromReader creates a yaml decoder from an io.Reader and returns a slice of *AnyResource and an error.
 // If the decoder is able to reach the end of the reader with no error, err will be nil.
 func ResourcesFromReader(reader io.Reader) ([]*AnyResource, error) {
@@ -328,6 +393,19 @@ func parseResource[T Resource](r *AnyResource, instance T) (T, error) {
 	return instance, nil
 }
 
+// parseResourceType maps the Spec of the provided resource to a resource type and adds the parameters of its
+// ParameterGroups
+func parseResourceType[T Resource](r *AnyResource, instance T) (T, error) {
+	instance, err := parseResource(r, instance)
+	if err != nil {
+		return instance, err
+	}
+	if err := ResourceTypeOf(instance).Spec.addParameterGroups(); err != nil {
+		return instance, err
+	}
+	return instance, nil
+}
+
 // ----------------------------------------------------------------------
 // Printable
 
@@ -412,6 +490,12 @@ func NewEmptyResource(kind Kind) (Resource, error) {
 		return &ProcessorType{}, nil
 	case KindDestinationType:
 		return &DestinationType{}, nil
+	case KindConnector:
+		return &Connector{}, nil
+	case KindConnectorType:
+		return &ConnectorType{}, nil
+	case KindAgentGroup:
+		return &AgentGroup{}, nil
 	default:
 		return nil, fmt.Errorf("cannot make empty resource for unexpected kind: %s", kind)
 	}
@@ -44,6 +44,10 @@ type ResourceTypeSpec struct {
 	Parameters         []ParameterDefinition `json:"parameters"  yaml:"parameters"  mapstructure:"parameters"`
 	SupportedPlatforms []string              `json:"supportedPlatforms" yaml:"supportedPlatforms" mapstructure:"supportedPlatforms"`
 
+	// ParameterGroups are the names of groups of parameters shared by resource types, e.g. retry_on_failure and
+	// sending_queue. The parameters of the groups are added to Parameters when the resource type is parsed.
+	ParameterGroups []string `json:"parameterGroups,omitempty" yaml:"parameterGroups,omitempty" mapstructure:"parameterGroups"`
+
 	// individual
 	Logs    ResourceTypeOutput `json:"logs,omitempty"    yaml:"logs,omitempty"    mapstructure:"logs"`
 	Metrics ResourceTypeOutput `json:"metrics,omitempty" yaml:"metrics,omitempty" mapstructure:"metrics"`
@@ -56,6 +60,14 @@ type ResourceTypeSpec struct {
 
 	// all three (alphabetical order)
 	LogsMetricsTraces ResourceTypeOutput `json:"logs+metrics+traces,omitempty" yaml:"logs+metrics+traces,omitempty" mapstructure:"logs+metrics+traces"`
+
+	// ConnectorOutputs are the telemetry types emitted by the connectors of a ConnectorType. The connectors rendered for
+	// a telemetry type are exporters of the pipelines of that type and receivers of the pipelines of these types, e.g.
+	// a connector rendered by traces with metrics outputs converts traces to metrics.
+	ConnectorOutputs []otel.PipelineType `json:"connectorOutputs,omitempty" yaml:"connectorOutputs,omitempty" mapstructure:"connectorOutputs"`
+
+	// Migrations describe how to update the parameters of resources using previous versions of this resource type
+	Migrations []ResourceTypeMigration `json:"migrations,omitempty" yaml:"migrations,omitempty" mapstructure:"migrations"`
 }
 
 // ResourceTypeOutput describes the output of the resource type
@@ -64,11 +76,12 @@ type ResourceTypeOutput struct {
 	Processors ResourceTypeTemplate `json:"processors,omitempty" yaml:"processors,omitempty" mapstructure:"processors"`
 	Exporters  ResourceTypeTemplate `json:"exporters,omitempty"  yaml:"exporters,omitempty"  mapstructure:"exporters"`
 	Extensions ResourceTypeTemplate `json:"extensions,omitempty" yaml:"extensions,omitempty" mapstructure:"extensions"`
+	Connectors ResourceTypeTemplate `json:"connectors,omitempty" yaml:"connectors,omitempty" mapstructure:"connectors"`
 }
 
-// Empty returns true if Receivers, Processors, Exporters, and Extensions are the zero value ""
+// Empty returns true if Receivers, Processors, Exporters, Extensions, and Connectors are the zero value ""
 func (s ResourceTypeOutput) Empty() bool {
-	return s.Receivers == "" && s.Processors == "" && s.Exporters == "" && s.Extensions == ""
+	return s.Receivers == "" && s.Processors == "" && s.Exporters == "" && s.Extensions == "" && s.Connectors == ""
 }
 
 // ResourceTypeTemplate is a go-template that evaluates to an array of OpenTelemetry resources
@@ -88,6 +101,39 @@ func (s *ResourceTypeSpec) ParameterDefinition(name string) *ParameterDefinition
 	return nil
 }
 
+// parameterValues returns the values of the parameters, starting with the default values of the parameter
+// definitions which can be overridden by the specified parameters.
+func (s *ResourceTypeSpec) parameterValues(parameters []Parameter) map[string]any {
+	values := map[string]any{}
+	// start with default parameters
+	for _, p := range s.Parameters {
+		if p.Default != nil {
+			values[p.Name] = p.Default
+		}
+	}
+	// resource can overrides the parameters
+	for _, p := range parameters {
+		values[p.Name] = p.Value
+	}
+	return values
+}
+
+// ResourceTypeOf returns the ResourceType of a SourceType, ProcessorType, DestinationType, or ConnectorType or nil if
+// the resource is not a resource type
+func ResourceTypeOf(r Resource) *ResourceType {
+	switch r := r.(type) {
+	case *SourceType:
+		return &r.ResourceType
+	case *ProcessorType:
+		return &r.ResourceType
+	case *DestinationType:
+		return &r.ResourceType
+	case *ConnectorType:
+		return &r.ResourceType
+	}
+	return nil
+}
+
 // ----------------------------------------------------------------------
 
 // eval executes all of the templates associated with this resource type, returning a partial configuration for each
@@ -122,23 +168,14 @@ func (rt *ResourceType) eval(resource parameterizedResource, errorHandler Templa
 
 // evalOutput executes the templates associated with the specified output using the specified resource and errorHandler.
 func (rt *ResourceType) evalOutput(output *ResourceTypeOutput, resource parameterizedResource, errorHandler TemplateErrorHandler) *otel.Partial {
-	params := map[string]any{}
-	// start with default parameters
-	for _, p := range rt.Spec.Parameters {
-		if p.Default != nil {
-			params[p.Name] = p.Default
-		}
-	}
-	// resource can overrides the parameters
-	for _, p := range resource.ResourceParameters() {
-		params[p.Name] = p.Value
-	}
+	params := rt.Spec.parameterValues(resource.ResourceParameters())
 	// eval all of the components
 	return &otel.Partial{
 		Receivers:  rt.evalTemplate(output.Receivers, resource, params, errorHandler),
 		Processors: rt.evalTemplate(output.Processors, resource, params, errorHandler),
 		Exporters:  rt.evalTemplate(output.Exporters, resource, params, errorHandler),
 		Extensions: rt.evalTemplate(output.Extensions, resource, params, errorHandler),
+		Connectors: rt.evalTemplate(output.Connectors, resource, params, errorHandler),
 	}
 }
 
@@ -150,14 +187,14 @@ func (rt *ResourceType) evalTemplate(r ResourceTypeTemplate, nameProvider otel.C
 	// get the template for the key
 	t, err := template.New(rt.Name()).Option("missingkey=error").Funcs(template.FuncMap(sprig.FuncMap())).Parse(string(r))
 	if err != nil {
-		errorHandler(err)
+		errorHandler(newTemplateError(err, rt.Name(), string(r)))
 		return set
 	}
 
 	// render the template
 	var writer bytes.Buffer
 	if err := t.Execute(&writer, paramValues); err != nil {
-		errorHandler(err)
+		errorHandler(newTemplateError(err, rt.Name(), string(r)))
 		return set
 	}
 
@@ -166,7 +203,7 @@ func (rt *ResourceType) evalTemplate(r ResourceTypeTemplate, nameProvider otel.C
 	// parse as yaml so that we can combine yaml fragments and render
 	var parsed []map[string]any
 	if err := yaml.Unmarshal(bytes, &parsed); err != nil {
-		errorHandler(err)
+		errorHandler(newYamlTemplateError(err, string(bytes)))
 		return set
 	}
 
@@ -199,6 +236,7 @@ func (rt *ResourceType) Validate() error {
 
 	rt.ResourceMeta.validate(errs)
 	rt.Spec.validate(errs)
+	rt.validateConnectors(errs)
 
 	return errs.Result()
 }
@@ -209,33 +247,15 @@ func (rt *ResourceType) ValidateWithStore(store ResourceStore) error {
 }
 
 func (s *ResourceTypeSpec) validate(errs validation.Errors) {
+	s.validateParameterGroups(errs)
 	s.validateParameterDefinitions(errs)
+	s.validateMigrations(errs)
 
 	// assemble default parameter values for validation
 	params := map[string]any{}
 	for _, p := range s.Parameters {
-		if p.Default != nil {
-			params[p.Name] = p.Default
-		} else {
-			// for template validation, just provide a reasonable default based on the type
-			switch p.Type {
-			case boolType:
-				params[p.Name] = false
-			case enumType:
-				params[p.Name] = "" // p.ValidValues[0] // cannot guarantee this is valid and "" is fine
-			case enumsType:
-				params[p.Name] = []string{}
-			case intType:
-				params[p.Name] = 0
-			case mapType:
-				params[p.Name] = make(map[string]string)
-			case stringType:
-				params[p.Name] = ""
-			case stringsType:
-				params[p.Name] = []string{}
-			case yamlType:
-				params[p.Name] = ""
-			}
+		if value := p.templateValidationValue(); value != nil {
+			params[p.Name] = value
 		}
 	}
 
@@ -244,6 +264,41 @@ func (s *ResourceTypeSpec) validate(errs validation.Errors) {
 	s.Traces.validateTemplates(errs, "traces", params)
 }
 
+// templateValidationValue returns the default value of the parameter or a reasonable default based on the type which
+// is used to validate templates
+func (p ParameterDefinition) templateValidationValue() any {
+	if p.Default != nil {
+		return p.Default
+	}
+	switch p.Type {
+	case boolType:
+		return false
+	case enumType:
+		return "" // p.ValidValues[0] // cannot guarantee this is valid and "" is fine
+	case enumsType:
+		return []string{}
+	case intType:
+		return 0
+	case floatType:
+		return 0.0
+	case mapType:
+		return make(map[string]string)
+	case stringType, yamlType, durationType, byteSizeType, regexType, timezoneType, filePathType, hostPortType:
+		return ""
+	case stringsType:
+		return []string{}
+	case objectType:
+		object := map[string]any{}
+		for _, property := range p.Properties {
+			object[property.Name] = property.templateValidationValue()
+		}
+		return object
+	case objectsType:
+		return []any{}
+	}
+	return nil
+}
+
 func (s *ResourceTypeSpec) validateParameterDefinitions(errs validation.Errors) {
 	for _, parameter := range s.Parameters {
 		parameter.validateDefinition(errs)
@@ -252,36 +307,97 @@ func (s *ResourceTypeSpec) validateParameterDefinitions(errs validation.Errors)
 }
 
 // validateParameterRelevantIf in ResourceTypeSpec because we need to check against other parameter names
-func (s *ResourceTypeSpec) validateParameterRelevantIf(parameter ParameterDefinition, errs validation.Errors) {
-	for _, relevantIf := range parameter.RelevantIf {
-		if relevantIf.Name == "" {
-			errs.Add(fmt.Errorf("relevantIf for '%s' must have a name", parameter.Name))
+// validateParameterValues validates the parameters against their definitions and ensures that relevant required
+// parameters are specified. The values of all parameters, which may include parameters that are not validated, are used
+// to determine relevance.
+func (s *ResourceTypeSpec) validateParameterValues(typeName string, parameters []Parameter, all []Parameter, errors validation.Errors) {
+	values := s.parameterValues(all)
+
+	// ensure parameters are valid
+	for _, parameter := range parameters {
+		if parameter.Name == "" {
 			continue
 		}
-		ref := s.ParameterDefinition(relevantIf.Name)
-		if ref == nil {
-			errs.Add(fmt.Errorf("relevantIf for '%s' refers to nonexistant parameter '%s'", parameter.Name, relevantIf.Name))
+		def := s.ParameterDefinition(parameter.Name)
+		if def == nil {
+			errors.Add(fmt.Errorf("parameter %s not defined in type %s", parameter.Name, typeName))
 			continue
 		}
-		if relevantIf.Operator == "" {
-			errs.Add(fmt.Errorf("relevantIf '%s' for '%s' must have an operator", ref.Name, parameter.Name))
-		}
-		if relevantIf.Value == nil {
-			errs.Add(fmt.Errorf("relevantIf '%s' for '%s' must have a value", ref.Name, parameter.Name))
+		if !def.relevant(values) {
+			// irrelevant parameters are not used and their values are ignored
 			continue
 		}
-		err := ref.validateValueType(parameterFieldRelevantIf, relevantIf.Value)
+		err := def.validateValue(parameter.Value)
 		if err != nil {
-			errs.Add(fmt.Errorf("relevantIf '%s' for '%s': %w", ref.Name, parameter.Name, err))
+			errors.Add(err)
+		}
+	}
+
+	// ensure relevant required parameters are specified
+	for _, def := range s.Parameters {
+		if def.Required && values[def.Name] == nil && def.relevant(values) {
+			errors.Add(fmt.Errorf("missing required parameter %s for type %s", def.Name, typeName))
 		}
 	}
 }
 
+func (s *ResourceTypeSpec) validateParameterRelevantIf(parameter ParameterDefinition, errs validation.Errors) {
+	for _, relevantIf := range parameter.RelevantIf {
+		s.validateRelevantIf(parameter, relevantIf, errs)
+	}
+}
+
 func (s ResourceTypeOutput) validateTemplates(errs validation.Errors, name string, params map[string]any) {
 	s.Receivers.validate(errs, fmt.Sprintf("%s.receivers", name), params)
 	s.Processors.validate(errs, fmt.Sprintf("%s.processors", name), params)
 	s.Exporters.validate(errs, fmt.Sprintf("%s.exporters", name), params)
 	s.Extensions.validate(errs, fmt.Sprintf("%s.extensions", name), params)
+	s.Connectors.validate(errs, fmt.Sprintf("%s.connectors", name), params)
+}
+
+// validateConnectors ensures that only a ConnectorType renders connectors and that it specifies the telemetry types
+// emitted by its connectors
+func (rt *ResourceType) validateConnectors(errs validation.Errors) {
+	s := &rt.Spec
+	if rt.Kind != KindConnectorType {
+		for i, output := range s.outputs() {
+			if output.Connectors != "" {
+				errs.Add(fmt.Errorf("%s.connectors is only supported by %s", outputNames[i], KindConnectorType))
+			}
+		}
+		if len(s.ConnectorOutputs) > 0 {
+			errs.Add(fmt.Errorf("connectorOutputs is only supported by %s", KindConnectorType))
+		}
+		return
+	}
+
+	connectors := false
+	for _, output := range s.outputs() {
+		if output.Connectors != "" {
+			connectors = true
+		}
+	}
+	if !connectors {
+		errs.Add(fmt.Errorf("%s must specify connectors for at least one telemetry type", KindConnectorType))
+	}
+	if len(s.ConnectorOutputs) == 0 {
+		errs.Add(fmt.Errorf("%s must specify connectorOutputs", KindConnectorType))
+	}
+	for _, pipelineType := range s.ConnectorOutputs {
+		switch pipelineType {
+		case otel.Logs, otel.Metrics, otel.Traces:
+		default:
+			errs.Add(fmt.Errorf("invalid connectorOutputs %s: must be one of logs, metrics, or traces", pipelineType))
+		}
+	}
+}
+
+// outputNames are the names of the outputs of a spec in the order returned by outputs
+var outputNames = []string{"logs", "metrics", "traces", "logs+metrics", "logs+traces", "metrics+traces", "logs+metrics+traces"}
+
+// outputs returns the outputs of the spec in the same order as outputNames
+func (s *ResourceTypeSpec) outputs() []ResourceTypeOutput {
+	return []ResourceTypeOutput{s.Logs, s.Metrics, s.Traces, s.LogsMetrics, s.LogsTraces, s.MetricsTraces, s.LogsMetricsTraces}
 }
 
 func (s ResourceTypeTemplate) validate(errs validation.Errors, name string, params map[string]any) {
@@ -292,12 +408,12 @@ func (s ResourceTypeTemplate) validate(errs validation.Errors, name string, para
 	// ensure the template is valid
 	t, err := template.New(name).Option("missingkey=error").Funcs(template.FuncMap(sprig.FuncMap())).Parse(string(s))
 	if err != nil {
-		errs.Add(err)
+		errs.Add(newTemplateError(err, name, string(s)))
 		return
 	}
 	// ensure that it can be executed with default values
 	if err := t.Execute(io.Discard, params); err != nil {
-		errs.Add(err)
+		errs.Add(newTemplateError(err, name, string(s)))
 	}
 }
 
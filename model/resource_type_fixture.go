@@ -0,0 +1,261 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+
+	"github.com/observiq/bindplane-op/model/otel"
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+// ResourceTypeFixtures are test cases for a ResourceType. Each test case renders the ResourceType with a set of
+// parameters and compares the configuration with a golden file containing the expected configuration.
+type ResourceTypeFixtures struct {
+	Tests []ResourceTypeFixture `json:"tests" yaml:"tests"`
+
+	// path is the path of the file containing the fixtures and golden files are relative to this file
+	path string
+}
+
+// ResourceTypeFixture is a single test case for a ResourceType
+type ResourceTypeFixture struct {
+	// Name is the name of the test case and is used as the name of the rendered resource
+	Name string `json:"name" yaml:"name"`
+	// Parameters are the parameters used to render the ResourceType
+	Parameters []Parameter `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	// Golden is the path of the file containing the expected configuration, relative to the fixtures file. If empty,
+	// <fixtures>/<name>.golden.yaml is used where <fixtures> is the name of the fixtures file without the extension.
+	Golden string `json:"golden,omitempty" yaml:"golden,omitempty"`
+	// ExpectError is a substring of the error expected when rendering. If specified, no golden file is used.
+	ExpectError string `json:"expectError,omitempty" yaml:"expectError,omitempty"`
+}
+
+// ResourceTypeFixtureResult is the result of a single ResourceTypeFixture
+type ResourceTypeFixtureResult struct {
+	// Name is the name of the test case
+	Name string
+	// Golden is the path of the golden file or empty if the test case expected an error
+	Golden string
+	// Passed will be true if the test case passed
+	Passed bool
+	// Updated will be true if the golden file was updated
+	Updated bool
+	// Diff is a unified diff from the golden file to the rendered configuration if they differ
+	Diff string
+	// Err is the error that caused the test case to fail
+	Err error
+}
+
+// ResourceTypeFromFile returns the ResourceType of the SourceType, ProcessorType, DestinationType, or ConnectorType in
+// the specified file. The file must contain exactly one resource.
+func ResourceTypeFromFile(path string) (*ResourceType, error) {
+	resources, err := ResourcesFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) != 1 {
+		return nil, fmt.Errorf("expected 1 resource type in %s, found %d resources", path, len(resources))
+	}
+
+	resource, err := ParseResource(resources[0])
+	if err != nil {
+		return nil, err
+	}
+
+	rt := ResourceTypeOf(resource)
+	if rt == nil {
+		return nil, fmt.Errorf("%s is not a resource type", resource.GetKind())
+	}
+	return rt, nil
+}
+
+// ResourceTypeFixturesFromFile reads the ResourceTypeFixtures in the specified file
+func ResourceTypeFixturesFromFile(path string) (*ResourceTypeFixtures, error) {
+	bytes, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures := &ResourceTypeFixtures{path: path}
+	if err := yaml.Unmarshal(bytes, fixtures); err != nil {
+		return nil, fmt.Errorf("unable to parse fixtures in %s: %w", path, err)
+	}
+	if err := fixtures.validate(); err != nil {
+		return nil, err
+	}
+	return fixtures, nil
+}
+
+func (f *ResourceTypeFixtures) validate() error {
+	if len(f.Tests) == 0 {
+		return fmt.Errorf("no tests found in %s", f.path)
+	}
+	names := map[string]bool{}
+	for _, test := range f.Tests {
+		if test.Name == "" {
+			return errors.New("all tests must have a name")
+		}
+		if names[test.Name] {
+			return fmt.Errorf("multiple tests named %s", test.Name)
+		}
+		names[test.Name] = true
+	}
+	return nil
+}
+
+// GoldenPath returns the path of the golden file for the specified fixture
+func (f *ResourceTypeFixtures) GoldenPath(fixture ResourceTypeFixture) string {
+	dir := filepath.Dir(f.path)
+	if fixture.Golden != "" {
+		return filepath.Join(dir, fixture.Golden)
+	}
+	base := strings.TrimSuffix(filepath.Base(f.path), filepath.Ext(f.path))
+	return filepath.Join(dir, base, fmt.Sprintf("%s.golden.yaml", fixture.Name))
+}
+
+// Run renders the ResourceType with each of the fixtures and compares the configuration with the golden files. If
+// update is true, the golden files are written with the rendered configuration instead.
+func (f *ResourceTypeFixtures) Run(rt *ResourceType, update bool) []*ResourceTypeFixtureResult {
+	results := make([]*ResourceTypeFixtureResult, len(f.Tests))
+	for i, fixture := range f.Tests {
+		results[i] = f.run(rt, fixture, update)
+	}
+	return results
+}
+
+func (f *ResourceTypeFixtures) run(rt *ResourceType, fixture ResourceTypeFixture, update bool) *ResourceTypeFixtureResult {
+	result := &ResourceTypeFixtureResult{Name: fixture.Name}
+	rendered, err := rt.RenderParameters(fixture.Name, fixture.Parameters)
+
+	if fixture.ExpectError != "" {
+		switch {
+		case err == nil:
+			result.Err = fmt.Errorf("expected error containing %q", fixture.ExpectError)
+		case !strings.Contains(err.Error(), fixture.ExpectError):
+			result.Err = fmt.Errorf("expected error containing %q: %w", fixture.ExpectError, err)
+		default:
+			result.Passed = true
+		}
+		return result
+	}
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	result.Golden = f.GoldenPath(fixture)
+	if update {
+		if err := writeGolden(result.Golden, rendered); err != nil {
+			result.Err = err
+			return result
+		}
+		result.Updated = true
+		result.Passed = true
+		return result
+	}
+
+	expected, err := os.ReadFile(filepath.Clean(result.Golden))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		result.Err = fmt.Errorf("missing golden file %s, update the golden files to create it", result.Golden)
+		return result
+	case err != nil:
+		result.Err = err
+		return result
+	}
+
+	if string(expected) == rendered {
+		result.Passed = true
+		return result
+	}
+	result.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(expected)),
+		B:        difflib.SplitLines(rendered),
+		FromFile: result.Golden,
+		ToFile:   "rendered",
+		Context:  3,
+	})
+	if err != nil {
+		result.Diff = fmt.Sprintf("unable to compute diff: %v", err)
+	}
+	return result
+}
+
+func writeGolden(path string, rendered string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(rendered), 0600)
+}
+
+// RenderParameters renders the ResourceType for a resource with the specified name and parameters. The parameters are
+// validated and the components for each type of telemetry are rendered as a pipeline, even if the pipeline is
+// incomplete. Template errors include the lines surrounding the error.
+func (rt *ResourceType) RenderParameters(name string, parameters []Parameter) (string, error) {
+	errs := validation.NewErrors()
+	rt.Spec.validateParameterValues(rt.Name(), parameters, parameters, errs)
+	if err := errs.Result(); err != nil {
+		return "", err
+	}
+
+	var err error
+	partials := rt.eval(&fixtureResource{name: name, typeName: rt.Name(), parameters: parameters}, func(e error) {
+		err = multierror.Append(err, TemplateErrorDetails(e))
+	})
+	if err != nil {
+		return "", err
+	}
+
+	configuration := otel.NewConfiguration()
+	for _, pipelineType := range []otel.PipelineType{otel.Logs, otel.Metrics, otel.Traces} {
+		configuration.AddPartial(name, pipelineType, partials[pipelineType])
+	}
+	bytes, err := yaml.Marshal(configuration)
+	return string(bytes), err
+}
+
+// fixtureResource is the parameterizedResource rendered for a ResourceTypeFixture
+type fixtureResource struct {
+	name       string
+	typeName   string
+	parameters []Parameter
+}
+
+var _ parameterizedResource = (*fixtureResource)(nil)
+
+func (r *fixtureResource) ComponentID(name string) otel.ComponentID {
+	return otel.UniqueComponentID(name, r.typeName, r.name)
+}
+
+func (r *fixtureResource) Name() string {
+	return r.name
+}
+
+func (r *fixtureResource) ResourceTypeName() string {
+	return r.typeName
+}
+
+func (r *fixtureResource) ResourceParameters() []Parameter {
+	return r.parameters
+}
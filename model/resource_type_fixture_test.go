@@ -0,0 +1,228 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testFixtureSourceType(receivers string) *SourceType {
+	return NewSourceTypeWithSpec("fixture", ResourceTypeSpec{
+		Parameters: []ParameterDefinition{
+			{Name: "endpoint", Type: "string", Required: true},
+			{Name: "interval", Type: "int", Default: 60},
+		},
+		Metrics: ResourceTypeOutput{
+			Receivers: ResourceTypeTemplate(receivers),
+		},
+	})
+}
+
+const testFixtureReceivers = `- example:
+    endpoint: {{ .endpoint }}
+    collection_interval: {{ .interval }}s
+`
+
+func TestRenderParameters(t *testing.T) {
+	tests := []struct {
+		name       string
+		receivers  string
+		parameters []Parameter
+		expect     string
+		expectErr  []string
+	}{
+		{
+			name:       "renders pipeline",
+			receivers:  testFixtureReceivers,
+			parameters: []Parameter{{Name: "endpoint", Value: "localhost:1234"}},
+			expect: strings.TrimLeft(`
+receivers:
+    example/fixture__test:
+        collection_interval: 60s
+        endpoint: localhost:1234
+service:
+    pipelines:
+        metrics/test:
+            receivers:
+                - example/fixture__test
+            processors: []
+            exporters: []
+`, "\n"),
+		},
+		{
+			name:      "invalid parameters",
+			receivers: testFixtureReceivers,
+			expectErr: []string{"missing required parameter endpoint for type fixture"},
+		},
+		{
+			name:       "parse error",
+			receivers:  "- example:\n    endpoint: {{ .endpoint }\n",
+			parameters: []Parameter{{Name: "endpoint", Value: "localhost:1234"}},
+			expectErr: []string{
+				"template: fixture:2: unexpected \"}\" in operand",
+				">    2 |     endpoint: {{ .endpoint }",
+			},
+		},
+		{
+			name:       "execute error",
+			receivers:  "- example:\n    endpoint: {{ .endpoint }}\n    missing: {{ .missing }}\n",
+			parameters: []Parameter{{Name: "endpoint", Value: "localhost:1234"}},
+			expectErr: []string{
+				"map has no entry for key \"missing\"",
+				"     2 |     endpoint: {{ .endpoint }}",
+				">    3 |     missing: {{ .missing }}",
+			},
+		},
+		{
+			name:       "yaml error",
+			receivers:  "- example:\n    endpoint: {{ .endpoint }}\n  - other\n",
+			parameters: []Parameter{{Name: "endpoint", Value: "localhost:1234"}},
+			expectErr: []string{
+				"yaml: line 2: did not find expected key",
+				">    2 |     endpoint: localhost:1234",
+				"     3 |   - other",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			st := testFixtureSourceType(test.receivers)
+			rendered, err := st.RenderParameters("test", test.parameters)
+			if len(test.expectErr) > 0 {
+				require.Error(t, err)
+				for _, expect := range test.expectErr {
+					require.Contains(t, err.Error(), expect)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expect, rendered)
+		})
+	}
+}
+
+func TestResourceTypeFixturesFromFile(t *testing.T) {
+	tests := []struct {
+		name      string
+		contents  string
+		expectErr string
+	}{
+		{
+			name:     "valid",
+			contents: "tests:\n  - name: one\n  - name: two\n",
+		},
+		{
+			name:      "no tests",
+			contents:  "tests: []\n",
+			expectErr: "no tests found in",
+		},
+		{
+			name:      "missing name",
+			contents:  "tests:\n  - parameters: []\n",
+			expectErr: "all tests must have a name",
+		},
+		{
+			name:      "duplicate name",
+			contents:  "tests:\n  - name: one\n  - name: one\n",
+			expectErr: "multiple tests named one",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "fixtures.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(test.contents), 0600))
+
+			_, err := ResourceTypeFixturesFromFile(path)
+			if test.expectErr != "" {
+				require.ErrorContains(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestResourceTypeFixturesRun(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "fixture.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+tests:
+  - name: default
+    parameters:
+      - name: endpoint
+        value: localhost:1234
+  - name: custom-golden
+    golden: golden/custom.yaml
+    parameters:
+      - name: endpoint
+        value: localhost:5678
+  - name: missing-endpoint
+    expectError: missing required parameter endpoint
+`), 0600))
+
+	st := testFixtureSourceType(testFixtureReceivers)
+	fixtures, err := ResourceTypeFixturesFromFile(path)
+	require.NoError(t, err)
+
+	defaultGolden := filepath.Join(dir, "fixture", "default.golden.yaml")
+	customGolden := filepath.Join(dir, "golden", "custom.yaml")
+	require.Equal(t, defaultGolden, fixtures.GoldenPath(fixtures.Tests[0]))
+	require.Equal(t, customGolden, fixtures.GoldenPath(fixtures.Tests[1]))
+
+	// missing golden files
+	results := fixtures.Run(&st.ResourceType, false)
+	require.Len(t, results, 3)
+	require.False(t, results[0].Passed)
+	require.ErrorContains(t, results[0].Err, "missing golden file")
+	require.True(t, results[2].Passed)
+
+	// update writes the golden files
+	results = fixtures.Run(&st.ResourceType, true)
+	for _, result := range results[:2] {
+		require.NoError(t, result.Err)
+		require.True(t, result.Updated)
+		require.FileExists(t, result.Golden)
+	}
+
+	results = fixtures.Run(&st.ResourceType, false)
+	for _, result := range results {
+		require.NoError(t, result.Err)
+		require.True(t, result.Passed)
+	}
+
+	// changes to the template are reported as a diff
+	st = testFixtureSourceType(strings.Replace(testFixtureReceivers, "collection_interval", "interval", 1))
+	results = fixtures.Run(&st.ResourceType, false)
+	require.False(t, results[0].Passed)
+	require.NoError(t, results[0].Err)
+	require.Contains(t, results[0].Diff, "-        collection_interval: 60s")
+	require.Contains(t, results[0].Diff, "+        interval: 60s")
+}
+
+func TestResourceTypeFromFile(t *testing.T) {
+	rt, err := ResourceTypeFromFile("testfiles/sourcetype-macos.yaml")
+	require.NoError(t, err)
+	require.Equal(t, KindSourceType, rt.Kind)
+
+	_, err = ResourceTypeFromFile("testfiles/source-macos.yaml")
+	require.EqualError(t, err, "Source is not a resource type")
+}
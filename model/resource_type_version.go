@@ -0,0 +1,237 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+// ResourceTypeMigration describes how to update the parameters of a resource using one version of a resource type so
+// that it can use a later version. The changes are applied in order: parameters are renamed, dropped, and then
+// defaults are added for parameters that are not set.
+type ResourceTypeMigration struct {
+	From    string            `json:"from" yaml:"from" mapstructure:"from"`
+	To      string            `json:"to" yaml:"to" mapstructure:"to"`
+	Rename  []ParameterRename `json:"rename,omitempty" yaml:"rename,omitempty" mapstructure:"rename"`
+	Drop    []string          `json:"drop,omitempty" yaml:"drop,omitempty" mapstructure:"drop"`
+	Default []Parameter       `json:"default,omitempty" yaml:"default,omitempty" mapstructure:"default"`
+}
+
+// ParameterRename renames a parameter as part of a ResourceTypeMigration
+type ParameterRename struct {
+	From string `json:"from" yaml:"from" mapstructure:"from"`
+	To   string `json:"to" yaml:"to" mapstructure:"to"`
+}
+
+// CompareVersions compares two resource type versions, returning -1 if a < b, 0 if a == b, and 1 if a > b. Versions
+// are compared as semantic versions if possible and as strings otherwise.
+func CompareVersions(a, b string) int {
+	va, errA := semver.NewVersion(a)
+	vb, errB := semver.NewVersion(b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	return va.Compare(vb)
+}
+
+// migration returns the migration from the specified version or nil if there is no such migration
+func (s *ResourceTypeSpec) migration(from string) *ResourceTypeMigration {
+	for i, m := range s.Migrations {
+		if CompareVersions(m.From, from) == 0 {
+			return &s.Migrations[i]
+		}
+	}
+	return nil
+}
+
+// MigrateParameters applies the migrations necessary to update parameters of a resource using the specified version
+// of this resource type to the current version. The specified parameters are not modified.
+func (s *ResourceTypeSpec) MigrateParameters(from string, parameters []Parameter) ([]Parameter, error) {
+	return s.migrateParameters(from, parameters, true)
+}
+
+// MigrateParameterOverrides is like MigrateParameters but does not add default values. It is used for parameters of a
+// Configuration that override the parameters of a named resource where a default would replace the value of the named
+// resource.
+func (s *ResourceTypeSpec) MigrateParameterOverrides(from string, parameters []Parameter) ([]Parameter, error) {
+	return s.migrateParameters(from, parameters, false)
+}
+
+func (s *ResourceTypeSpec) migrateParameters(from string, parameters []Parameter, defaults bool) ([]Parameter, error) {
+	result := append([]Parameter{}, parameters...)
+	version := from
+	for steps := 0; CompareVersions(version, s.Version) != 0; steps++ {
+		m := s.migration(version)
+		if m == nil || steps >= len(s.Migrations) {
+			return nil, fmt.Errorf("no migration from version %s to %s", version, s.Version)
+		}
+		result = m.apply(result, defaults)
+		version = m.To
+	}
+	return result, nil
+}
+
+// apply returns the parameters with the changes of the migration applied
+func (m *ResourceTypeMigration) apply(parameters []Parameter, defaults bool) []Parameter {
+	renames := map[string]string{}
+	for _, r := range m.Rename {
+		renames[r.From] = r.To
+	}
+	drops := map[string]bool{}
+	for _, name := range m.Drop {
+		drops[name] = true
+	}
+
+	result := make([]Parameter, 0, len(parameters)+len(m.Default))
+	names := map[string]bool{}
+	for _, p := range parameters {
+		if to, ok := renames[p.Name]; ok {
+			p.Name = to
+		}
+		if drops[p.Name] {
+			continue
+		}
+		result = append(result, p)
+		names[p.Name] = true
+	}
+	for _, p := range m.Default {
+		if defaults && !names[p.Name] {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func (s *ResourceTypeSpec) validateMigrations(errs validation.Errors) {
+	if len(s.Migrations) > 0 && s.Version == "" {
+		errs.Add(fmt.Errorf("migrations require a version"))
+		return
+	}
+	from := map[string]bool{}
+	for _, m := range s.Migrations {
+		if m.From == "" || m.To == "" {
+			errs.Add(fmt.Errorf("all migrations must specify from and to versions"))
+			continue
+		}
+		if CompareVersions(m.From, m.To) >= 0 {
+			errs.Add(fmt.Errorf("migration from version %s must be to a later version, not %s", m.From, m.To))
+		}
+		if CompareVersions(m.To, s.Version) > 0 {
+			errs.Add(fmt.Errorf("migration to version %s is later than the current version %s", m.To, s.Version))
+		}
+		if from[m.From] {
+			errs.Add(fmt.Errorf("multiple migrations from version %s", m.From))
+		}
+		from[m.From] = true
+
+		for _, r := range m.Rename {
+			if r.From == "" || r.To == "" {
+				errs.Add(fmt.Errorf("migration from version %s has a rename without from and to parameter names", m.From))
+			}
+		}
+		for _, name := range m.Drop {
+			if name == "" {
+				errs.Add(fmt.Errorf("migration from version %s drops a parameter without a name", m.From))
+			}
+		}
+		for _, p := range m.Default {
+			if p.Name == "" {
+				errs.Add(fmt.Errorf("migration from version %s has a default parameter without a name", m.From))
+			}
+		}
+	}
+}
+
+// ----------------------------------------------------------------------
+// pinned versions
+
+// resolveTypeVersion returns the version of the resource type pinned by the spec. Resources that are not pinned or are
+// pinned to the current version use the current resource type. Previous versions are retrieved from the store.
+func (s *ParameterizedSpec) resolveTypeVersion(kind Kind, current *ResourceType, store ResourceStore) (*ResourceType, error) {
+	if s.TypeVersion == "" || CompareVersions(s.TypeVersion, current.Spec.Version) == 0 {
+		return current, nil
+	}
+	pinned, err := store.ResourceTypeVersion(kind, current.Name(), s.TypeVersion)
+	if err == nil && pinned == nil {
+		err = fmt.Errorf("unknown version %s of %s: %s", s.TypeVersion, kind, current.Name())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return pinned, nil
+}
+
+// ----------------------------------------------------------------------
+// outdated resources
+
+// OutdatedResource is a resource that is pinned to a version of its resource type that is earlier than the current
+// version
+type OutdatedResource struct {
+	Kind Kind   `json:"kind" yaml:"kind"`
+	Name string `json:"name" yaml:"name"`
+	// Path identifies the source, processor, or destination within the resource that is pinned, e.g.
+	// sources[0].processors[1]. It is empty if the resource itself is pinned.
+	Path           string `json:"path,omitempty" yaml:"path,omitempty"`
+	ResourceType   string `json:"resourceType" yaml:"resourceType"`
+	TypeVersion    string `json:"typeVersion" yaml:"typeVersion"`
+	CurrentVersion string `json:"currentVersion" yaml:"currentVersion"`
+	// Migratable is true if the resource type has migrations from the pinned version to the current version
+	Migratable bool `json:"migratable" yaml:"migratable"`
+}
+
+// PrintableKindSingular returns the singular form of the Kind, e.g. "OutdatedResource"
+func (r *OutdatedResource) PrintableKindSingular() string {
+	return "OutdatedResource"
+}
+
+// PrintableKindPlural returns the plural form of the Kind, e.g. "OutdatedResources"
+func (r *OutdatedResource) PrintableKindPlural() string {
+	return "OutdatedResources"
+}
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (r *OutdatedResource) PrintableFieldTitles() []string {
+	return []string{"Kind", "Name", "Path", "Type", "Version", "Current", "Migratable"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (r *OutdatedResource) PrintableFieldValue(title string) string {
+	switch title {
+	case "Kind":
+		return string(r.Kind)
+	case "Name":
+		return r.Name
+	case "Path":
+		if r.Path == "" {
+			return "-"
+		}
+		return r.Path
+	case "Type":
+		return r.ResourceType
+	case "Version":
+		return r.TypeVersion
+	case "Current":
+		return r.CurrentVersion
+	case "Migratable":
+		return strconv.FormatBool(r.Migratable)
+	default:
+		return "-"
+	}
+}
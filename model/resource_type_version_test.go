@@ -0,0 +1,203 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		expect int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.0", "1.0.0", 0},
+		{"1.2.0", "1.10.0", -1},
+		{"2.0.0", "1.10.0", 1},
+		{"v1.0.0", "1.0.0", 0},
+		{"beta", "alpha", 1},
+		{"", "1.0.0", -1},
+	}
+	for _, test := range tests {
+		t.Run(test.a+" "+test.b, func(t *testing.T) {
+			require.Equal(t, test.expect, CompareVersions(test.a, test.b))
+		})
+	}
+}
+
+func TestMigrateParameters(t *testing.T) {
+	sourceType := testResource[*SourceType](t, "sourcetype-versioned.yaml")
+	require.NoError(t, sourceType.Validate())
+
+	tests := []struct {
+		name        string
+		from        string
+		parameters  []Parameter
+		expect      []Parameter
+		expectError string
+	}{
+		{
+			name:       "current version",
+			from:       "2.0.0",
+			parameters: []Parameter{{Name: "endpoint", Value: "localhost"}},
+			expect:     []Parameter{{Name: "endpoint", Value: "localhost"}},
+		},
+		{
+			name:       "one migration",
+			from:       "1.1.0",
+			parameters: []Parameter{{Name: "endpoint", Value: "localhost"}, {Name: "legacy", Value: true}},
+			expect:     []Parameter{{Name: "endpoint", Value: "localhost"}, {Name: "collection_interval", Value: 30}},
+		},
+		{
+			name:       "default does not replace value",
+			from:       "1.1.0",
+			parameters: []Parameter{{Name: "endpoint", Value: "localhost"}, {Name: "collection_interval", Value: 10}},
+			expect:     []Parameter{{Name: "endpoint", Value: "localhost"}, {Name: "collection_interval", Value: 10}},
+		},
+		{
+			name:       "multiple migrations",
+			from:       "1.0.0",
+			parameters: []Parameter{{Name: "host", Value: "localhost"}},
+			expect:     []Parameter{{Name: "endpoint", Value: "localhost"}, {Name: "collection_interval", Value: 30}},
+		},
+		{
+			name:        "no migration",
+			from:        "0.9.0",
+			parameters:  []Parameter{{Name: "host", Value: "localhost"}},
+			expectError: "no migration from version 0.9.0 to 2.0.0",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			original := append([]Parameter{}, test.parameters...)
+			result, err := sourceType.Spec.MigrateParameters(test.from, test.parameters)
+			if test.expectError != "" {
+				require.EqualError(t, err, test.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expect, result)
+			require.Equal(t, original, test.parameters, "parameters should not be modified")
+		})
+	}
+}
+
+func TestValidateMigrations(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        ResourceTypeSpec
+		expectError string
+	}{
+		{
+			name: "valid",
+			spec: ResourceTypeSpec{Version: "2.0.0", Migrations: []ResourceTypeMigration{
+				{From: "1.0.0", To: "2.0.0", Rename: []ParameterRename{{From: "a", To: "b"}}, Drop: []string{"c"}},
+			}},
+		},
+		{
+			name:        "missing version",
+			spec:        ResourceTypeSpec{Migrations: []ResourceTypeMigration{{From: "1.0.0", To: "2.0.0"}}},
+			expectError: "1 error occurred:\n\t* migrations require a version\n\n",
+		},
+		{
+			name:        "missing from",
+			spec:        ResourceTypeSpec{Version: "2.0.0", Migrations: []ResourceTypeMigration{{To: "2.0.0"}}},
+			expectError: "1 error occurred:\n\t* all migrations must specify from and to versions\n\n",
+		},
+		{
+			name:        "backwards",
+			spec:        ResourceTypeSpec{Version: "2.0.0", Migrations: []ResourceTypeMigration{{From: "2.0.0", To: "1.0.0"}}},
+			expectError: "1 error occurred:\n\t* migration from version 2.0.0 must be to a later version, not 1.0.0\n\n",
+		},
+		{
+			name:        "later than current",
+			spec:        ResourceTypeSpec{Version: "2.0.0", Migrations: []ResourceTypeMigration{{From: "2.0.0", To: "3.0.0"}}},
+			expectError: "1 error occurred:\n\t* migration to version 3.0.0 is later than the current version 2.0.0\n\n",
+		},
+		{
+			name: "duplicate from",
+			spec: ResourceTypeSpec{Version: "2.0.0", Migrations: []ResourceTypeMigration{
+				{From: "1.0.0", To: "2.0.0"},
+				{From: "1.0.0", To: "1.1.0"},
+			}},
+			expectError: "1 error occurred:\n\t* multiple migrations from version 1.0.0\n\n",
+		},
+		{
+			name: "invalid changes",
+			spec: ResourceTypeSpec{Version: "2.0.0", Migrations: []ResourceTypeMigration{
+				{From: "1.0.0", To: "2.0.0", Rename: []ParameterRename{{From: "a"}}, Drop: []string{""}, Default: []Parameter{{Value: 1}}},
+			}},
+			expectError: "3 errors occurred:\n\t* migration from version 1.0.0 has a rename without from and to parameter names\n\t* migration from version 1.0.0 drops a parameter without a name\n\t* migration from version 1.0.0 has a default parameter without a name\n\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			errs := validation.NewErrors()
+			test.spec.validateMigrations(errs)
+			err := errs.Result()
+			if test.expectError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, test.expectError)
+		})
+	}
+}
+
+func TestFindSourceAndTypePinnedVersion(t *testing.T) {
+	store := newTestResourceStore()
+
+	sourceType := testResource[*SourceType](t, "sourcetype-versioned.yaml")
+	store.sourceTypes[sourceType.Name()] = sourceType
+
+	previous := testResource[*SourceType](t, "sourcetype-versioned.yaml")
+	previous.Spec.Version = "1.0.0"
+	previous.Spec.Migrations = nil
+	previous.Spec.Parameters = []ParameterDefinition{{Name: "host", Type: "string", Required: true}}
+	store.resourceTypeVersions["SourceType|versioned|1.0.0"] = &previous.ResourceType
+
+	t.Run("unpinned", func(t *testing.T) {
+		_, srcType, err := findSourceAndType(&ResourceConfiguration{Type: "versioned"}, "source0", store)
+		require.NoError(t, err)
+		require.Equal(t, "2.0.0", srcType.Spec.Version)
+	})
+	t.Run("pinned to current", func(t *testing.T) {
+		_, srcType, err := findSourceAndType(&ResourceConfiguration{Type: "versioned", TypeVersion: "2.0.0"}, "source0", store)
+		require.NoError(t, err)
+		require.Equal(t, sourceType, srcType)
+	})
+	t.Run("pinned to previous", func(t *testing.T) {
+		src, srcType, err := findSourceAndType(&ResourceConfiguration{Type: "versioned", TypeVersion: "1.0.0"}, "source0", store)
+		require.NoError(t, err)
+		require.Equal(t, "1.0.0", srcType.Spec.Version)
+		require.Equal(t, "1.0.0", src.Spec.TypeVersion)
+		require.NotNil(t, srcType.Spec.ParameterDefinition("host"))
+	})
+	t.Run("pinned to unknown", func(t *testing.T) {
+		_, _, err := findSourceAndType(&ResourceConfiguration{Type: "versioned", TypeVersion: "1.5.0"}, "source0", store)
+		require.EqualError(t, err, "unknown version 1.5.0 of SourceType: versioned")
+	})
+	t.Run("validates against pinned version", func(t *testing.T) {
+		errs := validation.NewErrors()
+		rc := &ResourceConfiguration{Type: "versioned", TypeVersion: "1.0.0", Parameters: []Parameter{{Name: "host", Value: "localhost"}}}
+		rc.validate(KindSource, errs, store)
+		require.NoError(t, errs.Result())
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package resourcetypetest provides a helper to test the templates of a ResourceType using fixtures and golden files.
+package resourcetypetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+// Run renders the ResourceType in resourceTypePath with each of the fixtures in fixturesPath as a subtest and compares
+// the configuration with the golden files. If update is true, the golden files are written with the rendered
+// configuration instead.
+func Run(t *testing.T, resourceTypePath, fixturesPath string, update bool) {
+	t.Helper()
+
+	rt, err := model.ResourceTypeFromFile(resourceTypePath)
+	require.NoError(t, err)
+	require.NoError(t, rt.Validate())
+
+	fixtures, err := model.ResourceTypeFixturesFromFile(fixturesPath)
+	require.NoError(t, err)
+
+	for _, result := range fixtures.Run(rt, update) {
+		result := result
+		t.Run(result.Name, func(t *testing.T) {
+			require.NoError(t, result.Err)
+			require.True(t, result.Passed, "rendered configuration does not match %s:\n%s", result.Golden, result.Diff)
+		})
+	}
+}
@@ -22,6 +22,46 @@ type AgentResponse struct {
 // AgentsResponse is the REST API response to GET /v1/agents endpoint.
 type AgentsResponse struct {
 	Agents []*Agent `json:"agents"`
+	// NextCursor is the cursor for the next page of results when a limit is specified and there are more results
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// WatchEventType is the type of change streamed by GET /v1/agents?watch=true and GET /v1/configurations?watch=true
+type WatchEventType string
+
+const (
+	// WatchEventInsert indicates that the resource was created or is part of the initial list of resources
+	WatchEventInsert WatchEventType = "insert"
+
+	// WatchEventUpdate indicates that the resource was modified
+	WatchEventUpdate WatchEventType = "update"
+
+	// WatchEventRemove indicates that the resource was deleted or no longer matches the selector or query of the watch
+	WatchEventRemove WatchEventType = "remove"
+)
+
+// Event is a change to an agent or resource streamed by GET /v1/events. IDs are of the form <epoch>-<sequence> where the
+// sequence increases with each event and the epoch changes each time the server starts. The ID of the last event
+// received can be used to resume the stream.
+type Event struct {
+	ID   string         `json:"id"`
+	Type WatchEventType `json:"type"`
+	Kind Kind           `json:"kind"`
+	// Name is the name of the resource or the ID of the agent
+	Name     string `json:"name"`
+	Resource any    `json:"resource"`
+}
+
+// AgentWatchEvent is a change to an agent streamed by GET /v1/agents?watch=true
+type AgentWatchEvent struct {
+	Type  WatchEventType `json:"type"`
+	Agent *Agent         `json:"agent"`
+}
+
+// AgentWatchResponse is the data of each server-sent event streamed by GET /v1/agents?watch=true. The first event
+// contains the current agents as inserts.
+type AgentWatchResponse struct {
+	Events []*AgentWatchEvent `json:"events"`
 }
 
 // DeleteAgentsPayload is the REST API body to DELETE /v1/agents
@@ -46,6 +86,7 @@ type AgentLabelsPayload struct {
 // BulkAgentLabelsPayload is the REST API body for PATCH /v1/agents/labels
 type BulkAgentLabelsPayload struct {
 	IDs       []string          `json:"ids"`
+	Group     string            `json:"group,omitempty"`
 	Labels    map[string]string `json:"labels"`
 	Overwrite bool              `json:"overwrite"`
 }
@@ -55,9 +96,62 @@ type BulkAgentLabelsResponse struct {
 	Errors []string `json:"errors"`
 }
 
+// AgentCommandPayload is the REST API body for POST /v1/agents/commands. The command is sent to the agents with the
+// specified IDs, the agents matching the selector, and the members of the agent group.
+type AgentCommandPayload struct {
+	Type     string   `json:"type"`
+	IDs      []string `json:"ids"`
+	Selector string   `json:"selector"`
+	Group    string   `json:"group,omitempty"`
+}
+
+// AgentCommandsResponse is the REST API response to POST /v1/agents/commands and GET /v1/agents/{id}/commands
+type AgentCommandsResponse struct {
+	Commands []*AgentCommand `json:"commands"`
+	Errors   []string        `json:"errors,omitempty"`
+}
+
+// AgentCommandResponse is the REST API response to PUT /v1/agents/{id}/restart and POST /v1/agents/{id}/diagnostics
+type AgentCommandResponse struct {
+	Command *AgentCommand `json:"command"`
+}
+
+// DiagnosticsBundlesResponse is the REST API response to GET /v1/agents/{id}/diagnostics
+type DiagnosticsBundlesResponse struct {
+	Bundles []*DiagnosticsBundle `json:"bundles"`
+}
+
+// AgentDriftResponse is the REST API response to GET /v1/agents/drift
+type AgentDriftResponse struct {
+	Agents []*AgentDriftReport `json:"agents"`
+}
+
+// AgentPendingChangesResponse is the REST API response to GET /v1/agents/pending
+type AgentPendingChangesResponse struct {
+	Changes []*AgentPendingChange `json:"changes"`
+}
+
+// AgentPendingChangeResponse is the REST API response to POST /v1/agents/{id}/pending/apply
+type AgentPendingChangeResponse struct {
+	Change *AgentPendingChange `json:"change"`
+}
+
 // ConfigurationsResponse is the REST API response to GET /v1/configurations
 type ConfigurationsResponse struct {
 	Configurations []*Configuration `json:"configurations"`
+	NextCursor     string           `json:"nextCursor,omitempty"`
+}
+
+// ConfigurationWatchEvent is a change to a configuration streamed by GET /v1/configurations?watch=true
+type ConfigurationWatchEvent struct {
+	Type          WatchEventType `json:"type"`
+	Configuration *Configuration `json:"configuration"`
+}
+
+// ConfigurationWatchResponse is the data of each server-sent event streamed by GET /v1/configurations?watch=true. The
+// first event contains the current configurations as inserts.
+type ConfigurationWatchResponse struct {
+	Events []*ConfigurationWatchEvent `json:"events"`
 }
 
 // ConfigurationResponse is the REST API response to GET /v1/configuration/:name
@@ -68,7 +162,8 @@ type ConfigurationResponse struct {
 
 // SourcesResponse is the REST API response to GET /v1/sources
 type SourcesResponse struct {
-	Sources []*Source `json:"sources"`
+	Sources    []*Source `json:"sources"`
+	NextCursor string    `json:"nextCursor,omitempty"`
 }
 
 // SourceResponse is the REST API response to GET /v1/sources/:name
@@ -79,6 +174,7 @@ type SourceResponse struct {
 // SourceTypesResponse is the REST API response to GET /v1/sourceTypes
 type SourceTypesResponse struct {
 	SourceTypes []*SourceType `json:"sourceTypes"`
+	NextCursor  string        `json:"nextCursor,omitempty"`
 }
 
 // SourceTypeResponse is the REST API response to GET /v1/sourceType/:name
@@ -89,6 +185,7 @@ type SourceTypeResponse struct {
 // ProcessorsResponse is the REST API response to GET /v1/processors
 type ProcessorsResponse struct {
 	Processors []*Processor `json:"processors"`
+	NextCursor string       `json:"nextCursor,omitempty"`
 }
 
 // ProcessorResponse is the REST API response to GET /v1/processors/:name
@@ -99,6 +196,7 @@ type ProcessorResponse struct {
 // ProcessorTypesResponse is the REST API response to GET /v1/processorTypes
 type ProcessorTypesResponse struct {
 	ProcessorTypes []*ProcessorType `json:"processorTypes"`
+	NextCursor     string           `json:"nextCursor,omitempty"`
 }
 
 // ProcessorTypeResponse is the REST API response to GET /v1/processorType/:name
@@ -109,6 +207,7 @@ type ProcessorTypeResponse struct {
 // DestinationsResponse is the REST API response to GET /v1/destinations
 type DestinationsResponse struct {
 	Destinations []*Destination `json:"destinations"`
+	NextCursor   string         `json:"nextCursor,omitempty"`
 }
 
 // DestinationResponse is the REST API response to GET /v1/destinations/:name
@@ -119,6 +218,7 @@ type DestinationResponse struct {
 // DestinationTypesResponse is the REST API response to GET /v1/destinationTypes
 type DestinationTypesResponse struct {
 	DestinationTypes []*DestinationType `json:"destinationTypes"`
+	NextCursor       string             `json:"nextCursor,omitempty"`
 }
 
 // DestinationTypeResponse is the REST API response to GET /v1/destinationType/:name
@@ -126,6 +226,104 @@ type DestinationTypeResponse struct {
 	DestinationType *DestinationType `json:"destinationType"`
 }
 
+// ConnectorsResponse is the REST API response to GET /v1/connectors
+type ConnectorsResponse struct {
+	Connectors []*Connector `json:"connectors"`
+	NextCursor string       `json:"nextCursor,omitempty"`
+}
+
+// ConnectorResponse is the REST API response to GET /v1/connectors/:name
+type ConnectorResponse struct {
+	Connector *Connector `json:"connector"`
+}
+
+// ConnectorTypesResponse is the REST API response to GET /v1/connector-types
+type ConnectorTypesResponse struct {
+	ConnectorTypes []*ConnectorType `json:"connectorTypes"`
+	NextCursor     string           `json:"nextCursor,omitempty"`
+}
+
+// ConnectorTypeResponse is the REST API response to GET /v1/connector-types/:name
+type ConnectorTypeResponse struct {
+	ConnectorType *ConnectorType `json:"connectorType"`
+}
+
+// ResourceTypeVersionsResponse is the REST API response to GET /v1/source-types/:name/versions and the equivalent
+// routes for processor types and destination types
+type ResourceTypeVersionsResponse struct {
+	ResourceTypes []*ResourceType `json:"resourceTypes"`
+}
+
+// OutdatedResourcesResponse is the REST API response to GET /v1/resource-types/outdated
+type OutdatedResourcesResponse struct {
+	Resources []*OutdatedResource `json:"resources"`
+}
+
+// ResourceDependentsResponse is the REST API response to GET /v1/sources/:name/dependents and the equivalent routes for
+// the other kinds of resources
+type ResourceDependentsResponse struct {
+	Resources []*ResourceReference `json:"resources"`
+	AgentIDs  []string             `json:"agentIds"`
+}
+
+// ResourceDependenciesResponse is the REST API response to GET /v1/sources/:name/dependencies and the equivalent routes
+// for the other kinds of resources
+type ResourceDependenciesResponse struct {
+	Resources []*ResourceReference `json:"resources"`
+}
+
+// MigrateResourcesPayload is the REST API body for POST /v1/resource-types/migrate. If Kind is empty, all outdated
+// resources are migrated. If Name is empty, all outdated resources of the Kind are migrated.
+type MigrateResourcesPayload struct {
+	Kind Kind   `json:"kind,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// CatalogResponse is the REST API response to GET /v1/catalog, GET /v1/catalog/diff, and POST /v1/catalog/sync
+type CatalogResponse struct {
+	ResourceTypes []*CatalogResourceType `json:"resourceTypes"`
+}
+
+// SyncPayload is the REST API body for POST /v1/sync
+type SyncPayload struct {
+	Resources []*AnyResource `json:"resources"`
+	// Prune deletes resources managed by sync that are not included in Resources
+	Prune bool `json:"prune,omitempty"`
+	// DryRun compares Resources with the store without changing the store
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// SyncResponse is the REST API response to POST /v1/sync
+type SyncResponse struct {
+	Resources []*SyncResource `json:"resources"`
+}
+
+// BackupResponse is the REST API response to POST /v1/backups
+type BackupResponse struct {
+	Backup *Backup `json:"backup"`
+}
+
+// BackupsResponse is the REST API response to GET /v1/backups
+type BackupsResponse struct {
+	Backups []*Backup `json:"backups"`
+}
+
+// AgentGroupsResponse is the REST API response to GET /v1/agent-groups
+type AgentGroupsResponse struct {
+	AgentGroups []*AgentGroup `json:"agentGroups"`
+	NextCursor  string        `json:"nextCursor,omitempty"`
+}
+
+// AgentGroupResponse is the REST API response to GET /v1/agent-groups/:name
+type AgentGroupResponse struct {
+	AgentGroup *AgentGroup `json:"agentGroup"`
+}
+
+// AgentGroupSummaryResponse is the REST API response to GET /v1/agent-groups/:name/summary
+type AgentGroupSummaryResponse struct {
+	Summary *AgentGroupSummary `json:"summary"`
+}
+
 // ApplyResponse is the REST API response to POST /v1/apply.  This is used on
 // the server side to return updates consisting of generic ResourceStatuses.
 type ApplyResponse struct {
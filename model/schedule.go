@@ -0,0 +1,258 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+// MaxMaintenanceWindowDuration is the maximum duration of a maintenance window
+const MaxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+// ConfigurationSchedule restricts when changes to a Configuration are applied to agents. Changes that are not allowed
+// are recorded as pending changes on the agent and applied when the schedule allows.
+type ConfigurationSchedule struct {
+	// ActivateAt is the earliest time changes will be applied
+	ActivateAt *time.Time `json:"activateAt,omitempty" yaml:"activateAt,omitempty" mapstructure:"activateAt"`
+
+	// MaintenanceWindows are the recurring windows when changes may be applied. If no window matches the labels of an
+	// agent, changes are applied to the agent at any time after ActivateAt.
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty" yaml:"maintenanceWindows,omitempty" mapstructure:"maintenanceWindows"`
+}
+
+// MaintenanceWindow is a recurring period of time when changes may be applied to the agents matching the selector
+type MaintenanceWindow struct {
+	Name string `json:"name,omitempty" yaml:"name,omitempty" mapstructure:"name"`
+
+	// Days are the days of the week the window starts, e.g. ["sat", "sun"]. The window starts every day if empty.
+	Days []string `json:"days,omitempty" yaml:"days,omitempty" mapstructure:"days"`
+
+	// Start is the time of day the window starts in 24 hour format, e.g. "22:00"
+	Start string `json:"start" yaml:"start" mapstructure:"start"`
+
+	// Duration is the length of the window, e.g. "4h"
+	Duration string `json:"duration" yaml:"duration" mapstructure:"duration"`
+
+	// Timezone is the IANA name of the timezone of Start, e.g. "America/New_York". It defaults to UTC.
+	Timezone string `json:"timezone,omitempty" yaml:"timezone,omitempty" mapstructure:"timezone"`
+
+	// Selector limits the window to agents with matching labels. The window applies to all agents if empty.
+	Selector AgentSelector `json:"selector,omitempty" yaml:"selector,omitempty" mapstructure:"selector"`
+}
+
+// NextActivation returns the earliest time at or after t that changes may be applied to an agent with the specified
+// labels. Changes may be applied immediately if the result is equal to t. A nil schedule allows changes at any time.
+func (s *ConfigurationSchedule) NextActivation(labels Labels, t time.Time) time.Time {
+	if s == nil {
+		return t
+	}
+	if s.ActivateAt != nil && s.ActivateAt.After(t) {
+		t = *s.ActivateAt
+	}
+
+	var next *time.Time
+	for _, window := range s.MaintenanceWindows {
+		if !window.Selector.Selector().Matches(labels) {
+			continue
+		}
+		start, err := window.Next(t)
+		if err != nil {
+			// invalid windows are rejected by validation and ignored here
+			continue
+		}
+		if next == nil || start.Before(*next) {
+			next = &start
+		}
+	}
+	if next == nil {
+		return t
+	}
+	return *next
+}
+
+func (s *ConfigurationSchedule) validate(errors validation.Errors) {
+	if s == nil {
+		return
+	}
+	for i, window := range s.MaintenanceWindows {
+		if err := window.Validate(); err != nil {
+			errors.Add(fmt.Errorf("maintenance window %d is invalid: %w", i, err))
+		}
+		window.Selector.validate(errors)
+	}
+}
+
+// Validate returns an error if the days, start, duration, or timezone of the window are invalid
+func (w *MaintenanceWindow) Validate() error {
+	_, _, _, _, err := w.parse()
+	return err
+}
+
+// Contains returns true if t is within the window
+func (w *MaintenanceWindow) Contains(t time.Time) (bool, error) {
+	days, hour, minute, duration, err := w.parse()
+	if err != nil {
+		return false, err
+	}
+	return w.contains(t, days, hour, minute, duration), nil
+}
+
+// Next returns the earliest time at or after t that is within the window
+func (w *MaintenanceWindow) Next(t time.Time) (time.Time, error) {
+	days, hour, minute, duration, err := w.parse()
+	if err != nil {
+		return t, err
+	}
+	if w.contains(t, days, hour, minute, duration) {
+		return t, nil
+	}
+	local := t.In(w.location())
+	for offset := 0; offset <= 7; offset++ {
+		start := windowStart(local, offset, hour, minute)
+		if days[start.Weekday()] && start.After(t) {
+			return start, nil
+		}
+	}
+	// unreachable with at least one day in the window
+	return t, fmt.Errorf("window has no days")
+}
+
+func (w *MaintenanceWindow) contains(t time.Time, days map[time.Weekday]bool, hour, minute int, duration time.Duration) bool {
+	local := t.In(w.location())
+	// windows can be up to 7 days long, so a window that started in the last week may contain t
+	for offset := 0; offset >= -7; offset-- {
+		start := windowStart(local, offset, hour, minute)
+		if days[start.Weekday()] && !t.Before(start) && t.Before(start.Add(duration)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *MaintenanceWindow) location() *time.Location {
+	location, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+func (w *MaintenanceWindow) parse() (days map[time.Weekday]bool, hour, minute int, duration time.Duration, err error) {
+	days = map[time.Weekday]bool{}
+	for _, day := range w.Days {
+		weekday, ok := parseWeekday(day)
+		if !ok {
+			return nil, 0, 0, 0, fmt.Errorf("unknown day %s", day)
+		}
+		days[weekday] = true
+	}
+	if len(days) == 0 {
+		for d := time.Sunday; d <= time.Saturday; d++ {
+			days[d] = true
+		}
+	}
+
+	start, err := time.Parse("15:04", w.Start)
+	if err != nil {
+		return nil, 0, 0, 0, fmt.Errorf("start must be a time of day in the format HH:MM: %s", w.Start)
+	}
+
+	duration, err = time.ParseDuration(w.Duration)
+	if err != nil {
+		return nil, 0, 0, 0, fmt.Errorf("duration is invalid: %w", err)
+	}
+	if duration <= 0 || duration > MaxMaintenanceWindowDuration {
+		return nil, 0, 0, 0, fmt.Errorf("duration must be greater than 0 and at most %s", MaxMaintenanceWindowDuration)
+	}
+
+	if _, err := time.LoadLocation(w.Timezone); err != nil {
+		return nil, 0, 0, 0, fmt.Errorf("timezone is invalid: %w", err)
+	}
+
+	return days, start.Hour(), start.Minute(), duration, nil
+}
+
+// windowStart returns the time of day on the day offset from t
+func windowStart(t time.Time, offset, hour, minute int) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day()+offset, hour, minute, 0, 0, t.Location())
+}
+
+func parseWeekday(day string) (time.Weekday, bool) {
+	day = strings.ToLower(day)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		name := strings.ToLower(d.String())
+		if day == name || day == name[:3] {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// ----------------------------------------------------------------------
+// pending changes
+
+// AgentPendingChange is a configuration change that has not been applied to an agent because the schedule of the
+// configuration does not allow it
+type AgentPendingChange struct {
+	AgentID string `json:"agentId" yaml:"agentId"`
+
+	// Configuration is the name of the configuration with the pending change
+	Configuration string `json:"configuration" yaml:"configuration"`
+
+	// NotBefore is the next time the schedule of the configuration allows the change to be applied
+	NotBefore time.Time `json:"notBefore" yaml:"notBefore"`
+
+	// Forced is true if the change was applied before the schedule allows it. The pending change remains until the agent
+	// reports the new configuration.
+	Forced bool `json:"forced,omitempty" yaml:"forced,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt" yaml:"createdAt"`
+}
+
+// PrintableKindSingular returns the singular form of the Kind, e.g. "PendingChange"
+func (c *AgentPendingChange) PrintableKindSingular() string {
+	return "PendingChange"
+}
+
+// PrintableKindPlural returns the plural form of the Kind, e.g. "PendingChanges"
+func (c *AgentPendingChange) PrintableKindPlural() string {
+	return "PendingChanges"
+}
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (c *AgentPendingChange) PrintableFieldTitles() []string {
+	return []string{"Agent", "Configuration", "Not Before", "Forced", "Age"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (c *AgentPendingChange) PrintableFieldValue(title string) string {
+	switch title {
+	case "Agent":
+		return c.AgentID
+	case "Configuration":
+		return c.Configuration
+	case "Not Before":
+		return c.NotBefore.Format(time.RFC3339)
+	case "Forced":
+		return fmt.Sprintf("%t", c.Forced)
+	case "Age":
+		return durationDisplay(&c.CreatedAt)
+	}
+	return ""
+}
@@ -0,0 +1,147 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	// Monday
+	monday := time.Date(2022, 8, 1, 12, 0, 0, 0, time.UTC)
+	nightly := MaintenanceWindow{Start: "22:00", Duration: "4h"}
+	weekend := MaintenanceWindow{Days: []string{"sat", "Sunday"}, Start: "00:00", Duration: "24h"}
+	eastern := MaintenanceWindow{Start: "22:00", Duration: "1h", Timezone: "America/New_York"}
+
+	tests := []struct {
+		name         string
+		window       MaintenanceWindow
+		time         time.Time
+		expectInside bool
+		expectNext   time.Time
+	}{
+		{
+			name:       "before nightly",
+			window:     nightly,
+			time:       monday,
+			expectNext: time.Date(2022, 8, 1, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "nightly spanning midnight",
+			window:       nightly,
+			time:         time.Date(2022, 8, 2, 1, 0, 0, 0, time.UTC),
+			expectInside: true,
+			expectNext:   time.Date(2022, 8, 2, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "after nightly",
+			window:     nightly,
+			time:       time.Date(2022, 8, 2, 2, 0, 0, 0, time.UTC),
+			expectNext: time.Date(2022, 8, 2, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "weekday before weekend",
+			window:     weekend,
+			time:       monday,
+			expectNext: time.Date(2022, 8, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "sunday",
+			window:       weekend,
+			time:         time.Date(2022, 8, 7, 23, 0, 0, 0, time.UTC),
+			expectInside: true,
+			expectNext:   time.Date(2022, 8, 7, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "timezone",
+			window:     eastern,
+			time:       monday,
+			expectNext: time.Date(2022, 8, 2, 2, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inside, err := test.window.Contains(test.time)
+			require.NoError(t, err)
+			require.Equal(t, test.expectInside, inside)
+
+			next, err := test.window.Next(test.time)
+			require.NoError(t, err)
+			require.True(t, test.expectNext.Equal(next), "expected %s, got %s", test.expectNext, next)
+		})
+	}
+}
+
+func TestMaintenanceWindowValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		window    MaintenanceWindow
+		expectErr string
+	}{
+		{"valid", MaintenanceWindow{Days: []string{"mon"}, Start: "02:30", Duration: "90m", Timezone: "Europe/Berlin"}, ""},
+		{"unknown day", MaintenanceWindow{Days: []string{"someday"}, Start: "02:30", Duration: "1h"}, "unknown day someday"},
+		{"bad start", MaintenanceWindow{Start: "2pm", Duration: "1h"}, "start must be a time of day"},
+		{"bad duration", MaintenanceWindow{Start: "02:30", Duration: "soon"}, "duration is invalid"},
+		{"long duration", MaintenanceWindow{Start: "02:30", Duration: "200h"}, "duration must be greater than 0"},
+		{"bad timezone", MaintenanceWindow{Start: "02:30", Duration: "1h", Timezone: "Mars/Olympus"}, "timezone is invalid"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.window.Validate()
+			if test.expectErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, test.expectErr)
+		})
+	}
+}
+
+func TestConfigurationScheduleNextActivation(t *testing.T) {
+	now := time.Date(2022, 8, 1, 12, 0, 0, 0, time.UTC)
+	tomorrow := now.Add(24 * time.Hour)
+	production := MakeLabels()
+	production.Set["env"] = "production"
+
+	var none *ConfigurationSchedule
+	require.Equal(t, now, none.NextActivation(production, now))
+
+	activate := &ConfigurationSchedule{ActivateAt: &tomorrow}
+	require.Equal(t, tomorrow, activate.NextActivation(production, now))
+
+	windows := &ConfigurationSchedule{
+		MaintenanceWindows: []MaintenanceWindow{
+			{Start: "22:00", Duration: "2h", Selector: AgentSelector{MatchLabels: MatchLabels{"env": "production"}}},
+		},
+	}
+	require.Equal(t, time.Date(2022, 8, 1, 22, 0, 0, 0, time.UTC), windows.NextActivation(production, now))
+	require.Equal(t, now, windows.NextActivation(MakeLabels(), now), "windows only apply to matching agents")
+
+	windows.ActivateAt = &tomorrow
+	require.Equal(t, time.Date(2022, 8, 2, 22, 0, 0, 0, time.UTC), windows.NextActivation(production, now))
+}
+
+func TestConfigurationScheduleValidate(t *testing.T) {
+	configuration := NewRawConfiguration("test", "receivers:")
+	configuration.Spec.Schedule = &ConfigurationSchedule{
+		MaintenanceWindows: []MaintenanceWindow{{Start: "25:00", Duration: "1h"}},
+	}
+	require.ErrorContains(t, configuration.Validate(), "maintenance window 0 is invalid")
+}
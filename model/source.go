@@ -90,6 +90,7 @@ func FindSource(source *ResourceConfiguration, defaultName string, store Resourc
 		// inline source
 		src := NewSource(defaultName, source.Type, source.Parameters)
 		src.Spec.Processors = source.Processors
+		src.Spec.TypeVersion = source.TypeVersion
 		return src, nil
 	}
 	// find the source and override parameters
@@ -101,6 +102,9 @@ func FindSource(source *ResourceConfiguration, defaultName string, store Resourc
 		return nil, fmt.Errorf("unknown %s: %s", KindSource, source.Name)
 	}
 	spec := src.Spec.overrideParameters(source.Parameters)
+	if source.TypeVersion != "" {
+		spec.TypeVersion = source.TypeVersion
+	}
 	return NewSourceWithSpec(src.Name(), spec), nil
 }
 
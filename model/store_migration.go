@@ -0,0 +1,69 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "github.com/observiq/bindplane-op/common"
+
+// StoreMigrationRequest is the REST API request to POST /v1/store/migration. It configures the store that the server
+// copies its resources and agents to.
+type StoreMigrationRequest struct {
+	// StoreType is the type of the target store, e.g. bbolt or googlecloud
+	StoreType string `json:"storeType"`
+
+	// StorageFilePath is the path of the bbolt file of the target store, which must not be in use by another server
+	StorageFilePath string `json:"storageFilePath,omitempty"`
+
+	// GoogleCloudDatastore and GoogleCloudPubSub configure a googlecloud target store
+	GoogleCloudDatastore *common.GoogleCloudDatastore `json:"datastore,omitempty"`
+	GoogleCloudPubSub    *common.GoogleCloudPubSub    `json:"pubsub,omitempty"`
+
+	// Follow continues copying changes to the store of the server until the migration is stopped
+	Follow bool `json:"follow"`
+}
+
+// StoreMigration reports the progress of a migration of the store of the server to another store
+type StoreMigration struct {
+	// Following is true while changes are being copied to the target store
+	Following bool `json:"following"`
+
+	// Counts are the number of resources and agents of each kind copied by the most recent copy
+	Counts []*StoreMigrationCounts `json:"counts"`
+
+	// Checks compare the resources and agents of each kind in both stores once the migration is complete
+	Checks []*StoreMigrationCheck `json:"checks,omitempty"`
+}
+
+// StoreMigrationCounts are the number of resources or agents of a kind that were copied, unchanged, or deleted from
+// the target because they no longer exist in the store of the server
+type StoreMigrationCounts struct {
+	Kind      Kind `json:"kind"`
+	Copied    int  `json:"copied"`
+	Unchanged int  `json:"unchanged"`
+	Deleted   int  `json:"deleted"`
+}
+
+// StoreMigrationCheck compares the number of resources or agents of a kind and a checksum of their contents in the
+// store of the server and the target store
+type StoreMigrationCheck struct {
+	Kind        Kind `json:"kind"`
+	SourceCount int  `json:"sourceCount"`
+	TargetCount int  `json:"targetCount"`
+	Matches     bool `json:"matches"`
+}
+
+// StoreMigrationResponse is the REST API response to POST and DELETE /v1/store/migration
+type StoreMigrationResponse struct {
+	Migration *StoreMigration `json:"migration"`
+}
@@ -0,0 +1,153 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// LabelBindPlaneSyncDigest is the label of a resource managed by sync. The value is the digest of the resource when it
+// was synced and is used to detect resources modified since they were synced.
+const LabelBindPlaneSyncDigest = "bindplane/sync-digest"
+
+// syncDigestLength is the number of hex characters of the sha256 used as the digest. Label values are limited to 63
+// characters.
+const syncDigestLength = 32
+
+// SyncStatus describes how a resource in the sync directory compares to the resource in the store or the result of
+// syncing it
+type SyncStatus string
+
+const (
+	// SyncStatusCreate is the status of a resource that is not in the store
+	SyncStatusCreate SyncStatus = "create"
+
+	// SyncStatusUpdate is the status of a resource that differs from the resource in the store
+	SyncStatusUpdate SyncStatus = "update"
+
+	// SyncStatusUnchanged is the status of a resource that matches the resource in the store
+	SyncStatusUnchanged SyncStatus = "unchanged"
+
+	// SyncStatusDelete is the status of a resource managed by sync that is no longer in the sync directory and will be
+	// deleted because prune is enabled
+	SyncStatusDelete SyncStatus = "delete"
+
+	// SyncStatusOrphaned is the status of a resource managed by sync that is no longer in the sync directory and is kept
+	// because prune is not enabled
+	SyncStatusOrphaned SyncStatus = "orphaned"
+
+	// SyncStatusCreated is the status of a new resource that was synced
+	SyncStatusCreated SyncStatus = "created"
+
+	// SyncStatusUpdated is the status of a changed resource that was synced
+	SyncStatusUpdated SyncStatus = "updated"
+
+	// SyncStatusDeleted is the status of a resource that was pruned
+	SyncStatusDeleted SyncStatus = "deleted"
+
+	// SyncStatusInUse is the status of a resource that could not be pruned because it is referenced by another resource
+	SyncStatusInUse SyncStatus = "in-use"
+
+	// SyncStatusInvalid is the status of a resource that could not be parsed or was rejected by the store
+	SyncStatusInvalid SyncStatus = "invalid"
+)
+
+// SyncResource describes a resource in the sync directory or a resource managed by sync compared with the resource in
+// the store
+type SyncResource struct {
+	Kind   Kind       `json:"kind" yaml:"kind"`
+	Name   string     `json:"name" yaml:"name"`
+	Status SyncStatus `json:"status" yaml:"status"`
+	// Drift is true if the resource in the store was modified since it was synced
+	Drift bool `json:"drift,omitempty" yaml:"drift,omitempty"`
+	// Reason explains drift and in-use and invalid statuses
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
+
+// SyncDigest returns the digest of a resource used to detect changes. The ID and resourceVersion of the resource and
+// the LabelBindPlaneSyncDigest label are ignored.
+func SyncDigest(resource Resource) (string, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return "", err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", err
+	}
+
+	if metadata, ok := fields["metadata"].(map[string]any); ok {
+		delete(metadata, "id")
+		delete(metadata, "resourceVersion")
+		labels, _ := metadata["labels"].(map[string]any)
+		delete(labels, LabelBindPlaneSyncDigest)
+		if len(labels) == 0 {
+			delete(metadata, "labels")
+		}
+	}
+
+	// maps are marshaled with sorted keys so the digest does not depend on the order of fields
+	data, err = json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:syncDigestLength], nil
+}
+
+// IsManagedBySync returns true if the resource was synced from a sync directory
+func IsManagedBySync(resource Resource) bool {
+	return resource.GetLabels().Get(LabelBindPlaneSyncDigest) != ""
+}
+
+// ----------------------------------------------------------------------
+// Printable
+
+// PrintableKindSingular returns the singular form of the Kind, e.g. "Resource"
+func (s *SyncResource) PrintableKindSingular() string {
+	return "Resource"
+}
+
+// PrintableKindPlural returns the plural form of the Kind, e.g. "Resources"
+func (s *SyncResource) PrintableKindPlural() string {
+	return "Resources"
+}
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (s *SyncResource) PrintableFieldTitles() []string {
+	return []string{"Kind", "Name", "Status", "Drift", "Reason"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (s *SyncResource) PrintableFieldValue(title string) string {
+	switch title {
+	case "Kind":
+		return string(s.Kind)
+	case "Name":
+		return s.Name
+	case "Status":
+		return string(s.Status)
+	case "Drift":
+		if s.Drift {
+			return "drift"
+		}
+		return ""
+	case "Reason":
+		return s.Reason
+	}
+	return ""
+}
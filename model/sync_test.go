@@ -0,0 +1,60 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncDigest(t *testing.T) {
+	source := fileResource[*Source](t, "testfiles/source-macos.yaml")
+	digest, err := SyncDigest(source)
+	require.NoError(t, err)
+	require.Len(t, digest, 32)
+	require.False(t, IsManagedBySync(source))
+
+	// the ID, resourceVersion, and digest label are ignored
+	labeled := fileResource[*Source](t, "testfiles/source-macos.yaml")
+	labeled.Metadata.ID = "b1a2c3"
+	labeled.Metadata.ResourceVersion = 3
+	labeled.Metadata.Labels = LabelsFromValidatedMap(map[string]string{LabelBindPlaneSyncDigest: digest})
+	labeledDigest, err := SyncDigest(labeled)
+	require.NoError(t, err)
+	require.Equal(t, digest, labeledDigest)
+	require.True(t, IsManagedBySync(labeled))
+
+	// other labels and the spec are included
+	labeled.Metadata.Labels.Set["app"] = "cabin"
+	changedDigest, err := SyncDigest(labeled)
+	require.NoError(t, err)
+	require.NotEqual(t, digest, changedDigest)
+
+	changed := fileResource[*Source](t, "testfiles/source-macos.yaml")
+	changed.Spec.Parameters[0].Value = true
+	changedDigest, err = SyncDigest(changed)
+	require.NoError(t, err)
+	require.NotEqual(t, digest, changedDigest)
+}
+
+func TestResourcesFromDirectory(t *testing.T) {
+	resources, err := ResourcesFromDirectory("testfiles/validate")
+	require.NoError(t, err)
+	require.NotEmpty(t, resources)
+
+	_, err = ResourcesFromDirectory("testfiles/missing")
+	require.Error(t, err)
+}
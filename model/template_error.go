@@ -0,0 +1,131 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// templateErrorContextLines is the number of lines before and after the line of an error included in the context
+const templateErrorContextLines = 2
+
+var yamlErrorLine = regexp.MustCompile(`line (\d+):`)
+
+// TemplateError is an error rendering a ResourceType template. It includes the line of the template or rendered output
+// where the error occurred and the surrounding lines to make the error easier to find.
+type TemplateError struct {
+	// Err is the underlying error produced by the template or yaml parser
+	Err error
+	// Line is the line number of the error, starting at 1. It will be 0 if the line is unknown.
+	Line int
+	// Context contains the lines surrounding the error with the line of the error marked with >
+	Context string
+}
+
+var _ error = (*TemplateError)(nil)
+
+// newTemplateError creates a TemplateError from an error parsing or executing the template with the specified name and
+// source
+func newTemplateError(err error, name string, source string) *TemplateError {
+	pattern := regexp.MustCompile(fmt.Sprintf(`^template: %s:(\d+)`, regexp.QuoteMeta(name)))
+	return newTemplateErrorWithPattern(err, pattern, source)
+}
+
+// newYamlTemplateError creates a TemplateError from an error parsing the yaml rendered by a template
+func newYamlTemplateError(err error, rendered string) *TemplateError {
+	return newTemplateErrorWithPattern(err, yamlErrorLine, rendered)
+}
+
+func newTemplateErrorWithPattern(err error, pattern *regexp.Regexp, source string) *TemplateError {
+	result := &TemplateError{Err: err}
+	match := pattern.FindStringSubmatch(err.Error())
+	if len(match) < 2 {
+		return result
+	}
+	line, convErr := strconv.Atoi(match[1])
+	if convErr != nil {
+		return result
+	}
+	result.Line = line
+	result.Context = lineContext(source, line)
+	return result
+}
+
+// Error returns the message of the underlying error
+func (e *TemplateError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *TemplateError) Unwrap() error {
+	return e.Err
+}
+
+// Detail returns the error message followed by the context, if available
+func (e *TemplateError) Detail() string {
+	if e.Context == "" {
+		return e.Error()
+	}
+	return fmt.Sprintf("%s\n%s", e.Error(), e.Context)
+}
+
+// TemplateErrorDetails returns err with any TemplateErrors, including TemplateErrors accumulated in a multierror,
+// replaced by errors that include the context of the TemplateError in the message.
+func TemplateErrorDetails(err error) error {
+	if merr, ok := err.(*multierror.Error); ok {
+		var result error
+		for _, e := range merr.Errors {
+			result = multierror.Append(result, TemplateErrorDetails(e))
+		}
+		return result
+	}
+	var templateErr *TemplateError
+	if errors.As(err, &templateErr) {
+		return errors.New(templateErr.Detail())
+	}
+	return err
+}
+
+// lineContext returns the lines of source surrounding the specified line, prefixed with line numbers
+func lineContext(source string, line int) string {
+	lines := strings.Split(strings.TrimSuffix(source, "\n"), "\n")
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+	first := line - templateErrorContextLines
+	if first < 1 {
+		first = 1
+	}
+	last := line + templateErrorContextLines
+	if last > len(lines) {
+		last = len(lines)
+	}
+
+	var sb strings.Builder
+	for i := first; i <= last; i++ {
+		marker := " "
+		if i == line {
+			marker = ">"
+		}
+		fmt.Fprintf(&sb, "%s %4d | %s\n", marker, i, lines[i-1])
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
@@ -53,6 +53,12 @@ func TestConfigurationValidate(t *testing.T) {
 			expectValidateError:          "",
 			expectValidateWithStoreError: "4 errors occurred:\n\t* all Source parameters must have a name\n\t* all Source must have either a name or type\n\t* unknown Source: valid\n\t* unknown SourceType: unknown\n\n",
 		},
+		{
+			// destination and configuration-wide processors must have valid resources with name and/or type
+			testfile:                     "configuration-bad-processors.yaml",
+			expectValidateError:          "",
+			expectValidateWithStoreError: "3 errors occurred:\n\t* unknown ProcessorType: unknown-destination-processor\n\t* all Processor must have either a name or type\n\t* unknown ProcessorType: unknown-processor\n\n",
+		},
 		{
 			testfile:                     "configuration-bad-parameter-values.yaml",
 			expectValidateError:          "",
@@ -136,6 +142,10 @@ func TestSourceTypeValidate(t *testing.T) {
 			testfile:           "sourcetype-bad-templates.yaml",
 			expectErrorMessage: "2 errors occurred:\n\t* template: logs.receivers:6: unexpected \"}\" in operand\n\t* template: logs.processors:1:5: executing \"logs.processors\" at <.not_a_variable>: map has no entry for key \"not_a_variable\"\n\n",
 		},
+		{
+			testfile:           "sourcetype-bad-connectors.yaml",
+			expectErrorMessage: "2 errors occurred:\n\t* logs.connectors is only supported by ConnectorType\n\t* connectorOutputs is only supported by ConnectorType\n\n",
+		},
 	}
 
 	for _, test := range tests {
@@ -153,6 +163,34 @@ func TestSourceTypeValidate(t *testing.T) {
 
 }
 
+func TestConnectorTypeValidate(t *testing.T) {
+	tests := []struct {
+		testfile           string
+		expectErrorMessage string
+	}{
+		{
+			testfile: "connectortype-ok.yaml",
+		},
+		{
+			testfile:           "connectortype-bad-outputs.yaml",
+			expectErrorMessage: "2 errors occurred:\n\t* ConnectorType must specify connectors for at least one telemetry type\n\t* invalid connectorOutputs profiles: must be one of logs, metrics, or traces\n\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testfile, func(t *testing.T) {
+			connectorType := validateResource[*ConnectorType](t, test.testfile)
+			err := connectorType.Validate()
+			if test.expectErrorMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, test.expectErrorMessage, err.Error())
+			}
+		})
+	}
+}
+
 func TestSourceValidate(t *testing.T) {
 	tests := []struct {
 		testfile                     string
@@ -15,6 +15,7 @@
 package resources
 
 import (
+	"flag"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
@@ -22,8 +23,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/observiq/bindplane-op/model"
+	"github.com/observiq/bindplane-op/model/resourcetypetest"
 )
 
+var update = flag.Bool("update", false, "update the golden files of the resource type fixtures in testdata")
+
 // tests confirm that all resources are valid
 
 func fileResource[T model.Resource](t *testing.T, path string) T {
@@ -83,3 +87,94 @@ func TestValidateDestinationTypes(t *testing.T) {
 		})
 	}
 }
+
+// tlsParameters are the TLS parameters shared by destination types. A destination type that defines any of them must
+// define all of them with the same types as the otlp_grpc destination type.
+var tlsParameters = []string{"enable_tls", "insecure_skip_verify", "ca_file", "cert_file", "key_file"}
+
+// destinationsWithoutRetryAndQueue are the destination types with exporters that do not support the retry and sending
+// queue settings
+var destinationsWithoutRetryAndQueue = map[string]bool{
+	"aws_s3":     true,
+	"file":       true,
+	"prometheus": true,
+}
+
+func TestDestinationTypesTLSParameters(t *testing.T) {
+	reference := fileResource[*model.DestinationType](t, filepath.Join("destination-types", "otlp.yaml"))
+
+	paths := resourcePaths(t, "destination-types")
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			resource := fileResource[*model.DestinationType](t, path)
+			if resource.Spec.ParameterDefinition("enable_tls") == nil {
+				return
+			}
+			for _, name := range tlsParameters {
+				actual := resource.Spec.ParameterDefinition(name)
+				require.NotNil(t, actual, "missing parameter %s", name)
+				require.Equal(t, reference.Spec.ParameterDefinition(name).Type, actual.Type, "parameter %s", name)
+			}
+		})
+	}
+}
+
+// TestDestinationTypesRetryAndQueue renders each destination type with retry and sending queue settings and confirms
+// that the exporters use them
+func TestDestinationTypesRetryAndQueue(t *testing.T) {
+	paths := resourcePaths(t, "destination-types")
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			resource := fileResource[*model.DestinationType](t, path)
+			if destinationsWithoutRetryAndQueue[resource.Name()] {
+				require.Empty(t, resource.Spec.ParameterGroups)
+				return
+			}
+			require.Equal(t, []string{"retry_on_failure", "sending_queue"}, resource.Spec.ParameterGroups)
+
+			parameters := []model.Parameter{
+				{Name: "retry_initial_interval", Value: "7s"},
+				{Name: "retry_max_interval", Value: "42s"},
+				{Name: "retry_max_elapsed_time", Value: "420s"},
+				{Name: "sending_queue_num_consumers", Value: 3},
+				{Name: "sending_queue_queue_size", Value: 123},
+			}
+			// required parameters without a default only need a value to render
+			for _, p := range resource.Spec.Parameters {
+				if p.Required && p.Type == "string" && (p.Default == nil || p.Default == "") {
+					parameters = append(parameters, model.Parameter{Name: p.Name, Value: "test"})
+				}
+			}
+
+			rendered, err := resource.RenderParameters("test", parameters)
+			require.NoError(t, err)
+			for _, expected := range []string{"initial_interval: 7s", "max_interval: 42s", "num_consumers: 3", "queue_size: 123"} {
+				require.Contains(t, rendered, expected)
+			}
+
+			parameters = append(parameters,
+				model.Parameter{Name: "retry_on_failure_enabled", Value: false},
+				model.Parameter{Name: "sending_queue_enabled", Value: false},
+			)
+			rendered, err = resource.RenderParameters("test", parameters)
+			require.NoError(t, err)
+			require.NotContains(t, rendered, "initial_interval: 7s")
+			require.NotContains(t, rendered, "queue_size: 123")
+		})
+	}
+}
+
+// TestResourceTypeFixtures renders the resource types with the fixtures in testdata/<folder>/<file> and compares them
+// with the golden files. Run with -update to update the golden files.
+func TestResourceTypeFixtures(t *testing.T) {
+	for _, folder := range []string{"source-types", "processor-types", "destination-types"} {
+		paths, err := filepath.Glob(filepath.Join("testdata", folder, "*.yaml"))
+		require.NoError(t, err)
+		for _, path := range paths {
+			path := path
+			t.Run(path, func(t *testing.T) {
+				resourcetypetest.Run(t, filepath.Join(folder, filepath.Base(path)), path, *update)
+			})
+		}
+	}
+}
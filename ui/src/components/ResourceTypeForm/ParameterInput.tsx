@@ -23,7 +23,11 @@ import { useValidationContext } from "./ValidationContext";
 import { classes as classesUtil } from "../../utils/styles";
 import { YamlEditor } from "../YamlEditor";
 import { PlusCircleIcon, TrashIcon } from "../Icons";
-import { validateMapField, validateStringsField } from "./validation-functions";
+import {
+  validateMapField,
+  validateObjectField,
+  validateStringsField,
+} from "./validation-functions";
 
 import styles from "./parameter-input.module.scss";
 
@@ -43,6 +47,14 @@ export const ParameterInput: React.FC<ParamInputProps<any>> = (props) => {
   }
   switch (props.definition.type) {
     case ParameterType.String:
+    case ParameterType.Float:
+    case ParameterType.Duration:
+    case ParameterType.ByteSize:
+    case ParameterType.Regex:
+    case ParameterType.Timezone:
+    case ParameterType.FilePath:
+    case ParameterType.HostPort:
+      // these values are entered as text and validated by the server
       return <StringParamInput classes={classes} {...props} />;
     case ParameterType.Strings:
       return <StringsInput classes={classes} {...props} />;
@@ -58,6 +70,10 @@ export const ParameterInput: React.FC<ParamInputProps<any>> = (props) => {
       return <MapParamInput classes={classes} {...props} />;
     case ParameterType.Yaml:
       return <YamlParamInput classes={classes} {...props} />;
+    case ParameterType.Object:
+    case ParameterType.Objects:
+      // nested values are edited as JSON and validated by the server
+      return <ObjectParamInput classes={classes} {...props} />;
   }
 };
 
@@ -212,6 +228,80 @@ export const YamlParamInput: React.FC<ParamInputProps<string>> = ({
   );
 };
 
+export const ObjectParamInput: React.FC<ParamInputProps<any>> = ({
+  classes,
+  definition,
+  value,
+  onValueChange,
+}) => {
+  const [text, setText] = useState(objectValueToText(value));
+  const [isFocused, setFocused] = useState(false);
+
+  const { errors, setError, touched, touch } = useValidationContext();
+
+  const shrinkLabel = isFocused || !isEmpty(text);
+
+  function handleValueChange(e: ChangeEvent<HTMLTextAreaElement>) {
+    setText(e.target.value);
+
+    let objectValue: any = null;
+    if (!isEmpty(e.target.value.trim())) {
+      try {
+        objectValue = JSON.parse(e.target.value);
+      } catch (err) {
+        setError(definition.name, "Must be valid JSON.");
+        return;
+      }
+    }
+
+    const error = validateObjectField(
+      objectValue,
+      definition.type,
+      definition.required
+    );
+    setError(definition.name, error);
+    if (error == null) {
+      isFunction(onValueChange) && onValueChange(objectValue);
+    }
+  }
+
+  function handleBlur() {
+    setFocused(false);
+    if (!touched[definition.name]) {
+      touch(definition.name);
+    }
+  }
+
+  return (
+    <FormControl fullWidth classes={classes} required={definition.required}>
+      <InputLabel
+        shrink={shrinkLabel}
+        htmlFor={definition.name}
+        style={{
+          backgroundColor: "#fff",
+          color: shrinkLabel ? "#4abaeb" : undefined,
+          padding: shrinkLabel ? "0 10px 0 5px" : undefined,
+        }}
+      >
+        {definition.label}
+      </InputLabel>
+      <YamlEditor
+        required={definition.required}
+        name={definition.name}
+        value={text}
+        onValueChange={handleValueChange}
+        onFocus={() => setFocused(true)}
+        onBlur={handleBlur}
+        minHeight={200}
+      />
+      {touched[definition.name] && errors[definition.name] && (
+        <FormHelperText error>{errors[definition.name]}</FormHelperText>
+      )}
+      <FormHelperText>{definition.description}</FormHelperText>
+    </FormControl>
+  );
+};
+
 export const MapParamInput: React.FC<ParamInputProps<Record<string, string>>> =
   ({ classes, definition, value, onValueChange }) => {
     const initValue = valueToTupleArray(value);
@@ -565,6 +655,13 @@ export function tupleArrayToMap(tuples: Tuple[]): Record<string, string> {
   return mapValue;
 }
 
+export function objectValueToText(value: any): string {
+  if (value == null) {
+    return "";
+  }
+  return JSON.stringify(value, null, 2);
+}
+
 function addRow(tuples: Tuple[]): Tuple[] {
   const newTuples = [...tuples];
   newTuples.push(["", ""]);
@@ -6,6 +6,7 @@ import {
   RelevantIfOperatorType,
 } from "../../graphql/generated";
 import {
+  objectValueToText,
   ParameterInput,
   Tuple,
   tupleArrayToMap,
@@ -265,6 +266,44 @@ describe("ResourceForm component", () => {
   });
 });
 
+describe("ObjectParamInput", () => {
+  const objectParameter: ParameterDefinition = {
+    required: true,
+    label: "Label",
+    description: "description",
+    type: ParameterType.Object,
+    name: "object_type_param",
+  };
+
+  it("enables save button when valid JSON is entered", () => {
+    render(
+      <ResourceConfigForm
+        onSave={() => {}}
+        kind="destination"
+        title={"Title"}
+        description={ResourceType1.metadata.description!}
+        parameterDefinitions={[objectParameter]}
+      />
+    );
+
+    expect(screen.getByTestId("resource-form-save")).toBeDisabled();
+
+    const editor = screen.getByTestId("yaml-editor");
+    fireEvent.change(editor, { target: { value: `{"key": ` } });
+    expect(screen.getByTestId("resource-form-save")).toBeDisabled();
+
+    fireEvent.change(editor, { target: { value: `{"key": "value"}` } });
+    expect(screen.getByTestId("resource-form-save")).not.toBeDisabled();
+  });
+
+  it("formats the value as JSON", () => {
+    expect(objectValueToText(null)).toEqual("");
+    expect(objectValueToText({ key: "value" })).toEqual(
+      `{\n  "key": "value"\n}`
+    );
+  });
+});
+
 describe("MapParamInput", () => {
   const mapParameter: ParameterDefinition = {
     required: true,
@@ -15,7 +15,11 @@ import {
   useValidationContext,
   ValidationContextProvider,
 } from "./ValidationContext";
-import { validateStringsField, validateMapField } from "./validation-functions";
+import {
+  validateStringsField,
+  validateMapField,
+  validateObjectField,
+} from "./validation-functions";
 
 import mixins from "../../styles/mixins.module.scss";
 
@@ -198,6 +202,14 @@ export const ResourceConfigForm: React.FC<ResourceFormProps> = (props) => {
           definition.required
         );
         break;
+      case ParameterType.Object:
+      case ParameterType.Objects:
+        initErrors[definition.name] = validateObjectField(
+          defaults[definition.name],
+          definition.type,
+          definition.required
+        );
+        break;
       default:
         initErrors[definition.name] = null;
     }
@@ -0,0 +1,82 @@
+import { RelevantIfOperatorType } from "../../graphql/generated";
+import { satisfiesCondition } from "./satisfiesRelevantIf";
+
+const values = {
+  enabled: true,
+  transport: "tcp",
+  paths: ["/var/log/a.log"],
+  empty: "",
+};
+
+describe("satisfiesCondition", () => {
+  it("equals", () => {
+    expect(
+      satisfiesCondition(values, {
+        name: "enabled",
+        operator: RelevantIfOperatorType.Equals,
+        value: true,
+      })
+    ).toBe(true);
+  });
+
+  it("notIn", () => {
+    expect(
+      satisfiesCondition(values, {
+        name: "transport",
+        operator: RelevantIfOperatorType.NotIn,
+        value: ["unix", "tcp"],
+      })
+    ).toBe(false);
+  });
+
+  it("containsAny", () => {
+    expect(
+      satisfiesCondition(values, {
+        name: "paths",
+        operator: RelevantIfOperatorType.ContainsAny,
+        value: ["/var/log/a.log", "/var/log/b.log"],
+      })
+    ).toBe(true);
+  });
+
+  it("exists, empty string => false", () => {
+    expect(
+      satisfiesCondition(values, {
+        name: "empty",
+        operator: RelevantIfOperatorType.Exists,
+      })
+    ).toBe(false);
+  });
+
+  it("any of all", () => {
+    expect(
+      satisfiesCondition(values, {
+        name: "",
+        operator: RelevantIfOperatorType.Any,
+        conditions: [
+          {
+            name: "enabled",
+            operator: RelevantIfOperatorType.Equals,
+            value: false,
+          },
+          {
+            name: "",
+            operator: RelevantIfOperatorType.All,
+            conditions: [
+              {
+                name: "transport",
+                operator: RelevantIfOperatorType.In,
+                value: ["tcp", "unix"],
+              },
+              {
+                name: "paths",
+                operator: RelevantIfOperatorType.Exists,
+                value: true,
+              },
+            ],
+          },
+        ],
+      })
+    ).toBe(true);
+  });
+});
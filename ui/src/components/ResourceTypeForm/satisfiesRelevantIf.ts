@@ -1,5 +1,9 @@
-import { isEqual } from "lodash";
-import { ParameterDefinition } from "../../graphql/generated";
+import { isArray, isEmpty, isEqual, isNumber, isString } from "lodash";
+import {
+  ParameterDefinition,
+  RelevantIfCondition,
+  RelevantIfOperatorType,
+} from "../../graphql/generated";
 
 export function satisfiesRelevantIf(
   formValues: { [name: string]: any },
@@ -12,12 +16,60 @@ export function satisfiesRelevantIf(
   const relaventIf = definition.relevantIf;
 
   for (const condition of relaventIf) {
-    // TODO (dsvanlani) Right now we only support and expect the "equals" operator
-    // Add a capability to satisfy other operators like "less than" or "greater than".
-    if (!isEqual(formValues[condition.name], condition.value)) {
+    if (!satisfiesCondition(formValues, condition)) {
       return false;
     }
   }
 
   return true;
 }
+
+// satisfiesCondition mirrors the evaluation of relevantIf conditions on the server
+export function satisfiesCondition(
+  formValues: { [name: string]: any },
+  condition: RelevantIfCondition
+): boolean {
+  const conditions = condition.conditions ?? [];
+  const value = formValues[condition.name];
+
+  switch (condition.operator) {
+    case RelevantIfOperatorType.All:
+      return conditions.every((c) => satisfiesCondition(formValues, c));
+    case RelevantIfOperatorType.Any:
+      return conditions.some((c) => satisfiesCondition(formValues, c));
+    case RelevantIfOperatorType.Equals:
+      return isEqual(value, condition.value);
+    case RelevantIfOperatorType.NotEquals:
+      return !isEqual(value, condition.value);
+    case RelevantIfOperatorType.In:
+      return listContains(condition.value, value);
+    case RelevantIfOperatorType.NotIn:
+      return !listContains(condition.value, value);
+    case RelevantIfOperatorType.ContainsAny:
+      return (
+        isArray(value) && value.some((v) => listContains(condition.value, v))
+      );
+    case RelevantIfOperatorType.Exists:
+      // exists: false can be used to check that a parameter is not set
+      return hasValue(value) === (condition.value ?? true);
+    default:
+      return false;
+  }
+}
+
+function listContains(list: any, value: any): boolean {
+  return isArray(list) && list.some((item) => isEqual(item, value));
+}
+
+function hasValue(value: any): boolean {
+  if (value == null) {
+    return false;
+  }
+  if (isString(value) || isArray(value)) {
+    return value.length > 0;
+  }
+  if (isNumber(value) || typeof value === "boolean") {
+    return true;
+  }
+  return !isEmpty(value);
+}
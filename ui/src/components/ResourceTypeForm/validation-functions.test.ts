@@ -1,4 +1,9 @@
-import { validateMapField, validateStringsField } from "./validation-functions";
+import { ParameterType } from "../../graphql/generated";
+import {
+  validateMapField,
+  validateObjectField,
+  validateStringsField,
+} from "./validation-functions";
 
 describe("validateStringsField", () => {
   it("[], required", () => {
@@ -23,3 +28,38 @@ describe("validateMapField", () => {
     expect(error).not.toBeNull();
   });
 });
+
+describe("validateObjectField", () => {
+  it("null, required => error", () => {
+    const error = validateObjectField(null, ParameterType.Object, true);
+    expect(error).not.toBeNull();
+  });
+
+  it("null, not required", () => {
+    const error = validateObjectField(null, ParameterType.Object, false);
+    expect(error).toBeNull();
+  });
+
+  it("array for object => error", () => {
+    const error = validateObjectField([], ParameterType.Object, false);
+    expect(error).not.toBeNull();
+  });
+
+  it("list of objects", () => {
+    const error = validateObjectField(
+      [{ name: "a" }, { name: "b" }],
+      ParameterType.Objects,
+      true
+    );
+    expect(error).toBeNull();
+  });
+
+  it("list with a string => error", () => {
+    const error = validateObjectField(
+      [{ name: "a" }, "b"],
+      ParameterType.Objects,
+      false
+    );
+    expect(error).not.toBeNull();
+  });
+});
@@ -1,4 +1,5 @@
-import { isEmpty } from "lodash";
+import { isArray, isEmpty, isPlainObject } from "lodash";
+import { ParameterType } from "../../graphql/generated";
 
 const REQUIRED_ERROR_MSG = "Required.";
 
@@ -42,3 +43,29 @@ export function validateMapField(
 
   return null;
 }
+
+export function validateObjectField(
+  value: any,
+  type: ParameterType,
+  required?: boolean
+): string | null {
+  if (value == null) {
+    return required ? REQUIRED_ERROR_MSG : null;
+  }
+
+  if (type === ParameterType.Objects) {
+    if (!isArray(value) || !value.every((item) => isPlainObject(item))) {
+      return "Must be a list of objects.";
+    }
+    if (required && isEmpty(value)) {
+      return REQUIRED_ERROR_MSG;
+    }
+    return null;
+  }
+
+  if (!isPlainObject(value)) {
+    return "Must be an object.";
+  }
+
+  return null;
+}
@@ -59,6 +59,18 @@ export type AgentConfiguration = {
   Manager?: Maybe<Scalars['Map']>;
 };
 
+export type AgentConnection = {
+  __typename?: 'AgentConnection';
+  edges: Array<AgentEdge>;
+  pageInfo: PageInfo;
+};
+
+export type AgentEdge = {
+  __typename?: 'AgentEdge';
+  cursor: Scalars['String'];
+  node: Agent;
+};
+
 export type AgentSelector = {
   __typename?: 'AgentSelector';
   matchLabels?: Maybe<Scalars['Map']>;
@@ -91,10 +103,23 @@ export type ConfigurationChange = {
   eventType: EventType;
 };
 
+export type ConfigurationConnection = {
+  __typename?: 'ConfigurationConnection';
+  edges: Array<ConfigurationEdge>;
+  pageInfo: PageInfo;
+};
+
+export type ConfigurationEdge = {
+  __typename?: 'ConfigurationEdge';
+  cursor: Scalars['String'];
+  node: Configuration;
+};
+
 export type ConfigurationSpec = {
   __typename?: 'ConfigurationSpec';
   contentType?: Maybe<Scalars['String']>;
   destinations?: Maybe<Array<ResourceConfiguration>>;
+  processors?: Maybe<Array<ResourceConfiguration>>;
   raw?: Maybe<Scalars['String']>;
   selector?: Maybe<AgentSelector>;
   sources?: Maybe<Array<ResourceConfiguration>>;
@@ -115,6 +140,24 @@ export type Destination = {
   spec: ParameterizedSpec;
 };
 
+export type DestinationChange = {
+  __typename?: 'DestinationChange';
+  destination: Destination;
+  eventType: EventType;
+};
+
+export type DestinationConnection = {
+  __typename?: 'DestinationConnection';
+  edges: Array<DestinationEdge>;
+  pageInfo: PageInfo;
+};
+
+export type DestinationEdge = {
+  __typename?: 'DestinationEdge';
+  cursor: Scalars['String'];
+  node: Destination;
+};
+
 export type DestinationType = {
   __typename?: 'DestinationType';
   apiVersion: Scalars['String'];
@@ -123,6 +166,18 @@ export type DestinationType = {
   spec: ResourceTypeSpec;
 };
 
+export type DestinationTypeConnection = {
+  __typename?: 'DestinationTypeConnection';
+  edges: Array<DestinationTypeEdge>;
+  pageInfo: PageInfo;
+};
+
+export type DestinationTypeEdge = {
+  __typename?: 'DestinationTypeEdge';
+  cursor: Scalars['String'];
+  node: DestinationType;
+};
+
 export type DestinationWithType = {
   __typename?: 'DestinationWithType';
   destination?: Maybe<Destination>;
@@ -143,6 +198,13 @@ export type Metadata = {
   id: Scalars['ID'];
   labels?: Maybe<Scalars['Map']>;
   name: Scalars['String'];
+  resourceVersion?: Maybe<Scalars['Int']>;
+};
+
+export type PageInfo = {
+  __typename?: 'PageInfo';
+  endCursor?: Maybe<Scalars['String']>;
+  hasNextPage: Scalars['Boolean'];
 };
 
 export type Parameter = {
@@ -156,7 +218,11 @@ export type ParameterDefinition = {
   default?: Maybe<Scalars['Any']>;
   description: Scalars['String'];
   label: Scalars['String'];
+  max?: Maybe<Scalars['Float']>;
+  min?: Maybe<Scalars['Float']>;
   name: Scalars['String'];
+  pattern?: Maybe<Scalars['String']>;
+  properties?: Maybe<Array<ParameterDefinition>>;
   relevantIf?: Maybe<Array<RelevantIfCondition>>;
   required: Scalars['Boolean'];
   type: ParameterType;
@@ -165,12 +231,21 @@ export type ParameterDefinition = {
 
 export enum ParameterType {
   Bool = 'bool',
+  ByteSize = 'byteSize',
+  Duration = 'duration',
   Enum = 'enum',
   Enums = 'enums',
+  FilePath = 'filePath',
+  Float = 'float',
+  HostPort = 'hostPort',
   Int = 'int',
   Map = 'map',
+  Object = 'object',
+  Objects = 'objects',
+  Regex = 'regex',
   String = 'string',
   Strings = 'strings',
+  Timezone = 'timezone',
   Yaml = 'yaml'
 }
 
@@ -194,6 +269,24 @@ export type Processor = {
   spec: ParameterizedSpec;
 };
 
+export type ProcessorChange = {
+  __typename?: 'ProcessorChange';
+  eventType: EventType;
+  processor: Processor;
+};
+
+export type ProcessorConnection = {
+  __typename?: 'ProcessorConnection';
+  edges: Array<ProcessorEdge>;
+  pageInfo: PageInfo;
+};
+
+export type ProcessorEdge = {
+  __typename?: 'ProcessorEdge';
+  cursor: Scalars['String'];
+  node: Processor;
+};
+
 export type ProcessorType = {
   __typename?: 'ProcessorType';
   apiVersion: Scalars['String'];
@@ -202,26 +295,46 @@ export type ProcessorType = {
   spec: ResourceTypeSpec;
 };
 
+export type ProcessorTypeConnection = {
+  __typename?: 'ProcessorTypeConnection';
+  edges: Array<ProcessorTypeEdge>;
+  pageInfo: PageInfo;
+};
+
+export type ProcessorTypeEdge = {
+  __typename?: 'ProcessorTypeEdge';
+  cursor: Scalars['String'];
+  node: ProcessorType;
+};
+
 export type Query = {
   __typename?: 'Query';
   agent?: Maybe<Agent>;
   agents: Agents;
+  agentsConnection: AgentConnection;
   components: Components;
   configuration?: Maybe<Configuration>;
   configurations: Configurations;
+  configurationsConnection: ConfigurationConnection;
   destination?: Maybe<Destination>;
   destinationType?: Maybe<DestinationType>;
   destinationTypes: Array<DestinationType>;
+  destinationTypesConnection: DestinationTypeConnection;
   destinationWithType: DestinationWithType;
   destinations: Array<Destination>;
+  destinationsConnection: DestinationConnection;
   processor?: Maybe<Processor>;
   processorType?: Maybe<ProcessorType>;
   processorTypes: Array<ProcessorType>;
+  processorTypesConnection: ProcessorTypeConnection;
   processors: Array<Processor>;
+  processorsConnection: ProcessorConnection;
   source?: Maybe<Source>;
   sourceType?: Maybe<SourceType>;
   sourceTypes: Array<SourceType>;
+  sourceTypesConnection: SourceTypeConnection;
   sources: Array<Source>;
+  sourcesConnection: SourceConnection;
 };
 
 
@@ -236,6 +349,16 @@ export type QueryAgentsArgs = {
 };
 
 
+export type QueryAgentsConnectionArgs = {
+  after?: InputMaybe<Scalars['String']>;
+  first?: InputMaybe<Scalars['Int']>;
+  order?: InputMaybe<SortOrder>;
+  query?: InputMaybe<Scalars['String']>;
+  selector?: InputMaybe<Scalars['String']>;
+  sort?: InputMaybe<Scalars['String']>;
+};
+
+
 export type QueryConfigurationArgs = {
   name: Scalars['String'];
 };
@@ -247,6 +370,16 @@ export type QueryConfigurationsArgs = {
 };
 
 
+export type QueryConfigurationsConnectionArgs = {
+  after?: InputMaybe<Scalars['String']>;
+  first?: InputMaybe<Scalars['Int']>;
+  order?: InputMaybe<SortOrder>;
+  query?: InputMaybe<Scalars['String']>;
+  selector?: InputMaybe<Scalars['String']>;
+  sort?: InputMaybe<Scalars['String']>;
+};
+
+
 export type QueryDestinationArgs = {
   name: Scalars['String'];
 };
@@ -257,11 +390,29 @@ export type QueryDestinationTypeArgs = {
 };
 
 
+export type QueryDestinationTypesConnectionArgs = {
+  after?: InputMaybe<Scalars['String']>;
+  first?: InputMaybe<Scalars['Int']>;
+  order?: InputMaybe<SortOrder>;
+  selector?: InputMaybe<Scalars['String']>;
+  sort?: InputMaybe<Scalars['String']>;
+};
+
+
 export type QueryDestinationWithTypeArgs = {
   name: Scalars['String'];
 };
 
 
+export type QueryDestinationsConnectionArgs = {
+  after?: InputMaybe<Scalars['String']>;
+  first?: InputMaybe<Scalars['Int']>;
+  order?: InputMaybe<SortOrder>;
+  selector?: InputMaybe<Scalars['String']>;
+  sort?: InputMaybe<Scalars['String']>;
+};
+
+
 export type QueryProcessorArgs = {
   name: Scalars['String'];
 };
@@ -272,6 +423,24 @@ export type QueryProcessorTypeArgs = {
 };
 
 
+export type QueryProcessorTypesConnectionArgs = {
+  after?: InputMaybe<Scalars['String']>;
+  first?: InputMaybe<Scalars['Int']>;
+  order?: InputMaybe<SortOrder>;
+  selector?: InputMaybe<Scalars['String']>;
+  sort?: InputMaybe<Scalars['String']>;
+};
+
+
+export type QueryProcessorsConnectionArgs = {
+  after?: InputMaybe<Scalars['String']>;
+  first?: InputMaybe<Scalars['Int']>;
+  order?: InputMaybe<SortOrder>;
+  selector?: InputMaybe<Scalars['String']>;
+  sort?: InputMaybe<Scalars['String']>;
+};
+
+
 export type QuerySourceArgs = {
   name: Scalars['String'];
 };
@@ -281,17 +450,55 @@ export type QuerySourceTypeArgs = {
   name: Scalars['String'];
 };
 
+
+export type QuerySourceTypesConnectionArgs = {
+  after?: InputMaybe<Scalars['String']>;
+  first?: InputMaybe<Scalars['Int']>;
+  order?: InputMaybe<SortOrder>;
+  selector?: InputMaybe<Scalars['String']>;
+  sort?: InputMaybe<Scalars['String']>;
+};
+
+
+export type QuerySourcesConnectionArgs = {
+  after?: InputMaybe<Scalars['String']>;
+  first?: InputMaybe<Scalars['Int']>;
+  order?: InputMaybe<SortOrder>;
+  selector?: InputMaybe<Scalars['String']>;
+  sort?: InputMaybe<Scalars['String']>;
+};
+
 export type RelevantIfCondition = {
   __typename?: 'RelevantIfCondition';
+  conditions?: Maybe<Array<RelevantIfCondition>>;
   name: Scalars['String'];
   operator: RelevantIfOperatorType;
-  value: Scalars['Any'];
+  value?: Maybe<Scalars['Any']>;
 };
 
 export enum RelevantIfOperatorType {
-  Equals = 'equals'
+  All = 'all',
+  Any = 'any',
+  ContainsAny = 'containsAny',
+  Equals = 'equals',
+  Exists = 'exists',
+  In = 'in',
+  NotEquals = 'notEquals',
+  NotIn = 'notIn'
 }
 
+export type Resource = {
+  apiVersion: Scalars['String'];
+  kind: Scalars['String'];
+  metadata: Metadata;
+};
+
+export type ResourceChange = {
+  __typename?: 'ResourceChange';
+  eventType: EventType;
+  resource: Resource;
+};
+
 export type ResourceConfiguration = {
   __typename?: 'ResourceConfiguration';
   name?: Maybe<Scalars['String']>;
@@ -308,6 +515,11 @@ export type ResourceTypeSpec = {
   version: Scalars['String'];
 };
 
+export enum SortOrder {
+  Asc = 'ASC',
+  Desc = 'DESC'
+}
+
 export type Source = {
   __typename?: 'Source';
   apiVersion: Scalars['String'];
@@ -316,6 +528,24 @@ export type Source = {
   spec: ParameterizedSpec;
 };
 
+export type SourceChange = {
+  __typename?: 'SourceChange';
+  eventType: EventType;
+  source: Source;
+};
+
+export type SourceConnection = {
+  __typename?: 'SourceConnection';
+  edges: Array<SourceEdge>;
+  pageInfo: PageInfo;
+};
+
+export type SourceEdge = {
+  __typename?: 'SourceEdge';
+  cursor: Scalars['String'];
+  node: Source;
+};
+
 export type SourceType = {
   __typename?: 'SourceType';
   apiVersion: Scalars['String'];
@@ -324,10 +554,27 @@ export type SourceType = {
   spec: ResourceTypeSpec;
 };
 
+export type SourceTypeConnection = {
+  __typename?: 'SourceTypeConnection';
+  edges: Array<SourceTypeEdge>;
+  pageInfo: PageInfo;
+};
+
+export type SourceTypeEdge = {
+  __typename?: 'SourceTypeEdge';
+  cursor: Scalars['String'];
+  node: SourceType;
+};
+
 export type Subscription = {
   __typename?: 'Subscription';
   agentChanges: Array<AgentChange>;
   configurationChanges: Array<ConfigurationChange>;
+  destinationChanges: Array<DestinationChange>;
+  processorChanges: Array<ProcessorChange>;
+  resourceChanges: Array<ResourceChange>;
+  resourceTypeChanges: Array<ResourceChange>;
+  sourceChanges: Array<SourceChange>;
 };
 
 
@@ -342,6 +589,27 @@ export type SubscriptionConfigurationChangesArgs = {
   selector?: InputMaybe<Scalars['String']>;
 };
 
+
+export type SubscriptionDestinationChangesArgs = {
+  selector?: InputMaybe<Scalars['String']>;
+};
+
+
+export type SubscriptionProcessorChangesArgs = {
+  selector?: InputMaybe<Scalars['String']>;
+};
+
+
+export type SubscriptionResourceChangesArgs = {
+  kinds?: InputMaybe<Array<Scalars['String']>>;
+  selector?: InputMaybe<Scalars['String']>;
+};
+
+
+export type SubscriptionSourceChangesArgs = {
+  selector?: InputMaybe<Scalars['String']>;
+};
+
 export type Suggestion = {
   __typename?: 'Suggestion';
   label: Scalars['String'];
@@ -416,14 +684,14 @@ export type DestinationTypeQueryVariables = Exact<{
 }>;
 
 
-export type DestinationTypeQuery = { __typename?: 'Query', destinationType?: { __typename?: 'DestinationType', metadata: { __typename?: 'Metadata', displayName?: string | null, name: string, icon?: string | null, description?: string | null }, spec: { __typename?: 'ResourceTypeSpec', parameters: Array<{ __typename?: 'ParameterDefinition', label: string, name: string, description: string, required: boolean, type: ParameterType, default?: any | null, validValues?: Array<string> | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value: any }> | null }> } } | null };
+export type DestinationTypeQuery = { __typename?: 'Query', destinationType?: { __typename?: 'DestinationType', metadata: { __typename?: 'Metadata', displayName?: string | null, name: string, icon?: string | null, description?: string | null }, spec: { __typename?: 'ResourceTypeSpec', parameters: Array<{ __typename?: 'ParameterDefinition', label: string, name: string, description: string, required: boolean, type: ParameterType, default?: any | null, validValues?: Array<string> | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value?: any | null, conditions?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value?: any | null, conditions?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value?: any | null }> | null }> | null }> | null }> } } | null };
 
 export type GetDestinationWithTypeQueryVariables = Exact<{
   name: Scalars['String'];
 }>;
 
 
-export type GetDestinationWithTypeQuery = { __typename?: 'Query', destinationWithType: { __typename?: 'DestinationWithType', destination?: { __typename?: 'Destination', metadata: { __typename?: 'Metadata', name: string, id: string, labels?: any | null }, spec: { __typename?: 'ParameterizedSpec', type: string, parameters?: Array<{ __typename?: 'Parameter', name: string, value: any }> | null } } | null, destinationType?: { __typename?: 'DestinationType', metadata: { __typename?: 'Metadata', name: string, icon?: string | null }, spec: { __typename?: 'ResourceTypeSpec', parameters: Array<{ __typename?: 'ParameterDefinition', label: string, name: string, description: string, required: boolean, type: ParameterType, default?: any | null, validValues?: Array<string> | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value: any }> | null }> } } | null } };
+export type GetDestinationWithTypeQuery = { __typename?: 'Query', destinationWithType: { __typename?: 'DestinationWithType', destination?: { __typename?: 'Destination', metadata: { __typename?: 'Metadata', name: string, id: string, labels?: any | null }, spec: { __typename?: 'ParameterizedSpec', type: string, parameters?: Array<{ __typename?: 'Parameter', name: string, value: any }> | null } } | null, destinationType?: { __typename?: 'DestinationType', metadata: { __typename?: 'Metadata', name: string, icon?: string | null }, spec: { __typename?: 'ResourceTypeSpec', parameters: Array<{ __typename?: 'ParameterDefinition', label: string, name: string, description: string, required: boolean, type: ParameterType, default?: any | null, validValues?: Array<string> | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value?: any | null, conditions?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value?: any | null, conditions?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value?: any | null }> | null }> | null }> | null }> } } | null } };
 
 export type SourceTypeQueryVariables = Exact<{
   name: Scalars['String'];
@@ -442,12 +710,12 @@ export type GetConfigurationQuery = { __typename?: 'Query', configuration?: { __
 export type DestinationsAndTypesQueryVariables = Exact<{ [key: string]: never; }>;
 
 
-export type DestinationsAndTypesQuery = { __typename?: 'Query', destinationTypes: Array<{ __typename?: 'DestinationType', kind: string, apiVersion: string, metadata: { __typename?: 'Metadata', id: string, name: string, displayName?: string | null, description?: string | null, icon?: string | null }, spec: { __typename?: 'ResourceTypeSpec', version: string, supportedPlatforms: Array<string>, telemetryTypes: Array<PipelineType>, parameters: Array<{ __typename?: 'ParameterDefinition', label: string, type: ParameterType, name: string, description: string, default?: any | null, validValues?: Array<string> | null, required: boolean, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, value: any, operator: RelevantIfOperatorType }> | null }> } }>, destinations: Array<{ __typename?: 'Destination', metadata: { __typename?: 'Metadata', name: string }, spec: { __typename?: 'ParameterizedSpec', type: string, parameters?: Array<{ __typename?: 'Parameter', name: string, value: any }> | null } }> };
+export type DestinationsAndTypesQuery = { __typename?: 'Query', destinationTypes: Array<{ __typename?: 'DestinationType', kind: string, apiVersion: string, metadata: { __typename?: 'Metadata', id: string, name: string, displayName?: string | null, description?: string | null, icon?: string | null }, spec: { __typename?: 'ResourceTypeSpec', version: string, supportedPlatforms: Array<string>, telemetryTypes: Array<PipelineType>, parameters: Array<{ __typename?: 'ParameterDefinition', label: string, type: ParameterType, name: string, description: string, default?: any | null, validValues?: Array<string> | null, required: boolean, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, value?: any | null, operator: RelevantIfOperatorType, conditions?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value?: any | null, conditions?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value?: any | null }> | null }> | null }> | null }> } }>, destinations: Array<{ __typename?: 'Destination', metadata: { __typename?: 'Metadata', name: string }, spec: { __typename?: 'ParameterizedSpec', type: string, parameters?: Array<{ __typename?: 'Parameter', name: string, value: any }> | null } }> };
 
 export type SourceTypesQueryVariables = Exact<{ [key: string]: never; }>;
 
 
-export type SourceTypesQuery = { __typename?: 'Query', sourceTypes: Array<{ __typename?: 'SourceType', apiVersion: string, kind: string, metadata: { __typename?: 'Metadata', id: string, name: string, displayName?: string | null, description?: string | null, icon?: string | null }, spec: { __typename?: 'ResourceTypeSpec', supportedPlatforms: Array<string>, version: string, telemetryTypes: Array<PipelineType>, parameters: Array<{ __typename?: 'ParameterDefinition', name: string, label: string, description: string, required: boolean, type: ParameterType, validValues?: Array<string> | null, default?: any | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value: any }> | null }> } }> };
+export type SourceTypesQuery = { __typename?: 'Query', sourceTypes: Array<{ __typename?: 'SourceType', apiVersion: string, kind: string, metadata: { __typename?: 'Metadata', id: string, name: string, displayName?: string | null, description?: string | null, icon?: string | null }, spec: { __typename?: 'ResourceTypeSpec', supportedPlatforms: Array<string>, version: string, telemetryTypes: Array<PipelineType>, parameters: Array<{ __typename?: 'ParameterDefinition', name: string, label: string, description: string, required: boolean, type: ParameterType, validValues?: Array<string> | null, default?: any | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value?: any | null, conditions?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value?: any | null, conditions?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value?: any | null }> | null }> | null }> | null }> } }> };
 
 export type GetConfigNamesQueryVariables = Exact<{ [key: string]: never; }>;
 
@@ -924,6 +1192,16 @@ export const DestinationTypeDocument = gql`
           name
           operator
           value
+          conditions {
+            name
+            operator
+            value
+            conditions {
+              name
+              operator
+              value
+            }
+          }
         }
         validValues
       }
@@ -993,6 +1271,16 @@ export const GetDestinationWithTypeDocument = gql`
             name
             operator
             value
+            conditions {
+              name
+              operator
+              value
+              conditions {
+                name
+                operator
+                value
+              }
+            }
           }
           validValues
         }
@@ -1155,6 +1443,16 @@ export const DestinationsAndTypesDocument = gql`
           name
           value
           operator
+          conditions {
+            name
+            operator
+            value
+            conditions {
+              name
+              operator
+              value
+            }
+          }
         }
         required
       }
@@ -1224,6 +1522,16 @@ export const SourceTypesDocument = gql`
           name
           operator
           value
+          conditions {
+            name
+            operator
+            value
+            conditions {
+              name
+              operator
+              value
+            }
+          }
         }
         required
         type
@@ -37,6 +37,16 @@ gql`
             name
             operator
             value
+            conditions {
+              name
+              operator
+              value
+              conditions {
+                name
+                operator
+                value
+              }
+            }
           }
           validValues
         }
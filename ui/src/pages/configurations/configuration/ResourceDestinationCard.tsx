@@ -48,6 +48,16 @@ gql`
               name
               operator
               value
+              conditions {
+                name
+                operator
+                value
+                conditions {
+                  name
+                  operator
+                  value
+                }
+              }
             }
             validValues
           }
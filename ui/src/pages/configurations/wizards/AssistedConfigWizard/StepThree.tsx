@@ -70,6 +70,16 @@ gql`
             name
             value
             operator
+            conditions {
+              name
+              operator
+              value
+              conditions {
+                name
+                operator
+                value
+              }
+            }
           }
           required
         }
@@ -54,6 +54,16 @@ gql`
             name
             operator
             value
+            conditions {
+              name
+              operator
+              value
+              conditions {
+                name
+                operator
+                value
+              }
+            }
           }
           required
           type